@@ -207,13 +207,53 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	SessionService_GetSessionList_FullMethodName     = "/resonance.gateway.v1.SessionService/GetSessionList"
-	SessionService_CreateSession_FullMethodName      = "/resonance.gateway.v1.SessionService/CreateSession"
-	SessionService_GetHistoryMessages_FullMethodName = "/resonance.gateway.v1.SessionService/GetHistoryMessages"
-	SessionService_GetContactList_FullMethodName     = "/resonance.gateway.v1.SessionService/GetContactList"
-	SessionService_SearchUser_FullMethodName         = "/resonance.gateway.v1.SessionService/SearchUser"
-	SessionService_UpdateReadPosition_FullMethodName = "/resonance.gateway.v1.SessionService/UpdateReadPosition"
-	SessionService_PullInboxDelta_FullMethodName     = "/resonance.gateway.v1.SessionService/PullInboxDelta"
+	SessionService_GetSessionList_FullMethodName         = "/resonance.gateway.v1.SessionService/GetSessionList"
+	SessionService_CreateSession_FullMethodName          = "/resonance.gateway.v1.SessionService/CreateSession"
+	SessionService_GetHistoryMessages_FullMethodName     = "/resonance.gateway.v1.SessionService/GetHistoryMessages"
+	SessionService_GetContactList_FullMethodName         = "/resonance.gateway.v1.SessionService/GetContactList"
+	SessionService_SearchUser_FullMethodName             = "/resonance.gateway.v1.SessionService/SearchUser"
+	SessionService_UpdateReadPosition_FullMethodName     = "/resonance.gateway.v1.SessionService/UpdateReadPosition"
+	SessionService_PullInboxDelta_FullMethodName         = "/resonance.gateway.v1.SessionService/PullInboxDelta"
+	SessionService_RecallMessage_FullMethodName          = "/resonance.gateway.v1.SessionService/RecallMessage"
+	SessionService_EditMessage_FullMethodName            = "/resonance.gateway.v1.SessionService/EditMessage"
+	SessionService_GetThreadMessages_FullMethodName      = "/resonance.gateway.v1.SessionService/GetThreadMessages"
+	SessionService_FollowThread_FullMethodName           = "/resonance.gateway.v1.SessionService/FollowThread"
+	SessionService_UnfollowThread_FullMethodName         = "/resonance.gateway.v1.SessionService/UnfollowThread"
+	SessionService_AddReaction_FullMethodName            = "/resonance.gateway.v1.SessionService/AddReaction"
+	SessionService_RemoveReaction_FullMethodName         = "/resonance.gateway.v1.SessionService/RemoveReaction"
+	SessionService_CastVote_FullMethodName               = "/resonance.gateway.v1.SessionService/CastVote"
+	SessionService_ClosePoll_FullMethodName              = "/resonance.gateway.v1.SessionService/ClosePoll"
+	SessionService_GetMessageReadStatus_FullMethodName   = "/resonance.gateway.v1.SessionService/GetMessageReadStatus"
+	SessionService_ForwardMessages_FullMethodName        = "/resonance.gateway.v1.SessionService/ForwardMessages"
+	SessionService_PinMessage_FullMethodName             = "/resonance.gateway.v1.SessionService/PinMessage"
+	SessionService_UnpinMessage_FullMethodName           = "/resonance.gateway.v1.SessionService/UnpinMessage"
+	SessionService_ListPinnedMessages_FullMethodName     = "/resonance.gateway.v1.SessionService/ListPinnedMessages"
+	SessionService_SetMessageTTL_FullMethodName          = "/resonance.gateway.v1.SessionService/SetMessageTTL"
+	SessionService_SearchMessages_FullMethodName         = "/resonance.gateway.v1.SessionService/SearchMessages"
+	SessionService_DeleteMessagesForMe_FullMethodName    = "/resonance.gateway.v1.SessionService/DeleteMessagesForMe"
+	SessionService_AddMembers_FullMethodName             = "/resonance.gateway.v1.SessionService/AddMembers"
+	SessionService_RemoveMember_FullMethodName           = "/resonance.gateway.v1.SessionService/RemoveMember"
+	SessionService_LeaveSession_FullMethodName           = "/resonance.gateway.v1.SessionService/LeaveSession"
+	SessionService_RenameSession_FullMethodName          = "/resonance.gateway.v1.SessionService/RenameSession"
+	SessionService_PromoteAdmin_FullMethodName           = "/resonance.gateway.v1.SessionService/PromoteAdmin"
+	SessionService_DemoteAdmin_FullMethodName            = "/resonance.gateway.v1.SessionService/DemoteAdmin"
+	SessionService_TransferOwnership_FullMethodName      = "/resonance.gateway.v1.SessionService/TransferOwnership"
+	SessionService_MuteMember_FullMethodName             = "/resonance.gateway.v1.SessionService/MuteMember"
+	SessionService_UnmuteMember_FullMethodName           = "/resonance.gateway.v1.SessionService/UnmuteMember"
+	SessionService_SetDoNotDisturb_FullMethodName        = "/resonance.gateway.v1.SessionService/SetDoNotDisturb"
+	SessionService_ScheduleMessage_FullMethodName        = "/resonance.gateway.v1.SessionService/ScheduleMessage"
+	SessionService_ListScheduledMessages_FullMethodName  = "/resonance.gateway.v1.SessionService/ListScheduledMessages"
+	SessionService_UpdateScheduledMessage_FullMethodName = "/resonance.gateway.v1.SessionService/UpdateScheduledMessage"
+	SessionService_CancelScheduledMessage_FullMethodName = "/resonance.gateway.v1.SessionService/CancelScheduledMessage"
+	SessionService_Announce_FullMethodName               = "/resonance.gateway.v1.SessionService/Announce"
+	SessionService_ListAnnouncements_FullMethodName      = "/resonance.gateway.v1.SessionService/ListAnnouncements"
+	SessionService_CreateWebhook_FullMethodName          = "/resonance.gateway.v1.SessionService/CreateWebhook"
+	SessionService_ListWebhooks_FullMethodName           = "/resonance.gateway.v1.SessionService/ListWebhooks"
+	SessionService_DeleteWebhook_FullMethodName          = "/resonance.gateway.v1.SessionService/DeleteWebhook"
+	SessionService_ListWebhookDeliveries_FullMethodName  = "/resonance.gateway.v1.SessionService/ListWebhookDeliveries"
+	SessionService_CreateBot_FullMethodName              = "/resonance.gateway.v1.SessionService/CreateBot"
+	SessionService_ListBots_FullMethodName               = "/resonance.gateway.v1.SessionService/ListBots"
+	SessionService_RotateBotToken_FullMethodName         = "/resonance.gateway.v1.SessionService/RotateBotToken"
 )
 
 // SessionServiceClient is the client API for SessionService service.
@@ -236,6 +276,86 @@ type SessionServiceClient interface {
 	UpdateReadPosition(ctx context.Context, in *UpdateReadPositionRequest, opts ...grpc.CallOption) (*UpdateReadPositionResponse, error)
 	// PullInboxDelta 按用户游标增量拉取消息
 	PullInboxDelta(ctx context.Context, in *PullInboxDeltaRequest, opts ...grpc.CallOption) (*PullInboxDeltaResponse, error)
+	// RecallMessage 撤回消息（发送者在时间窗口内，或群管理员）
+	RecallMessage(ctx context.Context, in *RecallMessageRequest, opts ...grpc.CallOption) (*RecallMessageResponse, error)
+	// EditMessage 编辑消息（仅发送者，且在时间窗口内）
+	EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*EditMessageResponse, error)
+	// GetThreadMessages 拉取话题消息
+	GetThreadMessages(ctx context.Context, in *GetThreadMessagesRequest, opts ...grpc.CallOption) (*GetThreadMessagesResponse, error)
+	// FollowThread 关注话题
+	FollowThread(ctx context.Context, in *FollowThreadRequest, opts ...grpc.CallOption) (*FollowThreadResponse, error)
+	// UnfollowThread 取消关注话题
+	UnfollowThread(ctx context.Context, in *UnfollowThreadRequest, opts ...grpc.CallOption) (*UnfollowThreadResponse, error)
+	// AddReaction 添加表情回应
+	AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*AddReactionResponse, error)
+	// RemoveReaction 取消表情回应
+	RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error)
+	// CastVote 在投票消息中投票
+	CastVote(ctx context.Context, in *CastVoteRequest, opts ...grpc.CallOption) (*CastVoteResponse, error)
+	// ClosePoll 提前结束投票（发起者或群管理员）
+	ClosePoll(ctx context.Context, in *ClosePollRequest, opts ...grpc.CallOption) (*ClosePollResponse, error)
+	// GetMessageReadStatus 获取消息的已读/未读成员（仅发送者可查）
+	GetMessageReadStatus(ctx context.Context, in *GetMessageReadStatusRequest, opts ...grpc.CallOption) (*GetMessageReadStatusResponse, error)
+	// ForwardMessages 逐条或合并转发消息到其他会话
+	ForwardMessages(ctx context.Context, in *ForwardMessagesRequest, opts ...grpc.CallOption) (*ForwardMessagesResponse, error)
+	// PinMessage 置顶消息（群聊仅管理员可操作）
+	PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error)
+	// UnpinMessage 取消置顶
+	UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error)
+	// ListPinnedMessages 获取会话的置顶消息
+	ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error)
+	// SetMessageTTL 设置会话消息的保留时长（阅后即焚）
+	SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*SetMessageTTLResponse, error)
+	// SearchMessages 全文搜索当前用户所在会话的历史消息
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
+	// DeleteMessagesForMe 仅对自己删除消息或清空聊天记录，并同步到本人其他设备
+	DeleteMessagesForMe(ctx context.Context, in *DeleteMessagesForMeRequest, opts ...grpc.CallOption) (*DeleteMessagesForMeResponse, error)
+	// AddMembers 邀请用户加入群聊（仅管理员）
+	AddMembers(ctx context.Context, in *AddMembersRequest, opts ...grpc.CallOption) (*AddMembersResponse, error)
+	// RemoveMember 将成员移出群聊（仅管理员）
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
+	// LeaveSession 主动退出群聊
+	LeaveSession(ctx context.Context, in *LeaveSessionRequest, opts ...grpc.CallOption) (*LeaveSessionResponse, error)
+	// RenameSession 修改群名称（管理员及群主）
+	RenameSession(ctx context.Context, in *RenameSessionRequest, opts ...grpc.CallOption) (*RenameSessionResponse, error)
+	// PromoteAdmin 将成员设为管理员（仅群主）
+	PromoteAdmin(ctx context.Context, in *PromoteAdminRequest, opts ...grpc.CallOption) (*PromoteAdminResponse, error)
+	// DemoteAdmin 取消成员的管理员身份（仅群主）
+	DemoteAdmin(ctx context.Context, in *DemoteAdminRequest, opts ...grpc.CallOption) (*DemoteAdminResponse, error)
+	// TransferOwnership 转让群主（仅群主）
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error)
+	// MuteMember 禁言成员（管理员及群主）
+	MuteMember(ctx context.Context, in *MuteMemberRequest, opts ...grpc.CallOption) (*MuteMemberResponse, error)
+	// UnmuteMember 解除成员的禁言（管理员及群主）
+	UnmuteMember(ctx context.Context, in *UnmuteMemberRequest, opts ...grpc.CallOption) (*UnmuteMemberResponse, error)
+	// SetDoNotDisturb 设置会话免打扰（仅影响当前用户）
+	SetDoNotDisturb(ctx context.Context, in *SetDoNotDisturbRequest, opts ...grpc.CallOption) (*SetDoNotDisturbResponse, error)
+	// ScheduleMessage 创建定时消息
+	ScheduleMessage(ctx context.Context, in *ScheduleMessageRequest, opts ...grpc.CallOption) (*ScheduleMessageResponse, error)
+	// ListScheduledMessages 获取当前用户的定时消息
+	ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error)
+	// UpdateScheduledMessage 编辑待发送的定时消息
+	UpdateScheduledMessage(ctx context.Context, in *UpdateScheduledMessageRequest, opts ...grpc.CallOption) (*UpdateScheduledMessageResponse, error)
+	// CancelScheduledMessage 取消待发送的定时消息
+	CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error)
+	// Announce 发布系统公告（仅系统管理员）
+	Announce(ctx context.Context, in *AnnounceRequest, opts ...grpc.CallOption) (*AnnounceResponse, error)
+	// ListAnnouncements 获取离线期间错过的系统公告
+	ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error)
+	// CreateWebhook 为会话创建出站 Webhook 订阅
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	// ListWebhooks 列出会话的 Webhook 订阅
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// DeleteWebhook 删除 Webhook 订阅
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	// ListWebhookDeliveries 查看 Webhook 投递日志
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// CreateBot 创建机器人账号，返回其 API Token（仅此一次）
+	CreateBot(ctx context.Context, in *CreateBotRequest, opts ...grpc.CallOption) (*CreateBotResponse, error)
+	// ListBots 列出当前用户创建的机器人账号
+	ListBots(ctx context.Context, in *ListBotsRequest, opts ...grpc.CallOption) (*ListBotsResponse, error)
+	// RotateBotToken 轮换机器人的 API Token，旧 Token 立即失效
+	RotateBotToken(ctx context.Context, in *RotateBotTokenRequest, opts ...grpc.CallOption) (*RotateBotTokenResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -316,6 +436,406 @@ func (c *sessionServiceClient) PullInboxDelta(ctx context.Context, in *PullInbox
 	return out, nil
 }
 
+func (c *sessionServiceClient) RecallMessage(ctx context.Context, in *RecallMessageRequest, opts ...grpc.CallOption) (*RecallMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecallMessageResponse)
+	err := c.cc.Invoke(ctx, SessionService_RecallMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*EditMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditMessageResponse)
+	err := c.cc.Invoke(ctx, SessionService_EditMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) GetThreadMessages(ctx context.Context, in *GetThreadMessagesRequest, opts ...grpc.CallOption) (*GetThreadMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetThreadMessagesResponse)
+	err := c.cc.Invoke(ctx, SessionService_GetThreadMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) FollowThread(ctx context.Context, in *FollowThreadRequest, opts ...grpc.CallOption) (*FollowThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowThreadResponse)
+	err := c.cc.Invoke(ctx, SessionService_FollowThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) UnfollowThread(ctx context.Context, in *UnfollowThreadRequest, opts ...grpc.CallOption) (*UnfollowThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowThreadResponse)
+	err := c.cc.Invoke(ctx, SessionService_UnfollowThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*AddReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddReactionResponse)
+	err := c.cc.Invoke(ctx, SessionService_AddReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveReactionResponse)
+	err := c.cc.Invoke(ctx, SessionService_RemoveReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) CastVote(ctx context.Context, in *CastVoteRequest, opts ...grpc.CallOption) (*CastVoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CastVoteResponse)
+	err := c.cc.Invoke(ctx, SessionService_CastVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ClosePoll(ctx context.Context, in *ClosePollRequest, opts ...grpc.CallOption) (*ClosePollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClosePollResponse)
+	err := c.cc.Invoke(ctx, SessionService_ClosePoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) GetMessageReadStatus(ctx context.Context, in *GetMessageReadStatusRequest, opts ...grpc.CallOption) (*GetMessageReadStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMessageReadStatusResponse)
+	err := c.cc.Invoke(ctx, SessionService_GetMessageReadStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ForwardMessages(ctx context.Context, in *ForwardMessagesRequest, opts ...grpc.CallOption) (*ForwardMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForwardMessagesResponse)
+	err := c.cc.Invoke(ctx, SessionService_ForwardMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinMessageResponse)
+	err := c.cc.Invoke(ctx, SessionService_PinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpinMessageResponse)
+	err := c.cc.Invoke(ctx, SessionService_UnpinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPinnedMessagesResponse)
+	err := c.cc.Invoke(ctx, SessionService_ListPinnedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*SetMessageTTLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMessageTTLResponse)
+	err := c.cc.Invoke(ctx, SessionService_SetMessageTTL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMessagesResponse)
+	err := c.cc.Invoke(ctx, SessionService_SearchMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) DeleteMessagesForMe(ctx context.Context, in *DeleteMessagesForMeRequest, opts ...grpc.CallOption) (*DeleteMessagesForMeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMessagesForMeResponse)
+	err := c.cc.Invoke(ctx, SessionService_DeleteMessagesForMe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) AddMembers(ctx context.Context, in *AddMembersRequest, opts ...grpc.CallOption) (*AddMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddMembersResponse)
+	err := c.cc.Invoke(ctx, SessionService_AddMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMemberResponse)
+	err := c.cc.Invoke(ctx, SessionService_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) LeaveSession(ctx context.Context, in *LeaveSessionRequest, opts ...grpc.CallOption) (*LeaveSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveSessionResponse)
+	err := c.cc.Invoke(ctx, SessionService_LeaveSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RenameSession(ctx context.Context, in *RenameSessionRequest, opts ...grpc.CallOption) (*RenameSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameSessionResponse)
+	err := c.cc.Invoke(ctx, SessionService_RenameSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) PromoteAdmin(ctx context.Context, in *PromoteAdminRequest, opts ...grpc.CallOption) (*PromoteAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoteAdminResponse)
+	err := c.cc.Invoke(ctx, SessionService_PromoteAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) DemoteAdmin(ctx context.Context, in *DemoteAdminRequest, opts ...grpc.CallOption) (*DemoteAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DemoteAdminResponse)
+	err := c.cc.Invoke(ctx, SessionService_DemoteAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, SessionService_TransferOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) MuteMember(ctx context.Context, in *MuteMemberRequest, opts ...grpc.CallOption) (*MuteMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteMemberResponse)
+	err := c.cc.Invoke(ctx, SessionService_MuteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) UnmuteMember(ctx context.Context, in *UnmuteMemberRequest, opts ...grpc.CallOption) (*UnmuteMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmuteMemberResponse)
+	err := c.cc.Invoke(ctx, SessionService_UnmuteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) SetDoNotDisturb(ctx context.Context, in *SetDoNotDisturbRequest, opts ...grpc.CallOption) (*SetDoNotDisturbResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDoNotDisturbResponse)
+	err := c.cc.Invoke(ctx, SessionService_SetDoNotDisturb_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ScheduleMessage(ctx context.Context, in *ScheduleMessageRequest, opts ...grpc.CallOption) (*ScheduleMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleMessageResponse)
+	err := c.cc.Invoke(ctx, SessionService_ScheduleMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, SessionService_ListScheduledMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) UpdateScheduledMessage(ctx context.Context, in *UpdateScheduledMessageRequest, opts ...grpc.CallOption) (*UpdateScheduledMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateScheduledMessageResponse)
+	err := c.cc.Invoke(ctx, SessionService_UpdateScheduledMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledMessageResponse)
+	err := c.cc.Invoke(ctx, SessionService_CancelScheduledMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) Announce(ctx context.Context, in *AnnounceRequest, opts ...grpc.CallOption) (*AnnounceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnnounceResponse)
+	err := c.cc.Invoke(ctx, SessionService_Announce_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, SessionService_ListAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, SessionService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, SessionService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, SessionService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, SessionService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) CreateBot(ctx context.Context, in *CreateBotRequest, opts ...grpc.CallOption) (*CreateBotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBotResponse)
+	err := c.cc.Invoke(ctx, SessionService_CreateBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListBots(ctx context.Context, in *ListBotsRequest, opts ...grpc.CallOption) (*ListBotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBotsResponse)
+	err := c.cc.Invoke(ctx, SessionService_ListBots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RotateBotToken(ctx context.Context, in *RotateBotTokenRequest, opts ...grpc.CallOption) (*RotateBotTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateBotTokenResponse)
+	err := c.cc.Invoke(ctx, SessionService_RotateBotToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility.
@@ -336,180 +856,1100 @@ type SessionServiceServer interface {
 	UpdateReadPosition(context.Context, *UpdateReadPositionRequest) (*UpdateReadPositionResponse, error)
 	// PullInboxDelta 按用户游标增量拉取消息
 	PullInboxDelta(context.Context, *PullInboxDeltaRequest) (*PullInboxDeltaResponse, error)
+	// RecallMessage 撤回消息（发送者在时间窗口内，或群管理员）
+	RecallMessage(context.Context, *RecallMessageRequest) (*RecallMessageResponse, error)
+	// EditMessage 编辑消息（仅发送者，且在时间窗口内）
+	EditMessage(context.Context, *EditMessageRequest) (*EditMessageResponse, error)
+	// GetThreadMessages 拉取话题消息
+	GetThreadMessages(context.Context, *GetThreadMessagesRequest) (*GetThreadMessagesResponse, error)
+	// FollowThread 关注话题
+	FollowThread(context.Context, *FollowThreadRequest) (*FollowThreadResponse, error)
+	// UnfollowThread 取消关注话题
+	UnfollowThread(context.Context, *UnfollowThreadRequest) (*UnfollowThreadResponse, error)
+	// AddReaction 添加表情回应
+	AddReaction(context.Context, *AddReactionRequest) (*AddReactionResponse, error)
+	// RemoveReaction 取消表情回应
+	RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error)
+	// CastVote 在投票消息中投票
+	CastVote(context.Context, *CastVoteRequest) (*CastVoteResponse, error)
+	// ClosePoll 提前结束投票（发起者或群管理员）
+	ClosePoll(context.Context, *ClosePollRequest) (*ClosePollResponse, error)
+	// GetMessageReadStatus 获取消息的已读/未读成员（仅发送者可查）
+	GetMessageReadStatus(context.Context, *GetMessageReadStatusRequest) (*GetMessageReadStatusResponse, error)
+	// ForwardMessages 逐条或合并转发消息到其他会话
+	ForwardMessages(context.Context, *ForwardMessagesRequest) (*ForwardMessagesResponse, error)
+	// PinMessage 置顶消息（群聊仅管理员可操作）
+	PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error)
+	// UnpinMessage 取消置顶
+	UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error)
+	// ListPinnedMessages 获取会话的置顶消息
+	ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error)
+	// SetMessageTTL 设置会话消息的保留时长（阅后即焚）
+	SetMessageTTL(context.Context, *SetMessageTTLRequest) (*SetMessageTTLResponse, error)
+	// SearchMessages 全文搜索当前用户所在会话的历史消息
+	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
+	// DeleteMessagesForMe 仅对自己删除消息或清空聊天记录，并同步到本人其他设备
+	DeleteMessagesForMe(context.Context, *DeleteMessagesForMeRequest) (*DeleteMessagesForMeResponse, error)
+	// AddMembers 邀请用户加入群聊（仅管理员）
+	AddMembers(context.Context, *AddMembersRequest) (*AddMembersResponse, error)
+	// RemoveMember 将成员移出群聊（仅管理员）
+	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
+	// LeaveSession 主动退出群聊
+	LeaveSession(context.Context, *LeaveSessionRequest) (*LeaveSessionResponse, error)
+	// RenameSession 修改群名称（管理员及群主）
+	RenameSession(context.Context, *RenameSessionRequest) (*RenameSessionResponse, error)
+	// PromoteAdmin 将成员设为管理员（仅群主）
+	PromoteAdmin(context.Context, *PromoteAdminRequest) (*PromoteAdminResponse, error)
+	// DemoteAdmin 取消成员的管理员身份（仅群主）
+	DemoteAdmin(context.Context, *DemoteAdminRequest) (*DemoteAdminResponse, error)
+	// TransferOwnership 转让群主（仅群主）
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error)
+	// MuteMember 禁言成员（管理员及群主）
+	MuteMember(context.Context, *MuteMemberRequest) (*MuteMemberResponse, error)
+	// UnmuteMember 解除成员的禁言（管理员及群主）
+	UnmuteMember(context.Context, *UnmuteMemberRequest) (*UnmuteMemberResponse, error)
+	// SetDoNotDisturb 设置会话免打扰（仅影响当前用户）
+	SetDoNotDisturb(context.Context, *SetDoNotDisturbRequest) (*SetDoNotDisturbResponse, error)
+	// ScheduleMessage 创建定时消息
+	ScheduleMessage(context.Context, *ScheduleMessageRequest) (*ScheduleMessageResponse, error)
+	// ListScheduledMessages 获取当前用户的定时消息
+	ListScheduledMessages(context.Context, *ListScheduledMessagesRequest) (*ListScheduledMessagesResponse, error)
+	// UpdateScheduledMessage 编辑待发送的定时消息
+	UpdateScheduledMessage(context.Context, *UpdateScheduledMessageRequest) (*UpdateScheduledMessageResponse, error)
+	// CancelScheduledMessage 取消待发送的定时消息
+	CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*CancelScheduledMessageResponse, error)
+	// Announce 发布系统公告（仅系统管理员）
+	Announce(context.Context, *AnnounceRequest) (*AnnounceResponse, error)
+	// ListAnnouncements 获取离线期间错过的系统公告
+	ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*ListAnnouncementsResponse, error)
+	// CreateWebhook 为会话创建出站 Webhook 订阅
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	// ListWebhooks 列出会话的 Webhook 订阅
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// DeleteWebhook 删除 Webhook 订阅
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	// ListWebhookDeliveries 查看 Webhook 投递日志
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// CreateBot 创建机器人账号，返回其 API Token（仅此一次）
+	CreateBot(context.Context, *CreateBotRequest) (*CreateBotResponse, error)
+	// ListBots 列出当前用户创建的机器人账号
+	ListBots(context.Context, *ListBotsRequest) (*ListBotsResponse, error)
+	// RotateBotToken 轮换机器人的 API Token，旧 Token 立即失效
+	RotateBotToken(context.Context, *RotateBotTokenRequest) (*RotateBotTokenResponse, error)
+	mustEmbedUnimplementedSessionServiceServer()
+}
+
+// UnimplementedSessionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSessionServiceServer struct{}
+
+func (UnimplementedSessionServiceServer) GetSessionList(context.Context, *GetSessionListRequest) (*GetSessionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionList not implemented")
+}
+func (UnimplementedSessionServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+}
+func (UnimplementedSessionServiceServer) GetHistoryMessages(context.Context, *GetHistoryMessagesRequest) (*GetHistoryMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistoryMessages not implemented")
+}
+func (UnimplementedSessionServiceServer) GetContactList(context.Context, *GetContactListRequest) (*GetContactListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContactList not implemented")
+}
+func (UnimplementedSessionServiceServer) SearchUser(context.Context, *SearchUserRequest) (*SearchUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUser not implemented")
+}
+func (UnimplementedSessionServiceServer) UpdateReadPosition(context.Context, *UpdateReadPositionRequest) (*UpdateReadPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReadPosition not implemented")
+}
+func (UnimplementedSessionServiceServer) PullInboxDelta(context.Context, *PullInboxDeltaRequest) (*PullInboxDeltaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullInboxDelta not implemented")
+}
+func (UnimplementedSessionServiceServer) RecallMessage(context.Context, *RecallMessageRequest) (*RecallMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecallMessage not implemented")
+}
+func (UnimplementedSessionServiceServer) EditMessage(context.Context, *EditMessageRequest) (*EditMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditMessage not implemented")
+}
+func (UnimplementedSessionServiceServer) GetThreadMessages(context.Context, *GetThreadMessagesRequest) (*GetThreadMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThreadMessages not implemented")
+}
+func (UnimplementedSessionServiceServer) FollowThread(context.Context, *FollowThreadRequest) (*FollowThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowThread not implemented")
+}
+func (UnimplementedSessionServiceServer) UnfollowThread(context.Context, *UnfollowThreadRequest) (*UnfollowThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowThread not implemented")
+}
+func (UnimplementedSessionServiceServer) AddReaction(context.Context, *AddReactionRequest) (*AddReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReaction not implemented")
+}
+func (UnimplementedSessionServiceServer) RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedSessionServiceServer) CastVote(context.Context, *CastVoteRequest) (*CastVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CastVote not implemented")
+}
+func (UnimplementedSessionServiceServer) ClosePoll(context.Context, *ClosePollRequest) (*ClosePollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePoll not implemented")
+}
+func (UnimplementedSessionServiceServer) GetMessageReadStatus(context.Context, *GetMessageReadStatusRequest) (*GetMessageReadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageReadStatus not implemented")
+}
+func (UnimplementedSessionServiceServer) ForwardMessages(context.Context, *ForwardMessagesRequest) (*ForwardMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardMessages not implemented")
+}
+func (UnimplementedSessionServiceServer) PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMessage not implemented")
+}
+func (UnimplementedSessionServiceServer) UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMessage not implemented")
+}
+func (UnimplementedSessionServiceServer) ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPinnedMessages not implemented")
+}
+func (UnimplementedSessionServiceServer) SetMessageTTL(context.Context, *SetMessageTTLRequest) (*SetMessageTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMessageTTL not implemented")
+}
+func (UnimplementedSessionServiceServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
+}
+func (UnimplementedSessionServiceServer) DeleteMessagesForMe(context.Context, *DeleteMessagesForMeRequest) (*DeleteMessagesForMeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessagesForMe not implemented")
+}
+func (UnimplementedSessionServiceServer) AddMembers(context.Context, *AddMembersRequest) (*AddMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMembers not implemented")
+}
+func (UnimplementedSessionServiceServer) RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedSessionServiceServer) LeaveSession(context.Context, *LeaveSessionRequest) (*LeaveSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveSession not implemented")
+}
+func (UnimplementedSessionServiceServer) RenameSession(context.Context, *RenameSessionRequest) (*RenameSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameSession not implemented")
+}
+func (UnimplementedSessionServiceServer) PromoteAdmin(context.Context, *PromoteAdminRequest) (*PromoteAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteAdmin not implemented")
+}
+func (UnimplementedSessionServiceServer) DemoteAdmin(context.Context, *DemoteAdminRequest) (*DemoteAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemoteAdmin not implemented")
+}
+func (UnimplementedSessionServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedSessionServiceServer) MuteMember(context.Context, *MuteMemberRequest) (*MuteMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteMember not implemented")
+}
+func (UnimplementedSessionServiceServer) UnmuteMember(context.Context, *UnmuteMemberRequest) (*UnmuteMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteMember not implemented")
+}
+func (UnimplementedSessionServiceServer) SetDoNotDisturb(context.Context, *SetDoNotDisturbRequest) (*SetDoNotDisturbResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDoNotDisturb not implemented")
+}
+func (UnimplementedSessionServiceServer) ScheduleMessage(context.Context, *ScheduleMessageRequest) (*ScheduleMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMessage not implemented")
+}
+func (UnimplementedSessionServiceServer) ListScheduledMessages(context.Context, *ListScheduledMessagesRequest) (*ListScheduledMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledMessages not implemented")
+}
+func (UnimplementedSessionServiceServer) UpdateScheduledMessage(context.Context, *UpdateScheduledMessageRequest) (*UpdateScheduledMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledMessage not implemented")
+}
+func (UnimplementedSessionServiceServer) CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*CancelScheduledMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMessage not implemented")
+}
+func (UnimplementedSessionServiceServer) Announce(context.Context, *AnnounceRequest) (*AnnounceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Announce not implemented")
+}
+func (UnimplementedSessionServiceServer) ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*ListAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnnouncements not implemented")
+}
+func (UnimplementedSessionServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedSessionServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedSessionServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedSessionServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedSessionServiceServer) CreateBot(context.Context, *CreateBotRequest) (*CreateBotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBot not implemented")
+}
+func (UnimplementedSessionServiceServer) ListBots(context.Context, *ListBotsRequest) (*ListBotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBots not implemented")
+}
+func (UnimplementedSessionServiceServer) RotateBotToken(context.Context, *RotateBotTokenRequest) (*RotateBotTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateBotToken not implemented")
+}
+func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
+func (UnimplementedSessionServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SessionServiceServer will
+// result in compilation errors.
+type UnsafeSessionServiceServer interface {
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
-// UnimplementedSessionServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedSessionServiceServer struct{}
-
-func (UnimplementedSessionServiceServer) GetSessionList(context.Context, *GetSessionListRequest) (*GetSessionListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSessionList not implemented")
+func RegisterSessionServiceServer(s grpc.ServiceRegistrar, srv SessionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSessionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SessionService_ServiceDesc, srv)
+}
+
+func _SessionService_GetSessionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetSessionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_GetSessionList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetSessionList(ctx, req.(*GetSessionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_CreateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_GetHistoryMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetHistoryMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_GetHistoryMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetHistoryMessages(ctx, req.(*GetHistoryMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_GetContactList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetContactList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_GetContactList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetContactList(ctx, req.(*GetContactListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_SearchUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).SearchUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_SearchUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).SearchUser(ctx, req.(*SearchUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_UpdateReadPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReadPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UpdateReadPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_UpdateReadPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UpdateReadPosition(ctx, req.(*UpdateReadPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_PullInboxDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullInboxDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).PullInboxDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_PullInboxDelta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).PullInboxDelta(ctx, req.(*PullInboxDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RecallMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecallMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RecallMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_RecallMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RecallMessage(ctx, req.(*RecallMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_EditMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).EditMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_EditMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).EditMessage(ctx, req.(*EditMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_GetThreadMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetThreadMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_GetThreadMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetThreadMessages(ctx, req.(*GetThreadMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_FollowThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).FollowThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_FollowThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).FollowThread(ctx, req.(*FollowThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_UnfollowThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UnfollowThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_UnfollowThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UnfollowThread(ctx, req.(*UnfollowThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_AddReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).AddReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_AddReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).AddReaction(ctx, req.(*AddReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_RemoveReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RemoveReaction(ctx, req.(*RemoveReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_CastVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CastVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).CastVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_CastVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).CastVote(ctx, req.(*CastVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ClosePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ClosePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ClosePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ClosePoll(ctx, req.(*ClosePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_GetMessageReadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageReadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetMessageReadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_GetMessageReadStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetMessageReadStatus(ctx, req.(*GetMessageReadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ForwardMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ForwardMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ForwardMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ForwardMessages(ctx, req.(*ForwardMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_PinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).PinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_PinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).PinMessage(ctx, req.(*PinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_UnpinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UnpinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_UnpinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UnpinMessage(ctx, req.(*UnpinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ListPinnedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPinnedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListPinnedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ListPinnedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListPinnedMessages(ctx, req.(*ListPinnedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_SetMessageTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMessageTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).SetMessageTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_SetMessageTTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).SetMessageTTL(ctx, req.(*SetMessageTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).SearchMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_SearchMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).SearchMessages(ctx, req.(*SearchMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_DeleteMessagesForMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessagesForMeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).DeleteMessagesForMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_DeleteMessagesForMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).DeleteMessagesForMe(ctx, req.(*DeleteMessagesForMeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_AddMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).AddMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_AddMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).AddMembers(ctx, req.(*AddMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_LeaveSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).LeaveSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_LeaveSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).LeaveSession(ctx, req.(*LeaveSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RenameSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RenameSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_RenameSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RenameSession(ctx, req.(*RenameSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_PromoteAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).PromoteAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_PromoteAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).PromoteAdmin(ctx, req.(*PromoteAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_DemoteAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DemoteAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).DemoteAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_DemoteAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).DemoteAdmin(ctx, req.(*DemoteAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_MuteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).MuteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_MuteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).MuteMember(ctx, req.(*MuteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSessionServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+
+func _SessionService_UnmuteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UnmuteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_UnmuteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UnmuteMember(ctx, req.(*UnmuteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSessionServiceServer) GetHistoryMessages(context.Context, *GetHistoryMessagesRequest) (*GetHistoryMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHistoryMessages not implemented")
+
+func _SessionService_SetDoNotDisturb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDoNotDisturbRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).SetDoNotDisturb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_SetDoNotDisturb_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).SetDoNotDisturb(ctx, req.(*SetDoNotDisturbRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSessionServiceServer) GetContactList(context.Context, *GetContactListRequest) (*GetContactListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetContactList not implemented")
+
+func _SessionService_ScheduleMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ScheduleMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ScheduleMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ScheduleMessage(ctx, req.(*ScheduleMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSessionServiceServer) SearchUser(context.Context, *SearchUserRequest) (*SearchUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchUser not implemented")
+
+func _SessionService_ListScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ListScheduledMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListScheduledMessages(ctx, req.(*ListScheduledMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSessionServiceServer) UpdateReadPosition(context.Context, *UpdateReadPositionRequest) (*UpdateReadPositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateReadPosition not implemented")
+
+func _SessionService_UpdateScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UpdateScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_UpdateScheduledMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UpdateScheduledMessage(ctx, req.(*UpdateScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSessionServiceServer) PullInboxDelta(context.Context, *PullInboxDeltaRequest) (*PullInboxDeltaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PullInboxDelta not implemented")
+
+func _SessionService_CancelScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).CancelScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_CancelScheduledMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).CancelScheduledMessage(ctx, req.(*CancelScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
-func (UnimplementedSessionServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SessionServiceServer will
-// result in compilation errors.
-type UnsafeSessionServiceServer interface {
-	mustEmbedUnimplementedSessionServiceServer()
+func _SessionService_Announce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnounceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).Announce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_Announce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).Announce(ctx, req.(*AnnounceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSessionServiceServer(s grpc.ServiceRegistrar, srv SessionServiceServer) {
-	// If the following call pancis, it indicates UnimplementedSessionServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _SessionService_ListAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&SessionService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ListAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListAnnouncements(ctx, req.(*ListAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SessionService_GetSessionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSessionListRequest)
+func _SessionService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SessionServiceServer).GetSessionList(ctx, in)
+		return srv.(SessionServiceServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SessionService_GetSessionList_FullMethodName,
+		FullMethod: SessionService_CreateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SessionServiceServer).GetSessionList(ctx, req.(*GetSessionListRequest))
+		return srv.(SessionServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SessionService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSessionRequest)
+func _SessionService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SessionServiceServer).CreateSession(ctx, in)
+		return srv.(SessionServiceServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SessionService_CreateSession_FullMethodName,
+		FullMethod: SessionService_ListWebhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SessionServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+		return srv.(SessionServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SessionService_GetHistoryMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHistoryMessagesRequest)
+func _SessionService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SessionServiceServer).GetHistoryMessages(ctx, in)
+		return srv.(SessionServiceServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SessionService_GetHistoryMessages_FullMethodName,
+		FullMethod: SessionService_DeleteWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SessionServiceServer).GetHistoryMessages(ctx, req.(*GetHistoryMessagesRequest))
+		return srv.(SessionServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SessionService_GetContactList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetContactListRequest)
+func _SessionService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SessionServiceServer).GetContactList(ctx, in)
+		return srv.(SessionServiceServer).ListWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SessionService_GetContactList_FullMethodName,
+		FullMethod: SessionService_ListWebhookDeliveries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SessionServiceServer).GetContactList(ctx, req.(*GetContactListRequest))
+		return srv.(SessionServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SessionService_SearchUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchUserRequest)
+func _SessionService_CreateBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SessionServiceServer).SearchUser(ctx, in)
+		return srv.(SessionServiceServer).CreateBot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SessionService_SearchUser_FullMethodName,
+		FullMethod: SessionService_CreateBot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SessionServiceServer).SearchUser(ctx, req.(*SearchUserRequest))
+		return srv.(SessionServiceServer).CreateBot(ctx, req.(*CreateBotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SessionService_UpdateReadPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateReadPositionRequest)
+func _SessionService_ListBots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SessionServiceServer).UpdateReadPosition(ctx, in)
+		return srv.(SessionServiceServer).ListBots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SessionService_UpdateReadPosition_FullMethodName,
+		FullMethod: SessionService_ListBots_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SessionServiceServer).UpdateReadPosition(ctx, req.(*UpdateReadPositionRequest))
+		return srv.(SessionServiceServer).ListBots(ctx, req.(*ListBotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SessionService_PullInboxDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PullInboxDeltaRequest)
+func _SessionService_RotateBotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateBotTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SessionServiceServer).PullInboxDelta(ctx, in)
+		return srv.(SessionServiceServer).RotateBotToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SessionService_PullInboxDelta_FullMethodName,
+		FullMethod: SessionService_RotateBotToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SessionServiceServer).PullInboxDelta(ctx, req.(*PullInboxDeltaRequest))
+		return srv.(SessionServiceServer).RotateBotToken(ctx, req.(*RotateBotTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -549,6 +1989,166 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PullInboxDelta",
 			Handler:    _SessionService_PullInboxDelta_Handler,
 		},
+		{
+			MethodName: "RecallMessage",
+			Handler:    _SessionService_RecallMessage_Handler,
+		},
+		{
+			MethodName: "EditMessage",
+			Handler:    _SessionService_EditMessage_Handler,
+		},
+		{
+			MethodName: "GetThreadMessages",
+			Handler:    _SessionService_GetThreadMessages_Handler,
+		},
+		{
+			MethodName: "FollowThread",
+			Handler:    _SessionService_FollowThread_Handler,
+		},
+		{
+			MethodName: "UnfollowThread",
+			Handler:    _SessionService_UnfollowThread_Handler,
+		},
+		{
+			MethodName: "AddReaction",
+			Handler:    _SessionService_AddReaction_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _SessionService_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "CastVote",
+			Handler:    _SessionService_CastVote_Handler,
+		},
+		{
+			MethodName: "ClosePoll",
+			Handler:    _SessionService_ClosePoll_Handler,
+		},
+		{
+			MethodName: "GetMessageReadStatus",
+			Handler:    _SessionService_GetMessageReadStatus_Handler,
+		},
+		{
+			MethodName: "ForwardMessages",
+			Handler:    _SessionService_ForwardMessages_Handler,
+		},
+		{
+			MethodName: "PinMessage",
+			Handler:    _SessionService_PinMessage_Handler,
+		},
+		{
+			MethodName: "UnpinMessage",
+			Handler:    _SessionService_UnpinMessage_Handler,
+		},
+		{
+			MethodName: "ListPinnedMessages",
+			Handler:    _SessionService_ListPinnedMessages_Handler,
+		},
+		{
+			MethodName: "SetMessageTTL",
+			Handler:    _SessionService_SetMessageTTL_Handler,
+		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _SessionService_SearchMessages_Handler,
+		},
+		{
+			MethodName: "DeleteMessagesForMe",
+			Handler:    _SessionService_DeleteMessagesForMe_Handler,
+		},
+		{
+			MethodName: "AddMembers",
+			Handler:    _SessionService_AddMembers_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _SessionService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "LeaveSession",
+			Handler:    _SessionService_LeaveSession_Handler,
+		},
+		{
+			MethodName: "RenameSession",
+			Handler:    _SessionService_RenameSession_Handler,
+		},
+		{
+			MethodName: "PromoteAdmin",
+			Handler:    _SessionService_PromoteAdmin_Handler,
+		},
+		{
+			MethodName: "DemoteAdmin",
+			Handler:    _SessionService_DemoteAdmin_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _SessionService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "MuteMember",
+			Handler:    _SessionService_MuteMember_Handler,
+		},
+		{
+			MethodName: "UnmuteMember",
+			Handler:    _SessionService_UnmuteMember_Handler,
+		},
+		{
+			MethodName: "SetDoNotDisturb",
+			Handler:    _SessionService_SetDoNotDisturb_Handler,
+		},
+		{
+			MethodName: "ScheduleMessage",
+			Handler:    _SessionService_ScheduleMessage_Handler,
+		},
+		{
+			MethodName: "ListScheduledMessages",
+			Handler:    _SessionService_ListScheduledMessages_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledMessage",
+			Handler:    _SessionService_UpdateScheduledMessage_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessage",
+			Handler:    _SessionService_CancelScheduledMessage_Handler,
+		},
+		{
+			MethodName: "Announce",
+			Handler:    _SessionService_Announce_Handler,
+		},
+		{
+			MethodName: "ListAnnouncements",
+			Handler:    _SessionService_ListAnnouncements_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _SessionService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _SessionService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _SessionService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _SessionService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "CreateBot",
+			Handler:    _SessionService_CreateBot_Handler,
+		},
+		{
+			MethodName: "ListBots",
+			Handler:    _SessionService_ListBots_Handler,
+		},
+		{
+			MethodName: "RotateBotToken",
+			Handler:    _SessionService_RotateBotToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gateway/v1/api.proto",
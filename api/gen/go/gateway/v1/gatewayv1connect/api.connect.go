@@ -62,6 +62,123 @@ const (
 	// SessionServicePullInboxDeltaProcedure is the fully-qualified name of the SessionService's
 	// PullInboxDelta RPC.
 	SessionServicePullInboxDeltaProcedure = "/resonance.gateway.v1.SessionService/PullInboxDelta"
+	// SessionServiceRecallMessageProcedure is the fully-qualified name of the SessionService's
+	// RecallMessage RPC.
+	SessionServiceRecallMessageProcedure = "/resonance.gateway.v1.SessionService/RecallMessage"
+	// SessionServiceEditMessageProcedure is the fully-qualified name of the SessionService's
+	// EditMessage RPC.
+	SessionServiceEditMessageProcedure = "/resonance.gateway.v1.SessionService/EditMessage"
+	// SessionServiceGetThreadMessagesProcedure is the fully-qualified name of the SessionService's
+	// GetThreadMessages RPC.
+	SessionServiceGetThreadMessagesProcedure = "/resonance.gateway.v1.SessionService/GetThreadMessages"
+	// SessionServiceFollowThreadProcedure is the fully-qualified name of the SessionService's
+	// FollowThread RPC.
+	SessionServiceFollowThreadProcedure = "/resonance.gateway.v1.SessionService/FollowThread"
+	// SessionServiceUnfollowThreadProcedure is the fully-qualified name of the SessionService's
+	// UnfollowThread RPC.
+	SessionServiceUnfollowThreadProcedure = "/resonance.gateway.v1.SessionService/UnfollowThread"
+	// SessionServiceAddReactionProcedure is the fully-qualified name of the SessionService's
+	// AddReaction RPC.
+	SessionServiceAddReactionProcedure = "/resonance.gateway.v1.SessionService/AddReaction"
+	// SessionServiceRemoveReactionProcedure is the fully-qualified name of the SessionService's
+	// RemoveReaction RPC.
+	SessionServiceRemoveReactionProcedure = "/resonance.gateway.v1.SessionService/RemoveReaction"
+	// SessionServiceCastVoteProcedure is the fully-qualified name of the SessionService's CastVote RPC.
+	SessionServiceCastVoteProcedure = "/resonance.gateway.v1.SessionService/CastVote"
+	// SessionServiceClosePollProcedure is the fully-qualified name of the SessionService's ClosePoll
+	// RPC.
+	SessionServiceClosePollProcedure = "/resonance.gateway.v1.SessionService/ClosePoll"
+	// SessionServiceGetMessageReadStatusProcedure is the fully-qualified name of the SessionService's
+	// GetMessageReadStatus RPC.
+	SessionServiceGetMessageReadStatusProcedure = "/resonance.gateway.v1.SessionService/GetMessageReadStatus"
+	// SessionServiceForwardMessagesProcedure is the fully-qualified name of the SessionService's
+	// ForwardMessages RPC.
+	SessionServiceForwardMessagesProcedure = "/resonance.gateway.v1.SessionService/ForwardMessages"
+	// SessionServicePinMessageProcedure is the fully-qualified name of the SessionService's PinMessage
+	// RPC.
+	SessionServicePinMessageProcedure = "/resonance.gateway.v1.SessionService/PinMessage"
+	// SessionServiceUnpinMessageProcedure is the fully-qualified name of the SessionService's
+	// UnpinMessage RPC.
+	SessionServiceUnpinMessageProcedure = "/resonance.gateway.v1.SessionService/UnpinMessage"
+	// SessionServiceListPinnedMessagesProcedure is the fully-qualified name of the SessionService's
+	// ListPinnedMessages RPC.
+	SessionServiceListPinnedMessagesProcedure = "/resonance.gateway.v1.SessionService/ListPinnedMessages"
+	// SessionServiceSetMessageTTLProcedure is the fully-qualified name of the SessionService's
+	// SetMessageTTL RPC.
+	SessionServiceSetMessageTTLProcedure = "/resonance.gateway.v1.SessionService/SetMessageTTL"
+	// SessionServiceSearchMessagesProcedure is the fully-qualified name of the SessionService's
+	// SearchMessages RPC.
+	SessionServiceSearchMessagesProcedure = "/resonance.gateway.v1.SessionService/SearchMessages"
+	// SessionServiceDeleteMessagesForMeProcedure is the fully-qualified name of the SessionService's
+	// DeleteMessagesForMe RPC.
+	SessionServiceDeleteMessagesForMeProcedure = "/resonance.gateway.v1.SessionService/DeleteMessagesForMe"
+	// SessionServiceAddMembersProcedure is the fully-qualified name of the SessionService's AddMembers
+	// RPC.
+	SessionServiceAddMembersProcedure = "/resonance.gateway.v1.SessionService/AddMembers"
+	// SessionServiceRemoveMemberProcedure is the fully-qualified name of the SessionService's
+	// RemoveMember RPC.
+	SessionServiceRemoveMemberProcedure = "/resonance.gateway.v1.SessionService/RemoveMember"
+	// SessionServiceLeaveSessionProcedure is the fully-qualified name of the SessionService's
+	// LeaveSession RPC.
+	SessionServiceLeaveSessionProcedure = "/resonance.gateway.v1.SessionService/LeaveSession"
+	// SessionServiceRenameSessionProcedure is the fully-qualified name of the SessionService's
+	// RenameSession RPC.
+	SessionServiceRenameSessionProcedure = "/resonance.gateway.v1.SessionService/RenameSession"
+	// SessionServicePromoteAdminProcedure is the fully-qualified name of the SessionService's
+	// PromoteAdmin RPC.
+	SessionServicePromoteAdminProcedure = "/resonance.gateway.v1.SessionService/PromoteAdmin"
+	// SessionServiceDemoteAdminProcedure is the fully-qualified name of the SessionService's
+	// DemoteAdmin RPC.
+	SessionServiceDemoteAdminProcedure = "/resonance.gateway.v1.SessionService/DemoteAdmin"
+	// SessionServiceTransferOwnershipProcedure is the fully-qualified name of the SessionService's
+	// TransferOwnership RPC.
+	SessionServiceTransferOwnershipProcedure = "/resonance.gateway.v1.SessionService/TransferOwnership"
+	// SessionServiceMuteMemberProcedure is the fully-qualified name of the SessionService's MuteMember
+	// RPC.
+	SessionServiceMuteMemberProcedure = "/resonance.gateway.v1.SessionService/MuteMember"
+	// SessionServiceUnmuteMemberProcedure is the fully-qualified name of the SessionService's
+	// UnmuteMember RPC.
+	SessionServiceUnmuteMemberProcedure = "/resonance.gateway.v1.SessionService/UnmuteMember"
+	// SessionServiceSetDoNotDisturbProcedure is the fully-qualified name of the SessionService's
+	// SetDoNotDisturb RPC.
+	SessionServiceSetDoNotDisturbProcedure = "/resonance.gateway.v1.SessionService/SetDoNotDisturb"
+	// SessionServiceScheduleMessageProcedure is the fully-qualified name of the SessionService's
+	// ScheduleMessage RPC.
+	SessionServiceScheduleMessageProcedure = "/resonance.gateway.v1.SessionService/ScheduleMessage"
+	// SessionServiceListScheduledMessagesProcedure is the fully-qualified name of the SessionService's
+	// ListScheduledMessages RPC.
+	SessionServiceListScheduledMessagesProcedure = "/resonance.gateway.v1.SessionService/ListScheduledMessages"
+	// SessionServiceUpdateScheduledMessageProcedure is the fully-qualified name of the SessionService's
+	// UpdateScheduledMessage RPC.
+	SessionServiceUpdateScheduledMessageProcedure = "/resonance.gateway.v1.SessionService/UpdateScheduledMessage"
+	// SessionServiceCancelScheduledMessageProcedure is the fully-qualified name of the SessionService's
+	// CancelScheduledMessage RPC.
+	SessionServiceCancelScheduledMessageProcedure = "/resonance.gateway.v1.SessionService/CancelScheduledMessage"
+	// SessionServiceAnnounceProcedure is the fully-qualified name of the SessionService's Announce RPC.
+	SessionServiceAnnounceProcedure = "/resonance.gateway.v1.SessionService/Announce"
+	// SessionServiceListAnnouncementsProcedure is the fully-qualified name of the SessionService's
+	// ListAnnouncements RPC.
+	SessionServiceListAnnouncementsProcedure = "/resonance.gateway.v1.SessionService/ListAnnouncements"
+	// SessionServiceCreateWebhookProcedure is the fully-qualified name of the SessionService's
+	// CreateWebhook RPC.
+	SessionServiceCreateWebhookProcedure = "/resonance.gateway.v1.SessionService/CreateWebhook"
+	// SessionServiceListWebhooksProcedure is the fully-qualified name of the SessionService's
+	// ListWebhooks RPC.
+	SessionServiceListWebhooksProcedure = "/resonance.gateway.v1.SessionService/ListWebhooks"
+	// SessionServiceDeleteWebhookProcedure is the fully-qualified name of the SessionService's
+	// DeleteWebhook RPC.
+	SessionServiceDeleteWebhookProcedure = "/resonance.gateway.v1.SessionService/DeleteWebhook"
+	// SessionServiceListWebhookDeliveriesProcedure is the fully-qualified name of the SessionService's
+	// ListWebhookDeliveries RPC.
+	SessionServiceListWebhookDeliveriesProcedure = "/resonance.gateway.v1.SessionService/ListWebhookDeliveries"
+	// SessionServiceCreateBotProcedure is the fully-qualified name of the SessionService's CreateBot
+	// RPC.
+	SessionServiceCreateBotProcedure = "/resonance.gateway.v1.SessionService/CreateBot"
+	// SessionServiceListBotsProcedure is the fully-qualified name of the SessionService's ListBots RPC.
+	SessionServiceListBotsProcedure = "/resonance.gateway.v1.SessionService/ListBots"
+	// SessionServiceRotateBotTokenProcedure is the fully-qualified name of the SessionService's
+	// RotateBotToken RPC.
+	SessionServiceRotateBotTokenProcedure = "/resonance.gateway.v1.SessionService/RotateBotToken"
 )
 
 // AuthServiceClient is a client for the resonance.gateway.v1.AuthService service.
@@ -208,6 +325,86 @@ type SessionServiceClient interface {
 	UpdateReadPosition(context.Context, *connect.Request[v1.UpdateReadPositionRequest]) (*connect.Response[v1.UpdateReadPositionResponse], error)
 	// PullInboxDelta 按用户游标增量拉取消息
 	PullInboxDelta(context.Context, *connect.Request[v1.PullInboxDeltaRequest]) (*connect.Response[v1.PullInboxDeltaResponse], error)
+	// RecallMessage 撤回消息（发送者在时间窗口内，或群管理员）
+	RecallMessage(context.Context, *connect.Request[v1.RecallMessageRequest]) (*connect.Response[v1.RecallMessageResponse], error)
+	// EditMessage 编辑消息（仅发送者，且在时间窗口内）
+	EditMessage(context.Context, *connect.Request[v1.EditMessageRequest]) (*connect.Response[v1.EditMessageResponse], error)
+	// GetThreadMessages 拉取话题消息
+	GetThreadMessages(context.Context, *connect.Request[v1.GetThreadMessagesRequest]) (*connect.Response[v1.GetThreadMessagesResponse], error)
+	// FollowThread 关注话题
+	FollowThread(context.Context, *connect.Request[v1.FollowThreadRequest]) (*connect.Response[v1.FollowThreadResponse], error)
+	// UnfollowThread 取消关注话题
+	UnfollowThread(context.Context, *connect.Request[v1.UnfollowThreadRequest]) (*connect.Response[v1.UnfollowThreadResponse], error)
+	// AddReaction 添加表情回应
+	AddReaction(context.Context, *connect.Request[v1.AddReactionRequest]) (*connect.Response[v1.AddReactionResponse], error)
+	// RemoveReaction 取消表情回应
+	RemoveReaction(context.Context, *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error)
+	// CastVote 在投票消息中投票
+	CastVote(context.Context, *connect.Request[v1.CastVoteRequest]) (*connect.Response[v1.CastVoteResponse], error)
+	// ClosePoll 提前结束投票（发起者或群管理员）
+	ClosePoll(context.Context, *connect.Request[v1.ClosePollRequest]) (*connect.Response[v1.ClosePollResponse], error)
+	// GetMessageReadStatus 获取消息的已读/未读成员（仅发送者可查）
+	GetMessageReadStatus(context.Context, *connect.Request[v1.GetMessageReadStatusRequest]) (*connect.Response[v1.GetMessageReadStatusResponse], error)
+	// ForwardMessages 逐条或合并转发消息到其他会话
+	ForwardMessages(context.Context, *connect.Request[v1.ForwardMessagesRequest]) (*connect.Response[v1.ForwardMessagesResponse], error)
+	// PinMessage 置顶消息（群聊仅管理员可操作）
+	PinMessage(context.Context, *connect.Request[v1.PinMessageRequest]) (*connect.Response[v1.PinMessageResponse], error)
+	// UnpinMessage 取消置顶
+	UnpinMessage(context.Context, *connect.Request[v1.UnpinMessageRequest]) (*connect.Response[v1.UnpinMessageResponse], error)
+	// ListPinnedMessages 获取会话的置顶消息
+	ListPinnedMessages(context.Context, *connect.Request[v1.ListPinnedMessagesRequest]) (*connect.Response[v1.ListPinnedMessagesResponse], error)
+	// SetMessageTTL 设置会话消息的保留时长（阅后即焚）
+	SetMessageTTL(context.Context, *connect.Request[v1.SetMessageTTLRequest]) (*connect.Response[v1.SetMessageTTLResponse], error)
+	// SearchMessages 全文搜索当前用户所在会话的历史消息
+	SearchMessages(context.Context, *connect.Request[v1.SearchMessagesRequest]) (*connect.Response[v1.SearchMessagesResponse], error)
+	// DeleteMessagesForMe 仅对自己删除消息或清空聊天记录，并同步到本人其他设备
+	DeleteMessagesForMe(context.Context, *connect.Request[v1.DeleteMessagesForMeRequest]) (*connect.Response[v1.DeleteMessagesForMeResponse], error)
+	// AddMembers 邀请用户加入群聊（仅管理员）
+	AddMembers(context.Context, *connect.Request[v1.AddMembersRequest]) (*connect.Response[v1.AddMembersResponse], error)
+	// RemoveMember 将成员移出群聊（仅管理员）
+	RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error)
+	// LeaveSession 主动退出群聊
+	LeaveSession(context.Context, *connect.Request[v1.LeaveSessionRequest]) (*connect.Response[v1.LeaveSessionResponse], error)
+	// RenameSession 修改群名称（管理员及群主）
+	RenameSession(context.Context, *connect.Request[v1.RenameSessionRequest]) (*connect.Response[v1.RenameSessionResponse], error)
+	// PromoteAdmin 将成员设为管理员（仅群主）
+	PromoteAdmin(context.Context, *connect.Request[v1.PromoteAdminRequest]) (*connect.Response[v1.PromoteAdminResponse], error)
+	// DemoteAdmin 取消成员的管理员身份（仅群主）
+	DemoteAdmin(context.Context, *connect.Request[v1.DemoteAdminRequest]) (*connect.Response[v1.DemoteAdminResponse], error)
+	// TransferOwnership 转让群主（仅群主）
+	TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error)
+	// MuteMember 禁言成员（管理员及群主）
+	MuteMember(context.Context, *connect.Request[v1.MuteMemberRequest]) (*connect.Response[v1.MuteMemberResponse], error)
+	// UnmuteMember 解除成员的禁言（管理员及群主）
+	UnmuteMember(context.Context, *connect.Request[v1.UnmuteMemberRequest]) (*connect.Response[v1.UnmuteMemberResponse], error)
+	// SetDoNotDisturb 设置会话免打扰（仅影响当前用户）
+	SetDoNotDisturb(context.Context, *connect.Request[v1.SetDoNotDisturbRequest]) (*connect.Response[v1.SetDoNotDisturbResponse], error)
+	// ScheduleMessage 创建定时消息
+	ScheduleMessage(context.Context, *connect.Request[v1.ScheduleMessageRequest]) (*connect.Response[v1.ScheduleMessageResponse], error)
+	// ListScheduledMessages 获取当前用户的定时消息
+	ListScheduledMessages(context.Context, *connect.Request[v1.ListScheduledMessagesRequest]) (*connect.Response[v1.ListScheduledMessagesResponse], error)
+	// UpdateScheduledMessage 编辑待发送的定时消息
+	UpdateScheduledMessage(context.Context, *connect.Request[v1.UpdateScheduledMessageRequest]) (*connect.Response[v1.UpdateScheduledMessageResponse], error)
+	// CancelScheduledMessage 取消待发送的定时消息
+	CancelScheduledMessage(context.Context, *connect.Request[v1.CancelScheduledMessageRequest]) (*connect.Response[v1.CancelScheduledMessageResponse], error)
+	// Announce 发布系统公告（仅系统管理员）
+	Announce(context.Context, *connect.Request[v1.AnnounceRequest]) (*connect.Response[v1.AnnounceResponse], error)
+	// ListAnnouncements 获取离线期间错过的系统公告
+	ListAnnouncements(context.Context, *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error)
+	// CreateWebhook 为会话创建出站 Webhook 订阅
+	CreateWebhook(context.Context, *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error)
+	// ListWebhooks 列出会话的 Webhook 订阅
+	ListWebhooks(context.Context, *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error)
+	// DeleteWebhook 删除 Webhook 订阅
+	DeleteWebhook(context.Context, *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error)
+	// ListWebhookDeliveries 查看 Webhook 投递日志
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	// CreateBot 创建机器人账号，返回其 API Token（仅此一次）
+	CreateBot(context.Context, *connect.Request[v1.CreateBotRequest]) (*connect.Response[v1.CreateBotResponse], error)
+	// ListBots 列出当前用户创建的机器人账号
+	ListBots(context.Context, *connect.Request[v1.ListBotsRequest]) (*connect.Response[v1.ListBotsResponse], error)
+	// RotateBotToken 轮换机器人的 API Token，旧 Token 立即失效
+	RotateBotToken(context.Context, *connect.Request[v1.RotateBotTokenRequest]) (*connect.Response[v1.RotateBotTokenResponse], error)
 }
 
 // NewSessionServiceClient constructs a client for the resonance.gateway.v1.SessionService service.
@@ -263,18 +460,298 @@ func NewSessionServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(sessionServiceMethods.ByName("PullInboxDelta")),
 			connect.WithClientOptions(opts...),
 		),
+		recallMessage: connect.NewClient[v1.RecallMessageRequest, v1.RecallMessageResponse](
+			httpClient,
+			baseURL+SessionServiceRecallMessageProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("RecallMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		editMessage: connect.NewClient[v1.EditMessageRequest, v1.EditMessageResponse](
+			httpClient,
+			baseURL+SessionServiceEditMessageProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("EditMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		getThreadMessages: connect.NewClient[v1.GetThreadMessagesRequest, v1.GetThreadMessagesResponse](
+			httpClient,
+			baseURL+SessionServiceGetThreadMessagesProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("GetThreadMessages")),
+			connect.WithClientOptions(opts...),
+		),
+		followThread: connect.NewClient[v1.FollowThreadRequest, v1.FollowThreadResponse](
+			httpClient,
+			baseURL+SessionServiceFollowThreadProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("FollowThread")),
+			connect.WithClientOptions(opts...),
+		),
+		unfollowThread: connect.NewClient[v1.UnfollowThreadRequest, v1.UnfollowThreadResponse](
+			httpClient,
+			baseURL+SessionServiceUnfollowThreadProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("UnfollowThread")),
+			connect.WithClientOptions(opts...),
+		),
+		addReaction: connect.NewClient[v1.AddReactionRequest, v1.AddReactionResponse](
+			httpClient,
+			baseURL+SessionServiceAddReactionProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("AddReaction")),
+			connect.WithClientOptions(opts...),
+		),
+		removeReaction: connect.NewClient[v1.RemoveReactionRequest, v1.RemoveReactionResponse](
+			httpClient,
+			baseURL+SessionServiceRemoveReactionProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("RemoveReaction")),
+			connect.WithClientOptions(opts...),
+		),
+		castVote: connect.NewClient[v1.CastVoteRequest, v1.CastVoteResponse](
+			httpClient,
+			baseURL+SessionServiceCastVoteProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("CastVote")),
+			connect.WithClientOptions(opts...),
+		),
+		closePoll: connect.NewClient[v1.ClosePollRequest, v1.ClosePollResponse](
+			httpClient,
+			baseURL+SessionServiceClosePollProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("ClosePoll")),
+			connect.WithClientOptions(opts...),
+		),
+		getMessageReadStatus: connect.NewClient[v1.GetMessageReadStatusRequest, v1.GetMessageReadStatusResponse](
+			httpClient,
+			baseURL+SessionServiceGetMessageReadStatusProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("GetMessageReadStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		forwardMessages: connect.NewClient[v1.ForwardMessagesRequest, v1.ForwardMessagesResponse](
+			httpClient,
+			baseURL+SessionServiceForwardMessagesProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("ForwardMessages")),
+			connect.WithClientOptions(opts...),
+		),
+		pinMessage: connect.NewClient[v1.PinMessageRequest, v1.PinMessageResponse](
+			httpClient,
+			baseURL+SessionServicePinMessageProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("PinMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		unpinMessage: connect.NewClient[v1.UnpinMessageRequest, v1.UnpinMessageResponse](
+			httpClient,
+			baseURL+SessionServiceUnpinMessageProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("UnpinMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		listPinnedMessages: connect.NewClient[v1.ListPinnedMessagesRequest, v1.ListPinnedMessagesResponse](
+			httpClient,
+			baseURL+SessionServiceListPinnedMessagesProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("ListPinnedMessages")),
+			connect.WithClientOptions(opts...),
+		),
+		setMessageTTL: connect.NewClient[v1.SetMessageTTLRequest, v1.SetMessageTTLResponse](
+			httpClient,
+			baseURL+SessionServiceSetMessageTTLProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("SetMessageTTL")),
+			connect.WithClientOptions(opts...),
+		),
+		searchMessages: connect.NewClient[v1.SearchMessagesRequest, v1.SearchMessagesResponse](
+			httpClient,
+			baseURL+SessionServiceSearchMessagesProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("SearchMessages")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteMessagesForMe: connect.NewClient[v1.DeleteMessagesForMeRequest, v1.DeleteMessagesForMeResponse](
+			httpClient,
+			baseURL+SessionServiceDeleteMessagesForMeProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("DeleteMessagesForMe")),
+			connect.WithClientOptions(opts...),
+		),
+		addMembers: connect.NewClient[v1.AddMembersRequest, v1.AddMembersResponse](
+			httpClient,
+			baseURL+SessionServiceAddMembersProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("AddMembers")),
+			connect.WithClientOptions(opts...),
+		),
+		removeMember: connect.NewClient[v1.RemoveMemberRequest, v1.RemoveMemberResponse](
+			httpClient,
+			baseURL+SessionServiceRemoveMemberProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("RemoveMember")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveSession: connect.NewClient[v1.LeaveSessionRequest, v1.LeaveSessionResponse](
+			httpClient,
+			baseURL+SessionServiceLeaveSessionProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("LeaveSession")),
+			connect.WithClientOptions(opts...),
+		),
+		renameSession: connect.NewClient[v1.RenameSessionRequest, v1.RenameSessionResponse](
+			httpClient,
+			baseURL+SessionServiceRenameSessionProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("RenameSession")),
+			connect.WithClientOptions(opts...),
+		),
+		promoteAdmin: connect.NewClient[v1.PromoteAdminRequest, v1.PromoteAdminResponse](
+			httpClient,
+			baseURL+SessionServicePromoteAdminProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("PromoteAdmin")),
+			connect.WithClientOptions(opts...),
+		),
+		demoteAdmin: connect.NewClient[v1.DemoteAdminRequest, v1.DemoteAdminResponse](
+			httpClient,
+			baseURL+SessionServiceDemoteAdminProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("DemoteAdmin")),
+			connect.WithClientOptions(opts...),
+		),
+		transferOwnership: connect.NewClient[v1.TransferOwnershipRequest, v1.TransferOwnershipResponse](
+			httpClient,
+			baseURL+SessionServiceTransferOwnershipProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("TransferOwnership")),
+			connect.WithClientOptions(opts...),
+		),
+		muteMember: connect.NewClient[v1.MuteMemberRequest, v1.MuteMemberResponse](
+			httpClient,
+			baseURL+SessionServiceMuteMemberProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("MuteMember")),
+			connect.WithClientOptions(opts...),
+		),
+		unmuteMember: connect.NewClient[v1.UnmuteMemberRequest, v1.UnmuteMemberResponse](
+			httpClient,
+			baseURL+SessionServiceUnmuteMemberProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("UnmuteMember")),
+			connect.WithClientOptions(opts...),
+		),
+		setDoNotDisturb: connect.NewClient[v1.SetDoNotDisturbRequest, v1.SetDoNotDisturbResponse](
+			httpClient,
+			baseURL+SessionServiceSetDoNotDisturbProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("SetDoNotDisturb")),
+			connect.WithClientOptions(opts...),
+		),
+		scheduleMessage: connect.NewClient[v1.ScheduleMessageRequest, v1.ScheduleMessageResponse](
+			httpClient,
+			baseURL+SessionServiceScheduleMessageProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("ScheduleMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		listScheduledMessages: connect.NewClient[v1.ListScheduledMessagesRequest, v1.ListScheduledMessagesResponse](
+			httpClient,
+			baseURL+SessionServiceListScheduledMessagesProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("ListScheduledMessages")),
+			connect.WithClientOptions(opts...),
+		),
+		updateScheduledMessage: connect.NewClient[v1.UpdateScheduledMessageRequest, v1.UpdateScheduledMessageResponse](
+			httpClient,
+			baseURL+SessionServiceUpdateScheduledMessageProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("UpdateScheduledMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelScheduledMessage: connect.NewClient[v1.CancelScheduledMessageRequest, v1.CancelScheduledMessageResponse](
+			httpClient,
+			baseURL+SessionServiceCancelScheduledMessageProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("CancelScheduledMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		announce: connect.NewClient[v1.AnnounceRequest, v1.AnnounceResponse](
+			httpClient,
+			baseURL+SessionServiceAnnounceProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("Announce")),
+			connect.WithClientOptions(opts...),
+		),
+		listAnnouncements: connect.NewClient[v1.ListAnnouncementsRequest, v1.ListAnnouncementsResponse](
+			httpClient,
+			baseURL+SessionServiceListAnnouncementsProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("ListAnnouncements")),
+			connect.WithClientOptions(opts...),
+		),
+		createWebhook: connect.NewClient[v1.CreateWebhookRequest, v1.CreateWebhookResponse](
+			httpClient,
+			baseURL+SessionServiceCreateWebhookProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("CreateWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhooks: connect.NewClient[v1.ListWebhooksRequest, v1.ListWebhooksResponse](
+			httpClient,
+			baseURL+SessionServiceListWebhooksProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("ListWebhooks")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhook: connect.NewClient[v1.DeleteWebhookRequest, v1.DeleteWebhookResponse](
+			httpClient,
+			baseURL+SessionServiceDeleteWebhookProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("DeleteWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeliveries: connect.NewClient[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+SessionServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("ListWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		createBot: connect.NewClient[v1.CreateBotRequest, v1.CreateBotResponse](
+			httpClient,
+			baseURL+SessionServiceCreateBotProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("CreateBot")),
+			connect.WithClientOptions(opts...),
+		),
+		listBots: connect.NewClient[v1.ListBotsRequest, v1.ListBotsResponse](
+			httpClient,
+			baseURL+SessionServiceListBotsProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("ListBots")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateBotToken: connect.NewClient[v1.RotateBotTokenRequest, v1.RotateBotTokenResponse](
+			httpClient,
+			baseURL+SessionServiceRotateBotTokenProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("RotateBotToken")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // sessionServiceClient implements SessionServiceClient.
 type sessionServiceClient struct {
-	getSessionList     *connect.Client[v1.GetSessionListRequest, v1.GetSessionListResponse]
-	createSession      *connect.Client[v1.CreateSessionRequest, v1.CreateSessionResponse]
-	getHistoryMessages *connect.Client[v1.GetHistoryMessagesRequest, v1.GetHistoryMessagesResponse]
-	getContactList     *connect.Client[v1.GetContactListRequest, v1.GetContactListResponse]
-	searchUser         *connect.Client[v1.SearchUserRequest, v1.SearchUserResponse]
-	updateReadPosition *connect.Client[v1.UpdateReadPositionRequest, v1.UpdateReadPositionResponse]
-	pullInboxDelta     *connect.Client[v1.PullInboxDeltaRequest, v1.PullInboxDeltaResponse]
+	getSessionList         *connect.Client[v1.GetSessionListRequest, v1.GetSessionListResponse]
+	createSession          *connect.Client[v1.CreateSessionRequest, v1.CreateSessionResponse]
+	getHistoryMessages     *connect.Client[v1.GetHistoryMessagesRequest, v1.GetHistoryMessagesResponse]
+	getContactList         *connect.Client[v1.GetContactListRequest, v1.GetContactListResponse]
+	searchUser             *connect.Client[v1.SearchUserRequest, v1.SearchUserResponse]
+	updateReadPosition     *connect.Client[v1.UpdateReadPositionRequest, v1.UpdateReadPositionResponse]
+	pullInboxDelta         *connect.Client[v1.PullInboxDeltaRequest, v1.PullInboxDeltaResponse]
+	recallMessage          *connect.Client[v1.RecallMessageRequest, v1.RecallMessageResponse]
+	editMessage            *connect.Client[v1.EditMessageRequest, v1.EditMessageResponse]
+	getThreadMessages      *connect.Client[v1.GetThreadMessagesRequest, v1.GetThreadMessagesResponse]
+	followThread           *connect.Client[v1.FollowThreadRequest, v1.FollowThreadResponse]
+	unfollowThread         *connect.Client[v1.UnfollowThreadRequest, v1.UnfollowThreadResponse]
+	addReaction            *connect.Client[v1.AddReactionRequest, v1.AddReactionResponse]
+	removeReaction         *connect.Client[v1.RemoveReactionRequest, v1.RemoveReactionResponse]
+	castVote               *connect.Client[v1.CastVoteRequest, v1.CastVoteResponse]
+	closePoll              *connect.Client[v1.ClosePollRequest, v1.ClosePollResponse]
+	getMessageReadStatus   *connect.Client[v1.GetMessageReadStatusRequest, v1.GetMessageReadStatusResponse]
+	forwardMessages        *connect.Client[v1.ForwardMessagesRequest, v1.ForwardMessagesResponse]
+	pinMessage             *connect.Client[v1.PinMessageRequest, v1.PinMessageResponse]
+	unpinMessage           *connect.Client[v1.UnpinMessageRequest, v1.UnpinMessageResponse]
+	listPinnedMessages     *connect.Client[v1.ListPinnedMessagesRequest, v1.ListPinnedMessagesResponse]
+	setMessageTTL          *connect.Client[v1.SetMessageTTLRequest, v1.SetMessageTTLResponse]
+	searchMessages         *connect.Client[v1.SearchMessagesRequest, v1.SearchMessagesResponse]
+	deleteMessagesForMe    *connect.Client[v1.DeleteMessagesForMeRequest, v1.DeleteMessagesForMeResponse]
+	addMembers             *connect.Client[v1.AddMembersRequest, v1.AddMembersResponse]
+	removeMember           *connect.Client[v1.RemoveMemberRequest, v1.RemoveMemberResponse]
+	leaveSession           *connect.Client[v1.LeaveSessionRequest, v1.LeaveSessionResponse]
+	renameSession          *connect.Client[v1.RenameSessionRequest, v1.RenameSessionResponse]
+	promoteAdmin           *connect.Client[v1.PromoteAdminRequest, v1.PromoteAdminResponse]
+	demoteAdmin            *connect.Client[v1.DemoteAdminRequest, v1.DemoteAdminResponse]
+	transferOwnership      *connect.Client[v1.TransferOwnershipRequest, v1.TransferOwnershipResponse]
+	muteMember             *connect.Client[v1.MuteMemberRequest, v1.MuteMemberResponse]
+	unmuteMember           *connect.Client[v1.UnmuteMemberRequest, v1.UnmuteMemberResponse]
+	setDoNotDisturb        *connect.Client[v1.SetDoNotDisturbRequest, v1.SetDoNotDisturbResponse]
+	scheduleMessage        *connect.Client[v1.ScheduleMessageRequest, v1.ScheduleMessageResponse]
+	listScheduledMessages  *connect.Client[v1.ListScheduledMessagesRequest, v1.ListScheduledMessagesResponse]
+	updateScheduledMessage *connect.Client[v1.UpdateScheduledMessageRequest, v1.UpdateScheduledMessageResponse]
+	cancelScheduledMessage *connect.Client[v1.CancelScheduledMessageRequest, v1.CancelScheduledMessageResponse]
+	announce               *connect.Client[v1.AnnounceRequest, v1.AnnounceResponse]
+	listAnnouncements      *connect.Client[v1.ListAnnouncementsRequest, v1.ListAnnouncementsResponse]
+	createWebhook          *connect.Client[v1.CreateWebhookRequest, v1.CreateWebhookResponse]
+	listWebhooks           *connect.Client[v1.ListWebhooksRequest, v1.ListWebhooksResponse]
+	deleteWebhook          *connect.Client[v1.DeleteWebhookRequest, v1.DeleteWebhookResponse]
+	listWebhookDeliveries  *connect.Client[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]
+	createBot              *connect.Client[v1.CreateBotRequest, v1.CreateBotResponse]
+	listBots               *connect.Client[v1.ListBotsRequest, v1.ListBotsResponse]
+	rotateBotToken         *connect.Client[v1.RotateBotTokenRequest, v1.RotateBotTokenResponse]
 }
 
 // GetSessionList calls resonance.gateway.v1.SessionService.GetSessionList.
@@ -312,6 +789,206 @@ func (c *sessionServiceClient) PullInboxDelta(ctx context.Context, req *connect.
 	return c.pullInboxDelta.CallUnary(ctx, req)
 }
 
+// RecallMessage calls resonance.gateway.v1.SessionService.RecallMessage.
+func (c *sessionServiceClient) RecallMessage(ctx context.Context, req *connect.Request[v1.RecallMessageRequest]) (*connect.Response[v1.RecallMessageResponse], error) {
+	return c.recallMessage.CallUnary(ctx, req)
+}
+
+// EditMessage calls resonance.gateway.v1.SessionService.EditMessage.
+func (c *sessionServiceClient) EditMessage(ctx context.Context, req *connect.Request[v1.EditMessageRequest]) (*connect.Response[v1.EditMessageResponse], error) {
+	return c.editMessage.CallUnary(ctx, req)
+}
+
+// GetThreadMessages calls resonance.gateway.v1.SessionService.GetThreadMessages.
+func (c *sessionServiceClient) GetThreadMessages(ctx context.Context, req *connect.Request[v1.GetThreadMessagesRequest]) (*connect.Response[v1.GetThreadMessagesResponse], error) {
+	return c.getThreadMessages.CallUnary(ctx, req)
+}
+
+// FollowThread calls resonance.gateway.v1.SessionService.FollowThread.
+func (c *sessionServiceClient) FollowThread(ctx context.Context, req *connect.Request[v1.FollowThreadRequest]) (*connect.Response[v1.FollowThreadResponse], error) {
+	return c.followThread.CallUnary(ctx, req)
+}
+
+// UnfollowThread calls resonance.gateway.v1.SessionService.UnfollowThread.
+func (c *sessionServiceClient) UnfollowThread(ctx context.Context, req *connect.Request[v1.UnfollowThreadRequest]) (*connect.Response[v1.UnfollowThreadResponse], error) {
+	return c.unfollowThread.CallUnary(ctx, req)
+}
+
+// AddReaction calls resonance.gateway.v1.SessionService.AddReaction.
+func (c *sessionServiceClient) AddReaction(ctx context.Context, req *connect.Request[v1.AddReactionRequest]) (*connect.Response[v1.AddReactionResponse], error) {
+	return c.addReaction.CallUnary(ctx, req)
+}
+
+// RemoveReaction calls resonance.gateway.v1.SessionService.RemoveReaction.
+func (c *sessionServiceClient) RemoveReaction(ctx context.Context, req *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error) {
+	return c.removeReaction.CallUnary(ctx, req)
+}
+
+// CastVote calls resonance.gateway.v1.SessionService.CastVote.
+func (c *sessionServiceClient) CastVote(ctx context.Context, req *connect.Request[v1.CastVoteRequest]) (*connect.Response[v1.CastVoteResponse], error) {
+	return c.castVote.CallUnary(ctx, req)
+}
+
+// ClosePoll calls resonance.gateway.v1.SessionService.ClosePoll.
+func (c *sessionServiceClient) ClosePoll(ctx context.Context, req *connect.Request[v1.ClosePollRequest]) (*connect.Response[v1.ClosePollResponse], error) {
+	return c.closePoll.CallUnary(ctx, req)
+}
+
+// GetMessageReadStatus calls resonance.gateway.v1.SessionService.GetMessageReadStatus.
+func (c *sessionServiceClient) GetMessageReadStatus(ctx context.Context, req *connect.Request[v1.GetMessageReadStatusRequest]) (*connect.Response[v1.GetMessageReadStatusResponse], error) {
+	return c.getMessageReadStatus.CallUnary(ctx, req)
+}
+
+// ForwardMessages calls resonance.gateway.v1.SessionService.ForwardMessages.
+func (c *sessionServiceClient) ForwardMessages(ctx context.Context, req *connect.Request[v1.ForwardMessagesRequest]) (*connect.Response[v1.ForwardMessagesResponse], error) {
+	return c.forwardMessages.CallUnary(ctx, req)
+}
+
+// PinMessage calls resonance.gateway.v1.SessionService.PinMessage.
+func (c *sessionServiceClient) PinMessage(ctx context.Context, req *connect.Request[v1.PinMessageRequest]) (*connect.Response[v1.PinMessageResponse], error) {
+	return c.pinMessage.CallUnary(ctx, req)
+}
+
+// UnpinMessage calls resonance.gateway.v1.SessionService.UnpinMessage.
+func (c *sessionServiceClient) UnpinMessage(ctx context.Context, req *connect.Request[v1.UnpinMessageRequest]) (*connect.Response[v1.UnpinMessageResponse], error) {
+	return c.unpinMessage.CallUnary(ctx, req)
+}
+
+// ListPinnedMessages calls resonance.gateway.v1.SessionService.ListPinnedMessages.
+func (c *sessionServiceClient) ListPinnedMessages(ctx context.Context, req *connect.Request[v1.ListPinnedMessagesRequest]) (*connect.Response[v1.ListPinnedMessagesResponse], error) {
+	return c.listPinnedMessages.CallUnary(ctx, req)
+}
+
+// SetMessageTTL calls resonance.gateway.v1.SessionService.SetMessageTTL.
+func (c *sessionServiceClient) SetMessageTTL(ctx context.Context, req *connect.Request[v1.SetMessageTTLRequest]) (*connect.Response[v1.SetMessageTTLResponse], error) {
+	return c.setMessageTTL.CallUnary(ctx, req)
+}
+
+// SearchMessages calls resonance.gateway.v1.SessionService.SearchMessages.
+func (c *sessionServiceClient) SearchMessages(ctx context.Context, req *connect.Request[v1.SearchMessagesRequest]) (*connect.Response[v1.SearchMessagesResponse], error) {
+	return c.searchMessages.CallUnary(ctx, req)
+}
+
+// DeleteMessagesForMe calls resonance.gateway.v1.SessionService.DeleteMessagesForMe.
+func (c *sessionServiceClient) DeleteMessagesForMe(ctx context.Context, req *connect.Request[v1.DeleteMessagesForMeRequest]) (*connect.Response[v1.DeleteMessagesForMeResponse], error) {
+	return c.deleteMessagesForMe.CallUnary(ctx, req)
+}
+
+// AddMembers calls resonance.gateway.v1.SessionService.AddMembers.
+func (c *sessionServiceClient) AddMembers(ctx context.Context, req *connect.Request[v1.AddMembersRequest]) (*connect.Response[v1.AddMembersResponse], error) {
+	return c.addMembers.CallUnary(ctx, req)
+}
+
+// RemoveMember calls resonance.gateway.v1.SessionService.RemoveMember.
+func (c *sessionServiceClient) RemoveMember(ctx context.Context, req *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error) {
+	return c.removeMember.CallUnary(ctx, req)
+}
+
+// LeaveSession calls resonance.gateway.v1.SessionService.LeaveSession.
+func (c *sessionServiceClient) LeaveSession(ctx context.Context, req *connect.Request[v1.LeaveSessionRequest]) (*connect.Response[v1.LeaveSessionResponse], error) {
+	return c.leaveSession.CallUnary(ctx, req)
+}
+
+// RenameSession calls resonance.gateway.v1.SessionService.RenameSession.
+func (c *sessionServiceClient) RenameSession(ctx context.Context, req *connect.Request[v1.RenameSessionRequest]) (*connect.Response[v1.RenameSessionResponse], error) {
+	return c.renameSession.CallUnary(ctx, req)
+}
+
+// PromoteAdmin calls resonance.gateway.v1.SessionService.PromoteAdmin.
+func (c *sessionServiceClient) PromoteAdmin(ctx context.Context, req *connect.Request[v1.PromoteAdminRequest]) (*connect.Response[v1.PromoteAdminResponse], error) {
+	return c.promoteAdmin.CallUnary(ctx, req)
+}
+
+// DemoteAdmin calls resonance.gateway.v1.SessionService.DemoteAdmin.
+func (c *sessionServiceClient) DemoteAdmin(ctx context.Context, req *connect.Request[v1.DemoteAdminRequest]) (*connect.Response[v1.DemoteAdminResponse], error) {
+	return c.demoteAdmin.CallUnary(ctx, req)
+}
+
+// TransferOwnership calls resonance.gateway.v1.SessionService.TransferOwnership.
+func (c *sessionServiceClient) TransferOwnership(ctx context.Context, req *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error) {
+	return c.transferOwnership.CallUnary(ctx, req)
+}
+
+// MuteMember calls resonance.gateway.v1.SessionService.MuteMember.
+func (c *sessionServiceClient) MuteMember(ctx context.Context, req *connect.Request[v1.MuteMemberRequest]) (*connect.Response[v1.MuteMemberResponse], error) {
+	return c.muteMember.CallUnary(ctx, req)
+}
+
+// UnmuteMember calls resonance.gateway.v1.SessionService.UnmuteMember.
+func (c *sessionServiceClient) UnmuteMember(ctx context.Context, req *connect.Request[v1.UnmuteMemberRequest]) (*connect.Response[v1.UnmuteMemberResponse], error) {
+	return c.unmuteMember.CallUnary(ctx, req)
+}
+
+// SetDoNotDisturb calls resonance.gateway.v1.SessionService.SetDoNotDisturb.
+func (c *sessionServiceClient) SetDoNotDisturb(ctx context.Context, req *connect.Request[v1.SetDoNotDisturbRequest]) (*connect.Response[v1.SetDoNotDisturbResponse], error) {
+	return c.setDoNotDisturb.CallUnary(ctx, req)
+}
+
+// ScheduleMessage calls resonance.gateway.v1.SessionService.ScheduleMessage.
+func (c *sessionServiceClient) ScheduleMessage(ctx context.Context, req *connect.Request[v1.ScheduleMessageRequest]) (*connect.Response[v1.ScheduleMessageResponse], error) {
+	return c.scheduleMessage.CallUnary(ctx, req)
+}
+
+// ListScheduledMessages calls resonance.gateway.v1.SessionService.ListScheduledMessages.
+func (c *sessionServiceClient) ListScheduledMessages(ctx context.Context, req *connect.Request[v1.ListScheduledMessagesRequest]) (*connect.Response[v1.ListScheduledMessagesResponse], error) {
+	return c.listScheduledMessages.CallUnary(ctx, req)
+}
+
+// UpdateScheduledMessage calls resonance.gateway.v1.SessionService.UpdateScheduledMessage.
+func (c *sessionServiceClient) UpdateScheduledMessage(ctx context.Context, req *connect.Request[v1.UpdateScheduledMessageRequest]) (*connect.Response[v1.UpdateScheduledMessageResponse], error) {
+	return c.updateScheduledMessage.CallUnary(ctx, req)
+}
+
+// CancelScheduledMessage calls resonance.gateway.v1.SessionService.CancelScheduledMessage.
+func (c *sessionServiceClient) CancelScheduledMessage(ctx context.Context, req *connect.Request[v1.CancelScheduledMessageRequest]) (*connect.Response[v1.CancelScheduledMessageResponse], error) {
+	return c.cancelScheduledMessage.CallUnary(ctx, req)
+}
+
+// Announce calls resonance.gateway.v1.SessionService.Announce.
+func (c *sessionServiceClient) Announce(ctx context.Context, req *connect.Request[v1.AnnounceRequest]) (*connect.Response[v1.AnnounceResponse], error) {
+	return c.announce.CallUnary(ctx, req)
+}
+
+// ListAnnouncements calls resonance.gateway.v1.SessionService.ListAnnouncements.
+func (c *sessionServiceClient) ListAnnouncements(ctx context.Context, req *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error) {
+	return c.listAnnouncements.CallUnary(ctx, req)
+}
+
+// CreateWebhook calls resonance.gateway.v1.SessionService.CreateWebhook.
+func (c *sessionServiceClient) CreateWebhook(ctx context.Context, req *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error) {
+	return c.createWebhook.CallUnary(ctx, req)
+}
+
+// ListWebhooks calls resonance.gateway.v1.SessionService.ListWebhooks.
+func (c *sessionServiceClient) ListWebhooks(ctx context.Context, req *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error) {
+	return c.listWebhooks.CallUnary(ctx, req)
+}
+
+// DeleteWebhook calls resonance.gateway.v1.SessionService.DeleteWebhook.
+func (c *sessionServiceClient) DeleteWebhook(ctx context.Context, req *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error) {
+	return c.deleteWebhook.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveries calls resonance.gateway.v1.SessionService.ListWebhookDeliveries.
+func (c *sessionServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// CreateBot calls resonance.gateway.v1.SessionService.CreateBot.
+func (c *sessionServiceClient) CreateBot(ctx context.Context, req *connect.Request[v1.CreateBotRequest]) (*connect.Response[v1.CreateBotResponse], error) {
+	return c.createBot.CallUnary(ctx, req)
+}
+
+// ListBots calls resonance.gateway.v1.SessionService.ListBots.
+func (c *sessionServiceClient) ListBots(ctx context.Context, req *connect.Request[v1.ListBotsRequest]) (*connect.Response[v1.ListBotsResponse], error) {
+	return c.listBots.CallUnary(ctx, req)
+}
+
+// RotateBotToken calls resonance.gateway.v1.SessionService.RotateBotToken.
+func (c *sessionServiceClient) RotateBotToken(ctx context.Context, req *connect.Request[v1.RotateBotTokenRequest]) (*connect.Response[v1.RotateBotTokenResponse], error) {
+	return c.rotateBotToken.CallUnary(ctx, req)
+}
+
 // SessionServiceHandler is an implementation of the resonance.gateway.v1.SessionService service.
 type SessionServiceHandler interface {
 	// GetSessionList 获取用户的会话列表
@@ -328,6 +1005,86 @@ type SessionServiceHandler interface {
 	UpdateReadPosition(context.Context, *connect.Request[v1.UpdateReadPositionRequest]) (*connect.Response[v1.UpdateReadPositionResponse], error)
 	// PullInboxDelta 按用户游标增量拉取消息
 	PullInboxDelta(context.Context, *connect.Request[v1.PullInboxDeltaRequest]) (*connect.Response[v1.PullInboxDeltaResponse], error)
+	// RecallMessage 撤回消息（发送者在时间窗口内，或群管理员）
+	RecallMessage(context.Context, *connect.Request[v1.RecallMessageRequest]) (*connect.Response[v1.RecallMessageResponse], error)
+	// EditMessage 编辑消息（仅发送者，且在时间窗口内）
+	EditMessage(context.Context, *connect.Request[v1.EditMessageRequest]) (*connect.Response[v1.EditMessageResponse], error)
+	// GetThreadMessages 拉取话题消息
+	GetThreadMessages(context.Context, *connect.Request[v1.GetThreadMessagesRequest]) (*connect.Response[v1.GetThreadMessagesResponse], error)
+	// FollowThread 关注话题
+	FollowThread(context.Context, *connect.Request[v1.FollowThreadRequest]) (*connect.Response[v1.FollowThreadResponse], error)
+	// UnfollowThread 取消关注话题
+	UnfollowThread(context.Context, *connect.Request[v1.UnfollowThreadRequest]) (*connect.Response[v1.UnfollowThreadResponse], error)
+	// AddReaction 添加表情回应
+	AddReaction(context.Context, *connect.Request[v1.AddReactionRequest]) (*connect.Response[v1.AddReactionResponse], error)
+	// RemoveReaction 取消表情回应
+	RemoveReaction(context.Context, *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error)
+	// CastVote 在投票消息中投票
+	CastVote(context.Context, *connect.Request[v1.CastVoteRequest]) (*connect.Response[v1.CastVoteResponse], error)
+	// ClosePoll 提前结束投票（发起者或群管理员）
+	ClosePoll(context.Context, *connect.Request[v1.ClosePollRequest]) (*connect.Response[v1.ClosePollResponse], error)
+	// GetMessageReadStatus 获取消息的已读/未读成员（仅发送者可查）
+	GetMessageReadStatus(context.Context, *connect.Request[v1.GetMessageReadStatusRequest]) (*connect.Response[v1.GetMessageReadStatusResponse], error)
+	// ForwardMessages 逐条或合并转发消息到其他会话
+	ForwardMessages(context.Context, *connect.Request[v1.ForwardMessagesRequest]) (*connect.Response[v1.ForwardMessagesResponse], error)
+	// PinMessage 置顶消息（群聊仅管理员可操作）
+	PinMessage(context.Context, *connect.Request[v1.PinMessageRequest]) (*connect.Response[v1.PinMessageResponse], error)
+	// UnpinMessage 取消置顶
+	UnpinMessage(context.Context, *connect.Request[v1.UnpinMessageRequest]) (*connect.Response[v1.UnpinMessageResponse], error)
+	// ListPinnedMessages 获取会话的置顶消息
+	ListPinnedMessages(context.Context, *connect.Request[v1.ListPinnedMessagesRequest]) (*connect.Response[v1.ListPinnedMessagesResponse], error)
+	// SetMessageTTL 设置会话消息的保留时长（阅后即焚）
+	SetMessageTTL(context.Context, *connect.Request[v1.SetMessageTTLRequest]) (*connect.Response[v1.SetMessageTTLResponse], error)
+	// SearchMessages 全文搜索当前用户所在会话的历史消息
+	SearchMessages(context.Context, *connect.Request[v1.SearchMessagesRequest]) (*connect.Response[v1.SearchMessagesResponse], error)
+	// DeleteMessagesForMe 仅对自己删除消息或清空聊天记录，并同步到本人其他设备
+	DeleteMessagesForMe(context.Context, *connect.Request[v1.DeleteMessagesForMeRequest]) (*connect.Response[v1.DeleteMessagesForMeResponse], error)
+	// AddMembers 邀请用户加入群聊（仅管理员）
+	AddMembers(context.Context, *connect.Request[v1.AddMembersRequest]) (*connect.Response[v1.AddMembersResponse], error)
+	// RemoveMember 将成员移出群聊（仅管理员）
+	RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error)
+	// LeaveSession 主动退出群聊
+	LeaveSession(context.Context, *connect.Request[v1.LeaveSessionRequest]) (*connect.Response[v1.LeaveSessionResponse], error)
+	// RenameSession 修改群名称（管理员及群主）
+	RenameSession(context.Context, *connect.Request[v1.RenameSessionRequest]) (*connect.Response[v1.RenameSessionResponse], error)
+	// PromoteAdmin 将成员设为管理员（仅群主）
+	PromoteAdmin(context.Context, *connect.Request[v1.PromoteAdminRequest]) (*connect.Response[v1.PromoteAdminResponse], error)
+	// DemoteAdmin 取消成员的管理员身份（仅群主）
+	DemoteAdmin(context.Context, *connect.Request[v1.DemoteAdminRequest]) (*connect.Response[v1.DemoteAdminResponse], error)
+	// TransferOwnership 转让群主（仅群主）
+	TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error)
+	// MuteMember 禁言成员（管理员及群主）
+	MuteMember(context.Context, *connect.Request[v1.MuteMemberRequest]) (*connect.Response[v1.MuteMemberResponse], error)
+	// UnmuteMember 解除成员的禁言（管理员及群主）
+	UnmuteMember(context.Context, *connect.Request[v1.UnmuteMemberRequest]) (*connect.Response[v1.UnmuteMemberResponse], error)
+	// SetDoNotDisturb 设置会话免打扰（仅影响当前用户）
+	SetDoNotDisturb(context.Context, *connect.Request[v1.SetDoNotDisturbRequest]) (*connect.Response[v1.SetDoNotDisturbResponse], error)
+	// ScheduleMessage 创建定时消息
+	ScheduleMessage(context.Context, *connect.Request[v1.ScheduleMessageRequest]) (*connect.Response[v1.ScheduleMessageResponse], error)
+	// ListScheduledMessages 获取当前用户的定时消息
+	ListScheduledMessages(context.Context, *connect.Request[v1.ListScheduledMessagesRequest]) (*connect.Response[v1.ListScheduledMessagesResponse], error)
+	// UpdateScheduledMessage 编辑待发送的定时消息
+	UpdateScheduledMessage(context.Context, *connect.Request[v1.UpdateScheduledMessageRequest]) (*connect.Response[v1.UpdateScheduledMessageResponse], error)
+	// CancelScheduledMessage 取消待发送的定时消息
+	CancelScheduledMessage(context.Context, *connect.Request[v1.CancelScheduledMessageRequest]) (*connect.Response[v1.CancelScheduledMessageResponse], error)
+	// Announce 发布系统公告（仅系统管理员）
+	Announce(context.Context, *connect.Request[v1.AnnounceRequest]) (*connect.Response[v1.AnnounceResponse], error)
+	// ListAnnouncements 获取离线期间错过的系统公告
+	ListAnnouncements(context.Context, *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error)
+	// CreateWebhook 为会话创建出站 Webhook 订阅
+	CreateWebhook(context.Context, *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error)
+	// ListWebhooks 列出会话的 Webhook 订阅
+	ListWebhooks(context.Context, *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error)
+	// DeleteWebhook 删除 Webhook 订阅
+	DeleteWebhook(context.Context, *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error)
+	// ListWebhookDeliveries 查看 Webhook 投递日志
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	// CreateBot 创建机器人账号，返回其 API Token（仅此一次）
+	CreateBot(context.Context, *connect.Request[v1.CreateBotRequest]) (*connect.Response[v1.CreateBotResponse], error)
+	// ListBots 列出当前用户创建的机器人账号
+	ListBots(context.Context, *connect.Request[v1.ListBotsRequest]) (*connect.Response[v1.ListBotsResponse], error)
+	// RotateBotToken 轮换机器人的 API Token，旧 Token 立即失效
+	RotateBotToken(context.Context, *connect.Request[v1.RotateBotTokenRequest]) (*connect.Response[v1.RotateBotTokenResponse], error)
 }
 
 // NewSessionServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -379,6 +1136,246 @@ func NewSessionServiceHandler(svc SessionServiceHandler, opts ...connect.Handler
 		connect.WithSchema(sessionServiceMethods.ByName("PullInboxDelta")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sessionServiceRecallMessageHandler := connect.NewUnaryHandler(
+		SessionServiceRecallMessageProcedure,
+		svc.RecallMessage,
+		connect.WithSchema(sessionServiceMethods.ByName("RecallMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceEditMessageHandler := connect.NewUnaryHandler(
+		SessionServiceEditMessageProcedure,
+		svc.EditMessage,
+		connect.WithSchema(sessionServiceMethods.ByName("EditMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceGetThreadMessagesHandler := connect.NewUnaryHandler(
+		SessionServiceGetThreadMessagesProcedure,
+		svc.GetThreadMessages,
+		connect.WithSchema(sessionServiceMethods.ByName("GetThreadMessages")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceFollowThreadHandler := connect.NewUnaryHandler(
+		SessionServiceFollowThreadProcedure,
+		svc.FollowThread,
+		connect.WithSchema(sessionServiceMethods.ByName("FollowThread")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceUnfollowThreadHandler := connect.NewUnaryHandler(
+		SessionServiceUnfollowThreadProcedure,
+		svc.UnfollowThread,
+		connect.WithSchema(sessionServiceMethods.ByName("UnfollowThread")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceAddReactionHandler := connect.NewUnaryHandler(
+		SessionServiceAddReactionProcedure,
+		svc.AddReaction,
+		connect.WithSchema(sessionServiceMethods.ByName("AddReaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceRemoveReactionHandler := connect.NewUnaryHandler(
+		SessionServiceRemoveReactionProcedure,
+		svc.RemoveReaction,
+		connect.WithSchema(sessionServiceMethods.ByName("RemoveReaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceCastVoteHandler := connect.NewUnaryHandler(
+		SessionServiceCastVoteProcedure,
+		svc.CastVote,
+		connect.WithSchema(sessionServiceMethods.ByName("CastVote")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceClosePollHandler := connect.NewUnaryHandler(
+		SessionServiceClosePollProcedure,
+		svc.ClosePoll,
+		connect.WithSchema(sessionServiceMethods.ByName("ClosePoll")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceGetMessageReadStatusHandler := connect.NewUnaryHandler(
+		SessionServiceGetMessageReadStatusProcedure,
+		svc.GetMessageReadStatus,
+		connect.WithSchema(sessionServiceMethods.ByName("GetMessageReadStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceForwardMessagesHandler := connect.NewUnaryHandler(
+		SessionServiceForwardMessagesProcedure,
+		svc.ForwardMessages,
+		connect.WithSchema(sessionServiceMethods.ByName("ForwardMessages")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServicePinMessageHandler := connect.NewUnaryHandler(
+		SessionServicePinMessageProcedure,
+		svc.PinMessage,
+		connect.WithSchema(sessionServiceMethods.ByName("PinMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceUnpinMessageHandler := connect.NewUnaryHandler(
+		SessionServiceUnpinMessageProcedure,
+		svc.UnpinMessage,
+		connect.WithSchema(sessionServiceMethods.ByName("UnpinMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceListPinnedMessagesHandler := connect.NewUnaryHandler(
+		SessionServiceListPinnedMessagesProcedure,
+		svc.ListPinnedMessages,
+		connect.WithSchema(sessionServiceMethods.ByName("ListPinnedMessages")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceSetMessageTTLHandler := connect.NewUnaryHandler(
+		SessionServiceSetMessageTTLProcedure,
+		svc.SetMessageTTL,
+		connect.WithSchema(sessionServiceMethods.ByName("SetMessageTTL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceSearchMessagesHandler := connect.NewUnaryHandler(
+		SessionServiceSearchMessagesProcedure,
+		svc.SearchMessages,
+		connect.WithSchema(sessionServiceMethods.ByName("SearchMessages")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceDeleteMessagesForMeHandler := connect.NewUnaryHandler(
+		SessionServiceDeleteMessagesForMeProcedure,
+		svc.DeleteMessagesForMe,
+		connect.WithSchema(sessionServiceMethods.ByName("DeleteMessagesForMe")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceAddMembersHandler := connect.NewUnaryHandler(
+		SessionServiceAddMembersProcedure,
+		svc.AddMembers,
+		connect.WithSchema(sessionServiceMethods.ByName("AddMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceRemoveMemberHandler := connect.NewUnaryHandler(
+		SessionServiceRemoveMemberProcedure,
+		svc.RemoveMember,
+		connect.WithSchema(sessionServiceMethods.ByName("RemoveMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceLeaveSessionHandler := connect.NewUnaryHandler(
+		SessionServiceLeaveSessionProcedure,
+		svc.LeaveSession,
+		connect.WithSchema(sessionServiceMethods.ByName("LeaveSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceRenameSessionHandler := connect.NewUnaryHandler(
+		SessionServiceRenameSessionProcedure,
+		svc.RenameSession,
+		connect.WithSchema(sessionServiceMethods.ByName("RenameSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServicePromoteAdminHandler := connect.NewUnaryHandler(
+		SessionServicePromoteAdminProcedure,
+		svc.PromoteAdmin,
+		connect.WithSchema(sessionServiceMethods.ByName("PromoteAdmin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceDemoteAdminHandler := connect.NewUnaryHandler(
+		SessionServiceDemoteAdminProcedure,
+		svc.DemoteAdmin,
+		connect.WithSchema(sessionServiceMethods.ByName("DemoteAdmin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceTransferOwnershipHandler := connect.NewUnaryHandler(
+		SessionServiceTransferOwnershipProcedure,
+		svc.TransferOwnership,
+		connect.WithSchema(sessionServiceMethods.ByName("TransferOwnership")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceMuteMemberHandler := connect.NewUnaryHandler(
+		SessionServiceMuteMemberProcedure,
+		svc.MuteMember,
+		connect.WithSchema(sessionServiceMethods.ByName("MuteMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceUnmuteMemberHandler := connect.NewUnaryHandler(
+		SessionServiceUnmuteMemberProcedure,
+		svc.UnmuteMember,
+		connect.WithSchema(sessionServiceMethods.ByName("UnmuteMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceSetDoNotDisturbHandler := connect.NewUnaryHandler(
+		SessionServiceSetDoNotDisturbProcedure,
+		svc.SetDoNotDisturb,
+		connect.WithSchema(sessionServiceMethods.ByName("SetDoNotDisturb")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceScheduleMessageHandler := connect.NewUnaryHandler(
+		SessionServiceScheduleMessageProcedure,
+		svc.ScheduleMessage,
+		connect.WithSchema(sessionServiceMethods.ByName("ScheduleMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceListScheduledMessagesHandler := connect.NewUnaryHandler(
+		SessionServiceListScheduledMessagesProcedure,
+		svc.ListScheduledMessages,
+		connect.WithSchema(sessionServiceMethods.ByName("ListScheduledMessages")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceUpdateScheduledMessageHandler := connect.NewUnaryHandler(
+		SessionServiceUpdateScheduledMessageProcedure,
+		svc.UpdateScheduledMessage,
+		connect.WithSchema(sessionServiceMethods.ByName("UpdateScheduledMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceCancelScheduledMessageHandler := connect.NewUnaryHandler(
+		SessionServiceCancelScheduledMessageProcedure,
+		svc.CancelScheduledMessage,
+		connect.WithSchema(sessionServiceMethods.ByName("CancelScheduledMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceAnnounceHandler := connect.NewUnaryHandler(
+		SessionServiceAnnounceProcedure,
+		svc.Announce,
+		connect.WithSchema(sessionServiceMethods.ByName("Announce")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceListAnnouncementsHandler := connect.NewUnaryHandler(
+		SessionServiceListAnnouncementsProcedure,
+		svc.ListAnnouncements,
+		connect.WithSchema(sessionServiceMethods.ByName("ListAnnouncements")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceCreateWebhookHandler := connect.NewUnaryHandler(
+		SessionServiceCreateWebhookProcedure,
+		svc.CreateWebhook,
+		connect.WithSchema(sessionServiceMethods.ByName("CreateWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceListWebhooksHandler := connect.NewUnaryHandler(
+		SessionServiceListWebhooksProcedure,
+		svc.ListWebhooks,
+		connect.WithSchema(sessionServiceMethods.ByName("ListWebhooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceDeleteWebhookHandler := connect.NewUnaryHandler(
+		SessionServiceDeleteWebhookProcedure,
+		svc.DeleteWebhook,
+		connect.WithSchema(sessionServiceMethods.ByName("DeleteWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		SessionServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(sessionServiceMethods.ByName("ListWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceCreateBotHandler := connect.NewUnaryHandler(
+		SessionServiceCreateBotProcedure,
+		svc.CreateBot,
+		connect.WithSchema(sessionServiceMethods.ByName("CreateBot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceListBotsHandler := connect.NewUnaryHandler(
+		SessionServiceListBotsProcedure,
+		svc.ListBots,
+		connect.WithSchema(sessionServiceMethods.ByName("ListBots")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceRotateBotTokenHandler := connect.NewUnaryHandler(
+		SessionServiceRotateBotTokenProcedure,
+		svc.RotateBotToken,
+		connect.WithSchema(sessionServiceMethods.ByName("RotateBotToken")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/resonance.gateway.v1.SessionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SessionServiceGetSessionListProcedure:
@@ -395,6 +1392,86 @@ func NewSessionServiceHandler(svc SessionServiceHandler, opts ...connect.Handler
 			sessionServiceUpdateReadPositionHandler.ServeHTTP(w, r)
 		case SessionServicePullInboxDeltaProcedure:
 			sessionServicePullInboxDeltaHandler.ServeHTTP(w, r)
+		case SessionServiceRecallMessageProcedure:
+			sessionServiceRecallMessageHandler.ServeHTTP(w, r)
+		case SessionServiceEditMessageProcedure:
+			sessionServiceEditMessageHandler.ServeHTTP(w, r)
+		case SessionServiceGetThreadMessagesProcedure:
+			sessionServiceGetThreadMessagesHandler.ServeHTTP(w, r)
+		case SessionServiceFollowThreadProcedure:
+			sessionServiceFollowThreadHandler.ServeHTTP(w, r)
+		case SessionServiceUnfollowThreadProcedure:
+			sessionServiceUnfollowThreadHandler.ServeHTTP(w, r)
+		case SessionServiceAddReactionProcedure:
+			sessionServiceAddReactionHandler.ServeHTTP(w, r)
+		case SessionServiceRemoveReactionProcedure:
+			sessionServiceRemoveReactionHandler.ServeHTTP(w, r)
+		case SessionServiceCastVoteProcedure:
+			sessionServiceCastVoteHandler.ServeHTTP(w, r)
+		case SessionServiceClosePollProcedure:
+			sessionServiceClosePollHandler.ServeHTTP(w, r)
+		case SessionServiceGetMessageReadStatusProcedure:
+			sessionServiceGetMessageReadStatusHandler.ServeHTTP(w, r)
+		case SessionServiceForwardMessagesProcedure:
+			sessionServiceForwardMessagesHandler.ServeHTTP(w, r)
+		case SessionServicePinMessageProcedure:
+			sessionServicePinMessageHandler.ServeHTTP(w, r)
+		case SessionServiceUnpinMessageProcedure:
+			sessionServiceUnpinMessageHandler.ServeHTTP(w, r)
+		case SessionServiceListPinnedMessagesProcedure:
+			sessionServiceListPinnedMessagesHandler.ServeHTTP(w, r)
+		case SessionServiceSetMessageTTLProcedure:
+			sessionServiceSetMessageTTLHandler.ServeHTTP(w, r)
+		case SessionServiceSearchMessagesProcedure:
+			sessionServiceSearchMessagesHandler.ServeHTTP(w, r)
+		case SessionServiceDeleteMessagesForMeProcedure:
+			sessionServiceDeleteMessagesForMeHandler.ServeHTTP(w, r)
+		case SessionServiceAddMembersProcedure:
+			sessionServiceAddMembersHandler.ServeHTTP(w, r)
+		case SessionServiceRemoveMemberProcedure:
+			sessionServiceRemoveMemberHandler.ServeHTTP(w, r)
+		case SessionServiceLeaveSessionProcedure:
+			sessionServiceLeaveSessionHandler.ServeHTTP(w, r)
+		case SessionServiceRenameSessionProcedure:
+			sessionServiceRenameSessionHandler.ServeHTTP(w, r)
+		case SessionServicePromoteAdminProcedure:
+			sessionServicePromoteAdminHandler.ServeHTTP(w, r)
+		case SessionServiceDemoteAdminProcedure:
+			sessionServiceDemoteAdminHandler.ServeHTTP(w, r)
+		case SessionServiceTransferOwnershipProcedure:
+			sessionServiceTransferOwnershipHandler.ServeHTTP(w, r)
+		case SessionServiceMuteMemberProcedure:
+			sessionServiceMuteMemberHandler.ServeHTTP(w, r)
+		case SessionServiceUnmuteMemberProcedure:
+			sessionServiceUnmuteMemberHandler.ServeHTTP(w, r)
+		case SessionServiceSetDoNotDisturbProcedure:
+			sessionServiceSetDoNotDisturbHandler.ServeHTTP(w, r)
+		case SessionServiceScheduleMessageProcedure:
+			sessionServiceScheduleMessageHandler.ServeHTTP(w, r)
+		case SessionServiceListScheduledMessagesProcedure:
+			sessionServiceListScheduledMessagesHandler.ServeHTTP(w, r)
+		case SessionServiceUpdateScheduledMessageProcedure:
+			sessionServiceUpdateScheduledMessageHandler.ServeHTTP(w, r)
+		case SessionServiceCancelScheduledMessageProcedure:
+			sessionServiceCancelScheduledMessageHandler.ServeHTTP(w, r)
+		case SessionServiceAnnounceProcedure:
+			sessionServiceAnnounceHandler.ServeHTTP(w, r)
+		case SessionServiceListAnnouncementsProcedure:
+			sessionServiceListAnnouncementsHandler.ServeHTTP(w, r)
+		case SessionServiceCreateWebhookProcedure:
+			sessionServiceCreateWebhookHandler.ServeHTTP(w, r)
+		case SessionServiceListWebhooksProcedure:
+			sessionServiceListWebhooksHandler.ServeHTTP(w, r)
+		case SessionServiceDeleteWebhookProcedure:
+			sessionServiceDeleteWebhookHandler.ServeHTTP(w, r)
+		case SessionServiceListWebhookDeliveriesProcedure:
+			sessionServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case SessionServiceCreateBotProcedure:
+			sessionServiceCreateBotHandler.ServeHTTP(w, r)
+		case SessionServiceListBotsProcedure:
+			sessionServiceListBotsHandler.ServeHTTP(w, r)
+		case SessionServiceRotateBotTokenProcedure:
+			sessionServiceRotateBotTokenHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -431,3 +1508,163 @@ func (UnimplementedSessionServiceHandler) UpdateReadPosition(context.Context, *c
 func (UnimplementedSessionServiceHandler) PullInboxDelta(context.Context, *connect.Request[v1.PullInboxDeltaRequest]) (*connect.Response[v1.PullInboxDeltaResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.PullInboxDelta is not implemented"))
 }
+
+func (UnimplementedSessionServiceHandler) RecallMessage(context.Context, *connect.Request[v1.RecallMessageRequest]) (*connect.Response[v1.RecallMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.RecallMessage is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) EditMessage(context.Context, *connect.Request[v1.EditMessageRequest]) (*connect.Response[v1.EditMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.EditMessage is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) GetThreadMessages(context.Context, *connect.Request[v1.GetThreadMessagesRequest]) (*connect.Response[v1.GetThreadMessagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.GetThreadMessages is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) FollowThread(context.Context, *connect.Request[v1.FollowThreadRequest]) (*connect.Response[v1.FollowThreadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.FollowThread is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) UnfollowThread(context.Context, *connect.Request[v1.UnfollowThreadRequest]) (*connect.Response[v1.UnfollowThreadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.UnfollowThread is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) AddReaction(context.Context, *connect.Request[v1.AddReactionRequest]) (*connect.Response[v1.AddReactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.AddReaction is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) RemoveReaction(context.Context, *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.RemoveReaction is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) CastVote(context.Context, *connect.Request[v1.CastVoteRequest]) (*connect.Response[v1.CastVoteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.CastVote is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) ClosePoll(context.Context, *connect.Request[v1.ClosePollRequest]) (*connect.Response[v1.ClosePollResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.ClosePoll is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) GetMessageReadStatus(context.Context, *connect.Request[v1.GetMessageReadStatusRequest]) (*connect.Response[v1.GetMessageReadStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.GetMessageReadStatus is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) ForwardMessages(context.Context, *connect.Request[v1.ForwardMessagesRequest]) (*connect.Response[v1.ForwardMessagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.ForwardMessages is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) PinMessage(context.Context, *connect.Request[v1.PinMessageRequest]) (*connect.Response[v1.PinMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.PinMessage is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) UnpinMessage(context.Context, *connect.Request[v1.UnpinMessageRequest]) (*connect.Response[v1.UnpinMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.UnpinMessage is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) ListPinnedMessages(context.Context, *connect.Request[v1.ListPinnedMessagesRequest]) (*connect.Response[v1.ListPinnedMessagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.ListPinnedMessages is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) SetMessageTTL(context.Context, *connect.Request[v1.SetMessageTTLRequest]) (*connect.Response[v1.SetMessageTTLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.SetMessageTTL is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) SearchMessages(context.Context, *connect.Request[v1.SearchMessagesRequest]) (*connect.Response[v1.SearchMessagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.SearchMessages is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) DeleteMessagesForMe(context.Context, *connect.Request[v1.DeleteMessagesForMeRequest]) (*connect.Response[v1.DeleteMessagesForMeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.DeleteMessagesForMe is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) AddMembers(context.Context, *connect.Request[v1.AddMembersRequest]) (*connect.Response[v1.AddMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.AddMembers is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.RemoveMember is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) LeaveSession(context.Context, *connect.Request[v1.LeaveSessionRequest]) (*connect.Response[v1.LeaveSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.LeaveSession is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) RenameSession(context.Context, *connect.Request[v1.RenameSessionRequest]) (*connect.Response[v1.RenameSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.RenameSession is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) PromoteAdmin(context.Context, *connect.Request[v1.PromoteAdminRequest]) (*connect.Response[v1.PromoteAdminResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.PromoteAdmin is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) DemoteAdmin(context.Context, *connect.Request[v1.DemoteAdminRequest]) (*connect.Response[v1.DemoteAdminResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.DemoteAdmin is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.TransferOwnership is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) MuteMember(context.Context, *connect.Request[v1.MuteMemberRequest]) (*connect.Response[v1.MuteMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.MuteMember is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) UnmuteMember(context.Context, *connect.Request[v1.UnmuteMemberRequest]) (*connect.Response[v1.UnmuteMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.UnmuteMember is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) SetDoNotDisturb(context.Context, *connect.Request[v1.SetDoNotDisturbRequest]) (*connect.Response[v1.SetDoNotDisturbResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.SetDoNotDisturb is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) ScheduleMessage(context.Context, *connect.Request[v1.ScheduleMessageRequest]) (*connect.Response[v1.ScheduleMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.ScheduleMessage is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) ListScheduledMessages(context.Context, *connect.Request[v1.ListScheduledMessagesRequest]) (*connect.Response[v1.ListScheduledMessagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.ListScheduledMessages is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) UpdateScheduledMessage(context.Context, *connect.Request[v1.UpdateScheduledMessageRequest]) (*connect.Response[v1.UpdateScheduledMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.UpdateScheduledMessage is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) CancelScheduledMessage(context.Context, *connect.Request[v1.CancelScheduledMessageRequest]) (*connect.Response[v1.CancelScheduledMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.CancelScheduledMessage is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) Announce(context.Context, *connect.Request[v1.AnnounceRequest]) (*connect.Response[v1.AnnounceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.Announce is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) ListAnnouncements(context.Context, *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.ListAnnouncements is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) CreateWebhook(context.Context, *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.CreateWebhook is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) ListWebhooks(context.Context, *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.ListWebhooks is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) DeleteWebhook(context.Context, *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.DeleteWebhook is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.ListWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) CreateBot(context.Context, *connect.Request[v1.CreateBotRequest]) (*connect.Response[v1.CreateBotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.CreateBot is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) ListBots(context.Context, *connect.Request[v1.ListBotsRequest]) (*connect.Response[v1.ListBotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.ListBots is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) RotateBotToken(context.Context, *connect.Request[v1.RotateBotTokenRequest]) (*connect.Response[v1.RotateBotTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("resonance.gateway.v1.SessionService.RotateBotToken is not implemented"))
+}
@@ -20,6 +20,345 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TypingState 是正在输入的状态
+type TypingState int32
+
+const (
+	TypingState_TYPING_STATE_UNSPECIFIED TypingState = 0
+	TypingState_TYPING_STATE_STARTED     TypingState = 1 // 开始输入（输入期间客户端应周期性重发以续期）
+	TypingState_TYPING_STATE_STOPPED     TypingState = 2 // 停止输入
+)
+
+// Enum value maps for TypingState.
+var (
+	TypingState_name = map[int32]string{
+		0: "TYPING_STATE_UNSPECIFIED",
+		1: "TYPING_STATE_STARTED",
+		2: "TYPING_STATE_STOPPED",
+	}
+	TypingState_value = map[string]int32{
+		"TYPING_STATE_UNSPECIFIED": 0,
+		"TYPING_STATE_STARTED":     1,
+		"TYPING_STATE_STOPPED":     2,
+	}
+)
+
+func (x TypingState) Enum() *TypingState {
+	p := new(TypingState)
+	*p = x
+	return p
+}
+
+func (x TypingState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TypingState) Descriptor() protoreflect.EnumDescriptor {
+	return file_gateway_v1_packet_proto_enumTypes[0].Descriptor()
+}
+
+func (TypingState) Type() protoreflect.EnumType {
+	return &file_gateway_v1_packet_proto_enumTypes[0]
+}
+
+func (x TypingState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TypingState.Descriptor instead.
+func (TypingState) EnumDescriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{0}
+}
+
+// TextEntityType 是文本实体类型
+type TextEntityType int32
+
+const (
+	TextEntityType_TEXT_ENTITY_TYPE_UNSPECIFIED TextEntityType = 0
+	TextEntityType_TEXT_ENTITY_TYPE_MENTION     TextEntityType = 1 // @提及，username 为被提及用户
+	TextEntityType_TEXT_ENTITY_TYPE_LINK        TextEntityType = 2 // 链接，url 为链接地址
+	TextEntityType_TEXT_ENTITY_TYPE_BOLD        TextEntityType = 3 // 粗体
+	TextEntityType_TEXT_ENTITY_TYPE_ITALIC      TextEntityType = 4 // 斜体
+	TextEntityType_TEXT_ENTITY_TYPE_CODE        TextEntityType = 5 // 行内代码
+)
+
+// Enum value maps for TextEntityType.
+var (
+	TextEntityType_name = map[int32]string{
+		0: "TEXT_ENTITY_TYPE_UNSPECIFIED",
+		1: "TEXT_ENTITY_TYPE_MENTION",
+		2: "TEXT_ENTITY_TYPE_LINK",
+		3: "TEXT_ENTITY_TYPE_BOLD",
+		4: "TEXT_ENTITY_TYPE_ITALIC",
+		5: "TEXT_ENTITY_TYPE_CODE",
+	}
+	TextEntityType_value = map[string]int32{
+		"TEXT_ENTITY_TYPE_UNSPECIFIED": 0,
+		"TEXT_ENTITY_TYPE_MENTION":     1,
+		"TEXT_ENTITY_TYPE_LINK":        2,
+		"TEXT_ENTITY_TYPE_BOLD":        3,
+		"TEXT_ENTITY_TYPE_ITALIC":      4,
+		"TEXT_ENTITY_TYPE_CODE":        5,
+	}
+)
+
+func (x TextEntityType) Enum() *TextEntityType {
+	p := new(TextEntityType)
+	*p = x
+	return p
+}
+
+func (x TextEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TextEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_gateway_v1_packet_proto_enumTypes[1].Descriptor()
+}
+
+func (TextEntityType) Type() protoreflect.EnumType {
+	return &file_gateway_v1_packet_proto_enumTypes[1]
+}
+
+func (x TextEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TextEntityType.Descriptor instead.
+func (TextEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{1}
+}
+
+// ForwardMode 是转发方式
+type ForwardMode int32
+
+const (
+	ForwardMode_FORWARD_MODE_UNSPECIFIED ForwardMode = 0 // 未指定：按逐条转发处理
+	ForwardMode_FORWARD_MODE_SEPARATE    ForwardMode = 1 // 逐条转发：每条原消息生成一条新消息
+	ForwardMode_FORWARD_MODE_MERGED      ForwardMode = 2 // 合并转发：生成一条内嵌原消息快照的聊天记录消息
+)
+
+// Enum value maps for ForwardMode.
+var (
+	ForwardMode_name = map[int32]string{
+		0: "FORWARD_MODE_UNSPECIFIED",
+		1: "FORWARD_MODE_SEPARATE",
+		2: "FORWARD_MODE_MERGED",
+	}
+	ForwardMode_value = map[string]int32{
+		"FORWARD_MODE_UNSPECIFIED": 0,
+		"FORWARD_MODE_SEPARATE":    1,
+		"FORWARD_MODE_MERGED":      2,
+	}
+)
+
+func (x ForwardMode) Enum() *ForwardMode {
+	p := new(ForwardMode)
+	*p = x
+	return p
+}
+
+func (x ForwardMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForwardMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_gateway_v1_packet_proto_enumTypes[2].Descriptor()
+}
+
+func (ForwardMode) Type() protoreflect.EnumType {
+	return &file_gateway_v1_packet_proto_enumTypes[2]
+}
+
+func (x ForwardMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForwardMode.Descriptor instead.
+func (ForwardMode) EnumDescriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{2}
+}
+
+// WebhookDeliveryStatus 是 Webhook 投递状态
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING     WebhookDeliveryStatus = 1 // 待投递（含等待重试）
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED   WebhookDeliveryStatus = 2 // 投递成功（对端返回 2xx）
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED      WebhookDeliveryStatus = 3 // 重试耗尽，投递失败
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_SUCCEEDED",
+		3: "WEBHOOK_DELIVERY_STATUS_FAILED",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_SUCCEEDED":   2,
+		"WEBHOOK_DELIVERY_STATUS_FAILED":      3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_gateway_v1_packet_proto_enumTypes[3].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_gateway_v1_packet_proto_enumTypes[3]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{3}
+}
+
+// ScheduledMessageStatus 是定时消息状态
+type ScheduledMessageStatus int32
+
+const (
+	ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_UNSPECIFIED ScheduledMessageStatus = 0
+	ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_PENDING     ScheduledMessageStatus = 1 // 待发送
+	ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_SENDING     ScheduledMessageStatus = 2 // 发送中
+	ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_SENT        ScheduledMessageStatus = 3 // 已发送，msg_id 为生成的消息
+	ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_CANCELED    ScheduledMessageStatus = 4 // 已取消
+	ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_FAILED      ScheduledMessageStatus = 5 // 发送失败，error 为失败原因
+)
+
+// Enum value maps for ScheduledMessageStatus.
+var (
+	ScheduledMessageStatus_name = map[int32]string{
+		0: "SCHEDULED_MESSAGE_STATUS_UNSPECIFIED",
+		1: "SCHEDULED_MESSAGE_STATUS_PENDING",
+		2: "SCHEDULED_MESSAGE_STATUS_SENDING",
+		3: "SCHEDULED_MESSAGE_STATUS_SENT",
+		4: "SCHEDULED_MESSAGE_STATUS_CANCELED",
+		5: "SCHEDULED_MESSAGE_STATUS_FAILED",
+	}
+	ScheduledMessageStatus_value = map[string]int32{
+		"SCHEDULED_MESSAGE_STATUS_UNSPECIFIED": 0,
+		"SCHEDULED_MESSAGE_STATUS_PENDING":     1,
+		"SCHEDULED_MESSAGE_STATUS_SENDING":     2,
+		"SCHEDULED_MESSAGE_STATUS_SENT":        3,
+		"SCHEDULED_MESSAGE_STATUS_CANCELED":    4,
+		"SCHEDULED_MESSAGE_STATUS_FAILED":      5,
+	}
+)
+
+func (x ScheduledMessageStatus) Enum() *ScheduledMessageStatus {
+	p := new(ScheduledMessageStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduledMessageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduledMessageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_gateway_v1_packet_proto_enumTypes[4].Descriptor()
+}
+
+func (ScheduledMessageStatus) Type() protoreflect.EnumType {
+	return &file_gateway_v1_packet_proto_enumTypes[4]
+}
+
+func (x ScheduledMessageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduledMessageStatus.Descriptor instead.
+func (ScheduledMessageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{4}
+}
+
+// MessageAction 标识推送消息对应的动作
+type MessageAction int32
+
+const (
+	MessageAction_MESSAGE_ACTION_UNSPECIFIED    MessageAction = 0 // 未指定：普通消息
+	MessageAction_MESSAGE_ACTION_RECALL         MessageAction = 1 // 撤回：客户端应将 msg_id 对应的气泡替换为墓碑
+	MessageAction_MESSAGE_ACTION_EDIT           MessageAction = 2 // 编辑：客户端按 version 判断是否用新内容替换本地消息
+	MessageAction_MESSAGE_ACTION_REACTION       MessageAction = 3 // 表情回应变更：客户端按 msg_id 用 reactions 覆盖本地聚合
+	MessageAction_MESSAGE_ACTION_READ_RECEIPT   MessageAction = 4 // 已读回执：推送给消息发送者，from_username 为本次读者
+	MessageAction_MESSAGE_ACTION_EXPIRE         MessageAction = 5 // 过期：消息已被服务端删除，客户端应从本地缓存中删除 msg_id 对应的消息
+	MessageAction_MESSAGE_ACTION_HIDE           MessageAction = 6 // 仅自己删除：同步到本人其他设备，客户端删除 hidden_msg_ids 及 seq_id <= cleared_seq 的主时间线消息
+	MessageAction_MESSAGE_ACTION_POLL           MessageAction = 7 // 投票计票变更：客户端按 msg_id 用 poll_tally 覆盖本地计票（保留本地的 my_choices）
+	MessageAction_MESSAGE_ACTION_MEMBER_REMOVED MessageAction = 8 // 退出或被移出会话：推送给离开者本人（含其他设备），客户端应从会话列表移除 session_id，content 为提示文本
+)
+
+// Enum value maps for MessageAction.
+var (
+	MessageAction_name = map[int32]string{
+		0: "MESSAGE_ACTION_UNSPECIFIED",
+		1: "MESSAGE_ACTION_RECALL",
+		2: "MESSAGE_ACTION_EDIT",
+		3: "MESSAGE_ACTION_REACTION",
+		4: "MESSAGE_ACTION_READ_RECEIPT",
+		5: "MESSAGE_ACTION_EXPIRE",
+		6: "MESSAGE_ACTION_HIDE",
+		7: "MESSAGE_ACTION_POLL",
+		8: "MESSAGE_ACTION_MEMBER_REMOVED",
+	}
+	MessageAction_value = map[string]int32{
+		"MESSAGE_ACTION_UNSPECIFIED":    0,
+		"MESSAGE_ACTION_RECALL":         1,
+		"MESSAGE_ACTION_EDIT":           2,
+		"MESSAGE_ACTION_REACTION":       3,
+		"MESSAGE_ACTION_READ_RECEIPT":   4,
+		"MESSAGE_ACTION_EXPIRE":         5,
+		"MESSAGE_ACTION_HIDE":           6,
+		"MESSAGE_ACTION_POLL":           7,
+		"MESSAGE_ACTION_MEMBER_REMOVED": 8,
+	}
+)
+
+func (x MessageAction) Enum() *MessageAction {
+	p := new(MessageAction)
+	*p = x
+	return p
+}
+
+func (x MessageAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_gateway_v1_packet_proto_enumTypes[5].Descriptor()
+}
+
+func (MessageAction) Type() protoreflect.EnumType {
+	return &file_gateway_v1_packet_proto_enumTypes[5]
+}
+
+func (x MessageAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageAction.Descriptor instead.
+func (MessageAction) EnumDescriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{5}
+}
+
 // WsPacket 是所有 WebSocket 消息的封装
 type WsPacket struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -33,6 +372,8 @@ type WsPacket struct {
 	//	*WsPacket_Chat
 	//	*WsPacket_Push
 	//	*WsPacket_Ack
+	//	*WsPacket_Typing
+	//	*WsPacket_Announcement
 	Payload       isWsPacket_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -118,6 +459,24 @@ func (x *WsPacket) GetAck() *Ack {
 	return nil
 }
 
+func (x *WsPacket) GetTyping() *Typing {
+	if x != nil {
+		if x, ok := x.Payload.(*WsPacket_Typing); ok {
+			return x.Typing
+		}
+	}
+	return nil
+}
+
+func (x *WsPacket) GetAnnouncement() *Announcement {
+	if x != nil {
+		if x, ok := x.Payload.(*WsPacket_Announcement); ok {
+			return x.Announcement
+		}
+	}
+	return nil
+}
+
 type isWsPacket_Payload interface {
 	isWsPacket_Payload()
 }
@@ -138,6 +497,14 @@ type WsPacket_Ack struct {
 	Ack *Ack `protobuf:"bytes,13,opt,name=ack,proto3,oneof"` // 确认
 }
 
+type WsPacket_Typing struct {
+	Typing *Typing `protobuf:"bytes,14,opt,name=typing,proto3,oneof"` // 正在输入（双向：客户端上报、服务端转发）
+}
+
+type WsPacket_Announcement struct {
+	Announcement *Announcement `protobuf:"bytes,15,opt,name=announcement,proto3,oneof"` // 系统公告（仅服务端下发，广播给全部在线用户）
+}
+
 func (*WsPacket_Pulse) isWsPacket_Payload() {}
 
 func (*WsPacket_Chat) isWsPacket_Payload() {}
@@ -146,6 +513,10 @@ func (*WsPacket_Push) isWsPacket_Payload() {}
 
 func (*WsPacket_Ack) isWsPacket_Payload() {}
 
+func (*WsPacket_Typing) isWsPacket_Payload() {}
+
+func (*WsPacket_Announcement) isWsPacket_Payload() {}
+
 // Pulse 是心跳信号
 type Pulse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -188,12 +559,17 @@ func (*Pulse) Descriptor() ([]byte, []int) {
 type ChatRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 1, 2 预留给 id, seq (客户端发送时通常没有)
-	SessionId     string `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`          // 会话ID
-	FromUsername  string `protobuf:"bytes,4,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"` // 发送者 (客户端可不填，由网关填充)
-	ToUsername    string `protobuf:"bytes,5,opt,name=to_username,json=toUsername,proto3" json:"to_username,omitempty"`       // 目标用户 (私聊时可能需要)
-	Content       string `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`                               // 内容
-	Type          string `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`                                     // 类型
-	Timestamp     int64  `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                          // timestamp 可选字段，可由网关填充
+	SessionId     string       `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`                // 会话ID
+	FromUsername  string       `protobuf:"bytes,4,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"`       // 发送者 (客户端可不填，由网关填充)
+	ToUsername    string       `protobuf:"bytes,5,opt,name=to_username,json=toUsername,proto3" json:"to_username,omitempty"`             // 目标用户 (私聊时可能需要)
+	Content       string       `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`                                     // 内容
+	Type          string       `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`                                           // 类型
+	Timestamp     int64        `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                // timestamp 可选字段，可由网关填充
+	ReplyToMsgId  int64        `protobuf:"varint,16,opt,name=reply_to_msg_id,json=replyToMsgId,proto3" json:"reply_to_msg_id,omitempty"` // 引用回复的消息ID（0 表示非回复）
+	ThreadRootId  int64        `protobuf:"varint,18,opt,name=thread_root_id,json=threadRootId,proto3" json:"thread_root_id,omitempty"`   // 所属话题的根消息ID（0 表示主时间线消息）
+	Mentions      []string     `protobuf:"bytes,19,rep,name=mentions,proto3" json:"mentions,omitempty"`                                  // @提及的用户名列表
+	MentionAll    bool         `protobuf:"varint,20,opt,name=mention_all,json=mentionAll,proto3" json:"mention_all,omitempty"`           // 是否 @所有人（仅管理员可用）
+	Body          *MessageBody `protobuf:"bytes,26,opt,name=body,proto3" json:"body,omitempty"`                                          // 结构化消息体（为空时按 content/type 作为纯文本处理，兼容旧客户端）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -270,30 +646,67 @@ func (x *ChatRequest) GetTimestamp() int64 {
 	return 0
 }
 
-// SessionMeta 是会话元数据
-// 用于在推送消息时携带会话信息，避免前端额外查询
-type SessionMeta struct {
+func (x *ChatRequest) GetReplyToMsgId() int64 {
+	if x != nil {
+		return x.ReplyToMsgId
+	}
+	return 0
+}
+
+func (x *ChatRequest) GetThreadRootId() int64 {
+	if x != nil {
+		return x.ThreadRootId
+	}
+	return 0
+}
+
+func (x *ChatRequest) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *ChatRequest) GetMentionAll() bool {
+	if x != nil {
+		return x.MentionAll
+	}
+	return false
+}
+
+func (x *ChatRequest) GetBody() *MessageBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+// Typing 是正在输入信号
+// 纯瞬时信号：不落库、不经过 Outbox，离线用户不会收到
+type Typing struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`  // 会话名称
-	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"` // 会话类型：1=单聊, 2=群聊
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`               // 会话ID
+	State         TypingState            `protobuf:"varint,2,opt,name=state,proto3,enum=resonance.gateway.v1.TypingState" json:"state,omitempty"` // 输入状态
+	FromUsername  string                 `protobuf:"bytes,3,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"`      // 输入者（客户端可不填，由网关填充）
+	ExpiresIn     int32                  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`              // 有效期（秒，服务端下发时填充）：超时未续期时接收方应自动清除
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionMeta) Reset() {
-	*x = SessionMeta{}
+func (x *Typing) Reset() {
+	*x = Typing{}
 	mi := &file_gateway_v1_packet_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionMeta) String() string {
+func (x *Typing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionMeta) ProtoMessage() {}
+func (*Typing) ProtoMessage() {}
 
-func (x *SessionMeta) ProtoReflect() protoreflect.Message {
+func (x *Typing) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_v1_packet_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -305,55 +718,73 @@ func (x *SessionMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionMeta.ProtoReflect.Descriptor instead.
-func (*SessionMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use Typing.ProtoReflect.Descriptor instead.
+func (*Typing) Descriptor() ([]byte, []int) {
 	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *SessionMeta) GetName() string {
+func (x *Typing) GetSessionId() string {
 	if x != nil {
-		return x.Name
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *SessionMeta) GetType() int32 {
+func (x *Typing) GetState() TypingState {
 	if x != nil {
-		return x.Type
+		return x.State
+	}
+	return TypingState_TYPING_STATE_UNSPECIFIED
+}
+
+func (x *Typing) GetFromUsername() string {
+	if x != nil {
+		return x.FromUsername
+	}
+	return ""
+}
+
+func (x *Typing) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
 	}
 	return 0
 }
 
-// PushMessage 是推送给用户的消息
-type PushMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MsgId         int64                  `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`                     // 全局唯一物理ID (Snowflake)
-	SeqId         int64                  `protobuf:"varint,2,opt,name=seq_id,json=seqId,proto3" json:"seq_id,omitempty"`                     // 会话内逻辑时钟
-	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`          // 会话ID
-	FromUsername  string                 `protobuf:"bytes,4,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"` // 发送者
-	ToUsername    string                 `protobuf:"bytes,5,opt,name=to_username,json=toUsername,proto3" json:"to_username,omitempty"`       // 接收者/目标用户
-	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`                               // 内容
-	Type          string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`                                     // 类型
-	Timestamp     int64                  `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                          // 时间戳
-	SessionMeta   *SessionMeta           `protobuf:"bytes,10,opt,name=session_meta,json=sessionMeta,proto3" json:"session_meta,omitempty"`   // 会话元数据（首次推送时携带）
+// MessageBody 是结构化消息体
+// 服务端按 body 类型校验并派生 type 与 content（纯文本摘要），旧客户端仍可按 content 展示
+type MessageBody struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Body:
+	//
+	//	*MessageBody_Text
+	//	*MessageBody_Image
+	//	*MessageBody_File
+	//	*MessageBody_Audio
+	//	*MessageBody_Location
+	//	*MessageBody_Contact
+	//	*MessageBody_System
+	//	*MessageBody_Forward
+	//	*MessageBody_Poll
+	Body          isMessageBody_Body `protobuf_oneof:"body"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PushMessage) Reset() {
-	*x = PushMessage{}
+func (x *MessageBody) Reset() {
+	*x = MessageBody{}
 	mi := &file_gateway_v1_packet_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PushMessage) String() string {
+func (x *MessageBody) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushMessage) ProtoMessage() {}
+func (*MessageBody) ProtoMessage() {}
 
-func (x *PushMessage) ProtoReflect() protoreflect.Message {
+func (x *MessageBody) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_v1_packet_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -365,89 +796,2335 @@ func (x *PushMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushMessage.ProtoReflect.Descriptor instead.
-func (*PushMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use MessageBody.ProtoReflect.Descriptor instead.
+func (*MessageBody) Descriptor() ([]byte, []int) {
 	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PushMessage) GetMsgId() int64 {
+func (x *MessageBody) GetBody() isMessageBody_Body {
 	if x != nil {
-		return x.MsgId
+		return x.Body
 	}
-	return 0
+	return nil
 }
 
-func (x *PushMessage) GetSeqId() int64 {
+func (x *MessageBody) GetText() *TextBody {
 	if x != nil {
-		return x.SeqId
+		if x, ok := x.Body.(*MessageBody_Text); ok {
+			return x.Text
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *PushMessage) GetSessionId() string {
+func (x *MessageBody) GetImage() *ImageBody {
 	if x != nil {
-		return x.SessionId
+		if x, ok := x.Body.(*MessageBody_Image); ok {
+			return x.Image
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *PushMessage) GetFromUsername() string {
+func (x *MessageBody) GetFile() *FileBody {
 	if x != nil {
-		return x.FromUsername
+		if x, ok := x.Body.(*MessageBody_File); ok {
+			return x.File
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *PushMessage) GetToUsername() string {
+func (x *MessageBody) GetAudio() *AudioBody {
 	if x != nil {
-		return x.ToUsername
+		if x, ok := x.Body.(*MessageBody_Audio); ok {
+			return x.Audio
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *PushMessage) GetContent() string {
+func (x *MessageBody) GetLocation() *LocationBody {
 	if x != nil {
-		return x.Content
+		if x, ok := x.Body.(*MessageBody_Location); ok {
+			return x.Location
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *PushMessage) GetType() string {
+func (x *MessageBody) GetContact() *ContactCardBody {
 	if x != nil {
-		return x.Type
+		if x, ok := x.Body.(*MessageBody_Contact); ok {
+			return x.Contact
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *PushMessage) GetTimestamp() int64 {
+func (x *MessageBody) GetSystem() *SystemBody {
 	if x != nil {
-		return x.Timestamp
+		if x, ok := x.Body.(*MessageBody_System); ok {
+			return x.System
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *PushMessage) GetSessionMeta() *SessionMeta {
+func (x *MessageBody) GetForward() *ForwardBody {
 	if x != nil {
-		return x.SessionMeta
+		if x, ok := x.Body.(*MessageBody_Forward); ok {
+			return x.Forward
+		}
 	}
 	return nil
 }
 
-// Ack 是可靠交付的确认
+func (x *MessageBody) GetPoll() *PollBody {
+	if x != nil {
+		if x, ok := x.Body.(*MessageBody_Poll); ok {
+			return x.Poll
+		}
+	}
+	return nil
+}
+
+type isMessageBody_Body interface {
+	isMessageBody_Body()
+}
+
+type MessageBody_Text struct {
+	Text *TextBody `protobuf:"bytes,1,opt,name=text,proto3,oneof"` // 文本
+}
+
+type MessageBody_Image struct {
+	Image *ImageBody `protobuf:"bytes,2,opt,name=image,proto3,oneof"` // 图片
+}
+
+type MessageBody_File struct {
+	File *FileBody `protobuf:"bytes,3,opt,name=file,proto3,oneof"` // 文件
+}
+
+type MessageBody_Audio struct {
+	Audio *AudioBody `protobuf:"bytes,4,opt,name=audio,proto3,oneof"` // 语音
+}
+
+type MessageBody_Location struct {
+	Location *LocationBody `protobuf:"bytes,5,opt,name=location,proto3,oneof"` // 位置
+}
+
+type MessageBody_Contact struct {
+	Contact *ContactCardBody `protobuf:"bytes,6,opt,name=contact,proto3,oneof"` // 名片
+}
+
+type MessageBody_System struct {
+	System *SystemBody `protobuf:"bytes,7,opt,name=system,proto3,oneof"` // 系统消息（仅服务端生成）
+}
+
+type MessageBody_Forward struct {
+	Forward *ForwardBody `protobuf:"bytes,8,opt,name=forward,proto3,oneof"` // 合并转发的聊天记录（仅经 ForwardMessages 生成）
+}
+
+type MessageBody_Poll struct {
+	Poll *PollBody `protobuf:"bytes,9,opt,name=poll,proto3,oneof"` // 投票（仅群聊）
+}
+
+func (*MessageBody_Text) isMessageBody_Body() {}
+
+func (*MessageBody_Image) isMessageBody_Body() {}
+
+func (*MessageBody_File) isMessageBody_Body() {}
+
+func (*MessageBody_Audio) isMessageBody_Body() {}
+
+func (*MessageBody_Location) isMessageBody_Body() {}
+
+func (*MessageBody_Contact) isMessageBody_Body() {}
+
+func (*MessageBody_System) isMessageBody_Body() {}
+
+func (*MessageBody_Forward) isMessageBody_Body() {}
+
+func (*MessageBody_Poll) isMessageBody_Body() {}
+
+// TextEntity 是文本中的一段富文本标记
+// offset 与 length 以 Unicode 码点计数
+type TextEntity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          TextEntityType         `protobuf:"varint,1,opt,name=type,proto3,enum=resonance.gateway.v1.TextEntityType" json:"type,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`    // 起始位置
+	Length        int32                  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`    // 长度
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`           // 链接地址（仅 LINK）
+	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"` // 被提及用户（仅 MENTION）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextEntity) Reset() {
+	*x = TextEntity{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextEntity) ProtoMessage() {}
+
+func (x *TextEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextEntity.ProtoReflect.Descriptor instead.
+func (*TextEntity) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TextEntity) GetType() TextEntityType {
+	if x != nil {
+		return x.Type
+	}
+	return TextEntityType_TEXT_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *TextEntity) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TextEntity) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *TextEntity) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *TextEntity) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// TextBody 是文本消息
+type TextBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`         // 文本内容
+	Entities      []*TextEntity          `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"` // 富文本标记
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextBody) Reset() {
+	*x = TextBody{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextBody) ProtoMessage() {}
+
+func (x *TextBody) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextBody.ProtoReflect.Descriptor instead.
+func (*TextBody) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TextBody) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TextBody) GetEntities() []*TextEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+// ImageBody 是图片消息
+// 引用已上传附件时填 attachment_id（url 可为空，大小与 MIME 类型以附件记录为准）
+type ImageBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`                                        // 原图地址
+	ThumbnailUrl  string                 `protobuf:"bytes,2,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`  // 缩略图地址（可选）
+	Width         int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`                                   // 宽度（像素）
+	Height        int32                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`                                 // 高度（像素）
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`                                     // 文件大小（字节）
+	MimeType      string                 `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`              // MIME 类型
+	AttachmentId  int64                  `protobuf:"varint,7,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"` // 附件ID（0 表示外部地址）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageBody) Reset() {
+	*x = ImageBody{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageBody) ProtoMessage() {}
+
+func (x *ImageBody) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageBody.ProtoReflect.Descriptor instead.
+func (*ImageBody) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ImageBody) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImageBody) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *ImageBody) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageBody) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ImageBody) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ImageBody) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ImageBody) GetAttachmentId() int64 {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return 0
+}
+
+// FileBody 是文件消息
+// 引用已上传附件时填 attachment_id（url 可为空，文件名缺省时取附件文件名）
+type FileBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`                                        // 下载地址
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                      // 文件名
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                                     // 文件大小（字节）
+	MimeType      string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`              // MIME 类型
+	AttachmentId  int64                  `protobuf:"varint,5,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"` // 附件ID（0 表示外部地址）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileBody) Reset() {
+	*x = FileBody{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileBody) ProtoMessage() {}
+
+func (x *FileBody) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileBody.ProtoReflect.Descriptor instead.
+func (*FileBody) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FileBody) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FileBody) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileBody) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileBody) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *FileBody) GetAttachmentId() int64 {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return 0
+}
+
+// AudioBody 是语音消息
+// 引用已上传附件时填 attachment_id（url 可为空）
+type AudioBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`                                        // 音频地址
+	DurationMs    int32                  `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`       // 时长（毫秒）
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                                     // 文件大小（字节）
+	MimeType      string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`              // MIME 类型
+	AttachmentId  int64                  `protobuf:"varint,5,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"` // 附件ID（0 表示外部地址）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudioBody) Reset() {
+	*x = AudioBody{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudioBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudioBody) ProtoMessage() {}
+
+func (x *AudioBody) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudioBody.ProtoReflect.Descriptor instead.
+func (*AudioBody) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AudioBody) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AudioBody) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *AudioBody) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AudioBody) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *AudioBody) GetAttachmentId() int64 {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return 0
+}
+
+// LocationBody 是位置消息
+type LocationBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`   // 纬度 [-90, 90]
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"` // 经度 [-180, 180]
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`             // 地点名称
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`       // 详细地址
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationBody) Reset() {
+	*x = LocationBody{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationBody) ProtoMessage() {}
+
+func (x *LocationBody) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationBody.ProtoReflect.Descriptor instead.
+func (*LocationBody) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LocationBody) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *LocationBody) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *LocationBody) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LocationBody) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// ContactCardBody 是名片消息
+type ContactCardBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // 名片用户
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"` // 昵称
+	Avatar        string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`     // 头像
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContactCardBody) Reset() {
+	*x = ContactCardBody{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactCardBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactCardBody) ProtoMessage() {}
+
+func (x *ContactCardBody) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactCardBody.ProtoReflect.Descriptor instead.
+func (*ContactCardBody) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ContactCardBody) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ContactCardBody) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *ContactCardBody) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+// SystemBody 是系统消息（如成员变更通知），客户端不可发送
+type SystemBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                            // 系统消息类型标识，便于客户端本地化
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`                            // 默认展示文本
+	RefMsgId      int64                  `protobuf:"varint,3,opt,name=ref_msg_id,json=refMsgId,proto3" json:"ref_msg_id,omitempty"` // 关联的消息ID（如被置顶/取消置顶的消息，0 表示无）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemBody) Reset() {
+	*x = SystemBody{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemBody) ProtoMessage() {}
+
+func (x *SystemBody) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemBody.ProtoReflect.Descriptor instead.
+func (*SystemBody) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SystemBody) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SystemBody) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SystemBody) GetRefMsgId() int64 {
+	if x != nil {
+		return x.RefMsgId
+	}
+	return 0
+}
+
+// ForwardBody 是合并转发的聊天记录，内嵌原消息快照
+type ForwardBody struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`                                              // 标题，如「alice 的聊天记录」
+	SourceSessionId string                 `protobuf:"bytes,2,opt,name=source_session_id,json=sourceSessionId,proto3" json:"source_session_id,omitempty"` // 来源会话
+	Messages        []*ForwardedMessage    `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`                                        // 原消息快照（按 seq 升序）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ForwardBody) Reset() {
+	*x = ForwardBody{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardBody) ProtoMessage() {}
+
+func (x *ForwardBody) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardBody.ProtoReflect.Descriptor instead.
+func (*ForwardBody) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ForwardBody) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ForwardBody) GetSourceSessionId() string {
+	if x != nil {
+		return x.SourceSessionId
+	}
+	return ""
+}
+
+func (x *ForwardBody) GetMessages() []*ForwardedMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// ForwardedMessage 是被转发消息的快照
+type ForwardedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MsgId         int64                  `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`                     // 原消息ID
+	FromUsername  string                 `protobuf:"bytes,2,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"` // 原发送者
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                               // 纯文本摘要
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                                     // 类型
+	Timestamp     int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                          // 原发送时间
+	Body          *MessageBody           `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`                                     // 结构化消息体（旧消息可能为空，以 content 为准）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForwardedMessage) Reset() {
+	*x = ForwardedMessage{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardedMessage) ProtoMessage() {}
+
+func (x *ForwardedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardedMessage.ProtoReflect.Descriptor instead.
+func (*ForwardedMessage) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ForwardedMessage) GetMsgId() int64 {
+	if x != nil {
+		return x.MsgId
+	}
+	return 0
+}
+
+func (x *ForwardedMessage) GetFromUsername() string {
+	if x != nil {
+		return x.FromUsername
+	}
+	return ""
+}
+
+func (x *ForwardedMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ForwardedMessage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ForwardedMessage) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ForwardedMessage) GetBody() *MessageBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+// PollBody 是投票消息，发送后不可修改；计票状态见 PushMessage.poll_tally
+type PollBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      string                 `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`                           // 问题
+	Options       []string               `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`                             // 选项（2~10 个，不可重复）
+	MultiChoice   bool                   `protobuf:"varint,3,opt,name=multi_choice,json=multiChoice,proto3" json:"multi_choice,omitempty"` // 是否多选
+	Anonymous     bool                   `protobuf:"varint,4,opt,name=anonymous,proto3" json:"anonymous,omitempty"`                        // 是否匿名：匿名投票不向任何人展示投票明细
+	Deadline      int64                  `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`                          // 截止时间（Unix 秒，0 表示无截止时间），到期后不可再投票
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollBody) Reset() {
+	*x = PollBody{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollBody) ProtoMessage() {}
+
+func (x *PollBody) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollBody.ProtoReflect.Descriptor instead.
+func (*PollBody) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PollBody) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *PollBody) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *PollBody) GetMultiChoice() bool {
+	if x != nil {
+		return x.MultiChoice
+	}
+	return false
+}
+
+func (x *PollBody) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *PollBody) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+// PollTally 是一个投票的计票状态
+type PollTally struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        []int32                `protobuf:"varint,1,rep,packed,name=counts,proto3" json:"counts,omitempty"`                        // 各选项票数，下标与 PollBody.options 一致
+	VoterCount    int32                  `protobuf:"varint,2,opt,name=voter_count,json=voterCount,proto3" json:"voter_count,omitempty"`     // 投票人数（多选时一人计一次）
+	Closed        bool                   `protobuf:"varint,3,opt,name=closed,proto3" json:"closed,omitempty"`                               // 是否已结束（发起者或管理员结束，或已过截止时间）
+	ClosedBy      string                 `protobuf:"bytes,4,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`            // 结束投票的操作者（到期自动结束时为空）
+	ClosedAt      int64                  `protobuf:"varint,5,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`           // 结束时间（Unix 秒，未结束为 0）
+	MyChoices     []int32                `protobuf:"varint,6,rep,packed,name=my_choices,json=myChoices,proto3" json:"my_choices,omitempty"` // 当前用户选择的选项下标（仅拉取接口且非匿名投票时填充）
+	Voted         bool                   `protobuf:"varint,7,opt,name=voted,proto3" json:"voted,omitempty"`                                 // 当前用户是否已投票（仅拉取接口中有效）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollTally) Reset() {
+	*x = PollTally{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollTally) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollTally) ProtoMessage() {}
+
+func (x *PollTally) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollTally.ProtoReflect.Descriptor instead.
+func (*PollTally) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PollTally) GetCounts() []int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *PollTally) GetVoterCount() int32 {
+	if x != nil {
+		return x.VoterCount
+	}
+	return 0
+}
+
+func (x *PollTally) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *PollTally) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
+}
+
+func (x *PollTally) GetClosedAt() int64 {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return 0
+}
+
+func (x *PollTally) GetMyChoices() []int32 {
+	if x != nil {
+		return x.MyChoices
+	}
+	return nil
+}
+
+func (x *PollTally) GetVoted() bool {
+	if x != nil {
+		return x.Voted
+	}
+	return false
+}
+
+// ForwardResult 是转发到单个目标会话的结果
+type ForwardResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 目标会话
+	MsgIds        []int64                `protobuf:"varint,2,rep,packed,name=msg_ids,json=msgIds,proto3" json:"msg_ids,omitempty"`  // 生成的消息ID
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                          // 错误信息（为空表示成功）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForwardResult) Reset() {
+	*x = ForwardResult{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardResult) ProtoMessage() {}
+
+func (x *ForwardResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardResult.ProtoReflect.Descriptor instead.
+func (*ForwardResult) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ForwardResult) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ForwardResult) GetMsgIds() []int64 {
+	if x != nil {
+		return x.MsgIds
+	}
+	return nil
+}
+
+func (x *ForwardResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// PinnedMessage 是会话中的一条置顶消息
+type PinnedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *PushMessage           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`                    // 被置顶的消息
+	PinnedBy      string                 `protobuf:"bytes,2,opt,name=pinned_by,json=pinnedBy,proto3" json:"pinned_by,omitempty"`  // 置顶操作者
+	PinnedAt      int64                  `protobuf:"varint,3,opt,name=pinned_at,json=pinnedAt,proto3" json:"pinned_at,omitempty"` // 置顶时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinnedMessage) Reset() {
+	*x = PinnedMessage{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinnedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedMessage) ProtoMessage() {}
+
+func (x *PinnedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedMessage.ProtoReflect.Descriptor instead.
+func (*PinnedMessage) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PinnedMessage) GetMessage() *PushMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *PinnedMessage) GetPinnedBy() string {
+	if x != nil {
+		return x.PinnedBy
+	}
+	return ""
+}
+
+func (x *PinnedMessage) GetPinnedAt() int64 {
+	if x != nil {
+		return x.PinnedAt
+	}
+	return 0
+}
+
+// SearchResult 是一条消息搜索结果
+type SearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *PushMessage           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // 命中的消息
+	Snippet       string                 `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"` // 高亮摘要：已做 HTML 转义，命中词以 <mark></mark> 包裹
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SearchResult) GetMessage() *PushMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *SearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+// Announcement 是一条系统公告
+// 在线用户经 WsPacket 实时收到，离线用户登录后通过 ListAnnouncements 补拉
+type Announcement struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AnnouncementId int64                  `protobuf:"varint,1,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"` // 公告ID（Snowflake，随时间递增，客户端以此作为补拉游标）
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                                          // 标题（可为空）
+	Content        string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                                      // 正文
+	FromUsername   string                 `protobuf:"bytes,4,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"`        // 发布者
+	CreatedAt      int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                // 发布时间（Unix 秒）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Announcement) GetAnnouncementId() int64 {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return 0
+}
+
+func (x *Announcement) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Announcement) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Announcement) GetFromUsername() string {
+	if x != nil {
+		return x.FromUsername
+	}
+	return ""
+}
+
+func (x *Announcement) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Webhook 是会话的一个出站 Webhook 订阅
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     int64                  `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 所属会话
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                              // 回调地址（http/https）
+	Events        []string               `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`                        // 订阅的事件（为空表示全部）：message.created / message.edited / member.changed
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // 创建者
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Webhook) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *Webhook) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// WebhookDelivery 是一次 Webhook 投递记录
+type WebhookDelivery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    int64                  `protobuf:"varint,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	WebhookId     int64                  `protobuf:"varint,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Event         string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"` // 事件类型
+	Status        WebhookDeliveryStatus  `protobuf:"varint,4,opt,name=status,proto3,enum=resonance.gateway.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`                             // 已尝试次数
+	ResponseCode  int32                  `protobuf:"varint,6,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"` // 最近一次的 HTTP 状态码（0 表示未收到响应）
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                                    // 最近一次的失败原因
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WebhookDelivery) GetDeliveryId() int64 {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseCode() int32 {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// Bot 是一个机器人账号
+type Bot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	OwnerUsername string                 `protobuf:"bytes,3,opt,name=owner_username,json=ownerUsername,proto3" json:"owner_username,omitempty"` // 创建者
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bot) Reset() {
+	*x = Bot{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bot) ProtoMessage() {}
+
+func (x *Bot) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bot.ProtoReflect.Descriptor instead.
+func (*Bot) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Bot) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Bot) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *Bot) GetOwnerUsername() string {
+	if x != nil {
+		return x.OwnerUsername
+	}
+	return ""
+}
+
+func (x *Bot) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// ScheduledMessage 是一条定时消息
+type ScheduledMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Body          *MessageBody           `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	ReplyToMsgId  int64                  `protobuf:"varint,6,opt,name=reply_to_msg_id,json=replyToMsgId,proto3" json:"reply_to_msg_id,omitempty"`
+	ThreadRootId  int64                  `protobuf:"varint,7,opt,name=thread_root_id,json=threadRootId,proto3" json:"thread_root_id,omitempty"`
+	Mentions      []string               `protobuf:"bytes,8,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	MentionAll    bool                   `protobuf:"varint,9,opt,name=mention_all,json=mentionAll,proto3" json:"mention_all,omitempty"`
+	ScheduledAt   int64                  `protobuf:"varint,10,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // 计划发送时间（Unix 秒）
+	Status        ScheduledMessageStatus `protobuf:"varint,11,opt,name=status,proto3,enum=resonance.gateway.v1.ScheduledMessageStatus" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`               // 失败原因
+	MsgId         int64                  `protobuf:"varint,13,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"` // 发送成功后生成的消息ID
+	CreatedAt     int64                  `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledMessage) Reset() {
+	*x = ScheduledMessage{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMessage) ProtoMessage() {}
+
+func (x *ScheduledMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledMessage.ProtoReflect.Descriptor instead.
+func (*ScheduledMessage) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ScheduledMessage) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *ScheduledMessage) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetBody() *MessageBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetReplyToMsgId() int64 {
+	if x != nil {
+		return x.ReplyToMsgId
+	}
+	return 0
+}
+
+func (x *ScheduledMessage) GetThreadRootId() int64 {
+	if x != nil {
+		return x.ThreadRootId
+	}
+	return 0
+}
+
+func (x *ScheduledMessage) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetMentionAll() bool {
+	if x != nil {
+		return x.MentionAll
+	}
+	return false
+}
+
+func (x *ScheduledMessage) GetScheduledAt() int64 {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return 0
+}
+
+func (x *ScheduledMessage) GetStatus() ScheduledMessageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_UNSPECIFIED
+}
+
+func (x *ScheduledMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetMsgId() int64 {
+	if x != nil {
+		return x.MsgId
+	}
+	return 0
+}
+
+func (x *ScheduledMessage) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// SessionMeta 是会话元数据
+// 用于在推送消息时携带会话信息，避免前端额外查询
+type SessionMeta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`  // 会话名称
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"` // 会话类型：1=单聊, 2=群聊
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionMeta) Reset() {
+	*x = SessionMeta{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionMeta) ProtoMessage() {}
+
+func (x *SessionMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionMeta.ProtoReflect.Descriptor instead.
+func (*SessionMeta) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SessionMeta) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SessionMeta) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+// QuotedMessage 是被引用消息的精简快照
+// 随回复消息一并返回，避免前端逐条查询被引用的消息
+type QuotedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MsgId         int64                  `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`                     // 被引用消息ID
+	FromUsername  string                 `protobuf:"bytes,2,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"` // 被引用消息的发送者
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                               // 内容摘要（超长截断；已撤回时为墓碑占位内容）
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                                     // 类型
+	Recalled      bool                   `protobuf:"varint,5,opt,name=recalled,proto3" json:"recalled,omitempty"`                            // 被引用消息是否已撤回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotedMessage) Reset() {
+	*x = QuotedMessage{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotedMessage) ProtoMessage() {}
+
+func (x *QuotedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotedMessage.ProtoReflect.Descriptor instead.
+func (*QuotedMessage) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QuotedMessage) GetMsgId() int64 {
+	if x != nil {
+		return x.MsgId
+	}
+	return 0
+}
+
+func (x *QuotedMessage) GetFromUsername() string {
+	if x != nil {
+		return x.FromUsername
+	}
+	return ""
+}
+
+func (x *QuotedMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *QuotedMessage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *QuotedMessage) GetRecalled() bool {
+	if x != nil {
+		return x.Recalled
+	}
+	return false
+}
+
+// ReadReceipt 是一条消息的已读聚合
+type ReadReceipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MsgId         int64                  `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`                   // 消息ID
+	SeqId         int64                  `protobuf:"varint,2,opt,name=seq_id,json=seqId,proto3" json:"seq_id,omitempty"`                   // 消息序号
+	ReadCount     int32                  `protobuf:"varint,3,opt,name=read_count,json=readCount,proto3" json:"read_count,omitempty"`       // 已读人数（不含发送者）
+	UnreadCount   int32                  `protobuf:"varint,4,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"` // 未读人数（不含发送者）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadReceipt) Reset() {
+	*x = ReadReceipt{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadReceipt) ProtoMessage() {}
+
+func (x *ReadReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadReceipt.ProtoReflect.Descriptor instead.
+func (*ReadReceipt) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReadReceipt) GetMsgId() int64 {
+	if x != nil {
+		return x.MsgId
+	}
+	return 0
+}
+
+func (x *ReadReceipt) GetSeqId() int64 {
+	if x != nil {
+		return x.SeqId
+	}
+	return 0
+}
+
+func (x *ReadReceipt) GetReadCount() int32 {
+	if x != nil {
+		return x.ReadCount
+	}
+	return 0
+}
+
+func (x *ReadReceipt) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+// Reaction 是某个表情在一条消息上的聚合回应
+type Reaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emoji         string                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`      // 表情
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`     // 回应人数
+	Reacted       bool                   `protobuf:"varint,3,opt,name=reacted,proto3" json:"reacted,omitempty"` // 当前用户是否已回应（仅在拉取接口中有效，推送中不填充）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Reaction) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *Reaction) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Reaction) GetReacted() bool {
+	if x != nil {
+		return x.Reacted
+	}
+	return false
+}
+
+// ReactionChange 描述一次表情回应变更
+type ReactionChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // 操作者
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`       // 表情
+	Removed       bool                   `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`  // true=取消回应，false=添加回应
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionChange) Reset() {
+	*x = ReactionChange{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionChange) ProtoMessage() {}
+
+func (x *ReactionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionChange.ProtoReflect.Descriptor instead.
+func (*ReactionChange) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReactionChange) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReactionChange) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ReactionChange) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+// PushMessage 是推送给用户的消息
+type PushMessage struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MsgId             int64                  `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`                                          // 全局唯一物理ID (Snowflake)
+	SeqId             int64                  `protobuf:"varint,2,opt,name=seq_id,json=seqId,proto3" json:"seq_id,omitempty"`                                          // 会话内逻辑时钟
+	SessionId         string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`                               // 会话ID
+	FromUsername      string                 `protobuf:"bytes,4,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"`                      // 发送者
+	ToUsername        string                 `protobuf:"bytes,5,opt,name=to_username,json=toUsername,proto3" json:"to_username,omitempty"`                            // 接收者/目标用户
+	Content           string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`                                                    // 内容
+	Type              string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`                                                          // 类型
+	Timestamp         int64                  `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                               // 时间戳
+	SessionMeta       *SessionMeta           `protobuf:"bytes,10,opt,name=session_meta,json=sessionMeta,proto3" json:"session_meta,omitempty"`                        // 会话元数据（首次推送时携带）
+	Action            MessageAction          `protobuf:"varint,11,opt,name=action,proto3,enum=resonance.gateway.v1.MessageAction" json:"action,omitempty"`            // 推送动作（撤回通知等）
+	Recalled          bool                   `protobuf:"varint,12,opt,name=recalled,proto3" json:"recalled,omitempty"`                                                // 消息是否已撤回（已撤回时 content 为墓碑占位内容）
+	RecalledBy        string                 `protobuf:"bytes,13,opt,name=recalled_by,json=recalledBy,proto3" json:"recalled_by,omitempty"`                           // 撤回操作者
+	EditedAt          int64                  `protobuf:"varint,14,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`                                // 最后编辑时间（0 表示未编辑）
+	Version           int32                  `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`                                                  // 编辑版本号，每次编辑递增（0 表示未编辑）
+	ReplyToMsgId      int64                  `protobuf:"varint,16,opt,name=reply_to_msg_id,json=replyToMsgId,proto3" json:"reply_to_msg_id,omitempty"`                // 引用回复的消息ID（0 表示非回复）
+	ReplyTo           *QuotedMessage         `protobuf:"bytes,17,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`                                    // 被引用消息快照（历史消息中携带）
+	ThreadRootId      int64                  `protobuf:"varint,18,opt,name=thread_root_id,json=threadRootId,proto3" json:"thread_root_id,omitempty"`                  // 所属话题的根消息ID（0 表示主时间线消息；非 0 时 seq_id 为话题内序号）
+	ThreadReplyCount  int32                  `protobuf:"varint,19,opt,name=thread_reply_count,json=threadReplyCount,proto3" json:"thread_reply_count,omitempty"`      // 作为话题根消息时的回复数
+	ThreadLastReplyAt int64                  `protobuf:"varint,20,opt,name=thread_last_reply_at,json=threadLastReplyAt,proto3" json:"thread_last_reply_at,omitempty"` // 作为话题根消息时的最后回复时间
+	Reactions         []*Reaction            `protobuf:"bytes,21,rep,name=reactions,proto3" json:"reactions,omitempty"`                                               // 表情回应聚合
+	ReactionChange    *ReactionChange        `protobuf:"bytes,22,opt,name=reaction_change,json=reactionChange,proto3" json:"reaction_change,omitempty"`               // 本次表情回应变更（仅 MESSAGE_ACTION_REACTION 携带）
+	Mentions          []string               `protobuf:"bytes,23,rep,name=mentions,proto3" json:"mentions,omitempty"`                                                 // @提及的用户名列表（mention_all 时为空）
+	MentionAll        bool                   `protobuf:"varint,24,opt,name=mention_all,json=mentionAll,proto3" json:"mention_all,omitempty"`                          // 是否 @所有人
+	ReadReceipts      []*ReadReceipt         `protobuf:"bytes,25,rep,name=read_receipts,json=readReceipts,proto3" json:"read_receipts,omitempty"`                     // 已读回执（仅 MESSAGE_ACTION_READ_RECEIPT 携带）
+	Body              *MessageBody           `protobuf:"bytes,26,opt,name=body,proto3" json:"body,omitempty"`                                                         // 结构化消息体（旧消息或编辑后为空，以 content 为准）
+	ExpiresAt         int64                  `protobuf:"varint,27,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                             // 过期时间（Unix 秒，0 表示永久保留），到期后服务端删除消息并推送 MESSAGE_ACTION_EXPIRE
+	HiddenMsgIds      []int64                `protobuf:"varint,28,rep,packed,name=hidden_msg_ids,json=hiddenMsgIds,proto3" json:"hidden_msg_ids,omitempty"`           // 本人删除的消息 ID（仅 MESSAGE_ACTION_HIDE 携带）
+	ClearedSeq        int64                  `protobuf:"varint,29,opt,name=cleared_seq,json=clearedSeq,proto3" json:"cleared_seq,omitempty"`                          // 本人清空聊天记录的位点，0 表示未清空（仅 MESSAGE_ACTION_HIDE 携带）
+	FromBot           bool                   `protobuf:"varint,30,opt,name=from_bot,json=fromBot,proto3" json:"from_bot,omitempty"`                                   // 发送者是否为机器人账号
+	PollTally         *PollTally             `protobuf:"bytes,31,opt,name=poll_tally,json=pollTally,proto3" json:"poll_tally,omitempty"`                              // 投票计票状态（投票消息在拉取接口及 MESSAGE_ACTION_POLL 中携带）
+	Silent            bool                   `protobuf:"varint,32,opt,name=silent,proto3" json:"silent,omitempty"`                                                    // 静默推送：接收者对该会话开启了免打扰，客户端只更新消息列表，不弹出通知
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PushMessage) Reset() {
+	*x = PushMessage{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushMessage) ProtoMessage() {}
+
+func (x *PushMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushMessage.ProtoReflect.Descriptor instead.
+func (*PushMessage) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PushMessage) GetMsgId() int64 {
+	if x != nil {
+		return x.MsgId
+	}
+	return 0
+}
+
+func (x *PushMessage) GetSeqId() int64 {
+	if x != nil {
+		return x.SeqId
+	}
+	return 0
+}
+
+func (x *PushMessage) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PushMessage) GetFromUsername() string {
+	if x != nil {
+		return x.FromUsername
+	}
+	return ""
+}
+
+func (x *PushMessage) GetToUsername() string {
+	if x != nil {
+		return x.ToUsername
+	}
+	return ""
+}
+
+func (x *PushMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PushMessage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PushMessage) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PushMessage) GetSessionMeta() *SessionMeta {
+	if x != nil {
+		return x.SessionMeta
+	}
+	return nil
+}
+
+func (x *PushMessage) GetAction() MessageAction {
+	if x != nil {
+		return x.Action
+	}
+	return MessageAction_MESSAGE_ACTION_UNSPECIFIED
+}
+
+func (x *PushMessage) GetRecalled() bool {
+	if x != nil {
+		return x.Recalled
+	}
+	return false
+}
+
+func (x *PushMessage) GetRecalledBy() string {
+	if x != nil {
+		return x.RecalledBy
+	}
+	return ""
+}
+
+func (x *PushMessage) GetEditedAt() int64 {
+	if x != nil {
+		return x.EditedAt
+	}
+	return 0
+}
+
+func (x *PushMessage) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PushMessage) GetReplyToMsgId() int64 {
+	if x != nil {
+		return x.ReplyToMsgId
+	}
+	return 0
+}
+
+func (x *PushMessage) GetReplyTo() *QuotedMessage {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return nil
+}
+
+func (x *PushMessage) GetThreadRootId() int64 {
+	if x != nil {
+		return x.ThreadRootId
+	}
+	return 0
+}
+
+func (x *PushMessage) GetThreadReplyCount() int32 {
+	if x != nil {
+		return x.ThreadReplyCount
+	}
+	return 0
+}
+
+func (x *PushMessage) GetThreadLastReplyAt() int64 {
+	if x != nil {
+		return x.ThreadLastReplyAt
+	}
+	return 0
+}
+
+func (x *PushMessage) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *PushMessage) GetReactionChange() *ReactionChange {
+	if x != nil {
+		return x.ReactionChange
+	}
+	return nil
+}
+
+func (x *PushMessage) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *PushMessage) GetMentionAll() bool {
+	if x != nil {
+		return x.MentionAll
+	}
+	return false
+}
+
+func (x *PushMessage) GetReadReceipts() []*ReadReceipt {
+	if x != nil {
+		return x.ReadReceipts
+	}
+	return nil
+}
+
+func (x *PushMessage) GetBody() *MessageBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *PushMessage) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *PushMessage) GetHiddenMsgIds() []int64 {
+	if x != nil {
+		return x.HiddenMsgIds
+	}
+	return nil
+}
+
+func (x *PushMessage) GetClearedSeq() int64 {
+	if x != nil {
+		return x.ClearedSeq
+	}
+	return 0
+}
+
+func (x *PushMessage) GetFromBot() bool {
+	if x != nil {
+		return x.FromBot
+	}
+	return false
+}
+
+func (x *PushMessage) GetPollTally() *PollTally {
+	if x != nil {
+		return x.PollTally
+	}
+	return nil
+}
+
+func (x *PushMessage) GetSilent() bool {
+	if x != nil {
+		return x.Silent
+	}
+	return false
+}
+
+// Ack 是可靠交付的确认
 type Ack struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RefSeq        string                 `protobuf:"bytes,1,opt,name=ref_seq,json=refSeq,proto3" json:"ref_seq,omitempty"`          // 引用的序列号（客户端生成的临时 ID）
-	MsgId         int64                  `protobuf:"varint,2,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`            // 服务端生成的消息 ID
-	SeqId         int64                  `protobuf:"varint,3,opt,name=seq_id,json=seqId,proto3" json:"seq_id,omitempty"`            // 会话内的序列 ID
-	SessionId     string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 所属会话
-	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                          // 失败原因（为空表示成功）
+	RefSeq        string                 `protobuf:"bytes,1,opt,name=ref_seq,json=refSeq,proto3" json:"ref_seq,omitempty"`                   // 引用的序列号（客户端生成的临时 ID）
+	MsgId         int64                  `protobuf:"varint,2,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`                     // 服务端生成的消息 ID
+	SeqId         int64                  `protobuf:"varint,3,opt,name=seq_id,json=seqId,proto3" json:"seq_id,omitempty"`                     // 会话内的序列 ID
+	SessionId     string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`          // 所属会话
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                                   // 失败原因（为空表示成功）
+	ErrorCode     string                 `protobuf:"bytes,6,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`          // 结构化错误码（如 unknown_command），便于客户端区分处理；为空时仅看 error
+	CommandReply  *CommandReply          `protobuf:"bytes,7,opt,name=command_reply,json=commandReply,proto3" json:"command_reply,omitempty"` // 斜杠命令的仅自己可见回复（命令未生成消息时 msg_id 为 0）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Ack) Reset() {
 	*x = Ack{}
-	mi := &file_gateway_v1_packet_proto_msgTypes[5]
+	mi := &file_gateway_v1_packet_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -459,7 +3136,7 @@ func (x *Ack) String() string {
 func (*Ack) ProtoMessage() {}
 
 func (x *Ack) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_v1_packet_proto_msgTypes[5]
+	mi := &file_gateway_v1_packet_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +3149,7 @@ func (x *Ack) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ack.ProtoReflect.Descriptor instead.
 func (*Ack) Descriptor() ([]byte, []int) {
-	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{5}
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Ack) GetRefSeq() string {
@@ -510,13 +3187,88 @@ func (x *Ack) GetError() string {
 	return ""
 }
 
+func (x *Ack) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *Ack) GetCommandReply() *CommandReply {
+	if x != nil {
+		return x.CommandReply
+	}
+	return nil
+}
+
+// CommandReply 斜杠命令的仅发送者可见回复，不落库、不推送给其他成员
+type CommandReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Command       string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`                               // 命令名（不含 /）
+	FromUsername  string                 `protobuf:"bytes,2,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"` // 回复者：内置命令为空，机器人命令为机器人用户名
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandReply) Reset() {
+	*x = CommandReply{}
+	mi := &file_gateway_v1_packet_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandReply) ProtoMessage() {}
+
+func (x *CommandReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_packet_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandReply.ProtoReflect.Descriptor instead.
+func (*CommandReply) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_packet_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CommandReply) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *CommandReply) GetFromUsername() string {
+	if x != nil {
+		return x.FromUsername
+	}
+	return ""
+}
+
+func (x *CommandReply) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
 var File_gateway_v1_packet_proto protoreflect.FileDescriptor
 
 var file_gateway_v1_packet_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63,
 	0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x6e,
 	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x22,
-	0xfd, 0x01, 0x0a, 0x08, 0x57, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0xff, 0x02, 0x0a, 0x08, 0x57, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03,
 	0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x33,
 	0x0a, 0x05, 0x70, 0x75, 0x6c, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
@@ -531,65 +3283,515 @@ var file_gateway_v1_packet_proto_rawDesc = []byte{
 	0x04, 0x70, 0x75, 0x73, 0x68, 0x12, 0x2d, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67,
 	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52,
-	0x03, 0x61, 0x63, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
-	0x07, 0x0a, 0x05, 0x50, 0x75, 0x6c, 0x73, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x35, 0x0a, 0x0b, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x22, 0xb2, 0x02, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x65, 0x71, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x65, 0x71, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a,
-	0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06,
+	0x03, 0x61, 0x63, 0x6b, 0x12, 0x36, 0x0a, 0x06, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x69,
+	0x6e, 0x67, 0x48, 0x00, 0x52, 0x06, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x48, 0x0a, 0x0c,
+	0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x22, 0x07, 0x0a, 0x05, 0x50, 0x75, 0x6c, 0x73, 0x65, 0x22, 0xff, 0x02, 0x0a, 0x0b, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0f, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x4d, 0x73, 0x67,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x61, 0x6c, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x12, 0x35, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xa4, 0x01, 0x0a,
+	0x06, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x49, 0x6e, 0x22, 0xa9, 0x04, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42,
+	0x6f, 0x64, 0x79, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x42, 0x6f, 0x64,
+	0x79, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x6f, 0x64, 0x79,
+	0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x42, 0x6f, 0x64, 0x79, 0x48, 0x00, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69,
+	0x6f, 0x12, 0x40, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x48, 0x00, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x42, 0x6f, 0x64, 0x79, 0x48, 0x00, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x48, 0x00, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x42, 0x6f, 0x64, 0x79, 0x48,
+	0x00, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22,
+	0xa4, 0x01, 0x0a, 0x0a, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x38,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x08, 0x54, 0x65, 0x78, 0x74, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
+	0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x68, 0x75,
+	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x86, 0x01,
+	0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x09, 0x41, 0x75, 0x64, 0x69, 0x6f,
+	0x42, 0x6f, 0x64, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x76, 0x0a,
+	0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x61, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x43, 0x61, 0x72, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x22, 0x52, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c,
+	0x0a, 0x0a, 0x72, 0x65, 0x66, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x66, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x22, 0x93, 0x01, 0x0a,
+	0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x42,
+	0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x10, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x44, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63,
-	0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x81, 0x01, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x17, 0x0a,
-	0x07, 0x72, 0x65, 0x66, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x65, 0x66, 0x53, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x15, 0x0a,
-	0x06, 0x73, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
-	0x65, 0x71, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0xd7, 0x01, 0x0a, 0x18, 0x63, 0x6f,
-	0x6d, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x63, 0x65, 0x79, 0x65, 0x77, 0x61, 0x6e, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61,
-	0x6e, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x67,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x47, 0x58, 0xaa, 0x02, 0x14, 0x52, 0x65, 0x73, 0x6f,
-	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x14, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x61,
-	0x6e, 0x63, 0x65, 0x5c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x52, 0x65, 0x73,
-	0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x35, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x9d, 0x01, 0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x6c, 0x42,
+	0x6f, 0x64, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x6c, 0x6c, 0x54,
+	0x61, 0x6c, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x79, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x79, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x64, 0x22, 0x5d, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x0d, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0x65, 0x0a, 0x0c,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xaf, 0x01, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1d, 0x0a,
+	0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xc1, 0x02, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x03, 0x42, 0x6f, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf6, 0x03,
+	0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x35, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x25, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x6f,
+	0x6f, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c,
+	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x35, 0x0a, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x95, 0x01,
+	0x0a, 0x0d, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66,
+	0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x63,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0x7d, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x73,
+	0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x65, 0x71,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x50, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
+	0x65, 0x61, 0x63, 0x74, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x22, 0xf1, 0x09, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x73,
+	0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x65, 0x71,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x44, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0b, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0f, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x4d, 0x73, 0x67, 0x49,
+	0x64, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x6f, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x52, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c,
+	0x12, 0x46, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x1c, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x4d, 0x73,
+	0x67, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x5f,
+	0x73, 0x65, 0x71, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6c, 0x65, 0x61, 0x72,
+	0x65, 0x64, 0x53, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6f,
+	0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6f, 0x74,
+	0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x6c,
+	0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x09, 0x70, 0x6f, 0x6c, 0x6c, 0x54, 0x61, 0x6c, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x6c, 0x65, 0x6e, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x73, 0x69, 0x6c, 0x65, 0x6e, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x03, 0x41, 0x63, 0x6b,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x66, 0x53, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64,
+	0x12, 0x15, 0x0a, 0x06, 0x73, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x65, 0x71, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x47, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x67, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2a, 0x5f, 0x0a,
+	0x0b, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x18,
+	0x54, 0x59, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x59,
+	0x50, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x59, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xbe,
+	0x01, 0x0a, 0x0e, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49,
+	0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15,
+	0x54, 0x45, 0x58, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x42, 0x4f, 0x4c, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x45, 0x58, 0x54, 0x5f,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x54, 0x41, 0x4c,
+	0x49, 0x43, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x45, 0x4e, 0x54,
+	0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x05, 0x2a,
+	0x5f, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x18, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x50,
+	0x41, 0x52, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x4f, 0x52, 0x57, 0x41,
+	0x52, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x44, 0x10, 0x02,
+	0x2a, 0xb0, 0x01, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x45,
+	0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44,
+	0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x45, 0x42, 0x48,
+	0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x22, 0x0a, 0x1e, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56,
+	0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x03, 0x2a, 0xfd, 0x01, 0x0a, 0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28,
+	0x0a, 0x24, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x43, 0x48, 0x45,
+	0x44, 0x55, 0x4c, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x24,
+	0x0a, 0x20, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45,
+	0x44, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x43, 0x48, 0x45, 0x44,
+	0x55, 0x4c, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x23,
+	0x0a, 0x1f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x05, 0x2a, 0x91, 0x02, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x41, 0x4c, 0x4c, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x44, 0x49, 0x54, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x45, 0x53,
+	0x53, 0x41, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47,
+	0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45,
+	0x43, 0x45, 0x49, 0x50, 0x54, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x49, 0x44, 0x45, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x4d,
+	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f,
+	0x4c, 0x4c, 0x10, 0x07, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x52, 0x45,
+	0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x08, 0x42, 0xd7, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x65, 0x79, 0x65, 0x77, 0x61, 0x6e, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x52, 0x47, 0x58, 0xaa, 0x02, 0x14, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x14, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x5c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -604,26 +3806,90 @@ func file_gateway_v1_packet_proto_rawDescGZIP() []byte {
 	return file_gateway_v1_packet_proto_rawDescData
 }
 
-var file_gateway_v1_packet_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_gateway_v1_packet_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_gateway_v1_packet_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_gateway_v1_packet_proto_goTypes = []any{
-	(*WsPacket)(nil),    // 0: resonance.gateway.v1.WsPacket
-	(*Pulse)(nil),       // 1: resonance.gateway.v1.Pulse
-	(*ChatRequest)(nil), // 2: resonance.gateway.v1.ChatRequest
-	(*SessionMeta)(nil), // 3: resonance.gateway.v1.SessionMeta
-	(*PushMessage)(nil), // 4: resonance.gateway.v1.PushMessage
-	(*Ack)(nil),         // 5: resonance.gateway.v1.Ack
+	(TypingState)(0),            // 0: resonance.gateway.v1.TypingState
+	(TextEntityType)(0),         // 1: resonance.gateway.v1.TextEntityType
+	(ForwardMode)(0),            // 2: resonance.gateway.v1.ForwardMode
+	(WebhookDeliveryStatus)(0),  // 3: resonance.gateway.v1.WebhookDeliveryStatus
+	(ScheduledMessageStatus)(0), // 4: resonance.gateway.v1.ScheduledMessageStatus
+	(MessageAction)(0),          // 5: resonance.gateway.v1.MessageAction
+	(*WsPacket)(nil),            // 6: resonance.gateway.v1.WsPacket
+	(*Pulse)(nil),               // 7: resonance.gateway.v1.Pulse
+	(*ChatRequest)(nil),         // 8: resonance.gateway.v1.ChatRequest
+	(*Typing)(nil),              // 9: resonance.gateway.v1.Typing
+	(*MessageBody)(nil),         // 10: resonance.gateway.v1.MessageBody
+	(*TextEntity)(nil),          // 11: resonance.gateway.v1.TextEntity
+	(*TextBody)(nil),            // 12: resonance.gateway.v1.TextBody
+	(*ImageBody)(nil),           // 13: resonance.gateway.v1.ImageBody
+	(*FileBody)(nil),            // 14: resonance.gateway.v1.FileBody
+	(*AudioBody)(nil),           // 15: resonance.gateway.v1.AudioBody
+	(*LocationBody)(nil),        // 16: resonance.gateway.v1.LocationBody
+	(*ContactCardBody)(nil),     // 17: resonance.gateway.v1.ContactCardBody
+	(*SystemBody)(nil),          // 18: resonance.gateway.v1.SystemBody
+	(*ForwardBody)(nil),         // 19: resonance.gateway.v1.ForwardBody
+	(*ForwardedMessage)(nil),    // 20: resonance.gateway.v1.ForwardedMessage
+	(*PollBody)(nil),            // 21: resonance.gateway.v1.PollBody
+	(*PollTally)(nil),           // 22: resonance.gateway.v1.PollTally
+	(*ForwardResult)(nil),       // 23: resonance.gateway.v1.ForwardResult
+	(*PinnedMessage)(nil),       // 24: resonance.gateway.v1.PinnedMessage
+	(*SearchResult)(nil),        // 25: resonance.gateway.v1.SearchResult
+	(*Announcement)(nil),        // 26: resonance.gateway.v1.Announcement
+	(*Webhook)(nil),             // 27: resonance.gateway.v1.Webhook
+	(*WebhookDelivery)(nil),     // 28: resonance.gateway.v1.WebhookDelivery
+	(*Bot)(nil),                 // 29: resonance.gateway.v1.Bot
+	(*ScheduledMessage)(nil),    // 30: resonance.gateway.v1.ScheduledMessage
+	(*SessionMeta)(nil),         // 31: resonance.gateway.v1.SessionMeta
+	(*QuotedMessage)(nil),       // 32: resonance.gateway.v1.QuotedMessage
+	(*ReadReceipt)(nil),         // 33: resonance.gateway.v1.ReadReceipt
+	(*Reaction)(nil),            // 34: resonance.gateway.v1.Reaction
+	(*ReactionChange)(nil),      // 35: resonance.gateway.v1.ReactionChange
+	(*PushMessage)(nil),         // 36: resonance.gateway.v1.PushMessage
+	(*Ack)(nil),                 // 37: resonance.gateway.v1.Ack
+	(*CommandReply)(nil),        // 38: resonance.gateway.v1.CommandReply
 }
 var file_gateway_v1_packet_proto_depIdxs = []int32{
-	1, // 0: resonance.gateway.v1.WsPacket.pulse:type_name -> resonance.gateway.v1.Pulse
-	2, // 1: resonance.gateway.v1.WsPacket.chat:type_name -> resonance.gateway.v1.ChatRequest
-	4, // 2: resonance.gateway.v1.WsPacket.push:type_name -> resonance.gateway.v1.PushMessage
-	5, // 3: resonance.gateway.v1.WsPacket.ack:type_name -> resonance.gateway.v1.Ack
-	3, // 4: resonance.gateway.v1.PushMessage.session_meta:type_name -> resonance.gateway.v1.SessionMeta
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	7,  // 0: resonance.gateway.v1.WsPacket.pulse:type_name -> resonance.gateway.v1.Pulse
+	8,  // 1: resonance.gateway.v1.WsPacket.chat:type_name -> resonance.gateway.v1.ChatRequest
+	36, // 2: resonance.gateway.v1.WsPacket.push:type_name -> resonance.gateway.v1.PushMessage
+	37, // 3: resonance.gateway.v1.WsPacket.ack:type_name -> resonance.gateway.v1.Ack
+	9,  // 4: resonance.gateway.v1.WsPacket.typing:type_name -> resonance.gateway.v1.Typing
+	26, // 5: resonance.gateway.v1.WsPacket.announcement:type_name -> resonance.gateway.v1.Announcement
+	10, // 6: resonance.gateway.v1.ChatRequest.body:type_name -> resonance.gateway.v1.MessageBody
+	0,  // 7: resonance.gateway.v1.Typing.state:type_name -> resonance.gateway.v1.TypingState
+	12, // 8: resonance.gateway.v1.MessageBody.text:type_name -> resonance.gateway.v1.TextBody
+	13, // 9: resonance.gateway.v1.MessageBody.image:type_name -> resonance.gateway.v1.ImageBody
+	14, // 10: resonance.gateway.v1.MessageBody.file:type_name -> resonance.gateway.v1.FileBody
+	15, // 11: resonance.gateway.v1.MessageBody.audio:type_name -> resonance.gateway.v1.AudioBody
+	16, // 12: resonance.gateway.v1.MessageBody.location:type_name -> resonance.gateway.v1.LocationBody
+	17, // 13: resonance.gateway.v1.MessageBody.contact:type_name -> resonance.gateway.v1.ContactCardBody
+	18, // 14: resonance.gateway.v1.MessageBody.system:type_name -> resonance.gateway.v1.SystemBody
+	19, // 15: resonance.gateway.v1.MessageBody.forward:type_name -> resonance.gateway.v1.ForwardBody
+	21, // 16: resonance.gateway.v1.MessageBody.poll:type_name -> resonance.gateway.v1.PollBody
+	1,  // 17: resonance.gateway.v1.TextEntity.type:type_name -> resonance.gateway.v1.TextEntityType
+	11, // 18: resonance.gateway.v1.TextBody.entities:type_name -> resonance.gateway.v1.TextEntity
+	20, // 19: resonance.gateway.v1.ForwardBody.messages:type_name -> resonance.gateway.v1.ForwardedMessage
+	10, // 20: resonance.gateway.v1.ForwardedMessage.body:type_name -> resonance.gateway.v1.MessageBody
+	36, // 21: resonance.gateway.v1.PinnedMessage.message:type_name -> resonance.gateway.v1.PushMessage
+	36, // 22: resonance.gateway.v1.SearchResult.message:type_name -> resonance.gateway.v1.PushMessage
+	3,  // 23: resonance.gateway.v1.WebhookDelivery.status:type_name -> resonance.gateway.v1.WebhookDeliveryStatus
+	10, // 24: resonance.gateway.v1.ScheduledMessage.body:type_name -> resonance.gateway.v1.MessageBody
+	4,  // 25: resonance.gateway.v1.ScheduledMessage.status:type_name -> resonance.gateway.v1.ScheduledMessageStatus
+	31, // 26: resonance.gateway.v1.PushMessage.session_meta:type_name -> resonance.gateway.v1.SessionMeta
+	5,  // 27: resonance.gateway.v1.PushMessage.action:type_name -> resonance.gateway.v1.MessageAction
+	32, // 28: resonance.gateway.v1.PushMessage.reply_to:type_name -> resonance.gateway.v1.QuotedMessage
+	34, // 29: resonance.gateway.v1.PushMessage.reactions:type_name -> resonance.gateway.v1.Reaction
+	35, // 30: resonance.gateway.v1.PushMessage.reaction_change:type_name -> resonance.gateway.v1.ReactionChange
+	33, // 31: resonance.gateway.v1.PushMessage.read_receipts:type_name -> resonance.gateway.v1.ReadReceipt
+	10, // 32: resonance.gateway.v1.PushMessage.body:type_name -> resonance.gateway.v1.MessageBody
+	22, // 33: resonance.gateway.v1.PushMessage.poll_tally:type_name -> resonance.gateway.v1.PollTally
+	38, // 34: resonance.gateway.v1.Ack.command_reply:type_name -> resonance.gateway.v1.CommandReply
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_gateway_v1_packet_proto_init() }
@@ -636,19 +3902,33 @@ func file_gateway_v1_packet_proto_init() {
 		(*WsPacket_Chat)(nil),
 		(*WsPacket_Push)(nil),
 		(*WsPacket_Ack)(nil),
+		(*WsPacket_Typing)(nil),
+		(*WsPacket_Announcement)(nil),
+	}
+	file_gateway_v1_packet_proto_msgTypes[4].OneofWrappers = []any{
+		(*MessageBody_Text)(nil),
+		(*MessageBody_Image)(nil),
+		(*MessageBody_File)(nil),
+		(*MessageBody_Audio)(nil),
+		(*MessageBody_Location)(nil),
+		(*MessageBody_Contact)(nil),
+		(*MessageBody_System)(nil),
+		(*MessageBody_Forward)(nil),
+		(*MessageBody_Poll)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gateway_v1_packet_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      6,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_gateway_v1_packet_proto_goTypes,
 		DependencyIndexes: file_gateway_v1_packet_proto_depIdxs,
+		EnumInfos:         file_gateway_v1_packet_proto_enumTypes,
 		MessageInfos:      file_gateway_v1_packet_proto_msgTypes,
 	}.Build()
 	File_gateway_v1_packet_proto = out.File
@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PushService_Push_FullMethodName = "/resonance.gateway.v1.PushService/Push"
+	PushService_Push_FullMethodName      = "/resonance.gateway.v1.PushService/Push"
+	PushService_Broadcast_FullMethodName = "/resonance.gateway.v1.PushService/Broadcast"
 )
 
 // PushServiceClient is the client API for PushService service.
@@ -31,6 +32,9 @@ type PushServiceClient interface {
 	// Push 一元 RPC 推送单条消息到网关
 	// Task 异步持久化连接模式：每个 Gateway 一个 Client，独立 loop 持续推送
 	Push(ctx context.Context, in *PushRequest, opts ...grpc.CallOption) (*PushResponse, error)
+	// Broadcast 广播系统公告给本网关的全部在线用户
+	// Task 将同一公告投递到每个已注册的 Gateway
+	Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error)
 }
 
 type pushServiceClient struct {
@@ -51,6 +55,16 @@ func (c *pushServiceClient) Push(ctx context.Context, in *PushRequest, opts ...g
 	return out, nil
 }
 
+func (c *pushServiceClient) Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BroadcastResponse)
+	err := c.cc.Invoke(ctx, PushService_Broadcast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PushServiceServer is the server API for PushService service.
 // All implementations must embed UnimplementedPushServiceServer
 // for forward compatibility.
@@ -60,6 +74,9 @@ type PushServiceServer interface {
 	// Push 一元 RPC 推送单条消息到网关
 	// Task 异步持久化连接模式：每个 Gateway 一个 Client，独立 loop 持续推送
 	Push(context.Context, *PushRequest) (*PushResponse, error)
+	// Broadcast 广播系统公告给本网关的全部在线用户
+	// Task 将同一公告投递到每个已注册的 Gateway
+	Broadcast(context.Context, *BroadcastRequest) (*BroadcastResponse, error)
 	mustEmbedUnimplementedPushServiceServer()
 }
 
@@ -73,6 +90,9 @@ type UnimplementedPushServiceServer struct{}
 func (UnimplementedPushServiceServer) Push(context.Context, *PushRequest) (*PushResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Push not implemented")
 }
+func (UnimplementedPushServiceServer) Broadcast(context.Context, *BroadcastRequest) (*BroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Broadcast not implemented")
+}
 func (UnimplementedPushServiceServer) mustEmbedUnimplementedPushServiceServer() {}
 func (UnimplementedPushServiceServer) testEmbeddedByValue()                     {}
 
@@ -112,6 +132,24 @@ func _PushService_Push_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PushService_Broadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).Broadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_Broadcast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).Broadcast(ctx, req.(*BroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PushService_ServiceDesc is the grpc.ServiceDesc for PushService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -123,6 +161,10 @@ var PushService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Push",
 			Handler:    _PushService_Push_Handler,
 		},
+		{
+			MethodName: "Broadcast",
+			Handler:    _PushService_Broadcast_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gateway/v1/push.proto",
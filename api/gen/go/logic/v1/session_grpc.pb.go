@@ -19,13 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SessionService_GetSessionList_FullMethodName     = "/resonance.logic.v1.SessionService/GetSessionList"
-	SessionService_CreateSession_FullMethodName      = "/resonance.logic.v1.SessionService/CreateSession"
-	SessionService_GetHistoryMessages_FullMethodName = "/resonance.logic.v1.SessionService/GetHistoryMessages"
-	SessionService_GetContactList_FullMethodName     = "/resonance.logic.v1.SessionService/GetContactList"
-	SessionService_SearchUser_FullMethodName         = "/resonance.logic.v1.SessionService/SearchUser"
-	SessionService_UpdateReadPosition_FullMethodName = "/resonance.logic.v1.SessionService/UpdateReadPosition"
-	SessionService_PullInboxDelta_FullMethodName     = "/resonance.logic.v1.SessionService/PullInboxDelta"
+	SessionService_GetSessionList_FullMethodName       = "/resonance.logic.v1.SessionService/GetSessionList"
+	SessionService_CreateSession_FullMethodName        = "/resonance.logic.v1.SessionService/CreateSession"
+	SessionService_GetHistoryMessages_FullMethodName   = "/resonance.logic.v1.SessionService/GetHistoryMessages"
+	SessionService_GetContactList_FullMethodName       = "/resonance.logic.v1.SessionService/GetContactList"
+	SessionService_SearchUser_FullMethodName           = "/resonance.logic.v1.SessionService/SearchUser"
+	SessionService_UpdateReadPosition_FullMethodName   = "/resonance.logic.v1.SessionService/UpdateReadPosition"
+	SessionService_PullInboxDelta_FullMethodName       = "/resonance.logic.v1.SessionService/PullInboxDelta"
+	SessionService_GetThreadMessages_FullMethodName    = "/resonance.logic.v1.SessionService/GetThreadMessages"
+	SessionService_FollowThread_FullMethodName         = "/resonance.logic.v1.SessionService/FollowThread"
+	SessionService_UnfollowThread_FullMethodName       = "/resonance.logic.v1.SessionService/UnfollowThread"
+	SessionService_GetMessageReadStatus_FullMethodName = "/resonance.logic.v1.SessionService/GetMessageReadStatus"
+	SessionService_PinMessage_FullMethodName           = "/resonance.logic.v1.SessionService/PinMessage"
+	SessionService_UnpinMessage_FullMethodName         = "/resonance.logic.v1.SessionService/UnpinMessage"
+	SessionService_ListPinnedMessages_FullMethodName   = "/resonance.logic.v1.SessionService/ListPinnedMessages"
+	SessionService_SetMessageTTL_FullMethodName        = "/resonance.logic.v1.SessionService/SetMessageTTL"
+	SessionService_SearchMessages_FullMethodName       = "/resonance.logic.v1.SessionService/SearchMessages"
+	SessionService_DeleteMessagesForMe_FullMethodName  = "/resonance.logic.v1.SessionService/DeleteMessagesForMe"
+	SessionService_AddMembers_FullMethodName           = "/resonance.logic.v1.SessionService/AddMembers"
+	SessionService_RemoveMember_FullMethodName         = "/resonance.logic.v1.SessionService/RemoveMember"
+	SessionService_LeaveSession_FullMethodName         = "/resonance.logic.v1.SessionService/LeaveSession"
+	SessionService_RenameSession_FullMethodName        = "/resonance.logic.v1.SessionService/RenameSession"
+	SessionService_PromoteAdmin_FullMethodName         = "/resonance.logic.v1.SessionService/PromoteAdmin"
+	SessionService_DemoteAdmin_FullMethodName          = "/resonance.logic.v1.SessionService/DemoteAdmin"
+	SessionService_TransferOwnership_FullMethodName    = "/resonance.logic.v1.SessionService/TransferOwnership"
+	SessionService_MuteMember_FullMethodName           = "/resonance.logic.v1.SessionService/MuteMember"
+	SessionService_UnmuteMember_FullMethodName         = "/resonance.logic.v1.SessionService/UnmuteMember"
+	SessionService_SetDoNotDisturb_FullMethodName      = "/resonance.logic.v1.SessionService/SetDoNotDisturb"
 )
 
 // SessionServiceClient is the client API for SessionService service.
@@ -48,6 +68,46 @@ type SessionServiceClient interface {
 	UpdateReadPosition(ctx context.Context, in *UpdateReadPositionRequest, opts ...grpc.CallOption) (*UpdateReadPositionResponse, error)
 	// PullInboxDelta 按用户游标增量拉取消息（断线补偿/刷新同步）
 	PullInboxDelta(ctx context.Context, in *PullInboxDeltaRequest, opts ...grpc.CallOption) (*PullInboxDeltaResponse, error)
+	// GetThreadMessages 按话题内序号拉取话题消息
+	GetThreadMessages(ctx context.Context, in *GetThreadMessagesRequest, opts ...grpc.CallOption) (*GetThreadMessagesResponse, error)
+	// FollowThread 关注话题（关注者接收话题推送）
+	FollowThread(ctx context.Context, in *FollowThreadRequest, opts ...grpc.CallOption) (*FollowThreadResponse, error)
+	// UnfollowThread 取消关注话题
+	UnfollowThread(ctx context.Context, in *UnfollowThreadRequest, opts ...grpc.CallOption) (*UnfollowThreadResponse, error)
+	// GetMessageReadStatus 获取消息的已读/未读成员（基于成员的 last_read_seq 计算，仅发送者可查）
+	GetMessageReadStatus(ctx context.Context, in *GetMessageReadStatusRequest, opts ...grpc.CallOption) (*GetMessageReadStatusResponse, error)
+	// PinMessage 置顶消息（群聊仅管理员可操作），并发送系统消息通知全部成员
+	PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error)
+	// UnpinMessage 取消置顶（权限同 PinMessage），并发送系统消息通知全部成员
+	UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error)
+	// ListPinnedMessages 获取会话的置顶消息（按置顶时间倒序）
+	ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error)
+	// SetMessageTTL 设置会话消息的保留时长（阅后即焚），仅对设置后发送的消息生效
+	SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*SetMessageTTLResponse, error)
+	// SearchMessages 全文搜索历史消息，仅返回调用者所在会话的消息（按发送时间倒序，游标分页）
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
+	// DeleteMessagesForMe 仅对自己删除消息，或清空会话中 seq_id 不超过指定位点的聊天记录；不影响其他成员
+	DeleteMessagesForMe(ctx context.Context, in *DeleteMessagesForMeRequest, opts ...grpc.CallOption) (*DeleteMessagesForMeResponse, error)
+	// AddMembers 邀请用户加入群聊（已是成员的用户被忽略），仅管理员可操作
+	AddMembers(ctx context.Context, in *AddMembersRequest, opts ...grpc.CallOption) (*AddMembersResponse, error)
+	// RemoveMember 将成员移出群聊，仅管理员可操作；被移除者不再接收会话消息，也无法再拉取会话历史
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
+	// LeaveSession 主动退出群聊，群主须先转让群主后才能退出
+	LeaveSession(ctx context.Context, in *LeaveSessionRequest, opts ...grpc.CallOption) (*LeaveSessionResponse, error)
+	// RenameSession 修改群名称，管理员及群主可操作
+	RenameSession(ctx context.Context, in *RenameSessionRequest, opts ...grpc.CallOption) (*RenameSessionResponse, error)
+	// PromoteAdmin 将成员设为管理员，仅群主可操作
+	PromoteAdmin(ctx context.Context, in *PromoteAdminRequest, opts ...grpc.CallOption) (*PromoteAdminResponse, error)
+	// DemoteAdmin 取消成员的管理员身份，仅群主可操作
+	DemoteAdmin(ctx context.Context, in *DemoteAdminRequest, opts ...grpc.CallOption) (*DemoteAdminResponse, error)
+	// TransferOwnership 将群主转让给其他成员，原群主成为管理员；仅群主可操作
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error)
+	// MuteMember 禁言成员（被禁言者不能发送或转发消息），管理员及群主可操作，只能禁言角色低于自己的成员
+	MuteMember(ctx context.Context, in *MuteMemberRequest, opts ...grpc.CallOption) (*MuteMemberResponse, error)
+	// UnmuteMember 解除成员的禁言，管理员及群主可操作
+	UnmuteMember(ctx context.Context, in *UnmuteMemberRequest, opts ...grpc.CallOption) (*UnmuteMemberResponse, error)
+	// SetDoNotDisturb 设置会话免打扰（仅影响调用者本人）：新消息仍推送但标记为静默，@提及与关注的话题不受影响
+	SetDoNotDisturb(ctx context.Context, in *SetDoNotDisturbRequest, opts ...grpc.CallOption) (*SetDoNotDisturbResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -128,6 +188,206 @@ func (c *sessionServiceClient) PullInboxDelta(ctx context.Context, in *PullInbox
 	return out, nil
 }
 
+func (c *sessionServiceClient) GetThreadMessages(ctx context.Context, in *GetThreadMessagesRequest, opts ...grpc.CallOption) (*GetThreadMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetThreadMessagesResponse)
+	err := c.cc.Invoke(ctx, SessionService_GetThreadMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) FollowThread(ctx context.Context, in *FollowThreadRequest, opts ...grpc.CallOption) (*FollowThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowThreadResponse)
+	err := c.cc.Invoke(ctx, SessionService_FollowThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) UnfollowThread(ctx context.Context, in *UnfollowThreadRequest, opts ...grpc.CallOption) (*UnfollowThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowThreadResponse)
+	err := c.cc.Invoke(ctx, SessionService_UnfollowThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) GetMessageReadStatus(ctx context.Context, in *GetMessageReadStatusRequest, opts ...grpc.CallOption) (*GetMessageReadStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMessageReadStatusResponse)
+	err := c.cc.Invoke(ctx, SessionService_GetMessageReadStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinMessageResponse)
+	err := c.cc.Invoke(ctx, SessionService_PinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpinMessageResponse)
+	err := c.cc.Invoke(ctx, SessionService_UnpinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPinnedMessagesResponse)
+	err := c.cc.Invoke(ctx, SessionService_ListPinnedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*SetMessageTTLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMessageTTLResponse)
+	err := c.cc.Invoke(ctx, SessionService_SetMessageTTL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMessagesResponse)
+	err := c.cc.Invoke(ctx, SessionService_SearchMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) DeleteMessagesForMe(ctx context.Context, in *DeleteMessagesForMeRequest, opts ...grpc.CallOption) (*DeleteMessagesForMeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMessagesForMeResponse)
+	err := c.cc.Invoke(ctx, SessionService_DeleteMessagesForMe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) AddMembers(ctx context.Context, in *AddMembersRequest, opts ...grpc.CallOption) (*AddMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddMembersResponse)
+	err := c.cc.Invoke(ctx, SessionService_AddMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMemberResponse)
+	err := c.cc.Invoke(ctx, SessionService_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) LeaveSession(ctx context.Context, in *LeaveSessionRequest, opts ...grpc.CallOption) (*LeaveSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveSessionResponse)
+	err := c.cc.Invoke(ctx, SessionService_LeaveSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RenameSession(ctx context.Context, in *RenameSessionRequest, opts ...grpc.CallOption) (*RenameSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameSessionResponse)
+	err := c.cc.Invoke(ctx, SessionService_RenameSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) PromoteAdmin(ctx context.Context, in *PromoteAdminRequest, opts ...grpc.CallOption) (*PromoteAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoteAdminResponse)
+	err := c.cc.Invoke(ctx, SessionService_PromoteAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) DemoteAdmin(ctx context.Context, in *DemoteAdminRequest, opts ...grpc.CallOption) (*DemoteAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DemoteAdminResponse)
+	err := c.cc.Invoke(ctx, SessionService_DemoteAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, SessionService_TransferOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) MuteMember(ctx context.Context, in *MuteMemberRequest, opts ...grpc.CallOption) (*MuteMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteMemberResponse)
+	err := c.cc.Invoke(ctx, SessionService_MuteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) UnmuteMember(ctx context.Context, in *UnmuteMemberRequest, opts ...grpc.CallOption) (*UnmuteMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmuteMemberResponse)
+	err := c.cc.Invoke(ctx, SessionService_UnmuteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) SetDoNotDisturb(ctx context.Context, in *SetDoNotDisturbRequest, opts ...grpc.CallOption) (*SetDoNotDisturbResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDoNotDisturbResponse)
+	err := c.cc.Invoke(ctx, SessionService_SetDoNotDisturb_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility.
@@ -148,6 +408,46 @@ type SessionServiceServer interface {
 	UpdateReadPosition(context.Context, *UpdateReadPositionRequest) (*UpdateReadPositionResponse, error)
 	// PullInboxDelta 按用户游标增量拉取消息（断线补偿/刷新同步）
 	PullInboxDelta(context.Context, *PullInboxDeltaRequest) (*PullInboxDeltaResponse, error)
+	// GetThreadMessages 按话题内序号拉取话题消息
+	GetThreadMessages(context.Context, *GetThreadMessagesRequest) (*GetThreadMessagesResponse, error)
+	// FollowThread 关注话题（关注者接收话题推送）
+	FollowThread(context.Context, *FollowThreadRequest) (*FollowThreadResponse, error)
+	// UnfollowThread 取消关注话题
+	UnfollowThread(context.Context, *UnfollowThreadRequest) (*UnfollowThreadResponse, error)
+	// GetMessageReadStatus 获取消息的已读/未读成员（基于成员的 last_read_seq 计算，仅发送者可查）
+	GetMessageReadStatus(context.Context, *GetMessageReadStatusRequest) (*GetMessageReadStatusResponse, error)
+	// PinMessage 置顶消息（群聊仅管理员可操作），并发送系统消息通知全部成员
+	PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error)
+	// UnpinMessage 取消置顶（权限同 PinMessage），并发送系统消息通知全部成员
+	UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error)
+	// ListPinnedMessages 获取会话的置顶消息（按置顶时间倒序）
+	ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error)
+	// SetMessageTTL 设置会话消息的保留时长（阅后即焚），仅对设置后发送的消息生效
+	SetMessageTTL(context.Context, *SetMessageTTLRequest) (*SetMessageTTLResponse, error)
+	// SearchMessages 全文搜索历史消息，仅返回调用者所在会话的消息（按发送时间倒序，游标分页）
+	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
+	// DeleteMessagesForMe 仅对自己删除消息，或清空会话中 seq_id 不超过指定位点的聊天记录；不影响其他成员
+	DeleteMessagesForMe(context.Context, *DeleteMessagesForMeRequest) (*DeleteMessagesForMeResponse, error)
+	// AddMembers 邀请用户加入群聊（已是成员的用户被忽略），仅管理员可操作
+	AddMembers(context.Context, *AddMembersRequest) (*AddMembersResponse, error)
+	// RemoveMember 将成员移出群聊，仅管理员可操作；被移除者不再接收会话消息，也无法再拉取会话历史
+	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
+	// LeaveSession 主动退出群聊，群主须先转让群主后才能退出
+	LeaveSession(context.Context, *LeaveSessionRequest) (*LeaveSessionResponse, error)
+	// RenameSession 修改群名称，管理员及群主可操作
+	RenameSession(context.Context, *RenameSessionRequest) (*RenameSessionResponse, error)
+	// PromoteAdmin 将成员设为管理员，仅群主可操作
+	PromoteAdmin(context.Context, *PromoteAdminRequest) (*PromoteAdminResponse, error)
+	// DemoteAdmin 取消成员的管理员身份，仅群主可操作
+	DemoteAdmin(context.Context, *DemoteAdminRequest) (*DemoteAdminResponse, error)
+	// TransferOwnership 将群主转让给其他成员，原群主成为管理员；仅群主可操作
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error)
+	// MuteMember 禁言成员（被禁言者不能发送或转发消息），管理员及群主可操作，只能禁言角色低于自己的成员
+	MuteMember(context.Context, *MuteMemberRequest) (*MuteMemberResponse, error)
+	// UnmuteMember 解除成员的禁言，管理员及群主可操作
+	UnmuteMember(context.Context, *UnmuteMemberRequest) (*UnmuteMemberResponse, error)
+	// SetDoNotDisturb 设置会话免打扰（仅影响调用者本人）：新消息仍推送但标记为静默，@提及与关注的话题不受影响
+	SetDoNotDisturb(context.Context, *SetDoNotDisturbRequest) (*SetDoNotDisturbResponse, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -179,6 +479,66 @@ func (UnimplementedSessionServiceServer) UpdateReadPosition(context.Context, *Up
 func (UnimplementedSessionServiceServer) PullInboxDelta(context.Context, *PullInboxDeltaRequest) (*PullInboxDeltaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PullInboxDelta not implemented")
 }
+func (UnimplementedSessionServiceServer) GetThreadMessages(context.Context, *GetThreadMessagesRequest) (*GetThreadMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThreadMessages not implemented")
+}
+func (UnimplementedSessionServiceServer) FollowThread(context.Context, *FollowThreadRequest) (*FollowThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowThread not implemented")
+}
+func (UnimplementedSessionServiceServer) UnfollowThread(context.Context, *UnfollowThreadRequest) (*UnfollowThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowThread not implemented")
+}
+func (UnimplementedSessionServiceServer) GetMessageReadStatus(context.Context, *GetMessageReadStatusRequest) (*GetMessageReadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageReadStatus not implemented")
+}
+func (UnimplementedSessionServiceServer) PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMessage not implemented")
+}
+func (UnimplementedSessionServiceServer) UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMessage not implemented")
+}
+func (UnimplementedSessionServiceServer) ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPinnedMessages not implemented")
+}
+func (UnimplementedSessionServiceServer) SetMessageTTL(context.Context, *SetMessageTTLRequest) (*SetMessageTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMessageTTL not implemented")
+}
+func (UnimplementedSessionServiceServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
+}
+func (UnimplementedSessionServiceServer) DeleteMessagesForMe(context.Context, *DeleteMessagesForMeRequest) (*DeleteMessagesForMeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessagesForMe not implemented")
+}
+func (UnimplementedSessionServiceServer) AddMembers(context.Context, *AddMembersRequest) (*AddMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMembers not implemented")
+}
+func (UnimplementedSessionServiceServer) RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedSessionServiceServer) LeaveSession(context.Context, *LeaveSessionRequest) (*LeaveSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveSession not implemented")
+}
+func (UnimplementedSessionServiceServer) RenameSession(context.Context, *RenameSessionRequest) (*RenameSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameSession not implemented")
+}
+func (UnimplementedSessionServiceServer) PromoteAdmin(context.Context, *PromoteAdminRequest) (*PromoteAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteAdmin not implemented")
+}
+func (UnimplementedSessionServiceServer) DemoteAdmin(context.Context, *DemoteAdminRequest) (*DemoteAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemoteAdmin not implemented")
+}
+func (UnimplementedSessionServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedSessionServiceServer) MuteMember(context.Context, *MuteMemberRequest) (*MuteMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteMember not implemented")
+}
+func (UnimplementedSessionServiceServer) UnmuteMember(context.Context, *UnmuteMemberRequest) (*UnmuteMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteMember not implemented")
+}
+func (UnimplementedSessionServiceServer) SetDoNotDisturb(context.Context, *SetDoNotDisturbRequest) (*SetDoNotDisturbResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDoNotDisturb not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 func (UnimplementedSessionServiceServer) testEmbeddedByValue()                        {}
 
@@ -326,6 +686,366 @@ func _SessionService_PullInboxDelta_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_GetThreadMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetThreadMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_GetThreadMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetThreadMessages(ctx, req.(*GetThreadMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_FollowThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).FollowThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_FollowThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).FollowThread(ctx, req.(*FollowThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_UnfollowThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UnfollowThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_UnfollowThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UnfollowThread(ctx, req.(*UnfollowThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_GetMessageReadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageReadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetMessageReadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_GetMessageReadStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetMessageReadStatus(ctx, req.(*GetMessageReadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_PinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).PinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_PinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).PinMessage(ctx, req.(*PinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_UnpinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UnpinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_UnpinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UnpinMessage(ctx, req.(*UnpinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ListPinnedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPinnedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListPinnedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ListPinnedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListPinnedMessages(ctx, req.(*ListPinnedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_SetMessageTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMessageTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).SetMessageTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_SetMessageTTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).SetMessageTTL(ctx, req.(*SetMessageTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).SearchMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_SearchMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).SearchMessages(ctx, req.(*SearchMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_DeleteMessagesForMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessagesForMeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).DeleteMessagesForMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_DeleteMessagesForMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).DeleteMessagesForMe(ctx, req.(*DeleteMessagesForMeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_AddMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).AddMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_AddMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).AddMembers(ctx, req.(*AddMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_LeaveSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).LeaveSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_LeaveSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).LeaveSession(ctx, req.(*LeaveSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RenameSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RenameSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_RenameSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RenameSession(ctx, req.(*RenameSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_PromoteAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).PromoteAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_PromoteAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).PromoteAdmin(ctx, req.(*PromoteAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_DemoteAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DemoteAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).DemoteAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_DemoteAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).DemoteAdmin(ctx, req.(*DemoteAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_MuteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).MuteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_MuteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).MuteMember(ctx, req.(*MuteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_UnmuteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UnmuteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_UnmuteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UnmuteMember(ctx, req.(*UnmuteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_SetDoNotDisturb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDoNotDisturbRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).SetDoNotDisturb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_SetDoNotDisturb_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).SetDoNotDisturb(ctx, req.(*SetDoNotDisturbRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -361,6 +1081,86 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PullInboxDelta",
 			Handler:    _SessionService_PullInboxDelta_Handler,
 		},
+		{
+			MethodName: "GetThreadMessages",
+			Handler:    _SessionService_GetThreadMessages_Handler,
+		},
+		{
+			MethodName: "FollowThread",
+			Handler:    _SessionService_FollowThread_Handler,
+		},
+		{
+			MethodName: "UnfollowThread",
+			Handler:    _SessionService_UnfollowThread_Handler,
+		},
+		{
+			MethodName: "GetMessageReadStatus",
+			Handler:    _SessionService_GetMessageReadStatus_Handler,
+		},
+		{
+			MethodName: "PinMessage",
+			Handler:    _SessionService_PinMessage_Handler,
+		},
+		{
+			MethodName: "UnpinMessage",
+			Handler:    _SessionService_UnpinMessage_Handler,
+		},
+		{
+			MethodName: "ListPinnedMessages",
+			Handler:    _SessionService_ListPinnedMessages_Handler,
+		},
+		{
+			MethodName: "SetMessageTTL",
+			Handler:    _SessionService_SetMessageTTL_Handler,
+		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _SessionService_SearchMessages_Handler,
+		},
+		{
+			MethodName: "DeleteMessagesForMe",
+			Handler:    _SessionService_DeleteMessagesForMe_Handler,
+		},
+		{
+			MethodName: "AddMembers",
+			Handler:    _SessionService_AddMembers_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _SessionService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "LeaveSession",
+			Handler:    _SessionService_LeaveSession_Handler,
+		},
+		{
+			MethodName: "RenameSession",
+			Handler:    _SessionService_RenameSession_Handler,
+		},
+		{
+			MethodName: "PromoteAdmin",
+			Handler:    _SessionService_PromoteAdmin_Handler,
+		},
+		{
+			MethodName: "DemoteAdmin",
+			Handler:    _SessionService_DemoteAdmin_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _SessionService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "MuteMember",
+			Handler:    _SessionService_MuteMember_Handler,
+		},
+		{
+			MethodName: "UnmuteMember",
+			Handler:    _SessionService_UnmuteMember_Handler,
+		},
+		{
+			MethodName: "SetDoNotDisturb",
+			Handler:    _SessionService_SetDoNotDisturb_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "logic/v1/session.proto",
@@ -8,6 +8,7 @@ package mqv1
 
 import (
 	_ "github.com/ceyewan/resonance/api/gen/go/common/v1"
+	v1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -21,6 +22,130 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// EventType 标识 PushEvent 承载的事件类型
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED  EventType = 0  // 未指定：普通新消息（兼容旧事件）
+	EventType_EVENT_TYPE_RECALL       EventType = 1  // 消息撤回
+	EventType_EVENT_TYPE_EDIT         EventType = 2  // 消息编辑
+	EventType_EVENT_TYPE_REACTION     EventType = 3  // 表情回应变更
+	EventType_EVENT_TYPE_READ         EventType = 4  // 已读位置推进（seq_id 为新的已读位置）
+	EventType_EVENT_TYPE_EXPIRE       EventType = 6  // 消息过期（已删除），通知客户端清除本地缓存
+	EventType_EVENT_TYPE_HIDE         EventType = 7  // 仅自己删除消息 / 清空聊天记录，仅推送给操作者本人
+	EventType_EVENT_TYPE_ANNOUNCEMENT EventType = 8  // 系统公告，广播给所有 Gateway 的全部在线用户（不属于任何会话）
+	EventType_EVENT_TYPE_MEMBER       EventType = 9  // 会话成员变更（加入、退出、被移除）
+	EventType_EVENT_TYPE_POLL         EventType = 10 // 投票计票变更（投票或结束投票，操作者见 operator_username）
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0:  "EVENT_TYPE_UNSPECIFIED",
+		1:  "EVENT_TYPE_RECALL",
+		2:  "EVENT_TYPE_EDIT",
+		3:  "EVENT_TYPE_REACTION",
+		4:  "EVENT_TYPE_READ",
+		6:  "EVENT_TYPE_EXPIRE",
+		7:  "EVENT_TYPE_HIDE",
+		8:  "EVENT_TYPE_ANNOUNCEMENT",
+		9:  "EVENT_TYPE_MEMBER",
+		10: "EVENT_TYPE_POLL",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":  0,
+		"EVENT_TYPE_RECALL":       1,
+		"EVENT_TYPE_EDIT":         2,
+		"EVENT_TYPE_REACTION":     3,
+		"EVENT_TYPE_READ":         4,
+		"EVENT_TYPE_EXPIRE":       6,
+		"EVENT_TYPE_HIDE":         7,
+		"EVENT_TYPE_ANNOUNCEMENT": 8,
+		"EVENT_TYPE_MEMBER":       9,
+		"EVENT_TYPE_POLL":         10,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mq_v1_event_proto_enumTypes[0].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_mq_v1_event_proto_enumTypes[0]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_mq_v1_event_proto_rawDescGZIP(), []int{0}
+}
+
+// MemberChange 标识会话成员变更的类型
+type MemberChange int32
+
+const (
+	MemberChange_MEMBER_CHANGE_UNSPECIFIED MemberChange = 0
+	MemberChange_MEMBER_CHANGE_JOINED      MemberChange = 1 // 加入（被邀请或自动加入）
+	MemberChange_MEMBER_CHANGE_LEFT        MemberChange = 2 // 主动退出
+	MemberChange_MEMBER_CHANGE_REMOVED     MemberChange = 3 // 被移除
+)
+
+// Enum value maps for MemberChange.
+var (
+	MemberChange_name = map[int32]string{
+		0: "MEMBER_CHANGE_UNSPECIFIED",
+		1: "MEMBER_CHANGE_JOINED",
+		2: "MEMBER_CHANGE_LEFT",
+		3: "MEMBER_CHANGE_REMOVED",
+	}
+	MemberChange_value = map[string]int32{
+		"MEMBER_CHANGE_UNSPECIFIED": 0,
+		"MEMBER_CHANGE_JOINED":      1,
+		"MEMBER_CHANGE_LEFT":        2,
+		"MEMBER_CHANGE_REMOVED":     3,
+	}
+)
+
+func (x MemberChange) Enum() *MemberChange {
+	p := new(MemberChange)
+	*p = x
+	return p
+}
+
+func (x MemberChange) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemberChange) Descriptor() protoreflect.EnumDescriptor {
+	return file_mq_v1_event_proto_enumTypes[1].Descriptor()
+}
+
+func (MemberChange) Type() protoreflect.EnumType {
+	return &file_mq_v1_event_proto_enumTypes[1]
+}
+
+func (x MemberChange) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemberChange.Descriptor instead.
+func (MemberChange) EnumDescriptor() ([]byte, []int) {
+	return file_mq_v1_event_proto_rawDescGZIP(), []int{1}
+}
+
 // PushEvent 是 Logic -> Task 通过 MQ 传输的消息结构
 type PushEvent struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
@@ -37,7 +162,40 @@ type PushEvent struct {
 	SessionType int32  `protobuf:"varint,10,opt,name=session_type,json=sessionType,proto3" json:"session_type,omitempty"` // 会话类型：1=单聊, 2=群聊
 	// 可观测性：分布式追踪上下文
 	// 用于 MQ 场景下的 Trace 传播，由 trace.Inject 填充
-	TraceHeaders  map[string]string `protobuf:"bytes,11,rep,name=trace_headers,json=traceHeaders,proto3" json:"trace_headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TraceHeaders map[string]string `protobuf:"bytes,11,rep,name=trace_headers,json=traceHeaders,proto3" json:"trace_headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 事件类型，默认为普通新消息
+	EventType EventType `protobuf:"varint,12,opt,name=event_type,json=eventType,proto3,enum=resonance.mq.v1.EventType" json:"event_type,omitempty"`
+	// 操作者（撤回等变更类事件的发起人）
+	OperatorUsername string `protobuf:"bytes,13,opt,name=operator_username,json=operatorUsername,proto3" json:"operator_username,omitempty"`
+	// 编辑版本号（0 表示未编辑）与编辑时间，编辑事件携带
+	EditVersion int32 `protobuf:"varint,14,opt,name=edit_version,json=editVersion,proto3" json:"edit_version,omitempty"`
+	EditedAt    int64 `protobuf:"varint,15,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	// 引用回复的消息ID（0 表示非回复）
+	ReplyToMsgId int64 `protobuf:"varint,16,opt,name=reply_to_msg_id,json=replyToMsgId,proto3" json:"reply_to_msg_id,omitempty"`
+	// 所属话题的根消息ID（0 表示主时间线消息；非 0 时 seq_id 为话题内序号）
+	ThreadRootId int64 `protobuf:"varint,17,opt,name=thread_root_id,json=threadRootId,proto3" json:"thread_root_id,omitempty"`
+	// 表情回应变更，回应事件携带（操作者见 operator_username）
+	ReactionEmoji   string `protobuf:"bytes,18,opt,name=reaction_emoji,json=reactionEmoji,proto3" json:"reaction_emoji,omitempty"`
+	ReactionRemoved bool   `protobuf:"varint,19,opt,name=reaction_removed,json=reactionRemoved,proto3" json:"reaction_removed,omitempty"`
+	// @提及的用户名列表（已过滤为会话成员）与 @所有人标记
+	Mentions   []string `protobuf:"bytes,20,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	MentionAll bool     `protobuf:"varint,21,opt,name=mention_all,json=mentionAll,proto3" json:"mention_all,omitempty"`
+	// 已读事件：本次推进前的已读位置，已读区间为 (read_from_seq, seq_id]
+	ReadFromSeq int64 `protobuf:"varint,22,opt,name=read_from_seq,json=readFromSeq,proto3" json:"read_from_seq,omitempty"`
+	// 结构化消息体（为空时以 content/type 为准）
+	Body *v1.MessageBody `protobuf:"bytes,25,opt,name=body,proto3" json:"body,omitempty"`
+	// 消息过期时间（Unix 秒，0 表示永久保留）
+	ExpiresAt int64 `protobuf:"varint,26,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// 仅自己删除事件：删除的消息 ID 与清空聊天记录的位点
+	HiddenMsgIds []int64 `protobuf:"varint,27,rep,packed,name=hidden_msg_ids,json=hiddenMsgIds,proto3" json:"hidden_msg_ids,omitempty"`
+	ClearedSeq   int64   `protobuf:"varint,28,opt,name=cleared_seq,json=clearedSeq,proto3" json:"cleared_seq,omitempty"`
+	// 系统公告事件：公告内容
+	Announcement *v1.Announcement `protobuf:"bytes,29,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	// 成员变更事件：变更类型与涉及的成员（操作者见 operator_username）
+	MemberChange    MemberChange `protobuf:"varint,30,opt,name=member_change,json=memberChange,proto3,enum=resonance.mq.v1.MemberChange" json:"member_change,omitempty"`
+	MemberUsernames []string     `protobuf:"bytes,31,rep,name=member_usernames,json=memberUsernames,proto3" json:"member_usernames,omitempty"`
+	// 发送者是否为机器人账号
+	FromBot       bool `protobuf:"varint,32,opt,name=from_bot,json=fromBot,proto3" json:"from_bot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -149,55 +307,264 @@ func (x *PushEvent) GetTraceHeaders() map[string]string {
 	return nil
 }
 
+func (x *PushEvent) GetEventType() EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PushEvent) GetOperatorUsername() string {
+	if x != nil {
+		return x.OperatorUsername
+	}
+	return ""
+}
+
+func (x *PushEvent) GetEditVersion() int32 {
+	if x != nil {
+		return x.EditVersion
+	}
+	return 0
+}
+
+func (x *PushEvent) GetEditedAt() int64 {
+	if x != nil {
+		return x.EditedAt
+	}
+	return 0
+}
+
+func (x *PushEvent) GetReplyToMsgId() int64 {
+	if x != nil {
+		return x.ReplyToMsgId
+	}
+	return 0
+}
+
+func (x *PushEvent) GetThreadRootId() int64 {
+	if x != nil {
+		return x.ThreadRootId
+	}
+	return 0
+}
+
+func (x *PushEvent) GetReactionEmoji() string {
+	if x != nil {
+		return x.ReactionEmoji
+	}
+	return ""
+}
+
+func (x *PushEvent) GetReactionRemoved() bool {
+	if x != nil {
+		return x.ReactionRemoved
+	}
+	return false
+}
+
+func (x *PushEvent) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *PushEvent) GetMentionAll() bool {
+	if x != nil {
+		return x.MentionAll
+	}
+	return false
+}
+
+func (x *PushEvent) GetReadFromSeq() int64 {
+	if x != nil {
+		return x.ReadFromSeq
+	}
+	return 0
+}
+
+func (x *PushEvent) GetBody() *v1.MessageBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *PushEvent) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *PushEvent) GetHiddenMsgIds() []int64 {
+	if x != nil {
+		return x.HiddenMsgIds
+	}
+	return nil
+}
+
+func (x *PushEvent) GetClearedSeq() int64 {
+	if x != nil {
+		return x.ClearedSeq
+	}
+	return 0
+}
+
+func (x *PushEvent) GetAnnouncement() *v1.Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *PushEvent) GetMemberChange() MemberChange {
+	if x != nil {
+		return x.MemberChange
+	}
+	return MemberChange_MEMBER_CHANGE_UNSPECIFIED
+}
+
+func (x *PushEvent) GetMemberUsernames() []string {
+	if x != nil {
+		return x.MemberUsernames
+	}
+	return nil
+}
+
+func (x *PushEvent) GetFromBot() bool {
+	if x != nil {
+		return x.FromBot
+	}
+	return false
+}
+
 var File_mq_v1_event_proto protoreflect.FileDescriptor
 
 var file_mq_v1_event_proto_rawDesc = []byte{
 	0x0a, 0x11, 0x6d, 0x71, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x6d,
 	0x71, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe1, 0x03,
-	0x0a, 0x09, 0x50, 0x75, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6d,
-	0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x73, 0x67,
-	0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x73, 0x65, 0x71, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d,
-	0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x51, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61,
-	0x6e, 0x63, 0x65, 0x2e, 0x6d, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x0a, 0x0a, 0x09, 0x50, 0x75, 0x73, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x73,
+	0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x65, 0x71,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x51, 0x0a, 0x0d, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x6d, 0x71, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0a,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x6d, 0x71, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x64, 0x69, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f,
+	0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6d,
+	0x6f, 0x6a, 0x69, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c,
+	0x12, 0x22, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x65,
+	0x71, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x53, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x1b, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x0c, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x53, 0x65,
+	0x71, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x6d, 0x71, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x29, 0x0a,
+	0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x62, 0x6f, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d,
+	0x42, 0x6f, 0x74, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63, 0x65, 0x48, 0x65, 0x61, 0x64,
 	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
 	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x3a, 0x02, 0x38, 0x01, 0x3a, 0x1b, 0x8a, 0xb5, 0x18, 0x17, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61,
 	0x6e, 0x63, 0x65, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x42, 0xb3, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61,
-	0x6e, 0x63, 0x65, 0x2e, 0x6d, 0x71, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x65, 0x79, 0x65, 0x77, 0x61, 0x6e, 0x2f, 0x72, 0x65, 0x73, 0x6f,
-	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f,
-	0x2f, 0x6d, 0x71, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x71, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x4d,
-	0x58, 0xaa, 0x02, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x4d, 0x71,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5c,
-	0x4d, 0x71, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63,
-	0x65, 0x5c, 0x4d, 0x71, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x3a,
-	0x3a, 0x4d, 0x71, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x4a, 0x04, 0x08, 0x17, 0x10, 0x18, 0x4a, 0x04, 0x08, 0x18, 0x10, 0x19, 0x2a, 0xfc, 0x01,
+	0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x13,
+	0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x44, 0x49,
+	0x54, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10,
+	0x04, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x49, 0x44, 0x45, 0x10, 0x07, 0x12, 0x1b, 0x0a,
+	0x17, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4e, 0x4e, 0x4f,
+	0x55, 0x4e, 0x43, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x08, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10,
+	0x09, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x50, 0x4f, 0x4c, 0x4c, 0x10, 0x0a, 0x22, 0x04, 0x08, 0x05, 0x10, 0x05, 0x2a, 0x7a, 0x0a, 0x0c,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x19,
+	0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4d,
+	0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4a, 0x4f, 0x49,
+	0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x02, 0x12, 0x19, 0x0a,
+	0x15, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x42, 0xb3, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d,
+	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x6d, 0x71, 0x2e, 0x76, 0x31,
+	0x42, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x65, 0x79, 0x65, 0x77,
+	0x61, 0x6e, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6d, 0x71, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x71,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x4d, 0x58, 0xaa, 0x02, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x4d, 0x71, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x52, 0x65, 0x73,
+	0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5c, 0x4d, 0x71, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x52,
+	0x65, 0x73, 0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5c, 0x4d, 0x71, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x52, 0x65, 0x73,
+	0x6f, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x4d, 0x71, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -212,18 +579,27 @@ func file_mq_v1_event_proto_rawDescGZIP() []byte {
 	return file_mq_v1_event_proto_rawDescData
 }
 
+var file_mq_v1_event_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_mq_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_mq_v1_event_proto_goTypes = []any{
-	(*PushEvent)(nil), // 0: resonance.mq.v1.PushEvent
-	nil,               // 1: resonance.mq.v1.PushEvent.TraceHeadersEntry
+	(EventType)(0),          // 0: resonance.mq.v1.EventType
+	(MemberChange)(0),       // 1: resonance.mq.v1.MemberChange
+	(*PushEvent)(nil),       // 2: resonance.mq.v1.PushEvent
+	nil,                     // 3: resonance.mq.v1.PushEvent.TraceHeadersEntry
+	(*v1.MessageBody)(nil),  // 4: resonance.gateway.v1.MessageBody
+	(*v1.Announcement)(nil), // 5: resonance.gateway.v1.Announcement
 }
 var file_mq_v1_event_proto_depIdxs = []int32{
-	1, // 0: resonance.mq.v1.PushEvent.trace_headers:type_name -> resonance.mq.v1.PushEvent.TraceHeadersEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: resonance.mq.v1.PushEvent.trace_headers:type_name -> resonance.mq.v1.PushEvent.TraceHeadersEntry
+	0, // 1: resonance.mq.v1.PushEvent.event_type:type_name -> resonance.mq.v1.EventType
+	4, // 2: resonance.mq.v1.PushEvent.body:type_name -> resonance.gateway.v1.MessageBody
+	5, // 3: resonance.mq.v1.PushEvent.announcement:type_name -> resonance.gateway.v1.Announcement
+	1, // 4: resonance.mq.v1.PushEvent.member_change:type_name -> resonance.mq.v1.MemberChange
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_mq_v1_event_proto_init() }
@@ -236,13 +612,14 @@ func file_mq_v1_event_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mq_v1_event_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      2,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_mq_v1_event_proto_goTypes,
 		DependencyIndexes: file_mq_v1_event_proto_depIdxs,
+		EnumInfos:         file_mq_v1_event_proto_enumTypes,
 		MessageInfos:      file_mq_v1_event_proto_msgTypes,
 	}.Build()
 	File_mq_v1_event_proto = out.File
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateSessionRequest, CreateSessionResponse, GetContactListRequest, GetContactListResponse, GetHistoryMessagesRequest, GetHistoryMessagesResponse, GetSessionListRequest, GetSessionListResponse, LoginRequest, LoginResponse, LogoutRequest, LogoutResponse, PullInboxDeltaRequest, PullInboxDeltaResponse, RegisterRequest, RegisterResponse, SearchUserRequest, SearchUserResponse, UpdateReadPositionRequest, UpdateReadPositionResponse } from "./api_pb.js";
+import { AddMembersRequest, AddMembersResponse, AddReactionRequest, AddReactionResponse, AnnounceRequest, AnnounceResponse, CancelScheduledMessageRequest, CancelScheduledMessageResponse, CastVoteRequest, CastVoteResponse, ClosePollRequest, ClosePollResponse, CreateBotRequest, CreateBotResponse, CreateSessionRequest, CreateSessionResponse, CreateWebhookRequest, CreateWebhookResponse, DeleteMessagesForMeRequest, DeleteMessagesForMeResponse, DeleteWebhookRequest, DeleteWebhookResponse, DemoteAdminRequest, DemoteAdminResponse, EditMessageRequest, EditMessageResponse, FollowThreadRequest, FollowThreadResponse, ForwardMessagesRequest, ForwardMessagesResponse, GetContactListRequest, GetContactListResponse, GetHistoryMessagesRequest, GetHistoryMessagesResponse, GetMessageReadStatusRequest, GetMessageReadStatusResponse, GetSessionListRequest, GetSessionListResponse, GetThreadMessagesRequest, GetThreadMessagesResponse, LeaveSessionRequest, LeaveSessionResponse, ListAnnouncementsRequest, ListAnnouncementsResponse, ListBotsRequest, ListBotsResponse, ListPinnedMessagesRequest, ListPinnedMessagesResponse, ListScheduledMessagesRequest, ListScheduledMessagesResponse, ListWebhookDeliveriesRequest, ListWebhookDeliveriesResponse, ListWebhooksRequest, ListWebhooksResponse, LoginRequest, LoginResponse, LogoutRequest, LogoutResponse, MuteMemberRequest, MuteMemberResponse, PinMessageRequest, PinMessageResponse, PromoteAdminRequest, PromoteAdminResponse, PullInboxDeltaRequest, PullInboxDeltaResponse, RecallMessageRequest, RecallMessageResponse, RegisterRequest, RegisterResponse, RemoveMemberRequest, RemoveMemberResponse, RemoveReactionRequest, RemoveReactionResponse, RenameSessionRequest, RenameSessionResponse, RotateBotTokenRequest, RotateBotTokenResponse, ScheduleMessageRequest, ScheduleMessageResponse, SearchMessagesRequest, SearchMessagesResponse, SearchUserRequest, SearchUserResponse, SetDoNotDisturbRequest, SetDoNotDisturbResponse, SetMessageTTLRequest, SetMessageTTLResponse, TransferOwnershipRequest, TransferOwnershipResponse, UnfollowThreadRequest, UnfollowThreadResponse, UnmuteMemberRequest, UnmuteMemberResponse, UnpinMessageRequest, UnpinMessageResponse, UpdateReadPositionRequest, UpdateReadPositionResponse, UpdateScheduledMessageRequest, UpdateScheduledMessageResponse } from "./api_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -135,6 +135,446 @@ export const SessionService = {
       O: PullInboxDeltaResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * RecallMessage 撤回消息（发送者在时间窗口内，或群管理员）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.RecallMessage
+     */
+    recallMessage: {
+      name: "RecallMessage",
+      I: RecallMessageRequest,
+      O: RecallMessageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * EditMessage 编辑消息（仅发送者，且在时间窗口内）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.EditMessage
+     */
+    editMessage: {
+      name: "EditMessage",
+      I: EditMessageRequest,
+      O: EditMessageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetThreadMessages 拉取话题消息
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.GetThreadMessages
+     */
+    getThreadMessages: {
+      name: "GetThreadMessages",
+      I: GetThreadMessagesRequest,
+      O: GetThreadMessagesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * FollowThread 关注话题
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.FollowThread
+     */
+    followThread: {
+      name: "FollowThread",
+      I: FollowThreadRequest,
+      O: FollowThreadResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UnfollowThread 取消关注话题
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.UnfollowThread
+     */
+    unfollowThread: {
+      name: "UnfollowThread",
+      I: UnfollowThreadRequest,
+      O: UnfollowThreadResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * AddReaction 添加表情回应
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.AddReaction
+     */
+    addReaction: {
+      name: "AddReaction",
+      I: AddReactionRequest,
+      O: AddReactionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RemoveReaction 取消表情回应
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.RemoveReaction
+     */
+    removeReaction: {
+      name: "RemoveReaction",
+      I: RemoveReactionRequest,
+      O: RemoveReactionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CastVote 在投票消息中投票
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.CastVote
+     */
+    castVote: {
+      name: "CastVote",
+      I: CastVoteRequest,
+      O: CastVoteResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ClosePoll 提前结束投票（发起者或群管理员）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.ClosePoll
+     */
+    closePoll: {
+      name: "ClosePoll",
+      I: ClosePollRequest,
+      O: ClosePollResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetMessageReadStatus 获取消息的已读/未读成员（仅发送者可查）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.GetMessageReadStatus
+     */
+    getMessageReadStatus: {
+      name: "GetMessageReadStatus",
+      I: GetMessageReadStatusRequest,
+      O: GetMessageReadStatusResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ForwardMessages 逐条或合并转发消息到其他会话
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.ForwardMessages
+     */
+    forwardMessages: {
+      name: "ForwardMessages",
+      I: ForwardMessagesRequest,
+      O: ForwardMessagesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * PinMessage 置顶消息（群聊仅管理员可操作）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.PinMessage
+     */
+    pinMessage: {
+      name: "PinMessage",
+      I: PinMessageRequest,
+      O: PinMessageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UnpinMessage 取消置顶
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.UnpinMessage
+     */
+    unpinMessage: {
+      name: "UnpinMessage",
+      I: UnpinMessageRequest,
+      O: UnpinMessageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListPinnedMessages 获取会话的置顶消息
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.ListPinnedMessages
+     */
+    listPinnedMessages: {
+      name: "ListPinnedMessages",
+      I: ListPinnedMessagesRequest,
+      O: ListPinnedMessagesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetMessageTTL 设置会话消息的保留时长（阅后即焚）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.SetMessageTTL
+     */
+    setMessageTTL: {
+      name: "SetMessageTTL",
+      I: SetMessageTTLRequest,
+      O: SetMessageTTLResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SearchMessages 全文搜索当前用户所在会话的历史消息
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.SearchMessages
+     */
+    searchMessages: {
+      name: "SearchMessages",
+      I: SearchMessagesRequest,
+      O: SearchMessagesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteMessagesForMe 仅对自己删除消息或清空聊天记录，并同步到本人其他设备
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.DeleteMessagesForMe
+     */
+    deleteMessagesForMe: {
+      name: "DeleteMessagesForMe",
+      I: DeleteMessagesForMeRequest,
+      O: DeleteMessagesForMeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * AddMembers 邀请用户加入群聊（仅管理员）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.AddMembers
+     */
+    addMembers: {
+      name: "AddMembers",
+      I: AddMembersRequest,
+      O: AddMembersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RemoveMember 将成员移出群聊（仅管理员）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.RemoveMember
+     */
+    removeMember: {
+      name: "RemoveMember",
+      I: RemoveMemberRequest,
+      O: RemoveMemberResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * LeaveSession 主动退出群聊
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.LeaveSession
+     */
+    leaveSession: {
+      name: "LeaveSession",
+      I: LeaveSessionRequest,
+      O: LeaveSessionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RenameSession 修改群名称（管理员及群主）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.RenameSession
+     */
+    renameSession: {
+      name: "RenameSession",
+      I: RenameSessionRequest,
+      O: RenameSessionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * PromoteAdmin 将成员设为管理员（仅群主）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.PromoteAdmin
+     */
+    promoteAdmin: {
+      name: "PromoteAdmin",
+      I: PromoteAdminRequest,
+      O: PromoteAdminResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DemoteAdmin 取消成员的管理员身份（仅群主）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.DemoteAdmin
+     */
+    demoteAdmin: {
+      name: "DemoteAdmin",
+      I: DemoteAdminRequest,
+      O: DemoteAdminResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * TransferOwnership 转让群主（仅群主）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.TransferOwnership
+     */
+    transferOwnership: {
+      name: "TransferOwnership",
+      I: TransferOwnershipRequest,
+      O: TransferOwnershipResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * MuteMember 禁言成员（管理员及群主）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.MuteMember
+     */
+    muteMember: {
+      name: "MuteMember",
+      I: MuteMemberRequest,
+      O: MuteMemberResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UnmuteMember 解除成员的禁言（管理员及群主）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.UnmuteMember
+     */
+    unmuteMember: {
+      name: "UnmuteMember",
+      I: UnmuteMemberRequest,
+      O: UnmuteMemberResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetDoNotDisturb 设置会话免打扰（仅影响当前用户）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.SetDoNotDisturb
+     */
+    setDoNotDisturb: {
+      name: "SetDoNotDisturb",
+      I: SetDoNotDisturbRequest,
+      O: SetDoNotDisturbResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ScheduleMessage 创建定时消息
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.ScheduleMessage
+     */
+    scheduleMessage: {
+      name: "ScheduleMessage",
+      I: ScheduleMessageRequest,
+      O: ScheduleMessageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListScheduledMessages 获取当前用户的定时消息
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.ListScheduledMessages
+     */
+    listScheduledMessages: {
+      name: "ListScheduledMessages",
+      I: ListScheduledMessagesRequest,
+      O: ListScheduledMessagesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateScheduledMessage 编辑待发送的定时消息
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.UpdateScheduledMessage
+     */
+    updateScheduledMessage: {
+      name: "UpdateScheduledMessage",
+      I: UpdateScheduledMessageRequest,
+      O: UpdateScheduledMessageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CancelScheduledMessage 取消待发送的定时消息
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.CancelScheduledMessage
+     */
+    cancelScheduledMessage: {
+      name: "CancelScheduledMessage",
+      I: CancelScheduledMessageRequest,
+      O: CancelScheduledMessageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Announce 发布系统公告（仅系统管理员）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.Announce
+     */
+    announce: {
+      name: "Announce",
+      I: AnnounceRequest,
+      O: AnnounceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListAnnouncements 获取离线期间错过的系统公告
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.ListAnnouncements
+     */
+    listAnnouncements: {
+      name: "ListAnnouncements",
+      I: ListAnnouncementsRequest,
+      O: ListAnnouncementsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateWebhook 为会话创建出站 Webhook 订阅
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.CreateWebhook
+     */
+    createWebhook: {
+      name: "CreateWebhook",
+      I: CreateWebhookRequest,
+      O: CreateWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListWebhooks 列出会话的 Webhook 订阅
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.ListWebhooks
+     */
+    listWebhooks: {
+      name: "ListWebhooks",
+      I: ListWebhooksRequest,
+      O: ListWebhooksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteWebhook 删除 Webhook 订阅
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.DeleteWebhook
+     */
+    deleteWebhook: {
+      name: "DeleteWebhook",
+      I: DeleteWebhookRequest,
+      O: DeleteWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListWebhookDeliveries 查看 Webhook 投递日志
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.ListWebhookDeliveries
+     */
+    listWebhookDeliveries: {
+      name: "ListWebhookDeliveries",
+      I: ListWebhookDeliveriesRequest,
+      O: ListWebhookDeliveriesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateBot 创建机器人账号，返回其 API Token（仅此一次）
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.CreateBot
+     */
+    createBot: {
+      name: "CreateBot",
+      I: CreateBotRequest,
+      O: CreateBotResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListBots 列出当前用户创建的机器人账号
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.ListBots
+     */
+    listBots: {
+      name: "ListBots",
+      I: ListBotsRequest,
+      O: ListBotsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RotateBotToken 轮换机器人的 API Token，旧 Token 立即失效
+     *
+     * @generated from rpc resonance.gateway.v1.SessionService.RotateBotToken
+     */
+    rotateBotToken: {
+      name: "RotateBotToken",
+      I: RotateBotTokenRequest,
+      O: RotateBotTokenResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
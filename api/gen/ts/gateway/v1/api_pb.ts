@@ -6,7 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 import { User } from "../../common/v1/types_pb.js";
-import { PushMessage } from "./packet_pb.js";
+import { Announcement, Bot, ForwardMode, ForwardResult, MessageBody, PinnedMessage, PollTally, PushMessage, Reaction, ScheduledMessage, SearchResult, Webhook, WebhookDelivery } from "./packet_pb.js";
 
 /**
  * @generated from message resonance.gateway.v1.LoginRequest
@@ -338,6 +338,27 @@ export class SessionInfo extends Message<SessionInfo> {
    */
   lastMessage?: PushMessage;
 
+  /**
+   * 未读消息中 @当前用户（含 @所有人）的条数
+   *
+   * @generated from field: int64 mention_count = 8;
+   */
+  mentionCount = protoInt64.zero;
+
+  /**
+   * 消息保留时长（秒，0 表示永久保留）
+   *
+   * @generated from field: int64 message_ttl = 9;
+   */
+  messageTtl = protoInt64.zero;
+
+  /**
+   * 当前用户是否开启了免打扰
+   *
+   * @generated from field: bool do_not_disturb = 10;
+   */
+  doNotDisturb = false;
+
   constructor(data?: PartialMessage<SessionInfo>) {
     super();
     proto3.util.initPartial(data, this);
@@ -353,6 +374,9 @@ export class SessionInfo extends Message<SessionInfo> {
     { no: 5, name: "unread_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 6, name: "last_read_seq", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 7, name: "last_message", kind: "message", T: PushMessage },
+    { no: 8, name: "mention_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "message_ttl", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "do_not_disturb", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SessionInfo {
@@ -1029,3 +1053,3675 @@ export class PullInboxDeltaResponse extends Message<PullInboxDeltaResponse> {
   }
 }
 
+/**
+ * @generated from message resonance.gateway.v1.RecallMessageRequest
+ */
+export class RecallMessageRequest extends Message<RecallMessageRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: int64 msg_id = 3;
+   */
+  msgId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RecallMessageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.RecallMessageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RecallMessageRequest {
+    return new RecallMessageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RecallMessageRequest {
+    return new RecallMessageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RecallMessageRequest {
+    return new RecallMessageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RecallMessageRequest | PlainMessage<RecallMessageRequest> | undefined, b: RecallMessageRequest | PlainMessage<RecallMessageRequest> | undefined): boolean {
+    return proto3.util.equals(RecallMessageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.RecallMessageResponse
+ */
+export class RecallMessageResponse extends Message<RecallMessageResponse> {
+  constructor(data?: PartialMessage<RecallMessageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.RecallMessageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RecallMessageResponse {
+    return new RecallMessageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RecallMessageResponse {
+    return new RecallMessageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RecallMessageResponse {
+    return new RecallMessageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RecallMessageResponse | PlainMessage<RecallMessageResponse> | undefined, b: RecallMessageResponse | PlainMessage<RecallMessageResponse> | undefined): boolean {
+    return proto3.util.equals(RecallMessageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.EditMessageRequest
+ */
+export class EditMessageRequest extends Message<EditMessageRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: int64 msg_id = 3;
+   */
+  msgId = protoInt64.zero;
+
+  /**
+   * @generated from field: string content = 4;
+   */
+  content = "";
+
+  constructor(data?: PartialMessage<EditMessageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.EditMessageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EditMessageRequest {
+    return new EditMessageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EditMessageRequest {
+    return new EditMessageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EditMessageRequest {
+    return new EditMessageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EditMessageRequest | PlainMessage<EditMessageRequest> | undefined, b: EditMessageRequest | PlainMessage<EditMessageRequest> | undefined): boolean {
+    return proto3.util.equals(EditMessageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.EditMessageResponse
+ */
+export class EditMessageResponse extends Message<EditMessageResponse> {
+  /**
+   * @generated from field: int32 version = 1;
+   */
+  version = 0;
+
+  /**
+   * @generated from field: int64 edited_at = 2;
+   */
+  editedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<EditMessageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.EditMessageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "edited_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EditMessageResponse {
+    return new EditMessageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EditMessageResponse {
+    return new EditMessageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EditMessageResponse {
+    return new EditMessageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EditMessageResponse | PlainMessage<EditMessageResponse> | undefined, b: EditMessageResponse | PlainMessage<EditMessageResponse> | undefined): boolean {
+    return proto3.util.equals(EditMessageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.GetThreadMessagesRequest
+ */
+export class GetThreadMessagesRequest extends Message<GetThreadMessagesRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: int64 root_msg_id = 3;
+   */
+  rootMsgId = protoInt64.zero;
+
+  /**
+   * 拉取话题内 seq_id > after_seq 的消息
+   *
+   * @generated from field: int64 after_seq = 4;
+   */
+  afterSeq = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 limit = 5;
+   */
+  limit = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetThreadMessagesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.GetThreadMessagesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "root_msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "after_seq", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetThreadMessagesRequest {
+    return new GetThreadMessagesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetThreadMessagesRequest {
+    return new GetThreadMessagesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetThreadMessagesRequest {
+    return new GetThreadMessagesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetThreadMessagesRequest | PlainMessage<GetThreadMessagesRequest> | undefined, b: GetThreadMessagesRequest | PlainMessage<GetThreadMessagesRequest> | undefined): boolean {
+    return proto3.util.equals(GetThreadMessagesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.GetThreadMessagesResponse
+ */
+export class GetThreadMessagesResponse extends Message<GetThreadMessagesResponse> {
+  /**
+   * @generated from field: resonance.gateway.v1.PushMessage root = 1;
+   */
+  root?: PushMessage;
+
+  /**
+   * @generated from field: repeated resonance.gateway.v1.PushMessage messages = 2;
+   */
+  messages: PushMessage[] = [];
+
+  /**
+   * @generated from field: bool following = 3;
+   */
+  following = false;
+
+  constructor(data?: PartialMessage<GetThreadMessagesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.GetThreadMessagesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "root", kind: "message", T: PushMessage },
+    { no: 2, name: "messages", kind: "message", T: PushMessage, repeated: true },
+    { no: 3, name: "following", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetThreadMessagesResponse {
+    return new GetThreadMessagesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetThreadMessagesResponse {
+    return new GetThreadMessagesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetThreadMessagesResponse {
+    return new GetThreadMessagesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetThreadMessagesResponse | PlainMessage<GetThreadMessagesResponse> | undefined, b: GetThreadMessagesResponse | PlainMessage<GetThreadMessagesResponse> | undefined): boolean {
+    return proto3.util.equals(GetThreadMessagesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.FollowThreadRequest
+ */
+export class FollowThreadRequest extends Message<FollowThreadRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: int64 root_msg_id = 3;
+   */
+  rootMsgId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<FollowThreadRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.FollowThreadRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "root_msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FollowThreadRequest {
+    return new FollowThreadRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FollowThreadRequest {
+    return new FollowThreadRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FollowThreadRequest {
+    return new FollowThreadRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FollowThreadRequest | PlainMessage<FollowThreadRequest> | undefined, b: FollowThreadRequest | PlainMessage<FollowThreadRequest> | undefined): boolean {
+    return proto3.util.equals(FollowThreadRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.FollowThreadResponse
+ */
+export class FollowThreadResponse extends Message<FollowThreadResponse> {
+  constructor(data?: PartialMessage<FollowThreadResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.FollowThreadResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FollowThreadResponse {
+    return new FollowThreadResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FollowThreadResponse {
+    return new FollowThreadResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FollowThreadResponse {
+    return new FollowThreadResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FollowThreadResponse | PlainMessage<FollowThreadResponse> | undefined, b: FollowThreadResponse | PlainMessage<FollowThreadResponse> | undefined): boolean {
+    return proto3.util.equals(FollowThreadResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.UnfollowThreadRequest
+ */
+export class UnfollowThreadRequest extends Message<UnfollowThreadRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: int64 root_msg_id = 3;
+   */
+  rootMsgId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<UnfollowThreadRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.UnfollowThreadRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "root_msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnfollowThreadRequest {
+    return new UnfollowThreadRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnfollowThreadRequest {
+    return new UnfollowThreadRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnfollowThreadRequest {
+    return new UnfollowThreadRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnfollowThreadRequest | PlainMessage<UnfollowThreadRequest> | undefined, b: UnfollowThreadRequest | PlainMessage<UnfollowThreadRequest> | undefined): boolean {
+    return proto3.util.equals(UnfollowThreadRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.UnfollowThreadResponse
+ */
+export class UnfollowThreadResponse extends Message<UnfollowThreadResponse> {
+  constructor(data?: PartialMessage<UnfollowThreadResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.UnfollowThreadResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnfollowThreadResponse {
+    return new UnfollowThreadResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnfollowThreadResponse {
+    return new UnfollowThreadResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnfollowThreadResponse {
+    return new UnfollowThreadResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnfollowThreadResponse | PlainMessage<UnfollowThreadResponse> | undefined, b: UnfollowThreadResponse | PlainMessage<UnfollowThreadResponse> | undefined): boolean {
+    return proto3.util.equals(UnfollowThreadResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.AddReactionRequest
+ */
+export class AddReactionRequest extends Message<AddReactionRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: int64 msg_id = 3;
+   */
+  msgId = protoInt64.zero;
+
+  /**
+   * @generated from field: string emoji = 4;
+   */
+  emoji = "";
+
+  constructor(data?: PartialMessage<AddReactionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.AddReactionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "emoji", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddReactionRequest {
+    return new AddReactionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddReactionRequest {
+    return new AddReactionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddReactionRequest {
+    return new AddReactionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddReactionRequest | PlainMessage<AddReactionRequest> | undefined, b: AddReactionRequest | PlainMessage<AddReactionRequest> | undefined): boolean {
+    return proto3.util.equals(AddReactionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.AddReactionResponse
+ */
+export class AddReactionResponse extends Message<AddReactionResponse> {
+  /**
+   * @generated from field: repeated resonance.gateway.v1.Reaction reactions = 1;
+   */
+  reactions: Reaction[] = [];
+
+  constructor(data?: PartialMessage<AddReactionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.AddReactionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "reactions", kind: "message", T: Reaction, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddReactionResponse {
+    return new AddReactionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddReactionResponse {
+    return new AddReactionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddReactionResponse {
+    return new AddReactionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddReactionResponse | PlainMessage<AddReactionResponse> | undefined, b: AddReactionResponse | PlainMessage<AddReactionResponse> | undefined): boolean {
+    return proto3.util.equals(AddReactionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.RemoveReactionRequest
+ */
+export class RemoveReactionRequest extends Message<RemoveReactionRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: int64 msg_id = 3;
+   */
+  msgId = protoInt64.zero;
+
+  /**
+   * @generated from field: string emoji = 4;
+   */
+  emoji = "";
+
+  constructor(data?: PartialMessage<RemoveReactionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.RemoveReactionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "emoji", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveReactionRequest {
+    return new RemoveReactionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveReactionRequest {
+    return new RemoveReactionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveReactionRequest {
+    return new RemoveReactionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveReactionRequest | PlainMessage<RemoveReactionRequest> | undefined, b: RemoveReactionRequest | PlainMessage<RemoveReactionRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveReactionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.RemoveReactionResponse
+ */
+export class RemoveReactionResponse extends Message<RemoveReactionResponse> {
+  /**
+   * @generated from field: repeated resonance.gateway.v1.Reaction reactions = 1;
+   */
+  reactions: Reaction[] = [];
+
+  constructor(data?: PartialMessage<RemoveReactionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.RemoveReactionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "reactions", kind: "message", T: Reaction, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveReactionResponse {
+    return new RemoveReactionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveReactionResponse {
+    return new RemoveReactionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveReactionResponse {
+    return new RemoveReactionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveReactionResponse | PlainMessage<RemoveReactionResponse> | undefined, b: RemoveReactionResponse | PlainMessage<RemoveReactionResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveReactionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.CastVoteRequest
+ */
+export class CastVoteRequest extends Message<CastVoteRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: int64 msg_id = 3;
+   */
+  msgId = protoInt64.zero;
+
+  /**
+   * 选择的选项下标，为空表示撤回投票
+   *
+   * @generated from field: repeated int32 options = 4;
+   */
+  options: number[] = [];
+
+  constructor(data?: PartialMessage<CastVoteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.CastVoteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "options", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CastVoteRequest {
+    return new CastVoteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CastVoteRequest {
+    return new CastVoteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CastVoteRequest {
+    return new CastVoteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CastVoteRequest | PlainMessage<CastVoteRequest> | undefined, b: CastVoteRequest | PlainMessage<CastVoteRequest> | undefined): boolean {
+    return proto3.util.equals(CastVoteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.CastVoteResponse
+ */
+export class CastVoteResponse extends Message<CastVoteResponse> {
+  /**
+   * @generated from field: resonance.gateway.v1.PollTally tally = 1;
+   */
+  tally?: PollTally;
+
+  constructor(data?: PartialMessage<CastVoteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.CastVoteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tally", kind: "message", T: PollTally },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CastVoteResponse {
+    return new CastVoteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CastVoteResponse {
+    return new CastVoteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CastVoteResponse {
+    return new CastVoteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CastVoteResponse | PlainMessage<CastVoteResponse> | undefined, b: CastVoteResponse | PlainMessage<CastVoteResponse> | undefined): boolean {
+    return proto3.util.equals(CastVoteResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ClosePollRequest
+ */
+export class ClosePollRequest extends Message<ClosePollRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: int64 msg_id = 3;
+   */
+  msgId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ClosePollRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ClosePollRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClosePollRequest {
+    return new ClosePollRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClosePollRequest {
+    return new ClosePollRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClosePollRequest {
+    return new ClosePollRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ClosePollRequest | PlainMessage<ClosePollRequest> | undefined, b: ClosePollRequest | PlainMessage<ClosePollRequest> | undefined): boolean {
+    return proto3.util.equals(ClosePollRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ClosePollResponse
+ */
+export class ClosePollResponse extends Message<ClosePollResponse> {
+  /**
+   * @generated from field: resonance.gateway.v1.PollTally tally = 1;
+   */
+  tally?: PollTally;
+
+  constructor(data?: PartialMessage<ClosePollResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ClosePollResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tally", kind: "message", T: PollTally },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClosePollResponse {
+    return new ClosePollResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClosePollResponse {
+    return new ClosePollResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClosePollResponse {
+    return new ClosePollResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ClosePollResponse | PlainMessage<ClosePollResponse> | undefined, b: ClosePollResponse | PlainMessage<ClosePollResponse> | undefined): boolean {
+    return proto3.util.equals(ClosePollResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.GetMessageReadStatusRequest
+ */
+export class GetMessageReadStatusRequest extends Message<GetMessageReadStatusRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: int64 msg_id = 3;
+   */
+  msgId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetMessageReadStatusRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.GetMessageReadStatusRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMessageReadStatusRequest {
+    return new GetMessageReadStatusRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMessageReadStatusRequest {
+    return new GetMessageReadStatusRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMessageReadStatusRequest {
+    return new GetMessageReadStatusRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMessageReadStatusRequest | PlainMessage<GetMessageReadStatusRequest> | undefined, b: GetMessageReadStatusRequest | PlainMessage<GetMessageReadStatusRequest> | undefined): boolean {
+    return proto3.util.equals(GetMessageReadStatusRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.GetMessageReadStatusResponse
+ */
+export class GetMessageReadStatusResponse extends Message<GetMessageReadStatusResponse> {
+  /**
+   * @generated from field: repeated string read_usernames = 1;
+   */
+  readUsernames: string[] = [];
+
+  /**
+   * @generated from field: repeated string unread_usernames = 2;
+   */
+  unreadUsernames: string[] = [];
+
+  constructor(data?: PartialMessage<GetMessageReadStatusResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.GetMessageReadStatusResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "read_usernames", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "unread_usernames", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMessageReadStatusResponse {
+    return new GetMessageReadStatusResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMessageReadStatusResponse {
+    return new GetMessageReadStatusResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMessageReadStatusResponse {
+    return new GetMessageReadStatusResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMessageReadStatusResponse | PlainMessage<GetMessageReadStatusResponse> | undefined, b: GetMessageReadStatusResponse | PlainMessage<GetMessageReadStatusResponse> | undefined): boolean {
+    return proto3.util.equals(GetMessageReadStatusResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ForwardMessagesRequest
+ */
+export class ForwardMessagesRequest extends Message<ForwardMessagesRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string source_session_id = 2;
+   */
+  sourceSessionId = "";
+
+  /**
+   * @generated from field: repeated int64 msg_ids = 3;
+   */
+  msgIds: bigint[] = [];
+
+  /**
+   * @generated from field: repeated string target_session_ids = 4;
+   */
+  targetSessionIds: string[] = [];
+
+  /**
+   * @generated from field: resonance.gateway.v1.ForwardMode mode = 5;
+   */
+  mode = ForwardMode.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ForwardMessagesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ForwardMessagesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "source_session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "msg_ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+    { no: 4, name: "target_session_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "mode", kind: "enum", T: proto3.getEnumType(ForwardMode) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForwardMessagesRequest {
+    return new ForwardMessagesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForwardMessagesRequest {
+    return new ForwardMessagesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForwardMessagesRequest {
+    return new ForwardMessagesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ForwardMessagesRequest | PlainMessage<ForwardMessagesRequest> | undefined, b: ForwardMessagesRequest | PlainMessage<ForwardMessagesRequest> | undefined): boolean {
+    return proto3.util.equals(ForwardMessagesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ForwardMessagesResponse
+ */
+export class ForwardMessagesResponse extends Message<ForwardMessagesResponse> {
+  /**
+   * @generated from field: repeated resonance.gateway.v1.ForwardResult results = 1;
+   */
+  results: ForwardResult[] = [];
+
+  constructor(data?: PartialMessage<ForwardMessagesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ForwardMessagesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "results", kind: "message", T: ForwardResult, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForwardMessagesResponse {
+    return new ForwardMessagesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForwardMessagesResponse {
+    return new ForwardMessagesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForwardMessagesResponse {
+    return new ForwardMessagesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ForwardMessagesResponse | PlainMessage<ForwardMessagesResponse> | undefined, b: ForwardMessagesResponse | PlainMessage<ForwardMessagesResponse> | undefined): boolean {
+    return proto3.util.equals(ForwardMessagesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.PinMessageRequest
+ */
+export class PinMessageRequest extends Message<PinMessageRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: int64 msg_id = 3;
+   */
+  msgId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<PinMessageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.PinMessageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PinMessageRequest {
+    return new PinMessageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PinMessageRequest {
+    return new PinMessageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PinMessageRequest {
+    return new PinMessageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PinMessageRequest | PlainMessage<PinMessageRequest> | undefined, b: PinMessageRequest | PlainMessage<PinMessageRequest> | undefined): boolean {
+    return proto3.util.equals(PinMessageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.PinMessageResponse
+ */
+export class PinMessageResponse extends Message<PinMessageResponse> {
+  constructor(data?: PartialMessage<PinMessageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.PinMessageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PinMessageResponse {
+    return new PinMessageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PinMessageResponse {
+    return new PinMessageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PinMessageResponse {
+    return new PinMessageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PinMessageResponse | PlainMessage<PinMessageResponse> | undefined, b: PinMessageResponse | PlainMessage<PinMessageResponse> | undefined): boolean {
+    return proto3.util.equals(PinMessageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.UnpinMessageRequest
+ */
+export class UnpinMessageRequest extends Message<UnpinMessageRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: int64 msg_id = 3;
+   */
+  msgId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<UnpinMessageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.UnpinMessageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnpinMessageRequest {
+    return new UnpinMessageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnpinMessageRequest {
+    return new UnpinMessageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnpinMessageRequest {
+    return new UnpinMessageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnpinMessageRequest | PlainMessage<UnpinMessageRequest> | undefined, b: UnpinMessageRequest | PlainMessage<UnpinMessageRequest> | undefined): boolean {
+    return proto3.util.equals(UnpinMessageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.UnpinMessageResponse
+ */
+export class UnpinMessageResponse extends Message<UnpinMessageResponse> {
+  constructor(data?: PartialMessage<UnpinMessageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.UnpinMessageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnpinMessageResponse {
+    return new UnpinMessageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnpinMessageResponse {
+    return new UnpinMessageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnpinMessageResponse {
+    return new UnpinMessageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnpinMessageResponse | PlainMessage<UnpinMessageResponse> | undefined, b: UnpinMessageResponse | PlainMessage<UnpinMessageResponse> | undefined): boolean {
+    return proto3.util.equals(UnpinMessageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ListPinnedMessagesRequest
+ */
+export class ListPinnedMessagesRequest extends Message<ListPinnedMessagesRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  constructor(data?: PartialMessage<ListPinnedMessagesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ListPinnedMessagesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPinnedMessagesRequest {
+    return new ListPinnedMessagesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPinnedMessagesRequest {
+    return new ListPinnedMessagesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPinnedMessagesRequest {
+    return new ListPinnedMessagesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPinnedMessagesRequest | PlainMessage<ListPinnedMessagesRequest> | undefined, b: ListPinnedMessagesRequest | PlainMessage<ListPinnedMessagesRequest> | undefined): boolean {
+    return proto3.util.equals(ListPinnedMessagesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ListPinnedMessagesResponse
+ */
+export class ListPinnedMessagesResponse extends Message<ListPinnedMessagesResponse> {
+  /**
+   * @generated from field: repeated resonance.gateway.v1.PinnedMessage pins = 1;
+   */
+  pins: PinnedMessage[] = [];
+
+  constructor(data?: PartialMessage<ListPinnedMessagesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ListPinnedMessagesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pins", kind: "message", T: PinnedMessage, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPinnedMessagesResponse {
+    return new ListPinnedMessagesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPinnedMessagesResponse {
+    return new ListPinnedMessagesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPinnedMessagesResponse {
+    return new ListPinnedMessagesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPinnedMessagesResponse | PlainMessage<ListPinnedMessagesResponse> | undefined, b: ListPinnedMessagesResponse | PlainMessage<ListPinnedMessagesResponse> | undefined): boolean {
+    return proto3.util.equals(ListPinnedMessagesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.SetMessageTTLRequest
+ */
+export class SetMessageTTLRequest extends Message<SetMessageTTLRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * 消息保留时长（秒），0 表示关闭
+   *
+   * @generated from field: int64 ttl_seconds = 3;
+   */
+  ttlSeconds = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SetMessageTTLRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.SetMessageTTLRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "ttl_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetMessageTTLRequest {
+    return new SetMessageTTLRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetMessageTTLRequest {
+    return new SetMessageTTLRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetMessageTTLRequest {
+    return new SetMessageTTLRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetMessageTTLRequest | PlainMessage<SetMessageTTLRequest> | undefined, b: SetMessageTTLRequest | PlainMessage<SetMessageTTLRequest> | undefined): boolean {
+    return proto3.util.equals(SetMessageTTLRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.SetMessageTTLResponse
+ */
+export class SetMessageTTLResponse extends Message<SetMessageTTLResponse> {
+  constructor(data?: PartialMessage<SetMessageTTLResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.SetMessageTTLResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetMessageTTLResponse {
+    return new SetMessageTTLResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetMessageTTLResponse {
+    return new SetMessageTTLResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetMessageTTLResponse {
+    return new SetMessageTTLResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetMessageTTLResponse | PlainMessage<SetMessageTTLResponse> | undefined, b: SetMessageTTLResponse | PlainMessage<SetMessageTTLResponse> | undefined): boolean {
+    return proto3.util.equals(SetMessageTTLResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.SearchMessagesRequest
+ */
+export class SearchMessagesRequest extends Message<SearchMessagesRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * 搜索关键词（websearch 语法：空格为 AND，"短语"，-排除，or）
+   *
+   * @generated from field: string query = 2;
+   */
+  query = "";
+
+  /**
+   * 可选：限定会话
+   *
+   * @generated from field: string session_id = 3;
+   */
+  sessionId = "";
+
+  /**
+   * 可选：限定发送者
+   *
+   * @generated from field: string sender_username = 4;
+   */
+  senderUsername = "";
+
+  /**
+   * 可选：限定消息类型
+   *
+   * @generated from field: string msg_type = 5;
+   */
+  msgType = "";
+
+  /**
+   * 可选：发送时间下界（Unix 秒，含）
+   *
+   * @generated from field: int64 start_time = 6;
+   */
+  startTime = protoInt64.zero;
+
+  /**
+   * 可选：发送时间上界（Unix 秒，不含）
+   *
+   * @generated from field: int64 end_time = 7;
+   */
+  endTime = protoInt64.zero;
+
+  /**
+   * 上一页返回的 next_cursor，首页为 0
+   *
+   * @generated from field: int64 cursor = 8;
+   */
+  cursor = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 limit = 9;
+   */
+  limit = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SearchMessagesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.SearchMessagesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "sender_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "msg_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "start_time", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "end_time", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "cursor", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchMessagesRequest {
+    return new SearchMessagesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchMessagesRequest {
+    return new SearchMessagesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchMessagesRequest {
+    return new SearchMessagesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchMessagesRequest | PlainMessage<SearchMessagesRequest> | undefined, b: SearchMessagesRequest | PlainMessage<SearchMessagesRequest> | undefined): boolean {
+    return proto3.util.equals(SearchMessagesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.SearchMessagesResponse
+ */
+export class SearchMessagesResponse extends Message<SearchMessagesResponse> {
+  /**
+   * @generated from field: repeated resonance.gateway.v1.SearchResult results = 1;
+   */
+  results: SearchResult[] = [];
+
+  /**
+   * @generated from field: int64 next_cursor = 2;
+   */
+  nextCursor = protoInt64.zero;
+
+  /**
+   * @generated from field: bool has_more = 3;
+   */
+  hasMore = false;
+
+  constructor(data?: PartialMessage<SearchMessagesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.SearchMessagesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "results", kind: "message", T: SearchResult, repeated: true },
+    { no: 2, name: "next_cursor", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "has_more", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchMessagesResponse {
+    return new SearchMessagesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchMessagesResponse {
+    return new SearchMessagesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchMessagesResponse {
+    return new SearchMessagesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchMessagesResponse | PlainMessage<SearchMessagesResponse> | undefined, b: SearchMessagesResponse | PlainMessage<SearchMessagesResponse> | undefined): boolean {
+    return proto3.util.equals(SearchMessagesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.DeleteMessagesForMeRequest
+ */
+export class DeleteMessagesForMeRequest extends Message<DeleteMessagesForMeRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * 要删除的消息（需属于该会话）
+   *
+   * @generated from field: repeated int64 msg_ids = 3;
+   */
+  msgIds: bigint[] = [];
+
+  /**
+   * 可选：清空主时间线中 seq_id <= 该值的消息，0 表示不清空
+   *
+   * @generated from field: int64 clear_up_to_seq = 4;
+   */
+  clearUpToSeq = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeleteMessagesForMeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.DeleteMessagesForMeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "msg_ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+    { no: 4, name: "clear_up_to_seq", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteMessagesForMeRequest {
+    return new DeleteMessagesForMeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteMessagesForMeRequest {
+    return new DeleteMessagesForMeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteMessagesForMeRequest {
+    return new DeleteMessagesForMeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteMessagesForMeRequest | PlainMessage<DeleteMessagesForMeRequest> | undefined, b: DeleteMessagesForMeRequest | PlainMessage<DeleteMessagesForMeRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteMessagesForMeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.DeleteMessagesForMeResponse
+ */
+export class DeleteMessagesForMeResponse extends Message<DeleteMessagesForMeResponse> {
+  /**
+   * 当前生效的清空位点
+   *
+   * @generated from field: int64 cleared_seq = 1;
+   */
+  clearedSeq = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeleteMessagesForMeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.DeleteMessagesForMeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cleared_seq", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteMessagesForMeResponse {
+    return new DeleteMessagesForMeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteMessagesForMeResponse {
+    return new DeleteMessagesForMeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteMessagesForMeResponse {
+    return new DeleteMessagesForMeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteMessagesForMeResponse | PlainMessage<DeleteMessagesForMeResponse> | undefined, b: DeleteMessagesForMeResponse | PlainMessage<DeleteMessagesForMeResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteMessagesForMeResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.AddMembersRequest
+ */
+export class AddMembersRequest extends Message<AddMembersRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * 被邀请的用户名
+   *
+   * @generated from field: repeated string members = 3;
+   */
+  members: string[] = [];
+
+  constructor(data?: PartialMessage<AddMembersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.AddMembersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "members", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddMembersRequest {
+    return new AddMembersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddMembersRequest {
+    return new AddMembersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddMembersRequest {
+    return new AddMembersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddMembersRequest | PlainMessage<AddMembersRequest> | undefined, b: AddMembersRequest | PlainMessage<AddMembersRequest> | undefined): boolean {
+    return proto3.util.equals(AddMembersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.AddMembersResponse
+ */
+export class AddMembersResponse extends Message<AddMembersResponse> {
+  /**
+   * 本次实际加入的用户名（不含已是成员的用户）
+   *
+   * @generated from field: repeated string added = 1;
+   */
+  added: string[] = [];
+
+  constructor(data?: PartialMessage<AddMembersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.AddMembersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "added", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddMembersResponse {
+    return new AddMembersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddMembersResponse {
+    return new AddMembersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddMembersResponse {
+    return new AddMembersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddMembersResponse | PlainMessage<AddMembersResponse> | undefined, b: AddMembersResponse | PlainMessage<AddMembersResponse> | undefined): boolean {
+    return proto3.util.equals(AddMembersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.RemoveMemberRequest
+ */
+export class RemoveMemberRequest extends Message<RemoveMemberRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * 被移除的用户名
+   *
+   * @generated from field: string member = 3;
+   */
+  member = "";
+
+  constructor(data?: PartialMessage<RemoveMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.RemoveMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "member", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveMemberRequest {
+    return new RemoveMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveMemberRequest {
+    return new RemoveMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveMemberRequest {
+    return new RemoveMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveMemberRequest | PlainMessage<RemoveMemberRequest> | undefined, b: RemoveMemberRequest | PlainMessage<RemoveMemberRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.RemoveMemberResponse
+ */
+export class RemoveMemberResponse extends Message<RemoveMemberResponse> {
+  constructor(data?: PartialMessage<RemoveMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.RemoveMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveMemberResponse {
+    return new RemoveMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveMemberResponse {
+    return new RemoveMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveMemberResponse {
+    return new RemoveMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveMemberResponse | PlainMessage<RemoveMemberResponse> | undefined, b: RemoveMemberResponse | PlainMessage<RemoveMemberResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.LeaveSessionRequest
+ */
+export class LeaveSessionRequest extends Message<LeaveSessionRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  constructor(data?: PartialMessage<LeaveSessionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.LeaveSessionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveSessionRequest {
+    return new LeaveSessionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveSessionRequest {
+    return new LeaveSessionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveSessionRequest {
+    return new LeaveSessionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LeaveSessionRequest | PlainMessage<LeaveSessionRequest> | undefined, b: LeaveSessionRequest | PlainMessage<LeaveSessionRequest> | undefined): boolean {
+    return proto3.util.equals(LeaveSessionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.LeaveSessionResponse
+ */
+export class LeaveSessionResponse extends Message<LeaveSessionResponse> {
+  constructor(data?: PartialMessage<LeaveSessionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.LeaveSessionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveSessionResponse {
+    return new LeaveSessionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveSessionResponse {
+    return new LeaveSessionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveSessionResponse {
+    return new LeaveSessionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LeaveSessionResponse | PlainMessage<LeaveSessionResponse> | undefined, b: LeaveSessionResponse | PlainMessage<LeaveSessionResponse> | undefined): boolean {
+    return proto3.util.equals(LeaveSessionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.RenameSessionRequest
+ */
+export class RenameSessionRequest extends Message<RenameSessionRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * 新的群名称
+   *
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<RenameSessionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.RenameSessionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameSessionRequest {
+    return new RenameSessionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameSessionRequest {
+    return new RenameSessionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameSessionRequest {
+    return new RenameSessionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RenameSessionRequest | PlainMessage<RenameSessionRequest> | undefined, b: RenameSessionRequest | PlainMessage<RenameSessionRequest> | undefined): boolean {
+    return proto3.util.equals(RenameSessionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.RenameSessionResponse
+ */
+export class RenameSessionResponse extends Message<RenameSessionResponse> {
+  constructor(data?: PartialMessage<RenameSessionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.RenameSessionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameSessionResponse {
+    return new RenameSessionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameSessionResponse {
+    return new RenameSessionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameSessionResponse {
+    return new RenameSessionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RenameSessionResponse | PlainMessage<RenameSessionResponse> | undefined, b: RenameSessionResponse | PlainMessage<RenameSessionResponse> | undefined): boolean {
+    return proto3.util.equals(RenameSessionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.PromoteAdminRequest
+ */
+export class PromoteAdminRequest extends Message<PromoteAdminRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * 被设为管理员的成员
+   *
+   * @generated from field: string member = 3;
+   */
+  member = "";
+
+  constructor(data?: PartialMessage<PromoteAdminRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.PromoteAdminRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "member", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteAdminRequest {
+    return new PromoteAdminRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteAdminRequest {
+    return new PromoteAdminRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteAdminRequest {
+    return new PromoteAdminRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromoteAdminRequest | PlainMessage<PromoteAdminRequest> | undefined, b: PromoteAdminRequest | PlainMessage<PromoteAdminRequest> | undefined): boolean {
+    return proto3.util.equals(PromoteAdminRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.PromoteAdminResponse
+ */
+export class PromoteAdminResponse extends Message<PromoteAdminResponse> {
+  constructor(data?: PartialMessage<PromoteAdminResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.PromoteAdminResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteAdminResponse {
+    return new PromoteAdminResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteAdminResponse {
+    return new PromoteAdminResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteAdminResponse {
+    return new PromoteAdminResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromoteAdminResponse | PlainMessage<PromoteAdminResponse> | undefined, b: PromoteAdminResponse | PlainMessage<PromoteAdminResponse> | undefined): boolean {
+    return proto3.util.equals(PromoteAdminResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.DemoteAdminRequest
+ */
+export class DemoteAdminRequest extends Message<DemoteAdminRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * 被取消管理员的成员
+   *
+   * @generated from field: string member = 3;
+   */
+  member = "";
+
+  constructor(data?: PartialMessage<DemoteAdminRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.DemoteAdminRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "member", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DemoteAdminRequest {
+    return new DemoteAdminRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DemoteAdminRequest {
+    return new DemoteAdminRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DemoteAdminRequest {
+    return new DemoteAdminRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DemoteAdminRequest | PlainMessage<DemoteAdminRequest> | undefined, b: DemoteAdminRequest | PlainMessage<DemoteAdminRequest> | undefined): boolean {
+    return proto3.util.equals(DemoteAdminRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.DemoteAdminResponse
+ */
+export class DemoteAdminResponse extends Message<DemoteAdminResponse> {
+  constructor(data?: PartialMessage<DemoteAdminResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.DemoteAdminResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DemoteAdminResponse {
+    return new DemoteAdminResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DemoteAdminResponse {
+    return new DemoteAdminResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DemoteAdminResponse {
+    return new DemoteAdminResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DemoteAdminResponse | PlainMessage<DemoteAdminResponse> | undefined, b: DemoteAdminResponse | PlainMessage<DemoteAdminResponse> | undefined): boolean {
+    return proto3.util.equals(DemoteAdminResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.TransferOwnershipRequest
+ */
+export class TransferOwnershipRequest extends Message<TransferOwnershipRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * 新群主
+   *
+   * @generated from field: string member = 3;
+   */
+  member = "";
+
+  constructor(data?: PartialMessage<TransferOwnershipRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.TransferOwnershipRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "member", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransferOwnershipRequest {
+    return new TransferOwnershipRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransferOwnershipRequest {
+    return new TransferOwnershipRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransferOwnershipRequest {
+    return new TransferOwnershipRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransferOwnershipRequest | PlainMessage<TransferOwnershipRequest> | undefined, b: TransferOwnershipRequest | PlainMessage<TransferOwnershipRequest> | undefined): boolean {
+    return proto3.util.equals(TransferOwnershipRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.TransferOwnershipResponse
+ */
+export class TransferOwnershipResponse extends Message<TransferOwnershipResponse> {
+  constructor(data?: PartialMessage<TransferOwnershipResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.TransferOwnershipResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransferOwnershipResponse {
+    return new TransferOwnershipResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransferOwnershipResponse {
+    return new TransferOwnershipResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransferOwnershipResponse {
+    return new TransferOwnershipResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransferOwnershipResponse | PlainMessage<TransferOwnershipResponse> | undefined, b: TransferOwnershipResponse | PlainMessage<TransferOwnershipResponse> | undefined): boolean {
+    return proto3.util.equals(TransferOwnershipResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.MuteMemberRequest
+ */
+export class MuteMemberRequest extends Message<MuteMemberRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * 被禁言的成员
+   *
+   * @generated from field: string member = 3;
+   */
+  member = "";
+
+  /**
+   * 禁言时长（秒），0 表示直到解除禁言
+   *
+   * @generated from field: int64 duration = 4;
+   */
+  duration = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MuteMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.MuteMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "member", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "duration", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MuteMemberRequest {
+    return new MuteMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MuteMemberRequest {
+    return new MuteMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MuteMemberRequest {
+    return new MuteMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MuteMemberRequest | PlainMessage<MuteMemberRequest> | undefined, b: MuteMemberRequest | PlainMessage<MuteMemberRequest> | undefined): boolean {
+    return proto3.util.equals(MuteMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.MuteMemberResponse
+ */
+export class MuteMemberResponse extends Message<MuteMemberResponse> {
+  /**
+   * 禁言截止时间（Unix 秒，0 表示直到解除禁言）
+   *
+   * @generated from field: int64 muted_until = 1;
+   */
+  mutedUntil = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MuteMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.MuteMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "muted_until", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MuteMemberResponse {
+    return new MuteMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MuteMemberResponse {
+    return new MuteMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MuteMemberResponse {
+    return new MuteMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MuteMemberResponse | PlainMessage<MuteMemberResponse> | undefined, b: MuteMemberResponse | PlainMessage<MuteMemberResponse> | undefined): boolean {
+    return proto3.util.equals(MuteMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.UnmuteMemberRequest
+ */
+export class UnmuteMemberRequest extends Message<UnmuteMemberRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * 被解除禁言的成员
+   *
+   * @generated from field: string member = 3;
+   */
+  member = "";
+
+  constructor(data?: PartialMessage<UnmuteMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.UnmuteMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "member", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnmuteMemberRequest {
+    return new UnmuteMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnmuteMemberRequest {
+    return new UnmuteMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnmuteMemberRequest {
+    return new UnmuteMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnmuteMemberRequest | PlainMessage<UnmuteMemberRequest> | undefined, b: UnmuteMemberRequest | PlainMessage<UnmuteMemberRequest> | undefined): boolean {
+    return proto3.util.equals(UnmuteMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.UnmuteMemberResponse
+ */
+export class UnmuteMemberResponse extends Message<UnmuteMemberResponse> {
+  constructor(data?: PartialMessage<UnmuteMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.UnmuteMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnmuteMemberResponse {
+    return new UnmuteMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnmuteMemberResponse {
+    return new UnmuteMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnmuteMemberResponse {
+    return new UnmuteMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnmuteMemberResponse | PlainMessage<UnmuteMemberResponse> | undefined, b: UnmuteMemberResponse | PlainMessage<UnmuteMemberResponse> | undefined): boolean {
+    return proto3.util.equals(UnmuteMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.SetDoNotDisturbRequest
+ */
+export class SetDoNotDisturbRequest extends Message<SetDoNotDisturbRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: bool enabled = 3;
+   */
+  enabled = false;
+
+  constructor(data?: PartialMessage<SetDoNotDisturbRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.SetDoNotDisturbRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetDoNotDisturbRequest {
+    return new SetDoNotDisturbRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetDoNotDisturbRequest {
+    return new SetDoNotDisturbRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetDoNotDisturbRequest {
+    return new SetDoNotDisturbRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetDoNotDisturbRequest | PlainMessage<SetDoNotDisturbRequest> | undefined, b: SetDoNotDisturbRequest | PlainMessage<SetDoNotDisturbRequest> | undefined): boolean {
+    return proto3.util.equals(SetDoNotDisturbRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.SetDoNotDisturbResponse
+ */
+export class SetDoNotDisturbResponse extends Message<SetDoNotDisturbResponse> {
+  constructor(data?: PartialMessage<SetDoNotDisturbResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.SetDoNotDisturbResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetDoNotDisturbResponse {
+    return new SetDoNotDisturbResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetDoNotDisturbResponse {
+    return new SetDoNotDisturbResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetDoNotDisturbResponse {
+    return new SetDoNotDisturbResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetDoNotDisturbResponse | PlainMessage<SetDoNotDisturbResponse> | undefined, b: SetDoNotDisturbResponse | PlainMessage<SetDoNotDisturbResponse> | undefined): boolean {
+    return proto3.util.equals(SetDoNotDisturbResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ScheduleMessageRequest
+ */
+export class ScheduleMessageRequest extends Message<ScheduleMessageRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: string content = 3;
+   */
+  content = "";
+
+  /**
+   * @generated from field: string type = 4;
+   */
+  type = "";
+
+  /**
+   * @generated from field: resonance.gateway.v1.MessageBody body = 5;
+   */
+  body?: MessageBody;
+
+  /**
+   * @generated from field: int64 reply_to_msg_id = 6;
+   */
+  replyToMsgId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 thread_root_id = 7;
+   */
+  threadRootId = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated string mentions = 8;
+   */
+  mentions: string[] = [];
+
+  /**
+   * @generated from field: bool mention_all = 9;
+   */
+  mentionAll = false;
+
+  /**
+   * 计划发送时间（Unix 秒）
+   *
+   * @generated from field: int64 scheduled_at = 10;
+   */
+  scheduledAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ScheduleMessageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ScheduleMessageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "body", kind: "message", T: MessageBody },
+    { no: 6, name: "reply_to_msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "thread_root_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "mentions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "mention_all", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "scheduled_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScheduleMessageRequest {
+    return new ScheduleMessageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScheduleMessageRequest {
+    return new ScheduleMessageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScheduleMessageRequest {
+    return new ScheduleMessageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScheduleMessageRequest | PlainMessage<ScheduleMessageRequest> | undefined, b: ScheduleMessageRequest | PlainMessage<ScheduleMessageRequest> | undefined): boolean {
+    return proto3.util.equals(ScheduleMessageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ScheduleMessageResponse
+ */
+export class ScheduleMessageResponse extends Message<ScheduleMessageResponse> {
+  /**
+   * @generated from field: resonance.gateway.v1.ScheduledMessage scheduled = 1;
+   */
+  scheduled?: ScheduledMessage;
+
+  constructor(data?: PartialMessage<ScheduleMessageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ScheduleMessageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "scheduled", kind: "message", T: ScheduledMessage },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScheduleMessageResponse {
+    return new ScheduleMessageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScheduleMessageResponse {
+    return new ScheduleMessageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScheduleMessageResponse {
+    return new ScheduleMessageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScheduleMessageResponse | PlainMessage<ScheduleMessageResponse> | undefined, b: ScheduleMessageResponse | PlainMessage<ScheduleMessageResponse> | undefined): boolean {
+    return proto3.util.equals(ScheduleMessageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ListScheduledMessagesRequest
+ */
+export class ListScheduledMessagesRequest extends Message<ListScheduledMessagesRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * 为空时返回全部会话
+   *
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  constructor(data?: PartialMessage<ListScheduledMessagesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ListScheduledMessagesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListScheduledMessagesRequest {
+    return new ListScheduledMessagesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListScheduledMessagesRequest {
+    return new ListScheduledMessagesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListScheduledMessagesRequest {
+    return new ListScheduledMessagesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListScheduledMessagesRequest | PlainMessage<ListScheduledMessagesRequest> | undefined, b: ListScheduledMessagesRequest | PlainMessage<ListScheduledMessagesRequest> | undefined): boolean {
+    return proto3.util.equals(ListScheduledMessagesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ListScheduledMessagesResponse
+ */
+export class ListScheduledMessagesResponse extends Message<ListScheduledMessagesResponse> {
+  /**
+   * @generated from field: repeated resonance.gateway.v1.ScheduledMessage scheduled = 1;
+   */
+  scheduled: ScheduledMessage[] = [];
+
+  constructor(data?: PartialMessage<ListScheduledMessagesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ListScheduledMessagesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "scheduled", kind: "message", T: ScheduledMessage, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListScheduledMessagesResponse {
+    return new ListScheduledMessagesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListScheduledMessagesResponse {
+    return new ListScheduledMessagesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListScheduledMessagesResponse {
+    return new ListScheduledMessagesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListScheduledMessagesResponse | PlainMessage<ListScheduledMessagesResponse> | undefined, b: ListScheduledMessagesResponse | PlainMessage<ListScheduledMessagesResponse> | undefined): boolean {
+    return proto3.util.equals(ListScheduledMessagesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.UpdateScheduledMessageRequest
+ */
+export class UpdateScheduledMessageRequest extends Message<UpdateScheduledMessageRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: int64 schedule_id = 2;
+   */
+  scheduleId = protoInt64.zero;
+
+  /**
+   * @generated from field: string content = 3;
+   */
+  content = "";
+
+  /**
+   * @generated from field: string type = 4;
+   */
+  type = "";
+
+  /**
+   * @generated from field: resonance.gateway.v1.MessageBody body = 5;
+   */
+  body?: MessageBody;
+
+  /**
+   * @generated from field: repeated string mentions = 6;
+   */
+  mentions: string[] = [];
+
+  /**
+   * @generated from field: bool mention_all = 7;
+   */
+  mentionAll = false;
+
+  /**
+   * @generated from field: int64 scheduled_at = 8;
+   */
+  scheduledAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<UpdateScheduledMessageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.UpdateScheduledMessageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "schedule_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "body", kind: "message", T: MessageBody },
+    { no: 6, name: "mentions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "mention_all", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "scheduled_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateScheduledMessageRequest {
+    return new UpdateScheduledMessageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateScheduledMessageRequest {
+    return new UpdateScheduledMessageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateScheduledMessageRequest {
+    return new UpdateScheduledMessageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateScheduledMessageRequest | PlainMessage<UpdateScheduledMessageRequest> | undefined, b: UpdateScheduledMessageRequest | PlainMessage<UpdateScheduledMessageRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateScheduledMessageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.UpdateScheduledMessageResponse
+ */
+export class UpdateScheduledMessageResponse extends Message<UpdateScheduledMessageResponse> {
+  /**
+   * @generated from field: resonance.gateway.v1.ScheduledMessage scheduled = 1;
+   */
+  scheduled?: ScheduledMessage;
+
+  constructor(data?: PartialMessage<UpdateScheduledMessageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.UpdateScheduledMessageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "scheduled", kind: "message", T: ScheduledMessage },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateScheduledMessageResponse {
+    return new UpdateScheduledMessageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateScheduledMessageResponse {
+    return new UpdateScheduledMessageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateScheduledMessageResponse {
+    return new UpdateScheduledMessageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateScheduledMessageResponse | PlainMessage<UpdateScheduledMessageResponse> | undefined, b: UpdateScheduledMessageResponse | PlainMessage<UpdateScheduledMessageResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateScheduledMessageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.CancelScheduledMessageRequest
+ */
+export class CancelScheduledMessageRequest extends Message<CancelScheduledMessageRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: int64 schedule_id = 2;
+   */
+  scheduleId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<CancelScheduledMessageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.CancelScheduledMessageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "schedule_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelScheduledMessageRequest {
+    return new CancelScheduledMessageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelScheduledMessageRequest {
+    return new CancelScheduledMessageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelScheduledMessageRequest {
+    return new CancelScheduledMessageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelScheduledMessageRequest | PlainMessage<CancelScheduledMessageRequest> | undefined, b: CancelScheduledMessageRequest | PlainMessage<CancelScheduledMessageRequest> | undefined): boolean {
+    return proto3.util.equals(CancelScheduledMessageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.CancelScheduledMessageResponse
+ */
+export class CancelScheduledMessageResponse extends Message<CancelScheduledMessageResponse> {
+  constructor(data?: PartialMessage<CancelScheduledMessageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.CancelScheduledMessageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelScheduledMessageResponse {
+    return new CancelScheduledMessageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelScheduledMessageResponse {
+    return new CancelScheduledMessageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelScheduledMessageResponse {
+    return new CancelScheduledMessageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelScheduledMessageResponse | PlainMessage<CancelScheduledMessageResponse> | undefined, b: CancelScheduledMessageResponse | PlainMessage<CancelScheduledMessageResponse> | undefined): boolean {
+    return proto3.util.equals(CancelScheduledMessageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.AnnounceRequest
+ */
+export class AnnounceRequest extends Message<AnnounceRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title = "";
+
+  /**
+   * @generated from field: string content = 3;
+   */
+  content = "";
+
+  constructor(data?: PartialMessage<AnnounceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.AnnounceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AnnounceRequest {
+    return new AnnounceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AnnounceRequest {
+    return new AnnounceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AnnounceRequest {
+    return new AnnounceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AnnounceRequest | PlainMessage<AnnounceRequest> | undefined, b: AnnounceRequest | PlainMessage<AnnounceRequest> | undefined): boolean {
+    return proto3.util.equals(AnnounceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.AnnounceResponse
+ */
+export class AnnounceResponse extends Message<AnnounceResponse> {
+  /**
+   * @generated from field: resonance.gateway.v1.Announcement announcement = 1;
+   */
+  announcement?: Announcement;
+
+  constructor(data?: PartialMessage<AnnounceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.AnnounceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "announcement", kind: "message", T: Announcement },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AnnounceResponse {
+    return new AnnounceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AnnounceResponse {
+    return new AnnounceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AnnounceResponse {
+    return new AnnounceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AnnounceResponse | PlainMessage<AnnounceResponse> | undefined, b: AnnounceResponse | PlainMessage<AnnounceResponse> | undefined): boolean {
+    return proto3.util.equals(AnnounceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ListAnnouncementsRequest
+ */
+export class ListAnnouncementsRequest extends Message<ListAnnouncementsRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * 本地已看到的最新公告ID（0 表示首次拉取）
+   *
+   * @generated from field: int64 after_id = 2;
+   */
+  afterId = protoInt64.zero;
+
+  /**
+   * @generated from field: int32 limit = 3;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListAnnouncementsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ListAnnouncementsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "after_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAnnouncementsRequest {
+    return new ListAnnouncementsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAnnouncementsRequest {
+    return new ListAnnouncementsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAnnouncementsRequest {
+    return new ListAnnouncementsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAnnouncementsRequest | PlainMessage<ListAnnouncementsRequest> | undefined, b: ListAnnouncementsRequest | PlainMessage<ListAnnouncementsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAnnouncementsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ListAnnouncementsResponse
+ */
+export class ListAnnouncementsResponse extends Message<ListAnnouncementsResponse> {
+  /**
+   * @generated from field: repeated resonance.gateway.v1.Announcement announcements = 1;
+   */
+  announcements: Announcement[] = [];
+
+  constructor(data?: PartialMessage<ListAnnouncementsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ListAnnouncementsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "announcements", kind: "message", T: Announcement, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAnnouncementsResponse {
+    return new ListAnnouncementsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAnnouncementsResponse {
+    return new ListAnnouncementsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAnnouncementsResponse {
+    return new ListAnnouncementsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAnnouncementsResponse | PlainMessage<ListAnnouncementsResponse> | undefined, b: ListAnnouncementsResponse | PlainMessage<ListAnnouncementsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAnnouncementsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.CreateWebhookRequest
+ */
+export class CreateWebhookRequest extends Message<CreateWebhookRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: string url = 3;
+   */
+  url = "";
+
+  /**
+   * 为空时由服务端生成
+   *
+   * @generated from field: string secret = 4;
+   */
+  secret = "";
+
+  /**
+   * 为空表示全部事件
+   *
+   * @generated from field: repeated string events = 5;
+   */
+  events: string[] = [];
+
+  constructor(data?: PartialMessage<CreateWebhookRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.CreateWebhookRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "events", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWebhookRequest {
+    return new CreateWebhookRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWebhookRequest {
+    return new CreateWebhookRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWebhookRequest {
+    return new CreateWebhookRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWebhookRequest | PlainMessage<CreateWebhookRequest> | undefined, b: CreateWebhookRequest | PlainMessage<CreateWebhookRequest> | undefined): boolean {
+    return proto3.util.equals(CreateWebhookRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.CreateWebhookResponse
+ */
+export class CreateWebhookResponse extends Message<CreateWebhookResponse> {
+  /**
+   * @generated from field: resonance.gateway.v1.Webhook webhook = 1;
+   */
+  webhook?: Webhook;
+
+  /**
+   * 签名密钥（仅在创建时返回）
+   *
+   * @generated from field: string secret = 2;
+   */
+  secret = "";
+
+  constructor(data?: PartialMessage<CreateWebhookResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.CreateWebhookResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook", kind: "message", T: Webhook },
+    { no: 2, name: "secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWebhookResponse {
+    return new CreateWebhookResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWebhookResponse {
+    return new CreateWebhookResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWebhookResponse {
+    return new CreateWebhookResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWebhookResponse | PlainMessage<CreateWebhookResponse> | undefined, b: CreateWebhookResponse | PlainMessage<CreateWebhookResponse> | undefined): boolean {
+    return proto3.util.equals(CreateWebhookResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ListWebhooksRequest
+ */
+export class ListWebhooksRequest extends Message<ListWebhooksRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  constructor(data?: PartialMessage<ListWebhooksRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ListWebhooksRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhooksRequest {
+    return new ListWebhooksRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhooksRequest {
+    return new ListWebhooksRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhooksRequest {
+    return new ListWebhooksRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWebhooksRequest | PlainMessage<ListWebhooksRequest> | undefined, b: ListWebhooksRequest | PlainMessage<ListWebhooksRequest> | undefined): boolean {
+    return proto3.util.equals(ListWebhooksRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ListWebhooksResponse
+ */
+export class ListWebhooksResponse extends Message<ListWebhooksResponse> {
+  /**
+   * @generated from field: repeated resonance.gateway.v1.Webhook webhooks = 1;
+   */
+  webhooks: Webhook[] = [];
+
+  constructor(data?: PartialMessage<ListWebhooksResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ListWebhooksResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhooks", kind: "message", T: Webhook, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhooksResponse {
+    return new ListWebhooksResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhooksResponse {
+    return new ListWebhooksResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhooksResponse {
+    return new ListWebhooksResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWebhooksResponse | PlainMessage<ListWebhooksResponse> | undefined, b: ListWebhooksResponse | PlainMessage<ListWebhooksResponse> | undefined): boolean {
+    return proto3.util.equals(ListWebhooksResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.DeleteWebhookRequest
+ */
+export class DeleteWebhookRequest extends Message<DeleteWebhookRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: int64 webhook_id = 2;
+   */
+  webhookId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeleteWebhookRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.DeleteWebhookRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "webhook_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWebhookRequest {
+    return new DeleteWebhookRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWebhookRequest {
+    return new DeleteWebhookRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWebhookRequest {
+    return new DeleteWebhookRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWebhookRequest | PlainMessage<DeleteWebhookRequest> | undefined, b: DeleteWebhookRequest | PlainMessage<DeleteWebhookRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteWebhookRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.DeleteWebhookResponse
+ */
+export class DeleteWebhookResponse extends Message<DeleteWebhookResponse> {
+  constructor(data?: PartialMessage<DeleteWebhookResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.DeleteWebhookResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWebhookResponse {
+    return new DeleteWebhookResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWebhookResponse {
+    return new DeleteWebhookResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWebhookResponse {
+    return new DeleteWebhookResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWebhookResponse | PlainMessage<DeleteWebhookResponse> | undefined, b: DeleteWebhookResponse | PlainMessage<DeleteWebhookResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteWebhookResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ListWebhookDeliveriesRequest
+ */
+export class ListWebhookDeliveriesRequest extends Message<ListWebhookDeliveriesRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: int64 webhook_id = 2;
+   */
+  webhookId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 before_id = 3;
+   */
+  beforeId = protoInt64.zero;
+
+  /**
+   * @generated from field: int32 limit = 4;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListWebhookDeliveriesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ListWebhookDeliveriesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "webhook_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "before_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhookDeliveriesRequest {
+    return new ListWebhookDeliveriesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhookDeliveriesRequest {
+    return new ListWebhookDeliveriesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhookDeliveriesRequest {
+    return new ListWebhookDeliveriesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWebhookDeliveriesRequest | PlainMessage<ListWebhookDeliveriesRequest> | undefined, b: ListWebhookDeliveriesRequest | PlainMessage<ListWebhookDeliveriesRequest> | undefined): boolean {
+    return proto3.util.equals(ListWebhookDeliveriesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ListWebhookDeliveriesResponse
+ */
+export class ListWebhookDeliveriesResponse extends Message<ListWebhookDeliveriesResponse> {
+  /**
+   * @generated from field: repeated resonance.gateway.v1.WebhookDelivery deliveries = 1;
+   */
+  deliveries: WebhookDelivery[] = [];
+
+  constructor(data?: PartialMessage<ListWebhookDeliveriesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ListWebhookDeliveriesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deliveries", kind: "message", T: WebhookDelivery, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhookDeliveriesResponse {
+    return new ListWebhookDeliveriesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhookDeliveriesResponse {
+    return new ListWebhookDeliveriesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhookDeliveriesResponse {
+    return new ListWebhookDeliveriesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWebhookDeliveriesResponse | PlainMessage<ListWebhookDeliveriesResponse> | undefined, b: ListWebhookDeliveriesResponse | PlainMessage<ListWebhookDeliveriesResponse> | undefined): boolean {
+    return proto3.util.equals(ListWebhookDeliveriesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.CreateBotRequest
+ */
+export class CreateBotRequest extends Message<CreateBotRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * 机器人用户名（全局唯一）
+   *
+   * @generated from field: string username = 2;
+   */
+  username = "";
+
+  /**
+   * @generated from field: string nickname = 3;
+   */
+  nickname = "";
+
+  constructor(data?: PartialMessage<CreateBotRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.CreateBotRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "nickname", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBotRequest {
+    return new CreateBotRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBotRequest {
+    return new CreateBotRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBotRequest {
+    return new CreateBotRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateBotRequest | PlainMessage<CreateBotRequest> | undefined, b: CreateBotRequest | PlainMessage<CreateBotRequest> | undefined): boolean {
+    return proto3.util.equals(CreateBotRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.CreateBotResponse
+ */
+export class CreateBotResponse extends Message<CreateBotResponse> {
+  /**
+   * @generated from field: resonance.gateway.v1.Bot bot = 1;
+   */
+  bot?: Bot;
+
+  /**
+   * API Token（仅在创建时返回）
+   *
+   * @generated from field: string token = 2;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<CreateBotResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.CreateBotResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bot", kind: "message", T: Bot },
+    { no: 2, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBotResponse {
+    return new CreateBotResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBotResponse {
+    return new CreateBotResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBotResponse {
+    return new CreateBotResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateBotResponse | PlainMessage<CreateBotResponse> | undefined, b: CreateBotResponse | PlainMessage<CreateBotResponse> | undefined): boolean {
+    return proto3.util.equals(CreateBotResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ListBotsRequest
+ */
+export class ListBotsRequest extends Message<ListBotsRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  constructor(data?: PartialMessage<ListBotsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ListBotsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBotsRequest {
+    return new ListBotsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBotsRequest {
+    return new ListBotsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBotsRequest {
+    return new ListBotsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListBotsRequest | PlainMessage<ListBotsRequest> | undefined, b: ListBotsRequest | PlainMessage<ListBotsRequest> | undefined): boolean {
+    return proto3.util.equals(ListBotsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.ListBotsResponse
+ */
+export class ListBotsResponse extends Message<ListBotsResponse> {
+  /**
+   * @generated from field: repeated resonance.gateway.v1.Bot bots = 1;
+   */
+  bots: Bot[] = [];
+
+  constructor(data?: PartialMessage<ListBotsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ListBotsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bots", kind: "message", T: Bot, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBotsResponse {
+    return new ListBotsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBotsResponse {
+    return new ListBotsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBotsResponse {
+    return new ListBotsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListBotsResponse | PlainMessage<ListBotsResponse> | undefined, b: ListBotsResponse | PlainMessage<ListBotsResponse> | undefined): boolean {
+    return proto3.util.equals(ListBotsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.RotateBotTokenRequest
+ */
+export class RotateBotTokenRequest extends Message<RotateBotTokenRequest> {
+  /**
+   * 兼容字段：当前服务端不使用该字段，鉴权由网关中间件处理
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken = "";
+
+  /**
+   * 机器人用户名
+   *
+   * @generated from field: string username = 2;
+   */
+  username = "";
+
+  constructor(data?: PartialMessage<RotateBotTokenRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.RotateBotTokenRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateBotTokenRequest {
+    return new RotateBotTokenRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateBotTokenRequest {
+    return new RotateBotTokenRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateBotTokenRequest {
+    return new RotateBotTokenRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RotateBotTokenRequest | PlainMessage<RotateBotTokenRequest> | undefined, b: RotateBotTokenRequest | PlainMessage<RotateBotTokenRequest> | undefined): boolean {
+    return proto3.util.equals(RotateBotTokenRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message resonance.gateway.v1.RotateBotTokenResponse
+ */
+export class RotateBotTokenResponse extends Message<RotateBotTokenResponse> {
+  /**
+   * 新的 API Token（仅在轮换时返回）
+   *
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<RotateBotTokenResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.RotateBotTokenResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateBotTokenResponse {
+    return new RotateBotTokenResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateBotTokenResponse {
+    return new RotateBotTokenResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateBotTokenResponse {
+    return new RotateBotTokenResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RotateBotTokenResponse | PlainMessage<RotateBotTokenResponse> | undefined, b: RotateBotTokenResponse | PlainMessage<RotateBotTokenResponse> | undefined): boolean {
+    return proto3.util.equals(RotateBotTokenResponse, a, b);
+  }
+}
+
@@ -6,6 +6,306 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 
+/**
+ * TypingState 是正在输入的状态
+ *
+ * @generated from enum resonance.gateway.v1.TypingState
+ */
+export enum TypingState {
+  /**
+   * @generated from enum value: TYPING_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 开始输入（输入期间客户端应周期性重发以续期）
+   *
+   * @generated from enum value: TYPING_STATE_STARTED = 1;
+   */
+  STARTED = 1,
+
+  /**
+   * 停止输入
+   *
+   * @generated from enum value: TYPING_STATE_STOPPED = 2;
+   */
+  STOPPED = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TypingState)
+proto3.util.setEnumType(TypingState, "resonance.gateway.v1.TypingState", [
+  { no: 0, name: "TYPING_STATE_UNSPECIFIED" },
+  { no: 1, name: "TYPING_STATE_STARTED" },
+  { no: 2, name: "TYPING_STATE_STOPPED" },
+]);
+
+/**
+ * TextEntityType 是文本实体类型
+ *
+ * @generated from enum resonance.gateway.v1.TextEntityType
+ */
+export enum TextEntityType {
+  /**
+   * @generated from enum value: TEXT_ENTITY_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @提及，username 为被提及用户
+   *
+   * @generated from enum value: TEXT_ENTITY_TYPE_MENTION = 1;
+   */
+  MENTION = 1,
+
+  /**
+   * 链接，url 为链接地址
+   *
+   * @generated from enum value: TEXT_ENTITY_TYPE_LINK = 2;
+   */
+  LINK = 2,
+
+  /**
+   * 粗体
+   *
+   * @generated from enum value: TEXT_ENTITY_TYPE_BOLD = 3;
+   */
+  BOLD = 3,
+
+  /**
+   * 斜体
+   *
+   * @generated from enum value: TEXT_ENTITY_TYPE_ITALIC = 4;
+   */
+  ITALIC = 4,
+
+  /**
+   * 行内代码
+   *
+   * @generated from enum value: TEXT_ENTITY_TYPE_CODE = 5;
+   */
+  CODE = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TextEntityType)
+proto3.util.setEnumType(TextEntityType, "resonance.gateway.v1.TextEntityType", [
+  { no: 0, name: "TEXT_ENTITY_TYPE_UNSPECIFIED" },
+  { no: 1, name: "TEXT_ENTITY_TYPE_MENTION" },
+  { no: 2, name: "TEXT_ENTITY_TYPE_LINK" },
+  { no: 3, name: "TEXT_ENTITY_TYPE_BOLD" },
+  { no: 4, name: "TEXT_ENTITY_TYPE_ITALIC" },
+  { no: 5, name: "TEXT_ENTITY_TYPE_CODE" },
+]);
+
+/**
+ * ForwardMode 是转发方式
+ *
+ * @generated from enum resonance.gateway.v1.ForwardMode
+ */
+export enum ForwardMode {
+  /**
+   * 未指定：按逐条转发处理
+   *
+   * @generated from enum value: FORWARD_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 逐条转发：每条原消息生成一条新消息
+   *
+   * @generated from enum value: FORWARD_MODE_SEPARATE = 1;
+   */
+  SEPARATE = 1,
+
+  /**
+   * 合并转发：生成一条内嵌原消息快照的聊天记录消息
+   *
+   * @generated from enum value: FORWARD_MODE_MERGED = 2;
+   */
+  MERGED = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ForwardMode)
+proto3.util.setEnumType(ForwardMode, "resonance.gateway.v1.ForwardMode", [
+  { no: 0, name: "FORWARD_MODE_UNSPECIFIED" },
+  { no: 1, name: "FORWARD_MODE_SEPARATE" },
+  { no: 2, name: "FORWARD_MODE_MERGED" },
+]);
+
+/**
+ * WebhookDeliveryStatus 是 Webhook 投递状态
+ *
+ * @generated from enum resonance.gateway.v1.WebhookDeliveryStatus
+ */
+export enum WebhookDeliveryStatus {
+  /**
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 待投递（含等待重试）
+   *
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * 投递成功（对端返回 2xx）
+   *
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_SUCCEEDED = 2;
+   */
+  SUCCEEDED = 2,
+
+  /**
+   * 重试耗尽，投递失败
+   *
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_FAILED = 3;
+   */
+  FAILED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(WebhookDeliveryStatus)
+proto3.util.setEnumType(WebhookDeliveryStatus, "resonance.gateway.v1.WebhookDeliveryStatus", [
+  { no: 0, name: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED" },
+  { no: 1, name: "WEBHOOK_DELIVERY_STATUS_PENDING" },
+  { no: 2, name: "WEBHOOK_DELIVERY_STATUS_SUCCEEDED" },
+  { no: 3, name: "WEBHOOK_DELIVERY_STATUS_FAILED" },
+]);
+
+/**
+ * ScheduledMessageStatus 是定时消息状态
+ *
+ * @generated from enum resonance.gateway.v1.ScheduledMessageStatus
+ */
+export enum ScheduledMessageStatus {
+  /**
+   * @generated from enum value: SCHEDULED_MESSAGE_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 待发送
+   *
+   * @generated from enum value: SCHEDULED_MESSAGE_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * 发送中
+   *
+   * @generated from enum value: SCHEDULED_MESSAGE_STATUS_SENDING = 2;
+   */
+  SENDING = 2,
+
+  /**
+   * 已发送，msg_id 为生成的消息
+   *
+   * @generated from enum value: SCHEDULED_MESSAGE_STATUS_SENT = 3;
+   */
+  SENT = 3,
+
+  /**
+   * 已取消
+   *
+   * @generated from enum value: SCHEDULED_MESSAGE_STATUS_CANCELED = 4;
+   */
+  CANCELED = 4,
+
+  /**
+   * 发送失败，error 为失败原因
+   *
+   * @generated from enum value: SCHEDULED_MESSAGE_STATUS_FAILED = 5;
+   */
+  FAILED = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ScheduledMessageStatus)
+proto3.util.setEnumType(ScheduledMessageStatus, "resonance.gateway.v1.ScheduledMessageStatus", [
+  { no: 0, name: "SCHEDULED_MESSAGE_STATUS_UNSPECIFIED" },
+  { no: 1, name: "SCHEDULED_MESSAGE_STATUS_PENDING" },
+  { no: 2, name: "SCHEDULED_MESSAGE_STATUS_SENDING" },
+  { no: 3, name: "SCHEDULED_MESSAGE_STATUS_SENT" },
+  { no: 4, name: "SCHEDULED_MESSAGE_STATUS_CANCELED" },
+  { no: 5, name: "SCHEDULED_MESSAGE_STATUS_FAILED" },
+]);
+
+/**
+ * MessageAction 标识推送消息对应的动作
+ *
+ * @generated from enum resonance.gateway.v1.MessageAction
+ */
+export enum MessageAction {
+  /**
+   * 未指定：普通消息
+   *
+   * @generated from enum value: MESSAGE_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 撤回：客户端应将 msg_id 对应的气泡替换为墓碑
+   *
+   * @generated from enum value: MESSAGE_ACTION_RECALL = 1;
+   */
+  RECALL = 1,
+
+  /**
+   * 编辑：客户端按 version 判断是否用新内容替换本地消息
+   *
+   * @generated from enum value: MESSAGE_ACTION_EDIT = 2;
+   */
+  EDIT = 2,
+
+  /**
+   * 表情回应变更：客户端按 msg_id 用 reactions 覆盖本地聚合
+   *
+   * @generated from enum value: MESSAGE_ACTION_REACTION = 3;
+   */
+  REACTION = 3,
+
+  /**
+   * 已读回执：推送给消息发送者，from_username 为本次读者
+   *
+   * @generated from enum value: MESSAGE_ACTION_READ_RECEIPT = 4;
+   */
+  READ_RECEIPT = 4,
+
+  /**
+   * 过期：消息已被服务端删除，客户端应从本地缓存中删除 msg_id 对应的消息
+   *
+   * @generated from enum value: MESSAGE_ACTION_EXPIRE = 5;
+   */
+  EXPIRE = 5,
+
+  /**
+   * 仅自己删除：同步到本人其他设备，客户端删除 hidden_msg_ids 及 seq_id <= cleared_seq 的主时间线消息
+   *
+   * @generated from enum value: MESSAGE_ACTION_HIDE = 6;
+   */
+  HIDE = 6,
+
+  /**
+   * 投票计票变更：客户端按 msg_id 用 poll_tally 覆盖本地计票（保留本地的 my_choices）
+   *
+   * @generated from enum value: MESSAGE_ACTION_POLL = 7;
+   */
+  POLL = 7,
+
+  /**
+   * 退出或被移出会话：推送给离开者本人（含其他设备），客户端应从会话列表移除 session_id，content 为提示文本
+   *
+   * @generated from enum value: MESSAGE_ACTION_MEMBER_REMOVED = 8;
+   */
+  MEMBER_REMOVED = 8,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MessageAction)
+proto3.util.setEnumType(MessageAction, "resonance.gateway.v1.MessageAction", [
+  { no: 0, name: "MESSAGE_ACTION_UNSPECIFIED" },
+  { no: 1, name: "MESSAGE_ACTION_RECALL" },
+  { no: 2, name: "MESSAGE_ACTION_EDIT" },
+  { no: 3, name: "MESSAGE_ACTION_REACTION" },
+  { no: 4, name: "MESSAGE_ACTION_READ_RECEIPT" },
+  { no: 5, name: "MESSAGE_ACTION_EXPIRE" },
+  { no: 6, name: "MESSAGE_ACTION_HIDE" },
+  { no: 7, name: "MESSAGE_ACTION_POLL" },
+  { no: 8, name: "MESSAGE_ACTION_MEMBER_REMOVED" },
+]);
+
 /**
  * WsPacket 是所有 WebSocket 消息的封装
  *
@@ -56,6 +356,22 @@ export class WsPacket extends Message<WsPacket> {
      */
     value: Ack;
     case: "ack";
+  } | {
+    /**
+     * 正在输入（双向：客户端上报、服务端转发）
+     *
+     * @generated from field: resonance.gateway.v1.Typing typing = 14;
+     */
+    value: Typing;
+    case: "typing";
+  } | {
+    /**
+     * 系统公告（仅服务端下发，广播给全部在线用户）
+     *
+     * @generated from field: resonance.gateway.v1.Announcement announcement = 15;
+     */
+    value: Announcement;
+    case: "announcement";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<WsPacket>) {
@@ -71,6 +387,8 @@ export class WsPacket extends Message<WsPacket> {
     { no: 11, name: "chat", kind: "message", T: ChatRequest, oneof: "payload" },
     { no: 12, name: "push", kind: "message", T: PushMessage, oneof: "payload" },
     { no: 13, name: "ack", kind: "message", T: Ack, oneof: "payload" },
+    { no: 14, name: "typing", kind: "message", T: Typing, oneof: "payload" },
+    { no: 15, name: "announcement", kind: "message", T: Announcement, oneof: "payload" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WsPacket {
@@ -81,179 +399,2087 @@ export class WsPacket extends Message<WsPacket> {
     return new WsPacket().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WsPacket {
-    return new WsPacket().fromJsonString(jsonString, options);
-  }
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WsPacket {
+    return new WsPacket().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WsPacket | PlainMessage<WsPacket> | undefined, b: WsPacket | PlainMessage<WsPacket> | undefined): boolean {
+    return proto3.util.equals(WsPacket, a, b);
+  }
+}
+
+/**
+ * Pulse 是心跳信号
+ *
+ * @generated from message resonance.gateway.v1.Pulse
+ */
+export class Pulse extends Message<Pulse> {
+  constructor(data?: PartialMessage<Pulse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.Pulse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Pulse {
+    return new Pulse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Pulse {
+    return new Pulse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Pulse {
+    return new Pulse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Pulse | PlainMessage<Pulse> | undefined, b: Pulse | PlainMessage<Pulse> | undefined): boolean {
+    return proto3.util.equals(Pulse, a, b);
+  }
+}
+
+/**
+ * ChatRequest 是用户发送的消息
+ * 字段编号与 PushMessage 保持一致，方便转换
+ *
+ * @generated from message resonance.gateway.v1.ChatRequest
+ */
+export class ChatRequest extends Message<ChatRequest> {
+  /**
+   * 1, 2 预留给 id, seq (客户端发送时通常没有)
+   *
+   * 会话ID
+   *
+   * @generated from field: string session_id = 3;
+   */
+  sessionId = "";
+
+  /**
+   * 发送者 (客户端可不填，由网关填充)
+   *
+   * @generated from field: string from_username = 4;
+   */
+  fromUsername = "";
+
+  /**
+   * 目标用户 (私聊时可能需要)
+   *
+   * @generated from field: string to_username = 5;
+   */
+  toUsername = "";
+
+  /**
+   * 内容
+   *
+   * @generated from field: string content = 6;
+   */
+  content = "";
+
+  /**
+   * 类型
+   *
+   * @generated from field: string type = 7;
+   */
+  type = "";
+
+  /**
+   * timestamp 可选字段，可由网关填充
+   *
+   * @generated from field: int64 timestamp = 8;
+   */
+  timestamp = protoInt64.zero;
+
+  /**
+   * 引用回复的消息ID（0 表示非回复）
+   *
+   * @generated from field: int64 reply_to_msg_id = 16;
+   */
+  replyToMsgId = protoInt64.zero;
+
+  /**
+   * 所属话题的根消息ID（0 表示主时间线消息）
+   *
+   * @generated from field: int64 thread_root_id = 18;
+   */
+  threadRootId = protoInt64.zero;
+
+  /**
+   * @提及的用户名列表
+   *
+   * @generated from field: repeated string mentions = 19;
+   */
+  mentions: string[] = [];
+
+  /**
+   * 是否 @所有人（仅管理员可用）
+   *
+   * @generated from field: bool mention_all = 20;
+   */
+  mentionAll = false;
+
+  /**
+   * 结构化消息体（为空时按 content/type 作为纯文本处理，兼容旧客户端）
+   *
+   * @generated from field: resonance.gateway.v1.MessageBody body = 26;
+   */
+  body?: MessageBody;
+
+  constructor(data?: PartialMessage<ChatRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ChatRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 3, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "from_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "to_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "timestamp", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 16, name: "reply_to_msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 18, name: "thread_root_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 19, name: "mentions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 20, name: "mention_all", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 26, name: "body", kind: "message", T: MessageBody },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChatRequest {
+    return new ChatRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChatRequest {
+    return new ChatRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChatRequest {
+    return new ChatRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChatRequest | PlainMessage<ChatRequest> | undefined, b: ChatRequest | PlainMessage<ChatRequest> | undefined): boolean {
+    return proto3.util.equals(ChatRequest, a, b);
+  }
+}
+
+/**
+ * Typing 是正在输入信号
+ * 纯瞬时信号：不落库、不经过 Outbox，离线用户不会收到
+ *
+ * @generated from message resonance.gateway.v1.Typing
+ */
+export class Typing extends Message<Typing> {
+  /**
+   * 会话ID
+   *
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * 输入状态
+   *
+   * @generated from field: resonance.gateway.v1.TypingState state = 2;
+   */
+  state = TypingState.UNSPECIFIED;
+
+  /**
+   * 输入者（客户端可不填，由网关填充）
+   *
+   * @generated from field: string from_username = 3;
+   */
+  fromUsername = "";
+
+  /**
+   * 有效期（秒，服务端下发时填充）：超时未续期时接收方应自动清除
+   *
+   * @generated from field: int32 expires_in = 4;
+   */
+  expiresIn = 0;
+
+  constructor(data?: PartialMessage<Typing>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.Typing";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "state", kind: "enum", T: proto3.getEnumType(TypingState) },
+    { no: 3, name: "from_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "expires_in", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Typing {
+    return new Typing().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Typing {
+    return new Typing().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Typing {
+    return new Typing().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Typing | PlainMessage<Typing> | undefined, b: Typing | PlainMessage<Typing> | undefined): boolean {
+    return proto3.util.equals(Typing, a, b);
+  }
+}
+
+/**
+ * MessageBody 是结构化消息体
+ * 服务端按 body 类型校验并派生 type 与 content（纯文本摘要），旧客户端仍可按 content 展示
+ *
+ * @generated from message resonance.gateway.v1.MessageBody
+ */
+export class MessageBody extends Message<MessageBody> {
+  /**
+   * @generated from oneof resonance.gateway.v1.MessageBody.body
+   */
+  body: {
+    /**
+     * 文本
+     *
+     * @generated from field: resonance.gateway.v1.TextBody text = 1;
+     */
+    value: TextBody;
+    case: "text";
+  } | {
+    /**
+     * 图片
+     *
+     * @generated from field: resonance.gateway.v1.ImageBody image = 2;
+     */
+    value: ImageBody;
+    case: "image";
+  } | {
+    /**
+     * 文件
+     *
+     * @generated from field: resonance.gateway.v1.FileBody file = 3;
+     */
+    value: FileBody;
+    case: "file";
+  } | {
+    /**
+     * 语音
+     *
+     * @generated from field: resonance.gateway.v1.AudioBody audio = 4;
+     */
+    value: AudioBody;
+    case: "audio";
+  } | {
+    /**
+     * 位置
+     *
+     * @generated from field: resonance.gateway.v1.LocationBody location = 5;
+     */
+    value: LocationBody;
+    case: "location";
+  } | {
+    /**
+     * 名片
+     *
+     * @generated from field: resonance.gateway.v1.ContactCardBody contact = 6;
+     */
+    value: ContactCardBody;
+    case: "contact";
+  } | {
+    /**
+     * 系统消息（仅服务端生成）
+     *
+     * @generated from field: resonance.gateway.v1.SystemBody system = 7;
+     */
+    value: SystemBody;
+    case: "system";
+  } | {
+    /**
+     * 合并转发的聊天记录（仅经 ForwardMessages 生成）
+     *
+     * @generated from field: resonance.gateway.v1.ForwardBody forward = 8;
+     */
+    value: ForwardBody;
+    case: "forward";
+  } | {
+    /**
+     * 投票（仅群聊）
+     *
+     * @generated from field: resonance.gateway.v1.PollBody poll = 9;
+     */
+    value: PollBody;
+    case: "poll";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<MessageBody>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.MessageBody";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "text", kind: "message", T: TextBody, oneof: "body" },
+    { no: 2, name: "image", kind: "message", T: ImageBody, oneof: "body" },
+    { no: 3, name: "file", kind: "message", T: FileBody, oneof: "body" },
+    { no: 4, name: "audio", kind: "message", T: AudioBody, oneof: "body" },
+    { no: 5, name: "location", kind: "message", T: LocationBody, oneof: "body" },
+    { no: 6, name: "contact", kind: "message", T: ContactCardBody, oneof: "body" },
+    { no: 7, name: "system", kind: "message", T: SystemBody, oneof: "body" },
+    { no: 8, name: "forward", kind: "message", T: ForwardBody, oneof: "body" },
+    { no: 9, name: "poll", kind: "message", T: PollBody, oneof: "body" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MessageBody {
+    return new MessageBody().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MessageBody {
+    return new MessageBody().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MessageBody {
+    return new MessageBody().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MessageBody | PlainMessage<MessageBody> | undefined, b: MessageBody | PlainMessage<MessageBody> | undefined): boolean {
+    return proto3.util.equals(MessageBody, a, b);
+  }
+}
+
+/**
+ * TextEntity 是文本中的一段富文本标记
+ * offset 与 length 以 Unicode 码点计数
+ *
+ * @generated from message resonance.gateway.v1.TextEntity
+ */
+export class TextEntity extends Message<TextEntity> {
+  /**
+   * @generated from field: resonance.gateway.v1.TextEntityType type = 1;
+   */
+  type = TextEntityType.UNSPECIFIED;
+
+  /**
+   * 起始位置
+   *
+   * @generated from field: int32 offset = 2;
+   */
+  offset = 0;
+
+  /**
+   * 长度
+   *
+   * @generated from field: int32 length = 3;
+   */
+  length = 0;
+
+  /**
+   * 链接地址（仅 LINK）
+   *
+   * @generated from field: string url = 4;
+   */
+  url = "";
+
+  /**
+   * 被提及用户（仅 MENTION）
+   *
+   * @generated from field: string username = 5;
+   */
+  username = "";
+
+  constructor(data?: PartialMessage<TextEntity>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.TextEntity";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "enum", T: proto3.getEnumType(TextEntityType) },
+    { no: 2, name: "offset", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "length", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TextEntity {
+    return new TextEntity().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TextEntity {
+    return new TextEntity().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TextEntity {
+    return new TextEntity().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TextEntity | PlainMessage<TextEntity> | undefined, b: TextEntity | PlainMessage<TextEntity> | undefined): boolean {
+    return proto3.util.equals(TextEntity, a, b);
+  }
+}
+
+/**
+ * TextBody 是文本消息
+ *
+ * @generated from message resonance.gateway.v1.TextBody
+ */
+export class TextBody extends Message<TextBody> {
+  /**
+   * 文本内容
+   *
+   * @generated from field: string text = 1;
+   */
+  text = "";
+
+  /**
+   * 富文本标记
+   *
+   * @generated from field: repeated resonance.gateway.v1.TextEntity entities = 2;
+   */
+  entities: TextEntity[] = [];
+
+  constructor(data?: PartialMessage<TextBody>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.TextBody";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "entities", kind: "message", T: TextEntity, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TextBody {
+    return new TextBody().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TextBody {
+    return new TextBody().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TextBody {
+    return new TextBody().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TextBody | PlainMessage<TextBody> | undefined, b: TextBody | PlainMessage<TextBody> | undefined): boolean {
+    return proto3.util.equals(TextBody, a, b);
+  }
+}
+
+/**
+ * ImageBody 是图片消息
+ * 引用已上传附件时填 attachment_id（url 可为空，大小与 MIME 类型以附件记录为准）
+ *
+ * @generated from message resonance.gateway.v1.ImageBody
+ */
+export class ImageBody extends Message<ImageBody> {
+  /**
+   * 原图地址
+   *
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  /**
+   * 缩略图地址（可选）
+   *
+   * @generated from field: string thumbnail_url = 2;
+   */
+  thumbnailUrl = "";
+
+  /**
+   * 宽度（像素）
+   *
+   * @generated from field: int32 width = 3;
+   */
+  width = 0;
+
+  /**
+   * 高度（像素）
+   *
+   * @generated from field: int32 height = 4;
+   */
+  height = 0;
+
+  /**
+   * 文件大小（字节）
+   *
+   * @generated from field: int64 size = 5;
+   */
+  size = protoInt64.zero;
+
+  /**
+   * MIME 类型
+   *
+   * @generated from field: string mime_type = 6;
+   */
+  mimeType = "";
+
+  /**
+   * 附件ID（0 表示外部地址）
+   *
+   * @generated from field: int64 attachment_id = 7;
+   */
+  attachmentId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ImageBody>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ImageBody";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "thumbnail_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "width", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "height", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "attachment_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImageBody {
+    return new ImageBody().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImageBody {
+    return new ImageBody().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImageBody {
+    return new ImageBody().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImageBody | PlainMessage<ImageBody> | undefined, b: ImageBody | PlainMessage<ImageBody> | undefined): boolean {
+    return proto3.util.equals(ImageBody, a, b);
+  }
+}
+
+/**
+ * FileBody 是文件消息
+ * 引用已上传附件时填 attachment_id（url 可为空，文件名缺省时取附件文件名）
+ *
+ * @generated from message resonance.gateway.v1.FileBody
+ */
+export class FileBody extends Message<FileBody> {
+  /**
+   * 下载地址
+   *
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  /**
+   * 文件名
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * 文件大小（字节）
+   *
+   * @generated from field: int64 size = 3;
+   */
+  size = protoInt64.zero;
+
+  /**
+   * MIME 类型
+   *
+   * @generated from field: string mime_type = 4;
+   */
+  mimeType = "";
+
+  /**
+   * 附件ID（0 表示外部地址）
+   *
+   * @generated from field: int64 attachment_id = 5;
+   */
+  attachmentId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<FileBody>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.FileBody";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "attachment_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FileBody {
+    return new FileBody().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FileBody {
+    return new FileBody().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FileBody {
+    return new FileBody().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FileBody | PlainMessage<FileBody> | undefined, b: FileBody | PlainMessage<FileBody> | undefined): boolean {
+    return proto3.util.equals(FileBody, a, b);
+  }
+}
+
+/**
+ * AudioBody 是语音消息
+ * 引用已上传附件时填 attachment_id（url 可为空）
+ *
+ * @generated from message resonance.gateway.v1.AudioBody
+ */
+export class AudioBody extends Message<AudioBody> {
+  /**
+   * 音频地址
+   *
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  /**
+   * 时长（毫秒）
+   *
+   * @generated from field: int32 duration_ms = 2;
+   */
+  durationMs = 0;
+
+  /**
+   * 文件大小（字节）
+   *
+   * @generated from field: int64 size = 3;
+   */
+  size = protoInt64.zero;
+
+  /**
+   * MIME 类型
+   *
+   * @generated from field: string mime_type = 4;
+   */
+  mimeType = "";
+
+  /**
+   * 附件ID（0 表示外部地址）
+   *
+   * @generated from field: int64 attachment_id = 5;
+   */
+  attachmentId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<AudioBody>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.AudioBody";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "duration_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "attachment_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AudioBody {
+    return new AudioBody().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AudioBody {
+    return new AudioBody().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AudioBody {
+    return new AudioBody().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AudioBody | PlainMessage<AudioBody> | undefined, b: AudioBody | PlainMessage<AudioBody> | undefined): boolean {
+    return proto3.util.equals(AudioBody, a, b);
+  }
+}
+
+/**
+ * LocationBody 是位置消息
+ *
+ * @generated from message resonance.gateway.v1.LocationBody
+ */
+export class LocationBody extends Message<LocationBody> {
+  /**
+   * 纬度 [-90, 90]
+   *
+   * @generated from field: double latitude = 1;
+   */
+  latitude = 0;
+
+  /**
+   * 经度 [-180, 180]
+   *
+   * @generated from field: double longitude = 2;
+   */
+  longitude = 0;
+
+  /**
+   * 地点名称
+   *
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * 详细地址
+   *
+   * @generated from field: string address = 4;
+   */
+  address = "";
+
+  constructor(data?: PartialMessage<LocationBody>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.LocationBody";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "latitude", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "longitude", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LocationBody {
+    return new LocationBody().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LocationBody {
+    return new LocationBody().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LocationBody {
+    return new LocationBody().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LocationBody | PlainMessage<LocationBody> | undefined, b: LocationBody | PlainMessage<LocationBody> | undefined): boolean {
+    return proto3.util.equals(LocationBody, a, b);
+  }
+}
+
+/**
+ * ContactCardBody 是名片消息
+ *
+ * @generated from message resonance.gateway.v1.ContactCardBody
+ */
+export class ContactCardBody extends Message<ContactCardBody> {
+  /**
+   * 名片用户
+   *
+   * @generated from field: string username = 1;
+   */
+  username = "";
+
+  /**
+   * 昵称
+   *
+   * @generated from field: string nickname = 2;
+   */
+  nickname = "";
+
+  /**
+   * 头像
+   *
+   * @generated from field: string avatar = 3;
+   */
+  avatar = "";
+
+  constructor(data?: PartialMessage<ContactCardBody>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ContactCardBody";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "nickname", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "avatar", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ContactCardBody {
+    return new ContactCardBody().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ContactCardBody {
+    return new ContactCardBody().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ContactCardBody {
+    return new ContactCardBody().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ContactCardBody | PlainMessage<ContactCardBody> | undefined, b: ContactCardBody | PlainMessage<ContactCardBody> | undefined): boolean {
+    return proto3.util.equals(ContactCardBody, a, b);
+  }
+}
+
+/**
+ * SystemBody 是系统消息（如成员变更通知），客户端不可发送
+ *
+ * @generated from message resonance.gateway.v1.SystemBody
+ */
+export class SystemBody extends Message<SystemBody> {
+  /**
+   * 系统消息类型标识，便于客户端本地化
+   *
+   * @generated from field: string code = 1;
+   */
+  code = "";
+
+  /**
+   * 默认展示文本
+   *
+   * @generated from field: string text = 2;
+   */
+  text = "";
+
+  /**
+   * 关联的消息ID（如被置顶/取消置顶的消息，0 表示无）
+   *
+   * @generated from field: int64 ref_msg_id = 3;
+   */
+  refMsgId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SystemBody>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.SystemBody";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "ref_msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SystemBody {
+    return new SystemBody().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SystemBody {
+    return new SystemBody().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SystemBody {
+    return new SystemBody().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SystemBody | PlainMessage<SystemBody> | undefined, b: SystemBody | PlainMessage<SystemBody> | undefined): boolean {
+    return proto3.util.equals(SystemBody, a, b);
+  }
+}
+
+/**
+ * ForwardBody 是合并转发的聊天记录，内嵌原消息快照
+ *
+ * @generated from message resonance.gateway.v1.ForwardBody
+ */
+export class ForwardBody extends Message<ForwardBody> {
+  /**
+   * 标题，如「alice 的聊天记录」
+   *
+   * @generated from field: string title = 1;
+   */
+  title = "";
+
+  /**
+   * 来源会话
+   *
+   * @generated from field: string source_session_id = 2;
+   */
+  sourceSessionId = "";
+
+  /**
+   * 原消息快照（按 seq 升序）
+   *
+   * @generated from field: repeated resonance.gateway.v1.ForwardedMessage messages = 3;
+   */
+  messages: ForwardedMessage[] = [];
+
+  constructor(data?: PartialMessage<ForwardBody>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ForwardBody";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "source_session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "messages", kind: "message", T: ForwardedMessage, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForwardBody {
+    return new ForwardBody().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForwardBody {
+    return new ForwardBody().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForwardBody {
+    return new ForwardBody().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ForwardBody | PlainMessage<ForwardBody> | undefined, b: ForwardBody | PlainMessage<ForwardBody> | undefined): boolean {
+    return proto3.util.equals(ForwardBody, a, b);
+  }
+}
+
+/**
+ * ForwardedMessage 是被转发消息的快照
+ *
+ * @generated from message resonance.gateway.v1.ForwardedMessage
+ */
+export class ForwardedMessage extends Message<ForwardedMessage> {
+  /**
+   * 原消息ID
+   *
+   * @generated from field: int64 msg_id = 1;
+   */
+  msgId = protoInt64.zero;
+
+  /**
+   * 原发送者
+   *
+   * @generated from field: string from_username = 2;
+   */
+  fromUsername = "";
+
+  /**
+   * 纯文本摘要
+   *
+   * @generated from field: string content = 3;
+   */
+  content = "";
+
+  /**
+   * 类型
+   *
+   * @generated from field: string type = 4;
+   */
+  type = "";
+
+  /**
+   * 原发送时间
+   *
+   * @generated from field: int64 timestamp = 5;
+   */
+  timestamp = protoInt64.zero;
+
+  /**
+   * 结构化消息体（旧消息可能为空，以 content 为准）
+   *
+   * @generated from field: resonance.gateway.v1.MessageBody body = 6;
+   */
+  body?: MessageBody;
+
+  constructor(data?: PartialMessage<ForwardedMessage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ForwardedMessage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "from_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "timestamp", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "body", kind: "message", T: MessageBody },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForwardedMessage {
+    return new ForwardedMessage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForwardedMessage {
+    return new ForwardedMessage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForwardedMessage {
+    return new ForwardedMessage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ForwardedMessage | PlainMessage<ForwardedMessage> | undefined, b: ForwardedMessage | PlainMessage<ForwardedMessage> | undefined): boolean {
+    return proto3.util.equals(ForwardedMessage, a, b);
+  }
+}
+
+/**
+ * PollBody 是投票消息，发送后不可修改；计票状态见 PushMessage.poll_tally
+ *
+ * @generated from message resonance.gateway.v1.PollBody
+ */
+export class PollBody extends Message<PollBody> {
+  /**
+   * 问题
+   *
+   * @generated from field: string question = 1;
+   */
+  question = "";
+
+  /**
+   * 选项（2~10 个，不可重复）
+   *
+   * @generated from field: repeated string options = 2;
+   */
+  options: string[] = [];
+
+  /**
+   * 是否多选
+   *
+   * @generated from field: bool multi_choice = 3;
+   */
+  multiChoice = false;
+
+  /**
+   * 是否匿名：匿名投票不向任何人展示投票明细
+   *
+   * @generated from field: bool anonymous = 4;
+   */
+  anonymous = false;
+
+  /**
+   * 截止时间（Unix 秒，0 表示无截止时间），到期后不可再投票
+   *
+   * @generated from field: int64 deadline = 5;
+   */
+  deadline = protoInt64.zero;
+
+  constructor(data?: PartialMessage<PollBody>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.PollBody";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "question", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "options", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "multi_choice", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "anonymous", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "deadline", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PollBody {
+    return new PollBody().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PollBody {
+    return new PollBody().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PollBody {
+    return new PollBody().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PollBody | PlainMessage<PollBody> | undefined, b: PollBody | PlainMessage<PollBody> | undefined): boolean {
+    return proto3.util.equals(PollBody, a, b);
+  }
+}
+
+/**
+ * PollTally 是一个投票的计票状态
+ *
+ * @generated from message resonance.gateway.v1.PollTally
+ */
+export class PollTally extends Message<PollTally> {
+  /**
+   * 各选项票数，下标与 PollBody.options 一致
+   *
+   * @generated from field: repeated int32 counts = 1;
+   */
+  counts: number[] = [];
+
+  /**
+   * 投票人数（多选时一人计一次）
+   *
+   * @generated from field: int32 voter_count = 2;
+   */
+  voterCount = 0;
+
+  /**
+   * 是否已结束（发起者或管理员结束，或已过截止时间）
+   *
+   * @generated from field: bool closed = 3;
+   */
+  closed = false;
+
+  /**
+   * 结束投票的操作者（到期自动结束时为空）
+   *
+   * @generated from field: string closed_by = 4;
+   */
+  closedBy = "";
+
+  /**
+   * 结束时间（Unix 秒，未结束为 0）
+   *
+   * @generated from field: int64 closed_at = 5;
+   */
+  closedAt = protoInt64.zero;
+
+  /**
+   * 当前用户选择的选项下标（仅拉取接口且非匿名投票时填充）
+   *
+   * @generated from field: repeated int32 my_choices = 6;
+   */
+  myChoices: number[] = [];
+
+  /**
+   * 当前用户是否已投票（仅拉取接口中有效）
+   *
+   * @generated from field: bool voted = 7;
+   */
+  voted = false;
+
+  constructor(data?: PartialMessage<PollTally>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.PollTally";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "counts", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 2, name: "voter_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "closed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "closed_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "closed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "my_choices", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 7, name: "voted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PollTally {
+    return new PollTally().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PollTally {
+    return new PollTally().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PollTally {
+    return new PollTally().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PollTally | PlainMessage<PollTally> | undefined, b: PollTally | PlainMessage<PollTally> | undefined): boolean {
+    return proto3.util.equals(PollTally, a, b);
+  }
+}
+
+/**
+ * ForwardResult 是转发到单个目标会话的结果
+ *
+ * @generated from message resonance.gateway.v1.ForwardResult
+ */
+export class ForwardResult extends Message<ForwardResult> {
+  /**
+   * 目标会话
+   *
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * 生成的消息ID
+   *
+   * @generated from field: repeated int64 msg_ids = 2;
+   */
+  msgIds: bigint[] = [];
+
+  /**
+   * 错误信息（为空表示成功）
+   *
+   * @generated from field: string error = 3;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ForwardResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ForwardResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "msg_ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+    { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForwardResult {
+    return new ForwardResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForwardResult {
+    return new ForwardResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForwardResult {
+    return new ForwardResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ForwardResult | PlainMessage<ForwardResult> | undefined, b: ForwardResult | PlainMessage<ForwardResult> | undefined): boolean {
+    return proto3.util.equals(ForwardResult, a, b);
+  }
+}
+
+/**
+ * PinnedMessage 是会话中的一条置顶消息
+ *
+ * @generated from message resonance.gateway.v1.PinnedMessage
+ */
+export class PinnedMessage extends Message<PinnedMessage> {
+  /**
+   * 被置顶的消息
+   *
+   * @generated from field: resonance.gateway.v1.PushMessage message = 1;
+   */
+  message?: PushMessage;
+
+  /**
+   * 置顶操作者
+   *
+   * @generated from field: string pinned_by = 2;
+   */
+  pinnedBy = "";
+
+  /**
+   * 置顶时间
+   *
+   * @generated from field: int64 pinned_at = 3;
+   */
+  pinnedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<PinnedMessage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.PinnedMessage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "message", T: PushMessage },
+    { no: 2, name: "pinned_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "pinned_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PinnedMessage {
+    return new PinnedMessage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PinnedMessage {
+    return new PinnedMessage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PinnedMessage {
+    return new PinnedMessage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PinnedMessage | PlainMessage<PinnedMessage> | undefined, b: PinnedMessage | PlainMessage<PinnedMessage> | undefined): boolean {
+    return proto3.util.equals(PinnedMessage, a, b);
+  }
+}
+
+/**
+ * SearchResult 是一条消息搜索结果
+ *
+ * @generated from message resonance.gateway.v1.SearchResult
+ */
+export class SearchResult extends Message<SearchResult> {
+  /**
+   * 命中的消息
+   *
+   * @generated from field: resonance.gateway.v1.PushMessage message = 1;
+   */
+  message?: PushMessage;
+
+  /**
+   * 高亮摘要：已做 HTML 转义，命中词以 <mark></mark> 包裹
+   *
+   * @generated from field: string snippet = 2;
+   */
+  snippet = "";
+
+  constructor(data?: PartialMessage<SearchResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.SearchResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "message", T: PushMessage },
+    { no: 2, name: "snippet", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchResult {
+    return new SearchResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchResult {
+    return new SearchResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchResult {
+    return new SearchResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchResult | PlainMessage<SearchResult> | undefined, b: SearchResult | PlainMessage<SearchResult> | undefined): boolean {
+    return proto3.util.equals(SearchResult, a, b);
+  }
+}
+
+/**
+ * Announcement 是一条系统公告
+ * 在线用户经 WsPacket 实时收到，离线用户登录后通过 ListAnnouncements 补拉
+ *
+ * @generated from message resonance.gateway.v1.Announcement
+ */
+export class Announcement extends Message<Announcement> {
+  /**
+   * 公告ID（Snowflake，随时间递增，客户端以此作为补拉游标）
+   *
+   * @generated from field: int64 announcement_id = 1;
+   */
+  announcementId = protoInt64.zero;
+
+  /**
+   * 标题（可为空）
+   *
+   * @generated from field: string title = 2;
+   */
+  title = "";
+
+  /**
+   * 正文
+   *
+   * @generated from field: string content = 3;
+   */
+  content = "";
+
+  /**
+   * 发布者
+   *
+   * @generated from field: string from_username = 4;
+   */
+  fromUsername = "";
+
+  /**
+   * 发布时间（Unix 秒）
+   *
+   * @generated from field: int64 created_at = 5;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Announcement>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.Announcement";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "announcement_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "from_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Announcement {
+    return new Announcement().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Announcement {
+    return new Announcement().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Announcement {
+    return new Announcement().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Announcement | PlainMessage<Announcement> | undefined, b: Announcement | PlainMessage<Announcement> | undefined): boolean {
+    return proto3.util.equals(Announcement, a, b);
+  }
+}
+
+/**
+ * Webhook 是会话的一个出站 Webhook 订阅
+ *
+ * @generated from message resonance.gateway.v1.Webhook
+ */
+export class Webhook extends Message<Webhook> {
+  /**
+   * @generated from field: int64 webhook_id = 1;
+   */
+  webhookId = protoInt64.zero;
+
+  /**
+   * 所属会话
+   *
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * 回调地址（http/https）
+   *
+   * @generated from field: string url = 3;
+   */
+  url = "";
+
+  /**
+   * 订阅的事件（为空表示全部）：message.created / message.edited / member.changed
+   *
+   * @generated from field: repeated string events = 4;
+   */
+  events: string[] = [];
+
+  /**
+   * 创建者
+   *
+   * @generated from field: string created_by = 5;
+   */
+  createdBy = "";
+
+  /**
+   * @generated from field: int64 created_at = 6;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Webhook>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.Webhook";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "events", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "created_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Webhook {
+    return new Webhook().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Webhook {
+    return new Webhook().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Webhook {
+    return new Webhook().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Webhook | PlainMessage<Webhook> | undefined, b: Webhook | PlainMessage<Webhook> | undefined): boolean {
+    return proto3.util.equals(Webhook, a, b);
+  }
+}
+
+/**
+ * WebhookDelivery 是一次 Webhook 投递记录
+ *
+ * @generated from message resonance.gateway.v1.WebhookDelivery
+ */
+export class WebhookDelivery extends Message<WebhookDelivery> {
+  /**
+   * @generated from field: int64 delivery_id = 1;
+   */
+  deliveryId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 webhook_id = 2;
+   */
+  webhookId = protoInt64.zero;
+
+  /**
+   * 事件类型
+   *
+   * @generated from field: string event = 3;
+   */
+  event = "";
+
+  /**
+   * @generated from field: resonance.gateway.v1.WebhookDeliveryStatus status = 4;
+   */
+  status = WebhookDeliveryStatus.UNSPECIFIED;
+
+  /**
+   * 已尝试次数
+   *
+   * @generated from field: int32 attempts = 5;
+   */
+  attempts = 0;
+
+  /**
+   * 最近一次的 HTTP 状态码（0 表示未收到响应）
+   *
+   * @generated from field: int32 response_code = 6;
+   */
+  responseCode = 0;
+
+  /**
+   * 最近一次的失败原因
+   *
+   * @generated from field: string error = 7;
+   */
+  error = "";
+
+  /**
+   * @generated from field: int64 created_at = 8;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 updated_at = 9;
+   */
+  updatedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<WebhookDelivery>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.WebhookDelivery";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "delivery_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "webhook_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "event", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "status", kind: "enum", T: proto3.getEnumType(WebhookDeliveryStatus) },
+    { no: 5, name: "attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "response_code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebhookDelivery {
+    return new WebhookDelivery().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebhookDelivery {
+    return new WebhookDelivery().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebhookDelivery {
+    return new WebhookDelivery().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebhookDelivery | PlainMessage<WebhookDelivery> | undefined, b: WebhookDelivery | PlainMessage<WebhookDelivery> | undefined): boolean {
+    return proto3.util.equals(WebhookDelivery, a, b);
+  }
+}
+
+/**
+ * Bot 是一个机器人账号
+ *
+ * @generated from message resonance.gateway.v1.Bot
+ */
+export class Bot extends Message<Bot> {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username = "";
+
+  /**
+   * @generated from field: string nickname = 2;
+   */
+  nickname = "";
+
+  /**
+   * 创建者
+   *
+   * @generated from field: string owner_username = 3;
+   */
+  ownerUsername = "";
+
+  /**
+   * @generated from field: int64 created_at = 4;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Bot>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.Bot";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "nickname", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "owner_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Bot {
+    return new Bot().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Bot {
+    return new Bot().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Bot {
+    return new Bot().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Bot | PlainMessage<Bot> | undefined, b: Bot | PlainMessage<Bot> | undefined): boolean {
+    return proto3.util.equals(Bot, a, b);
+  }
+}
+
+/**
+ * ScheduledMessage 是一条定时消息
+ *
+ * @generated from message resonance.gateway.v1.ScheduledMessage
+ */
+export class ScheduledMessage extends Message<ScheduledMessage> {
+  /**
+   * @generated from field: int64 schedule_id = 1;
+   */
+  scheduleId = protoInt64.zero;
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: string content = 3;
+   */
+  content = "";
+
+  /**
+   * @generated from field: string type = 4;
+   */
+  type = "";
+
+  /**
+   * @generated from field: resonance.gateway.v1.MessageBody body = 5;
+   */
+  body?: MessageBody;
+
+  /**
+   * @generated from field: int64 reply_to_msg_id = 6;
+   */
+  replyToMsgId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 thread_root_id = 7;
+   */
+  threadRootId = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated string mentions = 8;
+   */
+  mentions: string[] = [];
+
+  /**
+   * @generated from field: bool mention_all = 9;
+   */
+  mentionAll = false;
+
+  /**
+   * 计划发送时间（Unix 秒）
+   *
+   * @generated from field: int64 scheduled_at = 10;
+   */
+  scheduledAt = protoInt64.zero;
+
+  /**
+   * @generated from field: resonance.gateway.v1.ScheduledMessageStatus status = 11;
+   */
+  status = ScheduledMessageStatus.UNSPECIFIED;
+
+  /**
+   * 失败原因
+   *
+   * @generated from field: string error = 12;
+   */
+  error = "";
+
+  /**
+   * 发送成功后生成的消息ID
+   *
+   * @generated from field: int64 msg_id = 13;
+   */
+  msgId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 created_at = 14;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ScheduledMessage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ScheduledMessage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schedule_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "body", kind: "message", T: MessageBody },
+    { no: 6, name: "reply_to_msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "thread_root_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "mentions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "mention_all", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "scheduled_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "status", kind: "enum", T: proto3.getEnumType(ScheduledMessageStatus) },
+    { no: 12, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 14, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScheduledMessage {
+    return new ScheduledMessage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScheduledMessage {
+    return new ScheduledMessage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScheduledMessage {
+    return new ScheduledMessage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScheduledMessage | PlainMessage<ScheduledMessage> | undefined, b: ScheduledMessage | PlainMessage<ScheduledMessage> | undefined): boolean {
+    return proto3.util.equals(ScheduledMessage, a, b);
+  }
+}
+
+/**
+ * SessionMeta 是会话元数据
+ * 用于在推送消息时携带会话信息，避免前端额外查询
+ *
+ * @generated from message resonance.gateway.v1.SessionMeta
+ */
+export class SessionMeta extends Message<SessionMeta> {
+  /**
+   * 会话名称
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * 会话类型：1=单聊, 2=群聊
+   *
+   * @generated from field: int32 type = 2;
+   */
+  type = 0;
+
+  constructor(data?: PartialMessage<SessionMeta>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.SessionMeta";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SessionMeta {
+    return new SessionMeta().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SessionMeta {
+    return new SessionMeta().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SessionMeta {
+    return new SessionMeta().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SessionMeta | PlainMessage<SessionMeta> | undefined, b: SessionMeta | PlainMessage<SessionMeta> | undefined): boolean {
+    return proto3.util.equals(SessionMeta, a, b);
+  }
+}
+
+/**
+ * QuotedMessage 是被引用消息的精简快照
+ * 随回复消息一并返回，避免前端逐条查询被引用的消息
+ *
+ * @generated from message resonance.gateway.v1.QuotedMessage
+ */
+export class QuotedMessage extends Message<QuotedMessage> {
+  /**
+   * 被引用消息ID
+   *
+   * @generated from field: int64 msg_id = 1;
+   */
+  msgId = protoInt64.zero;
+
+  /**
+   * 被引用消息的发送者
+   *
+   * @generated from field: string from_username = 2;
+   */
+  fromUsername = "";
+
+  /**
+   * 内容摘要（超长截断；已撤回时为墓碑占位内容）
+   *
+   * @generated from field: string content = 3;
+   */
+  content = "";
+
+  /**
+   * 类型
+   *
+   * @generated from field: string type = 4;
+   */
+  type = "";
 
-  static equals(a: WsPacket | PlainMessage<WsPacket> | undefined, b: WsPacket | PlainMessage<WsPacket> | undefined): boolean {
-    return proto3.util.equals(WsPacket, a, b);
-  }
-}
+  /**
+   * 被引用消息是否已撤回
+   *
+   * @generated from field: bool recalled = 5;
+   */
+  recalled = false;
 
-/**
- * Pulse 是心跳信号
- *
- * @generated from message resonance.gateway.v1.Pulse
- */
-export class Pulse extends Message<Pulse> {
-  constructor(data?: PartialMessage<Pulse>) {
+  constructor(data?: PartialMessage<QuotedMessage>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "resonance.gateway.v1.Pulse";
+  static readonly typeName = "resonance.gateway.v1.QuotedMessage";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "from_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "recalled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Pulse {
-    return new Pulse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuotedMessage {
+    return new QuotedMessage().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Pulse {
-    return new Pulse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuotedMessage {
+    return new QuotedMessage().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Pulse {
-    return new Pulse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuotedMessage {
+    return new QuotedMessage().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Pulse | PlainMessage<Pulse> | undefined, b: Pulse | PlainMessage<Pulse> | undefined): boolean {
-    return proto3.util.equals(Pulse, a, b);
+  static equals(a: QuotedMessage | PlainMessage<QuotedMessage> | undefined, b: QuotedMessage | PlainMessage<QuotedMessage> | undefined): boolean {
+    return proto3.util.equals(QuotedMessage, a, b);
   }
 }
 
 /**
- * ChatRequest 是用户发送的消息
- * 字段编号与 PushMessage 保持一致，方便转换
+ * ReadReceipt 是一条消息的已读聚合
  *
- * @generated from message resonance.gateway.v1.ChatRequest
+ * @generated from message resonance.gateway.v1.ReadReceipt
  */
-export class ChatRequest extends Message<ChatRequest> {
+export class ReadReceipt extends Message<ReadReceipt> {
   /**
-   * 1, 2 预留给 id, seq (客户端发送时通常没有)
+   * 消息ID
    *
-   * 会话ID
+   * @generated from field: int64 msg_id = 1;
+   */
+  msgId = protoInt64.zero;
+
+  /**
+   * 消息序号
    *
-   * @generated from field: string session_id = 3;
+   * @generated from field: int64 seq_id = 2;
    */
-  sessionId = "";
+  seqId = protoInt64.zero;
 
   /**
-   * 发送者 (客户端可不填，由网关填充)
+   * 已读人数（不含发送者）
    *
-   * @generated from field: string from_username = 4;
+   * @generated from field: int32 read_count = 3;
    */
-  fromUsername = "";
+  readCount = 0;
 
   /**
-   * 目标用户 (私聊时可能需要)
+   * 未读人数（不含发送者）
    *
-   * @generated from field: string to_username = 5;
+   * @generated from field: int32 unread_count = 4;
    */
-  toUsername = "";
+  unreadCount = 0;
+
+  constructor(data?: PartialMessage<ReadReceipt>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.ReadReceipt";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "seq_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "read_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "unread_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
 
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReadReceipt {
+    return new ReadReceipt().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReadReceipt {
+    return new ReadReceipt().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReadReceipt {
+    return new ReadReceipt().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReadReceipt | PlainMessage<ReadReceipt> | undefined, b: ReadReceipt | PlainMessage<ReadReceipt> | undefined): boolean {
+    return proto3.util.equals(ReadReceipt, a, b);
+  }
+}
+
+/**
+ * Reaction 是某个表情在一条消息上的聚合回应
+ *
+ * @generated from message resonance.gateway.v1.Reaction
+ */
+export class Reaction extends Message<Reaction> {
   /**
-   * 内容
+   * 表情
    *
-   * @generated from field: string content = 6;
+   * @generated from field: string emoji = 1;
    */
-  content = "";
+  emoji = "";
 
   /**
-   * 类型
+   * 回应人数
    *
-   * @generated from field: string type = 7;
+   * @generated from field: int32 count = 2;
    */
-  type = "";
+  count = 0;
 
   /**
-   * timestamp 可选字段，可由网关填充
+   * 当前用户是否已回应（仅在拉取接口中有效，推送中不填充）
    *
-   * @generated from field: int64 timestamp = 8;
+   * @generated from field: bool reacted = 3;
    */
-  timestamp = protoInt64.zero;
+  reacted = false;
 
-  constructor(data?: PartialMessage<ChatRequest>) {
+  constructor(data?: PartialMessage<Reaction>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "resonance.gateway.v1.ChatRequest";
+  static readonly typeName = "resonance.gateway.v1.Reaction";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 3, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "from_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "to_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 8, name: "timestamp", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 1, name: "emoji", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "reacted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChatRequest {
-    return new ChatRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Reaction {
+    return new Reaction().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChatRequest {
-    return new ChatRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Reaction {
+    return new Reaction().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChatRequest {
-    return new ChatRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Reaction {
+    return new Reaction().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ChatRequest | PlainMessage<ChatRequest> | undefined, b: ChatRequest | PlainMessage<ChatRequest> | undefined): boolean {
-    return proto3.util.equals(ChatRequest, a, b);
+  static equals(a: Reaction | PlainMessage<Reaction> | undefined, b: Reaction | PlainMessage<Reaction> | undefined): boolean {
+    return proto3.util.equals(Reaction, a, b);
   }
 }
 
 /**
- * SessionMeta 是会话元数据
- * 用于在推送消息时携带会话信息，避免前端额外查询
+ * ReactionChange 描述一次表情回应变更
  *
- * @generated from message resonance.gateway.v1.SessionMeta
+ * @generated from message resonance.gateway.v1.ReactionChange
  */
-export class SessionMeta extends Message<SessionMeta> {
+export class ReactionChange extends Message<ReactionChange> {
   /**
-   * 会话名称
+   * 操作者
    *
-   * @generated from field: string name = 1;
+   * @generated from field: string username = 1;
    */
-  name = "";
+  username = "";
 
   /**
-   * 会话类型：1=单聊, 2=群聊
+   * 表情
    *
-   * @generated from field: int32 type = 2;
+   * @generated from field: string emoji = 2;
    */
-  type = 0;
+  emoji = "";
 
-  constructor(data?: PartialMessage<SessionMeta>) {
+  /**
+   * true=取消回应，false=添加回应
+   *
+   * @generated from field: bool removed = 3;
+   */
+  removed = false;
+
+  constructor(data?: PartialMessage<ReactionChange>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "resonance.gateway.v1.SessionMeta";
+  static readonly typeName = "resonance.gateway.v1.ReactionChange";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "type", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "emoji", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "removed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SessionMeta {
-    return new SessionMeta().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReactionChange {
+    return new ReactionChange().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SessionMeta {
-    return new SessionMeta().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReactionChange {
+    return new ReactionChange().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SessionMeta {
-    return new SessionMeta().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReactionChange {
+    return new ReactionChange().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SessionMeta | PlainMessage<SessionMeta> | undefined, b: SessionMeta | PlainMessage<SessionMeta> | undefined): boolean {
-    return proto3.util.equals(SessionMeta, a, b);
+  static equals(a: ReactionChange | PlainMessage<ReactionChange> | undefined, b: ReactionChange | PlainMessage<ReactionChange> | undefined): boolean {
+    return proto3.util.equals(ReactionChange, a, b);
   }
 }
 
@@ -326,6 +2552,160 @@ export class PushMessage extends Message<PushMessage> {
    */
   sessionMeta?: SessionMeta;
 
+  /**
+   * 推送动作（撤回通知等）
+   *
+   * @generated from field: resonance.gateway.v1.MessageAction action = 11;
+   */
+  action = MessageAction.UNSPECIFIED;
+
+  /**
+   * 消息是否已撤回（已撤回时 content 为墓碑占位内容）
+   *
+   * @generated from field: bool recalled = 12;
+   */
+  recalled = false;
+
+  /**
+   * 撤回操作者
+   *
+   * @generated from field: string recalled_by = 13;
+   */
+  recalledBy = "";
+
+  /**
+   * 最后编辑时间（0 表示未编辑）
+   *
+   * @generated from field: int64 edited_at = 14;
+   */
+  editedAt = protoInt64.zero;
+
+  /**
+   * 编辑版本号，每次编辑递增（0 表示未编辑）
+   *
+   * @generated from field: int32 version = 15;
+   */
+  version = 0;
+
+  /**
+   * 引用回复的消息ID（0 表示非回复）
+   *
+   * @generated from field: int64 reply_to_msg_id = 16;
+   */
+  replyToMsgId = protoInt64.zero;
+
+  /**
+   * 被引用消息快照（历史消息中携带）
+   *
+   * @generated from field: resonance.gateway.v1.QuotedMessage reply_to = 17;
+   */
+  replyTo?: QuotedMessage;
+
+  /**
+   * 所属话题的根消息ID（0 表示主时间线消息；非 0 时 seq_id 为话题内序号）
+   *
+   * @generated from field: int64 thread_root_id = 18;
+   */
+  threadRootId = protoInt64.zero;
+
+  /**
+   * 作为话题根消息时的回复数
+   *
+   * @generated from field: int32 thread_reply_count = 19;
+   */
+  threadReplyCount = 0;
+
+  /**
+   * 作为话题根消息时的最后回复时间
+   *
+   * @generated from field: int64 thread_last_reply_at = 20;
+   */
+  threadLastReplyAt = protoInt64.zero;
+
+  /**
+   * 表情回应聚合
+   *
+   * @generated from field: repeated resonance.gateway.v1.Reaction reactions = 21;
+   */
+  reactions: Reaction[] = [];
+
+  /**
+   * 本次表情回应变更（仅 MESSAGE_ACTION_REACTION 携带）
+   *
+   * @generated from field: resonance.gateway.v1.ReactionChange reaction_change = 22;
+   */
+  reactionChange?: ReactionChange;
+
+  /**
+   * @提及的用户名列表（mention_all 时为空）
+   *
+   * @generated from field: repeated string mentions = 23;
+   */
+  mentions: string[] = [];
+
+  /**
+   * 是否 @所有人
+   *
+   * @generated from field: bool mention_all = 24;
+   */
+  mentionAll = false;
+
+  /**
+   * 已读回执（仅 MESSAGE_ACTION_READ_RECEIPT 携带）
+   *
+   * @generated from field: repeated resonance.gateway.v1.ReadReceipt read_receipts = 25;
+   */
+  readReceipts: ReadReceipt[] = [];
+
+  /**
+   * 结构化消息体（旧消息或编辑后为空，以 content 为准）
+   *
+   * @generated from field: resonance.gateway.v1.MessageBody body = 26;
+   */
+  body?: MessageBody;
+
+  /**
+   * 过期时间（Unix 秒，0 表示永久保留），到期后服务端删除消息并推送 MESSAGE_ACTION_EXPIRE
+   *
+   * @generated from field: int64 expires_at = 27;
+   */
+  expiresAt = protoInt64.zero;
+
+  /**
+   * 本人删除的消息 ID（仅 MESSAGE_ACTION_HIDE 携带）
+   *
+   * @generated from field: repeated int64 hidden_msg_ids = 28;
+   */
+  hiddenMsgIds: bigint[] = [];
+
+  /**
+   * 本人清空聊天记录的位点，0 表示未清空（仅 MESSAGE_ACTION_HIDE 携带）
+   *
+   * @generated from field: int64 cleared_seq = 29;
+   */
+  clearedSeq = protoInt64.zero;
+
+  /**
+   * 发送者是否为机器人账号
+   *
+   * @generated from field: bool from_bot = 30;
+   */
+  fromBot = false;
+
+  /**
+   * 投票计票状态（投票消息在拉取接口及 MESSAGE_ACTION_POLL 中携带）
+   *
+   * @generated from field: resonance.gateway.v1.PollTally poll_tally = 31;
+   */
+  pollTally?: PollTally;
+
+  /**
+   * 静默推送：接收者对该会话开启了免打扰，客户端只更新消息列表，不弹出通知
+   *
+   * @generated from field: bool silent = 32;
+   */
+  silent = false;
+
   constructor(data?: PartialMessage<PushMessage>) {
     super();
     proto3.util.initPartial(data, this);
@@ -343,6 +2723,28 @@ export class PushMessage extends Message<PushMessage> {
     { no: 7, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 8, name: "timestamp", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 10, name: "session_meta", kind: "message", T: SessionMeta },
+    { no: 11, name: "action", kind: "enum", T: proto3.getEnumType(MessageAction) },
+    { no: 12, name: "recalled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 13, name: "recalled_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "edited_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 15, name: "version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 16, name: "reply_to_msg_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 17, name: "reply_to", kind: "message", T: QuotedMessage },
+    { no: 18, name: "thread_root_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 19, name: "thread_reply_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 20, name: "thread_last_reply_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 21, name: "reactions", kind: "message", T: Reaction, repeated: true },
+    { no: 22, name: "reaction_change", kind: "message", T: ReactionChange },
+    { no: 23, name: "mentions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 24, name: "mention_all", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 25, name: "read_receipts", kind: "message", T: ReadReceipt, repeated: true },
+    { no: 26, name: "body", kind: "message", T: MessageBody },
+    { no: 27, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 28, name: "hidden_msg_ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+    { no: 29, name: "cleared_seq", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 30, name: "from_bot", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 31, name: "poll_tally", kind: "message", T: PollTally },
+    { no: 32, name: "silent", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PushMessage {
@@ -403,6 +2805,20 @@ export class Ack extends Message<Ack> {
    */
   error = "";
 
+  /**
+   * 结构化错误码（如 unknown_command），便于客户端区分处理；为空时仅看 error
+   *
+   * @generated from field: string error_code = 6;
+   */
+  errorCode = "";
+
+  /**
+   * 斜杠命令的仅自己可见回复（命令未生成消息时 msg_id 为 0）
+   *
+   * @generated from field: resonance.gateway.v1.CommandReply command_reply = 7;
+   */
+  commandReply?: CommandReply;
+
   constructor(data?: PartialMessage<Ack>) {
     super();
     proto3.util.initPartial(data, this);
@@ -416,6 +2832,8 @@ export class Ack extends Message<Ack> {
     { no: 3, name: "seq_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 4, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "error_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "command_reply", kind: "message", T: CommandReply },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Ack {
@@ -435,3 +2853,58 @@ export class Ack extends Message<Ack> {
   }
 }
 
+/**
+ * CommandReply 斜杠命令的仅发送者可见回复，不落库、不推送给其他成员
+ *
+ * @generated from message resonance.gateway.v1.CommandReply
+ */
+export class CommandReply extends Message<CommandReply> {
+  /**
+   * 命令名（不含 /）
+   *
+   * @generated from field: string command = 1;
+   */
+  command = "";
+
+  /**
+   * 回复者：内置命令为空，机器人命令为机器人用户名
+   *
+   * @generated from field: string from_username = 2;
+   */
+  fromUsername = "";
+
+  /**
+   * @generated from field: string content = 3;
+   */
+  content = "";
+
+  constructor(data?: PartialMessage<CommandReply>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "resonance.gateway.v1.CommandReply";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "command", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CommandReply {
+    return new CommandReply().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CommandReply {
+    return new CommandReply().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CommandReply {
+    return new CommandReply().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CommandReply | PlainMessage<CommandReply> | undefined, b: CommandReply | PlainMessage<CommandReply> | undefined): boolean {
+    return proto3.util.equals(CommandReply, a, b);
+  }
+}
+
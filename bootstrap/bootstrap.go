@@ -124,7 +124,7 @@ func seed(gormDB *gorm.DB, adminCfg *AdminConfig, logger clog.Logger) error {
 	member := &model.SessionMember{
 		SessionID: "0",
 		Username:  adminCfg.Username,
-		Role:      1, // 管理员
+		Role:      model.MemberRoleAdmin,
 	}
 	result = gormDB.Where("session_id = ? AND username = ?", member.SessionID, member.Username).FirstOrCreate(member)
 	if result.Error != nil {
@@ -0,0 +1,244 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/gateway/middleware"
+	"github.com/ceyewan/resonance/pkg/blobstore"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sniffLen http.DetectContentType 最多读取的字节数
+const sniffLen = 512
+
+// createUploadTicketRequest 申请上传凭证请求体
+type createUploadTicketRequest struct {
+	SessionID string `json:"session_id"`
+	FileName  string `json:"file_name"`
+	MimeType  string `json:"mime_type"`
+	Size      int64  `json:"size"`
+}
+
+// registerAttachmentRoutes 注册附件上传/下载路由（需要认证）
+// 上传流程：POST /attachments/tickets 申请凭证 -> PUT /attachments/:id/content?ticket= 上传内容
+// 下载：GET /attachments/:id，按附件所属会话的成员关系鉴权
+func (h *HTTPHandler) registerAttachmentRoutes(group *gin.RouterGroup) {
+	if h.blobStore == nil {
+		return
+	}
+	group.POST("/attachments/tickets", h.createUploadTicket)
+	group.PUT("/attachments/:id/content", h.uploadAttachment)
+	group.GET("/attachments/:id", h.downloadAttachment)
+}
+
+// createUploadTicket 申请附件上传凭证
+func (h *HTTPHandler) createUploadTicket(c *gin.Context) {
+	username := middleware.MustGetUsername(c)
+
+	var req createUploadTicketRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	resp, err := h.logicClient.CreateUploadTicket(c.Request.Context(), &logicv1.CreateUploadTicketRequest{
+		Username:  username,
+		SessionId: req.SessionID,
+		FileName:  req.FileName,
+		MimeType:  req.MimeType,
+		Size:      req.Size,
+	})
+	if err != nil {
+		h.logger.Warn("create upload ticket failed", clog.String("username", username), clog.Error(err))
+		writeGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"attachment_id": strconv.FormatInt(resp.AttachmentId, 10),
+		"ticket":        resp.Ticket,
+		"expires_at":    resp.ExpiresAt,
+		"upload_url":    fmt.Sprintf("/attachments/%d/content?ticket=%s", resp.AttachmentId, resp.Ticket),
+	})
+}
+
+// uploadAttachment 上传附件内容
+// 先校验凭证再写入存储，写入后由 Logic 确认大小并落库；确认失败时删除已写入的内容
+// 每次校验返回独立的存储位置，同一凭证的并发上传只有先确认者生效，失败者仅删除自己写入的内容
+func (h *HTTPHandler) uploadAttachment(c *gin.Context) {
+	username := middleware.MustGetUsername(c)
+	attachmentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || attachmentID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid attachment id"})
+		return
+	}
+	ticket := c.Query("ticket")
+	ctx := c.Request.Context()
+
+	ticketResp, err := h.logicClient.ValidateUploadTicket(ctx, &logicv1.ValidateUploadTicketRequest{
+		Username:     username,
+		AttachmentId: attachmentID,
+		Ticket:       ticket,
+	})
+	if err != nil {
+		h.logger.Warn("validate upload ticket failed", clog.Int64("attachment_id", attachmentID), clog.Error(err))
+		writeGRPCError(c, err)
+		return
+	}
+
+	// 超出申请凭证时声明的大小即中止写入
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, ticketResp.Size)
+	hasher := sha256.New()
+	counter := &countingWriter{}
+	sniff := &sniffWriter{}
+	if err := h.blobStore.Put(ctx, ticketResp.StorageKey, io.TeeReader(body, io.MultiWriter(hasher, counter, sniff))); err != nil {
+		h.logger.Error("write attachment failed", clog.Int64("attachment_id", attachmentID), clog.Error(err))
+		_ = h.blobStore.Delete(ctx, ticketResp.StorageKey)
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "attachment exceeds declared size"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to store attachment"})
+		return
+	}
+
+	mimeType := c.ContentType()
+	if mimeType == "" || mimeType == "application/octet-stream" {
+		mimeType = http.DetectContentType(sniff.buf)
+	}
+
+	completeResp, err := h.logicClient.CompleteUpload(ctx, &logicv1.CompleteUploadRequest{
+		Username:     username,
+		AttachmentId: attachmentID,
+		Ticket:       ticket,
+		Size:         counter.n,
+		MimeType:     mimeType,
+		Checksum:     hex.EncodeToString(hasher.Sum(nil)),
+		StorageKey:   ticketResp.StorageKey,
+	})
+	if err != nil {
+		h.logger.Warn("complete upload failed", clog.Int64("attachment_id", attachmentID), clog.Error(err))
+		if delErr := h.blobStore.Delete(ctx, ticketResp.StorageKey); delErr != nil {
+			h.logger.Error("delete attachment failed", clog.Int64("attachment_id", attachmentID), clog.Error(delErr))
+		}
+		writeGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, attachmentJSON(completeResp.Attachment))
+}
+
+// downloadAttachment 下载附件内容
+func (h *HTTPHandler) downloadAttachment(c *gin.Context) {
+	username := middleware.MustGetUsername(c)
+	attachmentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || attachmentID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid attachment id"})
+		return
+	}
+	ctx := c.Request.Context()
+
+	resp, err := h.logicClient.GetAttachment(ctx, &logicv1.GetAttachmentRequest{
+		Username:     username,
+		AttachmentId: attachmentID,
+	})
+	if err != nil {
+		h.logger.Warn("get attachment failed", clog.Int64("attachment_id", attachmentID), clog.Error(err))
+		writeGRPCError(c, err)
+		return
+	}
+	att := resp.Attachment
+
+	reader, err := h.blobStore.Open(ctx, att.StorageKey)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "attachment not found"})
+			return
+		}
+		h.logger.Error("open attachment failed", clog.Int64("attachment_id", attachmentID), clog.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read attachment"})
+		return
+	}
+	defer reader.Close()
+
+	mimeType := att.MimeType
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+	c.Header("Content-Type", mimeType)
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": att.FileName}))
+	c.Header("ETag", `"`+att.Checksum+`"`)
+	c.Header("X-Content-Type-Options", "nosniff")
+	// 附件内容不可变，ServeContent 负责 Range 与 If-None-Match 处理
+	http.ServeContent(c.Writer, c.Request, "", time.Unix(att.CreatedAt, 0), reader)
+}
+
+// attachmentJSON 附件元数据的 JSON 表示（不暴露存储路径）
+func attachmentJSON(a *logicv1.Attachment) gin.H {
+	return gin.H{
+		"attachment_id": strconv.FormatInt(a.AttachmentId, 10),
+		"session_id":    a.SessionId,
+		"file_name":     a.FileName,
+		"mime_type":     a.MimeType,
+		"size":          a.Size,
+		"checksum":      a.Checksum,
+		"created_at":    a.CreatedAt,
+	}
+}
+
+// writeGRPCError 将 Logic 返回的 gRPC 错误映射为 HTTP 状态码
+func writeGRPCError(c *gin.Context, err error) {
+	st, _ := status.FromError(err)
+	code := http.StatusInternalServerError
+	switch st.Code() {
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.PermissionDenied:
+		code = http.StatusForbidden
+	case codes.FailedPrecondition, codes.AlreadyExists:
+		code = http.StatusConflict
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+	}
+	c.JSON(code, gin.H{"error": st.Message()})
+}
+
+// countingWriter 统计写入字节数
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// sniffWriter 保留前 sniffLen 字节用于探测 MIME 类型
+type sniffWriter struct {
+	buf []byte
+}
+
+func (w *sniffWriter) Write(p []byte) (int, error) {
+	if remain := sniffLen - len(w.buf); remain > 0 {
+		if len(p) < remain {
+			remain = len(p)
+		}
+		w.buf = append(w.buf, p[:remain]...)
+	}
+	return len(p), nil
+}
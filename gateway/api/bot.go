@@ -0,0 +1,193 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/gateway/middleware"
+	"github.com/ceyewan/resonance/pkg/errcode"
+	"github.com/gin-gonic/gin"
+)
+
+// postBotMessageRequest 机器人发消息请求体
+// ID 类字段以字符串传递，避免 JavaScript 等客户端丢失 int64 精度
+type postBotMessageRequest struct {
+	SessionID    string   `json:"session_id"`
+	Content      string   `json:"content"`
+	Type         string   `json:"type"`
+	ClientMsgID  string   `json:"client_msg_id"`
+	ReplyToMsgID string   `json:"reply_to_msg_id"`
+	ThreadRootID string   `json:"thread_root_id"`
+	Mentions     []string `json:"mentions"`
+}
+
+// registerBotCommandRequest 注册机器人命令请求体
+type registerBotCommandRequest struct {
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	Secret      string `json:"secret"`
+}
+
+// registerBotRoutes 注册机器人接口路由（机器人 API Token 认证）
+// POST /bot/v1/messages 以机器人身份向其所在会话发消息，复用 ChatService.SendMessage，Outbox、信箱与推送链路保持不变
+// /bot/v1/commands 管理机器人的斜杠命令：成员在机器人所在会话输入 /name 时，Logic 签名回调注册的 URL
+func (h *HTTPHandler) registerBotRoutes(group *gin.RouterGroup) {
+	group.POST("/bot/v1/messages", h.postBotMessage)
+	group.GET("/bot/v1/commands", h.listBotCommands)
+	group.PUT("/bot/v1/commands/:name", h.registerBotCommand)
+	group.DELETE("/bot/v1/commands/:name", h.deleteBotCommand)
+}
+
+// postBotMessage 机器人发送消息
+func (h *HTTPHandler) postBotMessage(c *gin.Context) {
+	username := middleware.MustGetUsername(c)
+
+	var req postBotMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+	if req.SessionID == "" || req.Content == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "session_id and content are required"})
+		return
+	}
+	if req.Type == "" {
+		req.Type = "text"
+	}
+	replyTo, err := parseOptionalID(req.ReplyToMsgID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid reply_to_msg_id"})
+		return
+	}
+	threadRoot, err := parseOptionalID(req.ThreadRootID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid thread_root_id"})
+		return
+	}
+
+	resp, err := h.logicClient.SendBotMessage(c.Request.Context(), &logicv1.SendMessageRequest{
+		SessionId:    req.SessionID,
+		FromUsername: username,
+		Content:      req.Content,
+		Type:         req.Type,
+		Timestamp:    time.Now().Unix(),
+		ReplyToMsgId: replyTo,
+		ThreadRootId: threadRoot,
+		Mentions:     req.Mentions,
+		ClientMsgId:  req.ClientMsgID,
+	})
+	if err != nil {
+		h.logger.Warn("bot send message failed", clog.String("bot", username), clog.Error(err))
+		writeGRPCError(c, err)
+		return
+	}
+	// 业务错误（非会话成员、内容校验失败等）通过 Error 字段返回
+	if resp.Error != "" {
+		c.JSON(sendErrorStatus(resp.ErrorCode), gin.H{"error": resp.Error})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"msg_id": strconv.FormatInt(resp.MsgId, 10),
+		"seq_id": resp.SeqId,
+	})
+}
+
+// registerBotCommand 注册或更新机器人的斜杠命令
+func (h *HTTPHandler) registerBotCommand(c *gin.Context) {
+	username := middleware.MustGetUsername(c)
+
+	var req registerBotCommandRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	resp, err := h.logicClient.RegisterBotCommand(c.Request.Context(), &logicv1.RegisterBotCommandRequest{
+		BotUsername: username,
+		Name:        c.Param("name"),
+		Description: req.Description,
+		Url:         req.URL,
+		Secret:      req.Secret,
+	})
+	if err != nil {
+		h.logger.Warn("register bot command failed", clog.String("bot", username), clog.Error(err))
+		writeGRPCError(c, err)
+		return
+	}
+
+	body := botCommandJSON(resp.Command)
+	body["secret"] = resp.Secret
+	c.JSON(http.StatusOK, body)
+}
+
+// deleteBotCommand 删除机器人的斜杠命令
+func (h *HTTPHandler) deleteBotCommand(c *gin.Context) {
+	username := middleware.MustGetUsername(c)
+
+	if _, err := h.logicClient.DeleteBotCommand(c.Request.Context(), &logicv1.DeleteBotCommandRequest{
+		BotUsername: username,
+		Name:        c.Param("name"),
+	}); err != nil {
+		h.logger.Warn("delete bot command failed", clog.String("bot", username), clog.Error(err))
+		writeGRPCError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// listBotCommands 列出机器人注册的斜杠命令
+func (h *HTTPHandler) listBotCommands(c *gin.Context) {
+	username := middleware.MustGetUsername(c)
+
+	resp, err := h.logicClient.ListBotCommands(c.Request.Context(), &logicv1.ListBotCommandsRequest{
+		BotUsername: username,
+	})
+	if err != nil {
+		h.logger.Warn("list bot commands failed", clog.String("bot", username), clog.Error(err))
+		writeGRPCError(c, err)
+		return
+	}
+
+	commands := make([]gin.H, 0, len(resp.Commands))
+	for _, cmd := range resp.Commands {
+		commands = append(commands, botCommandJSON(cmd))
+	}
+	c.JSON(http.StatusOK, gin.H{"commands": commands})
+}
+
+// botCommandJSON 机器人命令的 JSON 表示（不包含签名密钥）
+func botCommandJSON(cmd *logicv1.BotCommand) gin.H {
+	return gin.H{
+		"name":        cmd.Name,
+		"description": cmd.Description,
+		"url":         cmd.Url,
+		"created_at":  cmd.CreatedAt,
+	}
+}
+
+// sendErrorStatus 将 SendMessageResponse.ErrorCode 映射为 HTTP 状态码，未携带错误码的业务错误视为请求无效
+func sendErrorStatus(code string) int {
+	switch code {
+	case errcode.NotSessionMember, errcode.PermissionDenied, errcode.Muted:
+		return http.StatusForbidden
+	case errcode.InProgress:
+		return http.StatusConflict
+	case errcode.InvalidArgument:
+		return http.StatusBadRequest
+	case errcode.Internal, errcode.ModerationFailed, errcode.CommandFailed:
+		return http.StatusInternalServerError
+	}
+	return http.StatusBadRequest
+}
+
+// parseOptionalID 解析可选的字符串 ID，空串视为 0
+func parseOptionalID(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
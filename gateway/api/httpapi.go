@@ -9,6 +9,7 @@ import (
 	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
 	"github.com/ceyewan/resonance/gateway/client"
 	"github.com/ceyewan/resonance/gateway/middleware"
+	"github.com/ceyewan/resonance/pkg/blobstore"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,14 +18,16 @@ type HTTPHandler struct {
 	logicClient *client.Client
 	logger      clog.Logger
 	authConfig  *middleware.AuthConfig
+	blobStore   blobstore.BlobStore // 附件内容存储（为 nil 时不注册附件路由）
 }
 
 // NewHTTPHandler 创建 API Handler
-func NewHTTPHandler(logicClient *client.Client, logger clog.Logger) *HTTPHandler {
+func NewHTTPHandler(logicClient *client.Client, blobStore blobstore.BlobStore, logger clog.Logger) *HTTPHandler {
 	return &HTTPHandler{
 		logicClient: logicClient,
 		logger:      logger,
 		authConfig:  middleware.NewAuthConfig(logicClient, logger),
+		blobStore:   blobStore,
 	}
 }
 
@@ -134,13 +137,16 @@ func (h *HTTPHandler) GetSessionList(
 	sessions := make([]*gatewayv1.SessionInfo, len(logicResp.Sessions))
 	for i, s := range logicResp.Sessions {
 		sessions[i] = &gatewayv1.SessionInfo{
-			SessionId:   s.SessionId,
-			Name:        s.Name,
-			Type:        s.Type,
-			AvatarUrl:   s.AvatarUrl,
-			UnreadCount: s.UnreadCount,
-			LastReadSeq: s.LastReadSeq,
-			LastMessage: s.LastMessage,
+			SessionId:    s.SessionId,
+			Name:         s.Name,
+			Type:         s.Type,
+			AvatarUrl:    s.AvatarUrl,
+			UnreadCount:  s.UnreadCount,
+			LastReadSeq:  s.LastReadSeq,
+			LastMessage:  s.LastMessage,
+			MentionCount: s.MentionCount,
+			MessageTtl:   s.MessageTtl,
+			DoNotDisturb: s.DoNotDisturb,
 		}
 	}
 
@@ -339,3 +345,1063 @@ func (h *HTTPHandler) PullInboxDelta(
 		HasMore:      logicResp.HasMore,
 	}), nil
 }
+
+// RecallMessage 实现 SessionService.RecallMessage
+func (h *HTTPHandler) RecallMessage(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.RecallMessageRequest],
+) (*connect.Response[gatewayv1.RecallMessageResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.RecallMessageRequest{
+		SessionId:        req.Msg.SessionId,
+		MsgId:            req.Msg.MsgId,
+		OperatorUsername: username,
+	}
+
+	if _, err := h.logicClient.RecallMessage(ctx, logicReq); err != nil {
+		h.logger.Error("recall message failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.RecallMessageResponse{}), nil
+}
+
+// EditMessage 实现 SessionService.EditMessage
+func (h *HTTPHandler) EditMessage(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.EditMessageRequest],
+) (*connect.Response[gatewayv1.EditMessageResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.EditMessageRequest{
+		SessionId:        req.Msg.SessionId,
+		MsgId:            req.Msg.MsgId,
+		OperatorUsername: username,
+		Content:          req.Msg.Content,
+	}
+
+	resp, err := h.logicClient.EditMessage(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("edit message failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.EditMessageResponse{
+		Version:  resp.Version,
+		EditedAt: resp.EditedAt,
+	}), nil
+}
+
+// GetThreadMessages 实现 SessionService.GetThreadMessages
+func (h *HTTPHandler) GetThreadMessages(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.GetThreadMessagesRequest],
+) (*connect.Response[gatewayv1.GetThreadMessagesResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.GetThreadMessagesRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		RootMsgId: req.Msg.RootMsgId,
+		AfterSeq:  req.Msg.AfterSeq,
+		Limit:     req.Msg.Limit,
+	}
+
+	logicResp, err := h.logicClient.GetThreadMessages(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("get thread messages failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.GetThreadMessagesResponse{
+		Root:      logicResp.Root,
+		Messages:  logicResp.Messages,
+		Following: logicResp.Following,
+	}), nil
+}
+
+// FollowThread 实现 SessionService.FollowThread
+func (h *HTTPHandler) FollowThread(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.FollowThreadRequest],
+) (*connect.Response[gatewayv1.FollowThreadResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.FollowThreadRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		RootMsgId: req.Msg.RootMsgId,
+	}
+
+	if _, err := h.logicClient.FollowThread(ctx, logicReq); err != nil {
+		h.logger.Error("follow thread failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.FollowThreadResponse{}), nil
+}
+
+// UnfollowThread 实现 SessionService.UnfollowThread
+func (h *HTTPHandler) UnfollowThread(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.UnfollowThreadRequest],
+) (*connect.Response[gatewayv1.UnfollowThreadResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.UnfollowThreadRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		RootMsgId: req.Msg.RootMsgId,
+	}
+
+	if _, err := h.logicClient.UnfollowThread(ctx, logicReq); err != nil {
+		h.logger.Error("unfollow thread failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.UnfollowThreadResponse{}), nil
+}
+
+// AddReaction 实现 SessionService.AddReaction
+func (h *HTTPHandler) AddReaction(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.AddReactionRequest],
+) (*connect.Response[gatewayv1.AddReactionResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.AddReactionRequest{
+		SessionId:        req.Msg.SessionId,
+		MsgId:            req.Msg.MsgId,
+		OperatorUsername: username,
+		Emoji:            req.Msg.Emoji,
+	}
+
+	resp, err := h.logicClient.AddReaction(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("add reaction failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.AddReactionResponse{
+		Reactions: resp.Reactions,
+	}), nil
+}
+
+// RemoveReaction 实现 SessionService.RemoveReaction
+func (h *HTTPHandler) RemoveReaction(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.RemoveReactionRequest],
+) (*connect.Response[gatewayv1.RemoveReactionResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.RemoveReactionRequest{
+		SessionId:        req.Msg.SessionId,
+		MsgId:            req.Msg.MsgId,
+		OperatorUsername: username,
+		Emoji:            req.Msg.Emoji,
+	}
+
+	resp, err := h.logicClient.RemoveReaction(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("remove reaction failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.RemoveReactionResponse{
+		Reactions: resp.Reactions,
+	}), nil
+}
+
+// CastVote 实现 SessionService.CastVote
+func (h *HTTPHandler) CastVote(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.CastVoteRequest],
+) (*connect.Response[gatewayv1.CastVoteResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.CastVoteRequest{
+		SessionId:     req.Msg.SessionId,
+		MsgId:         req.Msg.MsgId,
+		VoterUsername: username,
+		Options:       req.Msg.Options,
+	}
+
+	resp, err := h.logicClient.CastVote(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("cast vote failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.CastVoteResponse{
+		Tally: resp.Tally,
+	}), nil
+}
+
+// ClosePoll 实现 SessionService.ClosePoll
+func (h *HTTPHandler) ClosePoll(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.ClosePollRequest],
+) (*connect.Response[gatewayv1.ClosePollResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.ClosePollRequest{
+		SessionId:        req.Msg.SessionId,
+		MsgId:            req.Msg.MsgId,
+		OperatorUsername: username,
+	}
+
+	resp, err := h.logicClient.ClosePoll(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("close poll failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.ClosePollResponse{
+		Tally: resp.Tally,
+	}), nil
+}
+
+// GetMessageReadStatus 实现 SessionService.GetMessageReadStatus
+func (h *HTTPHandler) GetMessageReadStatus(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.GetMessageReadStatusRequest],
+) (*connect.Response[gatewayv1.GetMessageReadStatusResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.GetMessageReadStatusRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		MsgId:     req.Msg.MsgId,
+	}
+
+	logicResp, err := h.logicClient.GetMessageReadStatus(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("get message read status failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.GetMessageReadStatusResponse{
+		ReadUsernames:   logicResp.ReadUsernames,
+		UnreadUsernames: logicResp.UnreadUsernames,
+	}), nil
+}
+
+// ForwardMessages 实现 SessionService.ForwardMessages
+func (h *HTTPHandler) ForwardMessages(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.ForwardMessagesRequest],
+) (*connect.Response[gatewayv1.ForwardMessagesResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.ForwardMessagesRequest{
+		OperatorUsername: username,
+		SourceSessionId:  req.Msg.SourceSessionId,
+		MsgIds:           req.Msg.MsgIds,
+		TargetSessionIds: req.Msg.TargetSessionIds,
+		Mode:             req.Msg.Mode,
+	}
+
+	logicResp, err := h.logicClient.ForwardMessages(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("forward messages failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.ForwardMessagesResponse{
+		Results: logicResp.Results,
+	}), nil
+}
+
+// PinMessage 实现 SessionService.PinMessage
+func (h *HTTPHandler) PinMessage(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.PinMessageRequest],
+) (*connect.Response[gatewayv1.PinMessageResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.PinMessageRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		MsgId:     req.Msg.MsgId,
+	}
+
+	if _, err := h.logicClient.PinMessage(ctx, logicReq); err != nil {
+		h.logger.Error("pin message failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.PinMessageResponse{}), nil
+}
+
+// UnpinMessage 实现 SessionService.UnpinMessage
+func (h *HTTPHandler) UnpinMessage(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.UnpinMessageRequest],
+) (*connect.Response[gatewayv1.UnpinMessageResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.UnpinMessageRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		MsgId:     req.Msg.MsgId,
+	}
+
+	if _, err := h.logicClient.UnpinMessage(ctx, logicReq); err != nil {
+		h.logger.Error("unpin message failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.UnpinMessageResponse{}), nil
+}
+
+// ListPinnedMessages 实现 SessionService.ListPinnedMessages
+func (h *HTTPHandler) ListPinnedMessages(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.ListPinnedMessagesRequest],
+) (*connect.Response[gatewayv1.ListPinnedMessagesResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.ListPinnedMessagesRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+	}
+
+	logicResp, err := h.logicClient.ListPinnedMessages(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("list pinned messages failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.ListPinnedMessagesResponse{
+		Pins: logicResp.Pins,
+	}), nil
+}
+
+// SetMessageTTL 实现 SessionService.SetMessageTTL
+func (h *HTTPHandler) SetMessageTTL(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.SetMessageTTLRequest],
+) (*connect.Response[gatewayv1.SetMessageTTLResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.SetMessageTTLRequest{
+		Username:   username,
+		SessionId:  req.Msg.SessionId,
+		TtlSeconds: req.Msg.TtlSeconds,
+	}
+
+	if _, err := h.logicClient.SetMessageTTL(ctx, logicReq); err != nil {
+		h.logger.Error("set message ttl failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.SetMessageTTLResponse{}), nil
+}
+
+// SearchMessages 实现 SessionService.SearchMessages
+func (h *HTTPHandler) SearchMessages(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.SearchMessagesRequest],
+) (*connect.Response[gatewayv1.SearchMessagesResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.SearchMessagesRequest{
+		Username:       username,
+		Query:          req.Msg.Query,
+		SessionId:      req.Msg.SessionId,
+		SenderUsername: req.Msg.SenderUsername,
+		MsgType:        req.Msg.MsgType,
+		StartTime:      req.Msg.StartTime,
+		EndTime:        req.Msg.EndTime,
+		Cursor:         req.Msg.Cursor,
+		Limit:          req.Msg.Limit,
+	}
+
+	logicResp, err := h.logicClient.SearchMessages(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("search messages failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.SearchMessagesResponse{
+		Results:    logicResp.Results,
+		NextCursor: logicResp.NextCursor,
+		HasMore:    logicResp.HasMore,
+	}), nil
+}
+
+// DeleteMessagesForMe 实现 SessionService.DeleteMessagesForMe
+func (h *HTTPHandler) DeleteMessagesForMe(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.DeleteMessagesForMeRequest],
+) (*connect.Response[gatewayv1.DeleteMessagesForMeResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.DeleteMessagesForMeRequest{
+		Username:     username,
+		SessionId:    req.Msg.SessionId,
+		MsgIds:       req.Msg.MsgIds,
+		ClearUpToSeq: req.Msg.ClearUpToSeq,
+	}
+
+	logicResp, err := h.logicClient.DeleteMessagesForMe(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("delete messages for me failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.DeleteMessagesForMeResponse{
+		ClearedSeq: logicResp.ClearedSeq,
+	}), nil
+}
+
+// AddMembers 实现 SessionService.AddMembers
+func (h *HTTPHandler) AddMembers(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.AddMembersRequest],
+) (*connect.Response[gatewayv1.AddMembersResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.AddMembersRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		Members:   req.Msg.Members,
+	}
+
+	logicResp, err := h.logicClient.AddMembers(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("add members failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.AddMembersResponse{
+		Added: logicResp.Added,
+	}), nil
+}
+
+// RemoveMember 实现 SessionService.RemoveMember
+func (h *HTTPHandler) RemoveMember(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.RemoveMemberRequest],
+) (*connect.Response[gatewayv1.RemoveMemberResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.RemoveMemberRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		Member:    req.Msg.Member,
+	}
+
+	if _, err := h.logicClient.RemoveMember(ctx, logicReq); err != nil {
+		h.logger.Error("remove member failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.RemoveMemberResponse{}), nil
+}
+
+// LeaveSession 实现 SessionService.LeaveSession
+func (h *HTTPHandler) LeaveSession(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.LeaveSessionRequest],
+) (*connect.Response[gatewayv1.LeaveSessionResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.LeaveSessionRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+	}
+
+	if _, err := h.logicClient.LeaveSession(ctx, logicReq); err != nil {
+		h.logger.Error("leave session failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.LeaveSessionResponse{}), nil
+}
+
+// RenameSession 实现 SessionService.RenameSession
+func (h *HTTPHandler) RenameSession(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.RenameSessionRequest],
+) (*connect.Response[gatewayv1.RenameSessionResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.RenameSessionRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		Name:      req.Msg.Name,
+	}
+
+	if _, err := h.logicClient.RenameSession(ctx, logicReq); err != nil {
+		h.logger.Error("rename session failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.RenameSessionResponse{}), nil
+}
+
+// PromoteAdmin 实现 SessionService.PromoteAdmin
+func (h *HTTPHandler) PromoteAdmin(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.PromoteAdminRequest],
+) (*connect.Response[gatewayv1.PromoteAdminResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.PromoteAdminRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		Member:    req.Msg.Member,
+	}
+
+	if _, err := h.logicClient.PromoteAdmin(ctx, logicReq); err != nil {
+		h.logger.Error("promote admin failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.PromoteAdminResponse{}), nil
+}
+
+// DemoteAdmin 实现 SessionService.DemoteAdmin
+func (h *HTTPHandler) DemoteAdmin(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.DemoteAdminRequest],
+) (*connect.Response[gatewayv1.DemoteAdminResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.DemoteAdminRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		Member:    req.Msg.Member,
+	}
+
+	if _, err := h.logicClient.DemoteAdmin(ctx, logicReq); err != nil {
+		h.logger.Error("demote admin failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.DemoteAdminResponse{}), nil
+}
+
+// TransferOwnership 实现 SessionService.TransferOwnership
+func (h *HTTPHandler) TransferOwnership(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.TransferOwnershipRequest],
+) (*connect.Response[gatewayv1.TransferOwnershipResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.TransferOwnershipRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		Member:    req.Msg.Member,
+	}
+
+	if _, err := h.logicClient.TransferOwnership(ctx, logicReq); err != nil {
+		h.logger.Error("transfer ownership failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.TransferOwnershipResponse{}), nil
+}
+
+// MuteMember 实现 SessionService.MuteMember
+func (h *HTTPHandler) MuteMember(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.MuteMemberRequest],
+) (*connect.Response[gatewayv1.MuteMemberResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.MuteMemberRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		Member:    req.Msg.Member,
+		Duration:  req.Msg.Duration,
+	}
+
+	logicResp, err := h.logicClient.MuteMember(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("mute member failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.MuteMemberResponse{
+		MutedUntil: logicResp.MutedUntil,
+	}), nil
+}
+
+// UnmuteMember 实现 SessionService.UnmuteMember
+func (h *HTTPHandler) UnmuteMember(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.UnmuteMemberRequest],
+) (*connect.Response[gatewayv1.UnmuteMemberResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.UnmuteMemberRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		Member:    req.Msg.Member,
+	}
+
+	if _, err := h.logicClient.UnmuteMember(ctx, logicReq); err != nil {
+		h.logger.Error("unmute member failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.UnmuteMemberResponse{}), nil
+}
+
+// SetDoNotDisturb 实现 SessionService.SetDoNotDisturb
+func (h *HTTPHandler) SetDoNotDisturb(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.SetDoNotDisturbRequest],
+) (*connect.Response[gatewayv1.SetDoNotDisturbResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.SetDoNotDisturbRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		Enabled:   req.Msg.Enabled,
+	}
+
+	if _, err := h.logicClient.SetDoNotDisturb(ctx, logicReq); err != nil {
+		h.logger.Error("set do not disturb failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.SetDoNotDisturbResponse{}), nil
+}
+
+// ScheduleMessage 实现 SessionService.ScheduleMessage
+func (h *HTTPHandler) ScheduleMessage(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.ScheduleMessageRequest],
+) (*connect.Response[gatewayv1.ScheduleMessageResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.ScheduleMessageRequest{
+		Username:     username,
+		SessionId:    req.Msg.SessionId,
+		Content:      req.Msg.Content,
+		Type:         req.Msg.Type,
+		Body:         req.Msg.Body,
+		ReplyToMsgId: req.Msg.ReplyToMsgId,
+		ThreadRootId: req.Msg.ThreadRootId,
+		Mentions:     req.Msg.Mentions,
+		MentionAll:   req.Msg.MentionAll,
+		ScheduledAt:  req.Msg.ScheduledAt,
+	}
+
+	logicResp, err := h.logicClient.ScheduleMessage(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("schedule message failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.ScheduleMessageResponse{
+		Scheduled: logicResp.Scheduled,
+	}), nil
+}
+
+// ListScheduledMessages 实现 SessionService.ListScheduledMessages
+func (h *HTTPHandler) ListScheduledMessages(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.ListScheduledMessagesRequest],
+) (*connect.Response[gatewayv1.ListScheduledMessagesResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.ListScheduledMessagesRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+	}
+
+	logicResp, err := h.logicClient.ListScheduledMessages(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("list scheduled messages failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.ListScheduledMessagesResponse{
+		Scheduled: logicResp.Scheduled,
+	}), nil
+}
+
+// UpdateScheduledMessage 实现 SessionService.UpdateScheduledMessage
+func (h *HTTPHandler) UpdateScheduledMessage(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.UpdateScheduledMessageRequest],
+) (*connect.Response[gatewayv1.UpdateScheduledMessageResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.UpdateScheduledMessageRequest{
+		Username:    username,
+		ScheduleId:  req.Msg.ScheduleId,
+		Content:     req.Msg.Content,
+		Type:        req.Msg.Type,
+		Body:        req.Msg.Body,
+		Mentions:    req.Msg.Mentions,
+		MentionAll:  req.Msg.MentionAll,
+		ScheduledAt: req.Msg.ScheduledAt,
+	}
+
+	logicResp, err := h.logicClient.UpdateScheduledMessage(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("update scheduled message failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.UpdateScheduledMessageResponse{
+		Scheduled: logicResp.Scheduled,
+	}), nil
+}
+
+// CancelScheduledMessage 实现 SessionService.CancelScheduledMessage
+func (h *HTTPHandler) CancelScheduledMessage(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.CancelScheduledMessageRequest],
+) (*connect.Response[gatewayv1.CancelScheduledMessageResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.CancelScheduledMessageRequest{
+		Username:   username,
+		ScheduleId: req.Msg.ScheduleId,
+	}
+
+	if _, err := h.logicClient.CancelScheduledMessage(ctx, logicReq); err != nil {
+		h.logger.Error("cancel scheduled message failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.CancelScheduledMessageResponse{}), nil
+}
+
+// Announce 实现 SessionService.Announce
+func (h *HTTPHandler) Announce(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.AnnounceRequest],
+) (*connect.Response[gatewayv1.AnnounceResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.AnnounceRequest{
+		Username: username,
+		Title:    req.Msg.Title,
+		Content:  req.Msg.Content,
+	}
+
+	resp, err := h.logicClient.Announce(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("announce failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.AnnounceResponse{
+		Announcement: resp.Announcement,
+	}), nil
+}
+
+// ListAnnouncements 实现 SessionService.ListAnnouncements
+func (h *HTTPHandler) ListAnnouncements(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.ListAnnouncementsRequest],
+) (*connect.Response[gatewayv1.ListAnnouncementsResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.ListAnnouncementsRequest{
+		Username: username,
+		AfterId:  req.Msg.AfterId,
+		Limit:    req.Msg.Limit,
+	}
+
+	resp, err := h.logicClient.ListAnnouncements(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("list announcements failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.ListAnnouncementsResponse{
+		Announcements: resp.Announcements,
+	}), nil
+}
+
+// CreateWebhook 实现 SessionService.CreateWebhook
+func (h *HTTPHandler) CreateWebhook(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.CreateWebhookRequest],
+) (*connect.Response[gatewayv1.CreateWebhookResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.CreateWebhookRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+		Url:       req.Msg.Url,
+		Secret:    req.Msg.Secret,
+		Events:    req.Msg.Events,
+	}
+
+	resp, err := h.logicClient.CreateWebhook(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("create webhook failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.CreateWebhookResponse{
+		Webhook: resp.Webhook,
+		Secret:  resp.Secret,
+	}), nil
+}
+
+// ListWebhooks 实现 SessionService.ListWebhooks
+func (h *HTTPHandler) ListWebhooks(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.ListWebhooksRequest],
+) (*connect.Response[gatewayv1.ListWebhooksResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.ListWebhooksRequest{
+		Username:  username,
+		SessionId: req.Msg.SessionId,
+	}
+
+	resp, err := h.logicClient.ListWebhooks(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("list webhooks failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.ListWebhooksResponse{
+		Webhooks: resp.Webhooks,
+	}), nil
+}
+
+// DeleteWebhook 实现 SessionService.DeleteWebhook
+func (h *HTTPHandler) DeleteWebhook(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.DeleteWebhookRequest],
+) (*connect.Response[gatewayv1.DeleteWebhookResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.DeleteWebhookRequest{
+		Username:  username,
+		WebhookId: req.Msg.WebhookId,
+	}
+
+	if _, err := h.logicClient.DeleteWebhook(ctx, logicReq); err != nil {
+		h.logger.Error("delete webhook failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.DeleteWebhookResponse{}), nil
+}
+
+// ListWebhookDeliveries 实现 SessionService.ListWebhookDeliveries
+func (h *HTTPHandler) ListWebhookDeliveries(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.ListWebhookDeliveriesRequest],
+) (*connect.Response[gatewayv1.ListWebhookDeliveriesResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.ListWebhookDeliveriesRequest{
+		Username:  username,
+		WebhookId: req.Msg.WebhookId,
+		BeforeId:  req.Msg.BeforeId,
+		Limit:     req.Msg.Limit,
+	}
+
+	resp, err := h.logicClient.ListWebhookDeliveries(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("list webhook deliveries failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.ListWebhookDeliveriesResponse{
+		Deliveries: resp.Deliveries,
+	}), nil
+}
+
+// CreateBot 实现 SessionService.CreateBot
+func (h *HTTPHandler) CreateBot(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.CreateBotRequest],
+) (*connect.Response[gatewayv1.CreateBotResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.CreateBotRequest{
+		OwnerUsername: username,
+		Username:      req.Msg.Username,
+		Nickname:      req.Msg.Nickname,
+	}
+
+	resp, err := h.logicClient.CreateBot(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("create bot failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.CreateBotResponse{
+		Bot:   resp.Bot,
+		Token: resp.Token,
+	}), nil
+}
+
+// ListBots 实现 SessionService.ListBots
+func (h *HTTPHandler) ListBots(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.ListBotsRequest],
+) (*connect.Response[gatewayv1.ListBotsResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.logicClient.ListBots(ctx, &logicv1.ListBotsRequest{
+		OwnerUsername: username,
+	})
+	if err != nil {
+		h.logger.Error("list bots failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.ListBotsResponse{
+		Bots: resp.Bots,
+	}), nil
+}
+
+// RotateBotToken 实现 SessionService.RotateBotToken
+func (h *HTTPHandler) RotateBotToken(
+	ctx context.Context,
+	req *connect.Request[gatewayv1.RotateBotTokenRequest],
+) (*connect.Response[gatewayv1.RotateBotTokenResponse], error) {
+	username, err := h.getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logicReq := &logicv1.RotateBotTokenRequest{
+		OwnerUsername: username,
+		Username:      req.Msg.Username,
+	}
+
+	resp, err := h.logicClient.RotateBotToken(ctx, logicReq)
+	if err != nil {
+		h.logger.Error("rotate bot token failed", clog.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gatewayv1.RotateBotTokenResponse{
+		Token: resp.Token,
+	}), nil
+}
@@ -110,6 +110,27 @@ func (h *HTTPHandler) RegisterRoutes(router *gin.Engine, opts ...RouteOption) {
 
 	// 注册需要认证的路由
 	h.registerAuthRoutes(authGroup)
+
+	// 创建机器人路由组（机器人 API Token 认证）
+	botGroup := router.Group("")
+	if cfg.RecoveryMiddleware != nil {
+		botGroup.Use(cfg.RecoveryMiddleware)
+	}
+	if cfg.LoggerMiddleware != nil {
+		botGroup.Use(cfg.LoggerMiddleware)
+	}
+	if cfg.SlowQueryMiddleware != nil {
+		botGroup.Use(cfg.SlowQueryMiddleware)
+	}
+	if cfg.GlobalRateLimitMiddleware != nil {
+		botGroup.Use(cfg.GlobalRateLimitMiddleware)
+	}
+	botGroup.Use(h.authConfig.RequireBotAuth())
+	if cfg.UserRateLimitMiddleware != nil {
+		botGroup.Use(cfg.UserRateLimitMiddleware)
+	}
+
+	h.registerBotRoutes(botGroup)
 }
 
 // registerPublicRoutes 注册公开路由（不需要认证）
@@ -124,4 +145,7 @@ func (h *HTTPHandler) registerAuthRoutes(group *gin.RouterGroup) {
 	// SessionService: 所有接口都需要认证
 	path, handler := gatewayv1connect.NewSessionServiceHandler(h)
 	group.Any(path+"*any", gin.WrapH(handler))
+
+	// 附件上传/下载
+	h.registerAttachmentRoutes(group)
 }
@@ -25,10 +25,15 @@ type Client struct {
 	conn *grpc.ClientConn
 
 	// gRPC 原始客户端
-	authClient     logicv1.AuthServiceClient
-	sessionClient  logicv1.SessionServiceClient
-	chatClient     logicv1.ChatServiceClient
-	presenceClient logicv1.PresenceServiceClient
+	authClient       logicv1.AuthServiceClient
+	sessionClient    logicv1.SessionServiceClient
+	chatClient       logicv1.ChatServiceClient
+	presenceClient   logicv1.PresenceServiceClient
+	attachmentClient logicv1.AttachmentServiceClient
+	scheduleClient   logicv1.ScheduleServiceClient
+	announceClient   logicv1.AnnouncementServiceClient
+	webhookClient    logicv1.WebhookServiceClient
+	botClient        logicv1.BotServiceClient
 
 	logger        clog.Logger
 	gatewayID     string
@@ -78,6 +83,51 @@ const serviceConfigJSON = `{
 			"BackoffMultiplier": 2.0,
 			"RetryableStatusCodes": ["UNAVAILABLE"]
 		}
+	}, {
+		"name": [{"service": "logic.v1.AttachmentService"}],
+		"retryPolicy": {
+			"MaxAttempts": 4,
+			"InitialBackoff": "0.5s",
+			"MaxBackoff": "3s",
+			"BackoffMultiplier": 2.0,
+			"RetryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}, {
+		"name": [{"service": "logic.v1.ScheduleService"}],
+		"retryPolicy": {
+			"MaxAttempts": 4,
+			"InitialBackoff": "0.5s",
+			"MaxBackoff": "3s",
+			"BackoffMultiplier": 2.0,
+			"RetryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}, {
+		"name": [{"service": "logic.v1.AnnouncementService"}],
+		"retryPolicy": {
+			"MaxAttempts": 4,
+			"InitialBackoff": "0.5s",
+			"MaxBackoff": "3s",
+			"BackoffMultiplier": 2.0,
+			"RetryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}, {
+		"name": [{"service": "logic.v1.WebhookService"}],
+		"retryPolicy": {
+			"MaxAttempts": 4,
+			"InitialBackoff": "0.5s",
+			"MaxBackoff": "3s",
+			"BackoffMultiplier": 2.0,
+			"RetryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}, {
+		"name": [{"service": "logic.v1.BotService"}],
+		"retryPolicy": {
+			"MaxAttempts": 4,
+			"InitialBackoff": "0.5s",
+			"MaxBackoff": "3s",
+			"BackoffMultiplier": 2.0,
+			"RetryableStatusCodes": ["UNAVAILABLE"]
+		}
 	}]
 }`
 
@@ -117,13 +167,18 @@ func NewClient(logicServiceName, gatewayID string, logger clog.Logger, reg regis
 	logger.Info("logic client connected via service discovery", clog.String("service", logicServiceName))
 
 	client := &Client{
-		conn:           conn,
-		authClient:     logicv1.NewAuthServiceClient(conn),
-		sessionClient:  logicv1.NewSessionServiceClient(conn),
-		chatClient:     logicv1.NewChatServiceClient(conn),
-		presenceClient: logicv1.NewPresenceServiceClient(conn),
-		logger:         logger,
-		gatewayID:      gatewayID,
+		conn:             conn,
+		authClient:       logicv1.NewAuthServiceClient(conn),
+		sessionClient:    logicv1.NewSessionServiceClient(conn),
+		chatClient:       logicv1.NewChatServiceClient(conn),
+		presenceClient:   logicv1.NewPresenceServiceClient(conn),
+		attachmentClient: logicv1.NewAttachmentServiceClient(conn),
+		scheduleClient:   logicv1.NewScheduleServiceClient(conn),
+		announceClient:   logicv1.NewAnnouncementServiceClient(conn),
+		webhookClient:    logicv1.NewWebhookServiceClient(conn),
+		botClient:        logicv1.NewBotServiceClient(conn),
+		logger:           logger,
+		gatewayID:        gatewayID,
 	}
 
 	return client, nil
@@ -212,6 +267,26 @@ func (c *Client) PresenceSvc() logicv1.PresenceServiceClient {
 	return c.presenceClient
 }
 
+func (c *Client) attachmentSvc() logicv1.AttachmentServiceClient {
+	return c.attachmentClient
+}
+
+func (c *Client) scheduleSvc() logicv1.ScheduleServiceClient {
+	return c.scheduleClient
+}
+
+func (c *Client) announceSvc() logicv1.AnnouncementServiceClient {
+	return c.announceClient
+}
+
+func (c *Client) webhookSvc() logicv1.WebhookServiceClient {
+	return c.webhookClient
+}
+
+func (c *Client) botSvc() logicv1.BotServiceClient {
+	return c.botClient
+}
+
 // SetStatusBatcher 设置状态批量同步器（由 Gateway 初始化时调用）
 func (c *Client) SetStatusBatcher(batcher *StatusBatcher) {
 	c.statusBatcher = batcher
@@ -34,6 +34,26 @@ var (
 			Rate:  200,
 			Burst: 500,
 		},
+		"logic.v1.AttachmentService": {
+			Rate:  200,
+			Burst: 500,
+		},
+		"logic.v1.ScheduleService": {
+			Rate:  200,
+			Burst: 500,
+		},
+		"logic.v1.AnnouncementService": {
+			Rate:  200,
+			Burst: 500,
+		},
+		"logic.v1.WebhookService": {
+			Rate:  200,
+			Burst: 500,
+		},
+		"logic.v1.BotService": {
+			Rate:  200,
+			Burst: 500,
+		},
 	}
 
 	defaultLimiterConfig = ratelimit.Limit{
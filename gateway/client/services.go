@@ -30,7 +30,8 @@ func (c *Client) ValidateToken(ctx context.Context, token string) (*logicv1.Vali
 // ==================== ChatService 接口 ====================
 
 // SendMessage 发送消息到 Logic（Unary 调用）
-func (c *Client) SendMessage(ctx context.Context, msg *gatewayv1.ChatRequest) (*logicv1.SendMessageResponse, error) {
+// clientMsgID 为客户端生成的消息ID（WsPacket.seq），用于重试去重
+func (c *Client) SendMessage(ctx context.Context, msg *gatewayv1.ChatRequest, clientMsgID string) (*logicv1.SendMessageResponse, error) {
 	if c.chatClient == nil {
 		return nil, fmt.Errorf("chat client not initialized")
 	}
@@ -42,11 +43,90 @@ func (c *Client) SendMessage(ctx context.Context, msg *gatewayv1.ChatRequest) (*
 		Content:      msg.Content,
 		Type:         msg.Type,
 		Timestamp:    msg.Timestamp,
+		ReplyToMsgId: msg.ReplyToMsgId,
+		ThreadRootId: msg.ThreadRootId,
+		Mentions:     msg.Mentions,
+		MentionAll:   msg.MentionAll,
+		ClientMsgId:  clientMsgID,
+		Body:         msg.Body,
 	}
 
 	return c.chatClient.SendMessage(ctx, req)
 }
 
+// SendBotMessage 以机器人身份发送消息（供网关机器人接口使用），强制标记为机器人消息
+func (c *Client) SendBotMessage(ctx context.Context, req *logicv1.SendMessageRequest) (*logicv1.SendMessageResponse, error) {
+	if c.chatClient == nil {
+		return nil, fmt.Errorf("chat client not initialized")
+	}
+	req.FromBot = true
+	return c.chatClient.SendMessage(ctx, req)
+}
+
+// RecallMessage 撤回消息
+func (c *Client) RecallMessage(ctx context.Context, req *logicv1.RecallMessageRequest) (*logicv1.RecallMessageResponse, error) {
+	if c.chatClient == nil {
+		return nil, fmt.Errorf("chat client not initialized")
+	}
+	return c.chatClient.RecallMessage(ctx, req)
+}
+
+// EditMessage 编辑消息
+func (c *Client) EditMessage(ctx context.Context, req *logicv1.EditMessageRequest) (*logicv1.EditMessageResponse, error) {
+	if c.chatClient == nil {
+		return nil, fmt.Errorf("chat client not initialized")
+	}
+	return c.chatClient.EditMessage(ctx, req)
+}
+
+// AddReaction 添加表情回应
+func (c *Client) AddReaction(ctx context.Context, req *logicv1.AddReactionRequest) (*logicv1.AddReactionResponse, error) {
+	if c.chatClient == nil {
+		return nil, fmt.Errorf("chat client not initialized")
+	}
+	return c.chatClient.AddReaction(ctx, req)
+}
+
+// RemoveReaction 取消表情回应
+func (c *Client) RemoveReaction(ctx context.Context, req *logicv1.RemoveReactionRequest) (*logicv1.RemoveReactionResponse, error) {
+	if c.chatClient == nil {
+		return nil, fmt.Errorf("chat client not initialized")
+	}
+	return c.chatClient.RemoveReaction(ctx, req)
+}
+
+// CastVote 在投票消息中投票
+func (c *Client) CastVote(ctx context.Context, req *logicv1.CastVoteRequest) (*logicv1.CastVoteResponse, error) {
+	if c.chatClient == nil {
+		return nil, fmt.Errorf("chat client not initialized")
+	}
+	return c.chatClient.CastVote(ctx, req)
+}
+
+// ClosePoll 提前结束投票
+func (c *Client) ClosePoll(ctx context.Context, req *logicv1.ClosePollRequest) (*logicv1.ClosePollResponse, error) {
+	if c.chatClient == nil {
+		return nil, fmt.Errorf("chat client not initialized")
+	}
+	return c.chatClient.ClosePoll(ctx, req)
+}
+
+// SendTyping 转发正在输入信号
+func (c *Client) SendTyping(ctx context.Context, req *logicv1.SendTypingRequest) (*logicv1.SendTypingResponse, error) {
+	if c.chatClient == nil {
+		return nil, fmt.Errorf("chat client not initialized")
+	}
+	return c.chatClient.SendTyping(ctx, req)
+}
+
+// ForwardMessages 逐条或合并转发消息
+func (c *Client) ForwardMessages(ctx context.Context, req *logicv1.ForwardMessagesRequest) (*logicv1.ForwardMessagesResponse, error) {
+	if c.chatClient == nil {
+		return nil, fmt.Errorf("chat client not initialized")
+	}
+	return c.chatClient.ForwardMessages(ctx, req)
+}
+
 // ==================== SessionService 接口 ====================
 
 // GetSessionList 获取会话列表
@@ -90,6 +170,162 @@ func (c *Client) PullInboxDelta(ctx context.Context, req *logicv1.PullInboxDelta
 	return c.sessionSvc().PullInboxDelta(ctx, req)
 }
 
+// GetThreadMessages 拉取话题消息
+func (c *Client) GetThreadMessages(ctx context.Context, req *logicv1.GetThreadMessagesRequest) (*logicv1.GetThreadMessagesResponse, error) {
+	return c.sessionSvc().GetThreadMessages(ctx, req)
+}
+
+// FollowThread 关注话题
+func (c *Client) FollowThread(ctx context.Context, req *logicv1.FollowThreadRequest) (*logicv1.FollowThreadResponse, error) {
+	return c.sessionSvc().FollowThread(ctx, req)
+}
+
+// UnfollowThread 取消关注话题
+func (c *Client) UnfollowThread(ctx context.Context, req *logicv1.UnfollowThreadRequest) (*logicv1.UnfollowThreadResponse, error) {
+	return c.sessionSvc().UnfollowThread(ctx, req)
+}
+
+// GetMessageReadStatus 获取消息的已读/未读成员
+func (c *Client) GetMessageReadStatus(ctx context.Context, req *logicv1.GetMessageReadStatusRequest) (*logicv1.GetMessageReadStatusResponse, error) {
+	return c.sessionSvc().GetMessageReadStatus(ctx, req)
+}
+
+// PinMessage 置顶消息
+func (c *Client) PinMessage(ctx context.Context, req *logicv1.PinMessageRequest) (*logicv1.PinMessageResponse, error) {
+	return c.sessionSvc().PinMessage(ctx, req)
+}
+
+// UnpinMessage 取消置顶
+func (c *Client) UnpinMessage(ctx context.Context, req *logicv1.UnpinMessageRequest) (*logicv1.UnpinMessageResponse, error) {
+	return c.sessionSvc().UnpinMessage(ctx, req)
+}
+
+// ListPinnedMessages 获取会话的置顶消息
+func (c *Client) ListPinnedMessages(ctx context.Context, req *logicv1.ListPinnedMessagesRequest) (*logicv1.ListPinnedMessagesResponse, error) {
+	return c.sessionSvc().ListPinnedMessages(ctx, req)
+}
+
+// SetMessageTTL 设置会话消息保留时长
+func (c *Client) SetMessageTTL(ctx context.Context, req *logicv1.SetMessageTTLRequest) (*logicv1.SetMessageTTLResponse, error) {
+	return c.sessionSvc().SetMessageTTL(ctx, req)
+}
+
+// SearchMessages 全文搜索历史消息
+func (c *Client) SearchMessages(ctx context.Context, req *logicv1.SearchMessagesRequest) (*logicv1.SearchMessagesResponse, error) {
+	return c.sessionSvc().SearchMessages(ctx, req)
+}
+
+// DeleteMessagesForMe 仅对自己删除消息或清空聊天记录
+func (c *Client) DeleteMessagesForMe(ctx context.Context, req *logicv1.DeleteMessagesForMeRequest) (*logicv1.DeleteMessagesForMeResponse, error) {
+	return c.sessionSvc().DeleteMessagesForMe(ctx, req)
+}
+
+// AddMembers 邀请用户加入群聊
+func (c *Client) AddMembers(ctx context.Context, req *logicv1.AddMembersRequest) (*logicv1.AddMembersResponse, error) {
+	return c.sessionSvc().AddMembers(ctx, req)
+}
+
+// RemoveMember 将成员移出群聊
+func (c *Client) RemoveMember(ctx context.Context, req *logicv1.RemoveMemberRequest) (*logicv1.RemoveMemberResponse, error) {
+	return c.sessionSvc().RemoveMember(ctx, req)
+}
+
+// LeaveSession 主动退出群聊
+func (c *Client) LeaveSession(ctx context.Context, req *logicv1.LeaveSessionRequest) (*logicv1.LeaveSessionResponse, error) {
+	return c.sessionSvc().LeaveSession(ctx, req)
+}
+
+// RenameSession 修改群名称
+func (c *Client) RenameSession(ctx context.Context, req *logicv1.RenameSessionRequest) (*logicv1.RenameSessionResponse, error) {
+	return c.sessionSvc().RenameSession(ctx, req)
+}
+
+// PromoteAdmin 将成员设为管理员
+func (c *Client) PromoteAdmin(ctx context.Context, req *logicv1.PromoteAdminRequest) (*logicv1.PromoteAdminResponse, error) {
+	return c.sessionSvc().PromoteAdmin(ctx, req)
+}
+
+// DemoteAdmin 取消成员的管理员身份
+func (c *Client) DemoteAdmin(ctx context.Context, req *logicv1.DemoteAdminRequest) (*logicv1.DemoteAdminResponse, error) {
+	return c.sessionSvc().DemoteAdmin(ctx, req)
+}
+
+// TransferOwnership 转让群主
+func (c *Client) TransferOwnership(ctx context.Context, req *logicv1.TransferOwnershipRequest) (*logicv1.TransferOwnershipResponse, error) {
+	return c.sessionSvc().TransferOwnership(ctx, req)
+}
+
+// MuteMember 禁言成员
+func (c *Client) MuteMember(ctx context.Context, req *logicv1.MuteMemberRequest) (*logicv1.MuteMemberResponse, error) {
+	return c.sessionSvc().MuteMember(ctx, req)
+}
+
+// UnmuteMember 解除成员的禁言
+func (c *Client) UnmuteMember(ctx context.Context, req *logicv1.UnmuteMemberRequest) (*logicv1.UnmuteMemberResponse, error) {
+	return c.sessionSvc().UnmuteMember(ctx, req)
+}
+
+// SetDoNotDisturb 设置会话免打扰
+func (c *Client) SetDoNotDisturb(ctx context.Context, req *logicv1.SetDoNotDisturbRequest) (*logicv1.SetDoNotDisturbResponse, error) {
+	return c.sessionSvc().SetDoNotDisturb(ctx, req)
+}
+
+// ==================== AttachmentService 接口 ====================
+
+// CreateUploadTicket 申请附件上传凭证
+func (c *Client) CreateUploadTicket(ctx context.Context, req *logicv1.CreateUploadTicketRequest) (*logicv1.CreateUploadTicketResponse, error) {
+	return c.attachmentSvc().CreateUploadTicket(ctx, req)
+}
+
+// ValidateUploadTicket 校验附件上传凭证
+func (c *Client) ValidateUploadTicket(ctx context.Context, req *logicv1.ValidateUploadTicketRequest) (*logicv1.ValidateUploadTicketResponse, error) {
+	return c.attachmentSvc().ValidateUploadTicket(ctx, req)
+}
+
+// CompleteUpload 确认附件上传完成
+func (c *Client) CompleteUpload(ctx context.Context, req *logicv1.CompleteUploadRequest) (*logicv1.CompleteUploadResponse, error) {
+	return c.attachmentSvc().CompleteUpload(ctx, req)
+}
+
+// GetAttachment 获取附件元数据（按会话成员关系鉴权）
+func (c *Client) GetAttachment(ctx context.Context, req *logicv1.GetAttachmentRequest) (*logicv1.GetAttachmentResponse, error) {
+	return c.attachmentSvc().GetAttachment(ctx, req)
+}
+
+// ==================== ScheduleService 接口 ====================
+
+// ScheduleMessage 创建定时消息
+func (c *Client) ScheduleMessage(ctx context.Context, req *logicv1.ScheduleMessageRequest) (*logicv1.ScheduleMessageResponse, error) {
+	return c.scheduleSvc().ScheduleMessage(ctx, req)
+}
+
+// ListScheduledMessages 获取当前用户的定时消息
+func (c *Client) ListScheduledMessages(ctx context.Context, req *logicv1.ListScheduledMessagesRequest) (*logicv1.ListScheduledMessagesResponse, error) {
+	return c.scheduleSvc().ListScheduledMessages(ctx, req)
+}
+
+// UpdateScheduledMessage 编辑待发送的定时消息
+func (c *Client) UpdateScheduledMessage(ctx context.Context, req *logicv1.UpdateScheduledMessageRequest) (*logicv1.UpdateScheduledMessageResponse, error) {
+	return c.scheduleSvc().UpdateScheduledMessage(ctx, req)
+}
+
+// CancelScheduledMessage 取消定时消息
+func (c *Client) CancelScheduledMessage(ctx context.Context, req *logicv1.CancelScheduledMessageRequest) (*logicv1.CancelScheduledMessageResponse, error) {
+	return c.scheduleSvc().CancelScheduledMessage(ctx, req)
+}
+
+// ==================== AnnouncementService 接口 ====================
+
+// Announce 发布系统公告
+func (c *Client) Announce(ctx context.Context, req *logicv1.AnnounceRequest) (*logicv1.AnnounceResponse, error) {
+	return c.announceSvc().Announce(ctx, req)
+}
+
+// ListAnnouncements 获取离线期间错过的系统公告
+func (c *Client) ListAnnouncements(ctx context.Context, req *logicv1.ListAnnouncementsRequest) (*logicv1.ListAnnouncementsResponse, error) {
+	return c.announceSvc().ListAnnouncements(ctx, req)
+}
+
 // ==================== PresenceService 接口 ====================
 
 // SyncUserOnline 同步用户上线到 Logic（通过 StatusBatcher 批量处理）
@@ -120,3 +356,64 @@ func (c *Client) IsUserOnline(ctx context.Context, username string) (bool, strin
 	// 这里需要根据实际 API 调整
 	return false, "", nil
 }
+
+// ==================== WebhookService 接口 ====================
+
+// CreateWebhook 创建会话的出站 Webhook 订阅
+func (c *Client) CreateWebhook(ctx context.Context, req *logicv1.CreateWebhookRequest) (*logicv1.CreateWebhookResponse, error) {
+	return c.webhookSvc().CreateWebhook(ctx, req)
+}
+
+// ListWebhooks 列出会话的 Webhook 订阅
+func (c *Client) ListWebhooks(ctx context.Context, req *logicv1.ListWebhooksRequest) (*logicv1.ListWebhooksResponse, error) {
+	return c.webhookSvc().ListWebhooks(ctx, req)
+}
+
+// DeleteWebhook 删除 Webhook 订阅
+func (c *Client) DeleteWebhook(ctx context.Context, req *logicv1.DeleteWebhookRequest) (*logicv1.DeleteWebhookResponse, error) {
+	return c.webhookSvc().DeleteWebhook(ctx, req)
+}
+
+// ListWebhookDeliveries 查看 Webhook 投递日志
+func (c *Client) ListWebhookDeliveries(ctx context.Context, req *logicv1.ListWebhookDeliveriesRequest) (*logicv1.ListWebhookDeliveriesResponse, error) {
+	return c.webhookSvc().ListWebhookDeliveries(ctx, req)
+}
+
+// ==================== BotService 接口 ====================
+
+// CreateBot 创建机器人账号
+func (c *Client) CreateBot(ctx context.Context, req *logicv1.CreateBotRequest) (*logicv1.CreateBotResponse, error) {
+	return c.botSvc().CreateBot(ctx, req)
+}
+
+// ListBots 列出用户创建的机器人账号
+func (c *Client) ListBots(ctx context.Context, req *logicv1.ListBotsRequest) (*logicv1.ListBotsResponse, error) {
+	return c.botSvc().ListBots(ctx, req)
+}
+
+// RotateBotToken 轮换机器人的 API Token
+func (c *Client) RotateBotToken(ctx context.Context, req *logicv1.RotateBotTokenRequest) (*logicv1.RotateBotTokenResponse, error) {
+	return c.botSvc().RotateBotToken(ctx, req)
+}
+
+// ValidateBotToken 校验机器人 API Token
+func (c *Client) ValidateBotToken(ctx context.Context, token string) (*logicv1.ValidateBotTokenResponse, error) {
+	return c.botSvc().ValidateBotToken(ctx, &logicv1.ValidateBotTokenRequest{
+		Token: token,
+	})
+}
+
+// RegisterBotCommand 注册或更新机器人的斜杠命令
+func (c *Client) RegisterBotCommand(ctx context.Context, req *logicv1.RegisterBotCommandRequest) (*logicv1.RegisterBotCommandResponse, error) {
+	return c.botSvc().RegisterBotCommand(ctx, req)
+}
+
+// DeleteBotCommand 删除机器人的斜杠命令
+func (c *Client) DeleteBotCommand(ctx context.Context, req *logicv1.DeleteBotCommandRequest) (*logicv1.DeleteBotCommandResponse, error) {
+	return c.botSvc().DeleteBotCommand(ctx, req)
+}
+
+// ListBotCommands 列出机器人注册的斜杠命令
+func (c *Client) ListBotCommands(ctx context.Context, req *logicv1.ListBotCommandsRequest) (*logicv1.ListBotCommandsResponse, error) {
+	return c.botSvc().ListBotCommands(ctx, req)
+}
@@ -46,6 +46,31 @@ type Config struct {
 
 	// StatusBatcher 配置
 	StatusBatcher StatusBatcherConfig `mapstructure:"status_batcher"`
+
+	// 附件存储配置
+	BlobStore BlobStoreConfig `mapstructure:"blob_store"`
+}
+
+// BlobStoreConfig 附件存储配置
+type BlobStoreConfig struct {
+	Driver   string `mapstructure:"driver"`    // 存储驱动，当前仅支持 local
+	LocalDir string `mapstructure:"local_dir"` // 本地存储根目录
+}
+
+// GetDriver 获取存储驱动，默认 "local"
+func (c *BlobStoreConfig) GetDriver() string {
+	if c.Driver == "" {
+		return "local"
+	}
+	return c.Driver
+}
+
+// GetLocalDir 获取本地存储根目录，默认 "data/attachments"
+func (c *BlobStoreConfig) GetLocalDir() string {
+	if c.LocalDir == "" {
+		return "data/attachments"
+	}
+	return c.LocalDir
 }
 
 // StatusBatcherConfig 状态批量同步器配置
@@ -87,6 +112,8 @@ type WSConfig struct {
 	MaxMessageSize  int `mapstructure:"max_message_size"`  // 最大消息大小（字节）
 	PingInterval    int `mapstructure:"ping_interval"`     // 心跳间隔（秒）
 	PongTimeout     int `mapstructure:"pong_timeout"`      // 心跳超时（秒）
+	TypingThrottle  int `mapstructure:"typing_throttle"`   // 同一连接同一会话转发正在输入的最小间隔（秒）
+	TypingTimeout   int `mapstructure:"typing_timeout"`    // 正在输入未续期时自动停止的超时（秒）
 }
 
 // GetPingInterval 获取心跳间隔，单位为 Duration，默认 30s
@@ -105,6 +132,22 @@ func (c *WSConfig) GetPongTimeout() time.Duration {
 	return time.Duration(c.PongTimeout) * time.Second
 }
 
+// GetTypingThrottle 获取正在输入的转发间隔，单位为 Duration，默认 3s
+func (c *WSConfig) GetTypingThrottle() time.Duration {
+	if c.TypingThrottle <= 0 {
+		return 3 * time.Second
+	}
+	return time.Duration(c.TypingThrottle) * time.Second
+}
+
+// GetTypingTimeout 获取正在输入的自动停止超时，单位为 Duration，默认 6s
+func (c *WSConfig) GetTypingTimeout() time.Duration {
+	if c.TypingTimeout <= 0 {
+		return 6 * time.Second
+	}
+	return time.Duration(c.TypingTimeout) * time.Second
+}
+
 // WorkerIDConfig WorkerID 分发配置
 type WorkerIDConfig struct {
 	MaxID int    `mapstructure:"max_id"` // 最大 ID 范围 [0, max_id)
@@ -18,6 +18,7 @@ import (
 	"github.com/ceyewan/resonance/gateway/push"
 	"github.com/ceyewan/resonance/gateway/server"
 	"github.com/ceyewan/resonance/gateway/ws"
+	"github.com/ceyewan/resonance/pkg/blobstore"
 	"github.com/ceyewan/resonance/pkg/health"
 )
 
@@ -148,13 +149,29 @@ func (g *Gateway) initComponents() error {
 		return fmt.Errorf("create id generator: %w", err)
 	}
 
-	// 8. 初始化服务接口 (Servers)
+	// 8. 初始化附件存储
+	blobStore, err := newBlobStore(&g.config.BlobStore)
+	if err != nil {
+		return fmt.Errorf("blob store init: %w", err)
+	}
+
+	// 9. 初始化服务接口 (Servers)
 	g.healthProbe = health.NewProbe()
-	g.initServers(idGen)
+	g.initServers(idGen, blobStore)
 
 	return nil
 }
 
+// newBlobStore 按配置创建附件存储
+func newBlobStore(cfg *config.BlobStoreConfig) (blobstore.BlobStore, error) {
+	switch cfg.GetDriver() {
+	case "local":
+		return blobstore.NewLocalStore(cfg.GetLocalDir())
+	default:
+		return nil, fmt.Errorf("unsupported blob store driver: %s", cfg.GetDriver())
+	}
+}
+
 // initBaseResources 初始化外部连接 (Redis、Etcd、Registry)
 func (g *Gateway) initBaseResources() (*resources, error) {
 	// Redis
@@ -223,9 +240,9 @@ func (g *Gateway) initLogicDependencies() error {
 }
 
 // initServers 初始化各个协议的服务端
-func (g *Gateway) initServers(idGen idgen.Generator) {
+func (g *Gateway) initServers(idGen idgen.Generator, blobStore blobstore.BlobStore) {
 	// WebSocket Handler
-	dispatcher := ws.NewDispatcher(g.logger, g.resources.logicClient)
+	dispatcher := ws.NewDispatcher(g.logger, g.resources.logicClient, g.config.WSConfig)
 	wsHandler := ws.NewUpgrader(g.logger, g.resources.connMgr, dispatcher, g.config.WSConfig)
 	g.resources.connMgr.SetUpgrader(wsHandler.Upgrader())
 
@@ -234,7 +251,7 @@ func (g *Gateway) initServers(idGen idgen.Generator) {
 		Driver: ratelimit.DriverStandalone,
 	}, ratelimit.WithLogger(g.logger))
 	middlewares := api.NewMiddlewares(g.logger, limiter, idGen)
-	apiHandler := api.NewHTTPHandler(g.resources.logicClient, g.logger)
+	apiHandler := api.NewHTTPHandler(g.resources.logicClient, blobStore, g.logger)
 
 	// Push Service
 	pushService := push.NewService(g.resources.connMgr, g.logger)
@@ -56,6 +56,30 @@ func (a *AuthConfig) RequireAuth() gin.HandlerFunc {
 	}
 }
 
+// RequireBotAuth 返回机器人接口的认证中间件
+// 仅接受请求头 "Authorization: Bot <token>"（兼容 Bearer 前缀），不从查询参数读取，避免 API Token 进入访问日志
+func (a *AuthConfig) RequireBotAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username, err := a.extractAndValidateBot(c)
+		if err != nil {
+			a.logger.Warn("bot authentication failed",
+				clog.String("client_ip", c.ClientIP()),
+				clog.Error(err),
+			)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": "unauthorized: " + err.Error(),
+			})
+			return
+		}
+
+		c.Set(UsernameKey, username)
+		ctx := context.WithValue(c.Request.Context(), UsernameKey, username)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
+
 // OptionalAuth 返回一个可选认证的中间件
 // 如果提供了 token 则验证，没有则跳过
 func (a *AuthConfig) OptionalAuth() gin.HandlerFunc {
@@ -102,6 +126,29 @@ func (a *AuthConfig) extractAndValidate(c *gin.Context) (string, error) {
 	return resp.Username, nil
 }
 
+// extractAndValidateBot 从请求头提取并验证机器人 API Token
+func (a *AuthConfig) extractAndValidateBot(c *gin.Context) (string, error) {
+	token := c.GetHeader("Authorization")
+	if after, ok := strings.CutPrefix(token, "Bot "); ok {
+		token = after
+	} else if after, ok := strings.CutPrefix(token, "Bearer "); ok {
+		token = after
+	}
+	if token == "" {
+		return "", ErrMissingToken
+	}
+
+	resp, err := a.logicClient.ValidateBotToken(c.Request.Context(), token)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	if !resp.Valid {
+		return "", ErrInvalidToken
+	}
+
+	return resp.Username, nil
+}
+
 // GetUsername 从上下文获取用户名
 func GetUsername(c *gin.Context) (string, bool) {
 	username, exists := c.Get(UsernameKey)
@@ -29,10 +29,11 @@ type Connection interface {
 
 // DefaultHandler 默认的消息处理器
 type DefaultHandler struct {
-	logger  clog.Logger
-	onPulse func(ctx context.Context, conn Connection) error
-	onChat  func(ctx context.Context, conn Connection, seq string, chat *gatewayv1.ChatRequest) error
-	onAck   func(ctx context.Context, conn Connection, ack *gatewayv1.Ack) error
+	logger   clog.Logger
+	onPulse  func(ctx context.Context, conn Connection) error
+	onChat   func(ctx context.Context, conn Connection, seq string, chat *gatewayv1.ChatRequest) error
+	onAck    func(ctx context.Context, conn Connection, ack *gatewayv1.Ack) error
+	onTyping func(ctx context.Context, conn Connection, typing *gatewayv1.Typing) error
 }
 
 // NewDefaultHandler 创建默认处理器
@@ -41,12 +42,14 @@ func NewDefaultHandler(
 	onPulse func(ctx context.Context, conn Connection) error,
 	onChat func(ctx context.Context, conn Connection, seq string, chat *gatewayv1.ChatRequest) error,
 	onAck func(ctx context.Context, conn Connection, ack *gatewayv1.Ack) error,
+	onTyping func(ctx context.Context, conn Connection, typing *gatewayv1.Typing) error,
 ) *DefaultHandler {
 	return &DefaultHandler{
-		logger:  logger,
-		onPulse: onPulse,
-		onChat:  onChat,
-		onAck:   onAck,
+		logger:   logger,
+		onPulse:  onPulse,
+		onChat:   onChat,
+		onAck:    onAck,
+		onTyping: onTyping,
 	}
 }
 
@@ -74,6 +77,13 @@ func (h *DefaultHandler) HandlePacket(ctx context.Context, conn Connection, pack
 		}
 		return nil
 
+	case *gatewayv1.WsPacket_Typing:
+		// 正在输入
+		if h.onTyping != nil {
+			return h.onTyping(ctx, conn, payload.Typing)
+		}
+		return nil
+
 	default:
 		return fmt.Errorf("unknown packet type: %T", payload)
 	}
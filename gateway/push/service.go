@@ -9,6 +9,8 @@ import (
 	"github.com/ceyewan/resonance/gateway/connection"
 	"github.com/ceyewan/resonance/gateway/observability"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Service 实现 PushService，接收 Task 服务的推送请求
@@ -34,15 +36,20 @@ func (s *Service) Push(ctx context.Context, req *gatewayv1.PushRequest) (*gatewa
 	failedUsernames := make([]string, 0)
 
 	s.logger.Debug("received push request",
-		clog.Int64("msg_id", message.MsgId),
+		clog.Int64("msg_id", message.GetMsgId()),
 		clog.Int("user_count", len(req.ToUsernames)))
 
-	// 1. 构造 WebSocket 包
+	// 1. 构造 WebSocket 包（正在输入信号优先）
 	packet := &gatewayv1.WsPacket{
 		Payload: &gatewayv1.WsPacket_Push{
 			Push: message,
 		},
 	}
+	if req.Typing != nil {
+		packet.Payload = &gatewayv1.WsPacket_Typing{
+			Typing: req.Typing,
+		}
+	}
 
 	// 2. 循环分发
 	for _, username := range req.ToUsernames {
@@ -64,7 +71,7 @@ func (s *Service) Push(ctx context.Context, req *gatewayv1.PushRequest) (*gatewa
 
 	successCount := len(req.ToUsernames) - len(failedUsernames)
 	s.logger.Debug("push completed",
-		clog.Int64("msg_id", message.MsgId),
+		clog.Int64("msg_id", message.GetMsgId()),
 		clog.Int("success_count", successCount),
 		clog.Int("failed_count", len(failedUsernames)))
 
@@ -78,11 +85,40 @@ func (s *Service) Push(ctx context.Context, req *gatewayv1.PushRequest) (*gatewa
 	}
 
 	return &gatewayv1.PushResponse{
-		MsgId:           message.MsgId,
+		MsgId:           message.GetMsgId(),
 		FailedUsernames: failedUsernames,
 	}, nil
 }
 
+// Broadcast 实现 PushService.Broadcast（一元 RPC）
+// 将系统公告广播给本 Gateway 的全部在线用户
+func (s *Service) Broadcast(ctx context.Context, req *gatewayv1.BroadcastRequest) (*gatewayv1.BroadcastResponse, error) {
+	startTime := time.Now()
+	announcement := req.GetAnnouncement()
+	if announcement == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "announcement is required")
+	}
+
+	packet := &gatewayv1.WsPacket{
+		Payload: &gatewayv1.WsPacket_Announcement{
+			Announcement: announcement,
+		},
+	}
+	s.connMgr.Broadcast(packet)
+	onlineCount := s.connMgr.OnlineCount()
+
+	s.logger.Info("announcement broadcast",
+		clog.Int64("announcement_id", announcement.AnnouncementId),
+		clog.Int("online_count", onlineCount))
+
+	observability.RecordGRPCRequest(ctx)
+	observability.RecordGRPCRequestDuration(ctx, time.Since(startTime))
+
+	return &gatewayv1.BroadcastResponse{
+		OnlineCount: int32(onlineCount),
+	}, nil
+}
+
 // RegisterGRPC 注册 gRPC 服务
 func (s *Service) RegisterGRPC(server *grpc.Server) {
 	gatewayv1.RegisterPushServiceServer(server, s)
@@ -2,12 +2,15 @@ package ws
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/ceyewan/genesis/clog"
 	"github.com/ceyewan/genesis/xerrors"
 	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
 	"github.com/ceyewan/resonance/gateway/client"
+	"github.com/ceyewan/resonance/gateway/config"
 	"github.com/ceyewan/resonance/gateway/protocol"
 )
 
@@ -15,13 +18,35 @@ import (
 type Dispatcher struct {
 	logger      clog.Logger
 	logicClient *client.Client
+
+	// 正在输入状态：按连接和会话节流转发，超时未续期时由服务端代发停止信号
+	typingThrottle time.Duration
+	typingTimeout  time.Duration
+	typingMu       sync.Mutex
+	typing         map[typingKey]*typingEntry
+}
+
+// typingKey 正在输入状态的键（同一用户的多个连接互不影响）
+type typingKey struct {
+	conn      protocol.Connection
+	sessionID string
+}
+
+// typingEntry 正在输入状态
+type typingEntry struct {
+	lastSent time.Time   // 最近一次转发开始输入的时间
+	deadline time.Time   // 自动停止的时间
+	timer    *time.Timer // 自动停止定时器
 }
 
 // NewDispatcher 创建消息分发器
-func NewDispatcher(logger clog.Logger, logicClient *client.Client) *Dispatcher {
+func NewDispatcher(logger clog.Logger, logicClient *client.Client, cfg config.WSConfig) *Dispatcher {
 	return &Dispatcher{
-		logger:      logger,
-		logicClient: logicClient,
+		logger:         logger,
+		logicClient:    logicClient,
+		typingThrottle: cfg.GetTypingThrottle(),
+		typingTimeout:  cfg.GetTypingTimeout(),
+		typing:         make(map[typingKey]*typingEntry),
 	}
 }
 
@@ -42,8 +67,8 @@ func (d *Dispatcher) HandleChat(ctx context.Context, conn protocol.Connection, s
 		chat.Timestamp = time.Now().Unix()
 	}
 
-	// 调用 Logic 服务处理消息
-	resp, err := d.logicClient.SendMessage(ctx, chat)
+	// 调用 Logic 服务处理消息，seq 作为客户端消息ID用于重试去重
+	resp, err := d.logicClient.SendMessage(ctx, chat, seq)
 	var ackErr string
 	var msgID, seqID int64
 	if err != nil {
@@ -56,6 +81,11 @@ func (d *Dispatcher) HandleChat(ctx context.Context, conn protocol.Connection, s
 
 	// 发送确认给客户端，包含服务端生成的 ID
 	ackPacket := protocol.CreateAckPacket(seq, msgID, seqID, chat.SessionId, ackErr)
+	// 斜杠命令：结构化错误码与仅发送者可见的回复随 Ack 返回
+	if ack := ackPacket.GetAck(); resp != nil {
+		ack.ErrorCode = resp.GetErrorCode()
+		ack.CommandReply = resp.GetCommandReply()
+	}
 	if err := conn.Send(ackPacket); err != nil {
 		d.logger.Error("failed to send ack", clog.Error(err))
 		return err
@@ -75,3 +105,81 @@ func (d *Dispatcher) HandleAck(ctx context.Context, conn protocol.Connection, ac
 	// 目前仅作为连接活跃的信号，暂无特殊逻辑
 	return nil
 }
+
+// HandleTyping 处理正在输入信号
+// 开始输入：同一连接同一会话在节流间隔内只转发一次，每次上报都会续期；
+// 超过 typingTimeout 未续期时由服务端代为转发停止信号，避免客户端异常断开后状态残留。
+// 停止输入：仅在存在开始状态时转发。
+func (d *Dispatcher) HandleTyping(ctx context.Context, conn protocol.Connection, typing *gatewayv1.Typing) error {
+	if typing.SessionId == "" {
+		return xerrors.New("typing session_id is required")
+	}
+	key := typingKey{conn: conn, sessionID: typing.SessionId}
+	now := time.Now()
+
+	switch typing.State {
+	case gatewayv1.TypingState_TYPING_STATE_STARTED:
+		d.typingMu.Lock()
+		entry, ok := d.typing[key]
+		if ok {
+			entry.deadline = now.Add(d.typingTimeout)
+			entry.timer.Reset(d.typingTimeout)
+			if now.Sub(entry.lastSent) < d.typingThrottle {
+				d.typingMu.Unlock()
+				return nil
+			}
+			entry.lastSent = now
+		} else {
+			entry = &typingEntry{lastSent: now, deadline: now.Add(d.typingTimeout)}
+			entry.timer = time.AfterFunc(d.typingTimeout, func() { d.expireTyping(key, entry) })
+			d.typing[key] = entry
+		}
+		d.typingMu.Unlock()
+
+	case gatewayv1.TypingState_TYPING_STATE_STOPPED:
+		d.typingMu.Lock()
+		entry, ok := d.typing[key]
+		if ok {
+			entry.timer.Stop()
+			delete(d.typing, key)
+		}
+		d.typingMu.Unlock()
+		if !ok {
+			return nil
+		}
+
+	default:
+		return xerrors.New("invalid typing state")
+	}
+
+	return d.relayTyping(ctx, conn.Username(), typing.SessionId, typing.State)
+}
+
+// expireTyping 开始输入超时未续期，代为转发停止信号
+func (d *Dispatcher) expireTyping(key typingKey, entry *typingEntry) {
+	d.typingMu.Lock()
+	// 定时器触发与续期并发时，以最新的 deadline 为准
+	if d.typing[key] != entry || time.Now().Before(entry.deadline) {
+		d.typingMu.Unlock()
+		return
+	}
+	delete(d.typing, key)
+	d.typingMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	if err := d.relayTyping(ctx, key.conn.Username(), key.sessionID, gatewayv1.TypingState_TYPING_STATE_STOPPED); err != nil {
+		d.logger.Warn("failed to relay typing timeout", clog.Error(err))
+	}
+}
+
+// relayTyping 通过 Logic 将正在输入信号转发给会话内其他成员
+func (d *Dispatcher) relayTyping(ctx context.Context, username, sessionID string, state gatewayv1.TypingState) error {
+	_, err := d.logicClient.SendTyping(ctx, &logicv1.SendTypingRequest{
+		SessionId:    sessionID,
+		FromUsername: username,
+		State:        state,
+		ExpiresIn:    int32(d.typingTimeout / time.Second),
+	})
+	return err
+}
@@ -75,6 +75,7 @@ func (h *Upgrader) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 		h.dispatcher.HandlePulse,
 		h.dispatcher.HandleChat,
 		h.dispatcher.HandleAck,
+		h.dispatcher.HandleTyping,
 	)
 
 	// 创建连接对象
@@ -36,6 +36,9 @@ type Config struct {
 	// 服务注册发现配置
 	Registry RegistryConfig `mapstructure:"registry"`
 
+	// Gateway 服务名称（直接转发正在输入等瞬时信号时用于发现 Gateway）
+	GatewayServiceName string `mapstructure:"gateway_service_name"`
+
 	// 认证配置
 	Auth auth.Config `mapstructure:"auth"`
 
@@ -62,6 +65,252 @@ type Config struct {
 
 	// Outbox 配置
 	Outbox OutboxConfig `mapstructure:"outbox"`
+
+	// 消息配置
+	Message MessageConfig `mapstructure:"message"`
+
+	// 附件配置
+	Attachment AttachmentConfig `mapstructure:"attachment"`
+
+	// 附件存储配置（需与 Gateway 指向同一存储，用于删除过期消息的附件内容）
+	BlobStore BlobStoreConfig `mapstructure:"blob_store"`
+
+	// 定时消息配置
+	Schedule ScheduleConfig `mapstructure:"schedule"`
+
+	// 过期消息清理配置
+	Expiry ExpiryConfig `mapstructure:"expiry"`
+
+	// 斜杠命令配置
+	Command CommandConfig `mapstructure:"command"`
+
+	// 内容审核配置
+	Moderation ModerationConfig `mapstructure:"moderation"`
+}
+
+// ModerationConfig 内容审核配置
+type ModerationConfig struct {
+	Enabled        bool          `mapstructure:"enabled"`         // 是否启用内容审核
+	Filters        []string      `mapstructure:"filters"`         // 过滤器及执行顺序：word / regex / hook
+	WordFile       string        `mapstructure:"word_file"`       // 敏感词文件（一行一个词，可用 "词|动作" 指定动作，# 开头为注释）
+	WordAction     string        `mapstructure:"word_action"`     // 敏感词文件中未指定动作的词的处理方式
+	MaskChar       string        `mapstructure:"mask_char"`       // 打码字符
+	ReloadInterval time.Duration `mapstructure:"reload_interval"` // 规则热加载间隔（重新读取敏感词文件与规则表）
+	Hook           struct {
+		URL        string        `mapstructure:"url"`         // 外部分类器地址，为空时不启用
+		Timeout    time.Duration `mapstructure:"timeout"`     // 外部分类器超时
+		FailClosed bool          `mapstructure:"fail_closed"` // 外部分类器不可用时拒绝消息（默认放行）
+	} `mapstructure:"hook"`
+}
+
+// GetFilters 获取过滤器执行顺序，默认 word -> regex -> hook
+func (c *ModerationConfig) GetFilters() []string {
+	if len(c.Filters) == 0 {
+		return []string{"word", "regex", "hook"}
+	}
+	return c.Filters
+}
+
+// GetWordAction 获取敏感词文件的默认动作，默认 mask
+func (c *ModerationConfig) GetWordAction() string {
+	if c.WordAction == "" {
+		return "mask"
+	}
+	return c.WordAction
+}
+
+// GetMaskChar 获取打码字符，默认 *
+func (c *ModerationConfig) GetMaskChar() rune {
+	for _, r := range c.MaskChar {
+		return r
+	}
+	return '*'
+}
+
+// GetReloadInterval 获取规则热加载间隔，默认 30 秒
+func (c *ModerationConfig) GetReloadInterval() time.Duration {
+	if c.ReloadInterval <= 0 {
+		return 30 * time.Second
+	}
+	return c.ReloadInterval
+}
+
+// GetHookTimeout 获取外部分类器超时，默认 500 毫秒
+func (c *ModerationConfig) GetHookTimeout() time.Duration {
+	if c.Hook.Timeout <= 0 {
+		return 500 * time.Millisecond
+	}
+	return c.Hook.Timeout
+}
+
+// CommandConfig 斜杠命令配置
+type CommandConfig struct {
+	Timeout         time.Duration `mapstructure:"timeout"`           // 机器人命令回调超时
+	MaxResponseSize int64         `mapstructure:"max_response_size"` // 机器人命令回调响应体的最大字节数
+	// AllowPrivateNetwork 允许回调回环、内网等地址，仅用于本地开发与测试，生产环境必须关闭
+	AllowPrivateNetwork bool `mapstructure:"allow_private_network"`
+}
+
+// GetTimeout 获取机器人命令回调超时，默认 3 秒
+func (c *CommandConfig) GetTimeout() time.Duration {
+	if c.Timeout <= 0 {
+		return 3 * time.Second
+	}
+	return c.Timeout
+}
+
+// GetMaxResponseSize 获取回调响应体的最大字节数，默认 64KB
+func (c *CommandConfig) GetMaxResponseSize() int64 {
+	if c.MaxResponseSize <= 0 {
+		return 64 * 1024
+	}
+	return c.MaxResponseSize
+}
+
+// ExpiryConfig 过期消息清理 Job 配置
+type ExpiryConfig struct {
+	BatchSize  int           `mapstructure:"batch_size"`  // 每次清理的过期消息批次大小
+	TickerTime time.Duration `mapstructure:"ticker_time"` // 扫描间隔
+}
+
+// GetBatchSize 获取批次大小，默认 100
+func (c *ExpiryConfig) GetBatchSize() int {
+	if c.BatchSize <= 0 {
+		return 100
+	}
+	return c.BatchSize
+}
+
+// GetTickerTime 获取扫描间隔，默认 5 秒
+func (c *ExpiryConfig) GetTickerTime() time.Duration {
+	if c.TickerTime <= 0 {
+		return 5 * time.Second
+	}
+	return c.TickerTime
+}
+
+// ScheduleConfig 定时消息配置
+type ScheduleConfig struct {
+	MaxDelay     time.Duration `mapstructure:"max_delay"`     // 计划发送时间距当前的最大间隔
+	MaxPending   int           `mapstructure:"max_pending"`   // 单个用户待发送定时消息的最大数量
+	BatchSize    int           `mapstructure:"batch_size"`    // 每次领取的到期消息批次大小
+	TickerTime   time.Duration `mapstructure:"ticker_time"`   // 扫描间隔
+	ClaimTimeout time.Duration `mapstructure:"claim_timeout"` // 领取后未完成发送的超时时间，超时后重新领取
+}
+
+// GetMaxDelay 获取计划发送时间的最大间隔，默认 30 天
+func (c *ScheduleConfig) GetMaxDelay() time.Duration {
+	if c.MaxDelay <= 0 {
+		return 30 * 24 * time.Hour
+	}
+	return c.MaxDelay
+}
+
+// GetMaxPending 获取单个用户待发送定时消息的最大数量，默认 100
+func (c *ScheduleConfig) GetMaxPending() int {
+	if c.MaxPending <= 0 {
+		return 100
+	}
+	return c.MaxPending
+}
+
+// GetBatchSize 获取批次大小，默认 100
+func (c *ScheduleConfig) GetBatchSize() int {
+	if c.BatchSize <= 0 {
+		return 100
+	}
+	return c.BatchSize
+}
+
+// GetTickerTime 获取扫描间隔，默认 1 秒
+func (c *ScheduleConfig) GetTickerTime() time.Duration {
+	if c.TickerTime <= 0 {
+		return time.Second
+	}
+	return c.TickerTime
+}
+
+// GetClaimTimeout 获取领取超时时间，默认 1 分钟
+func (c *ScheduleConfig) GetClaimTimeout() time.Duration {
+	if c.ClaimTimeout <= 0 {
+		return time.Minute
+	}
+	return c.ClaimTimeout
+}
+
+// AttachmentConfig 附件相关配置
+type AttachmentConfig struct {
+	MaxSize   int64         `mapstructure:"max_size"`   // 单个附件的最大大小（字节）
+	TicketTTL time.Duration `mapstructure:"ticket_ttl"` // 上传凭证有效期
+}
+
+// GetMaxSize 获取单个附件的最大大小，默认 20MB
+func (c *AttachmentConfig) GetMaxSize() int64 {
+	if c.MaxSize <= 0 {
+		return 20 << 20
+	}
+	return c.MaxSize
+}
+
+// GetTicketTTL 获取上传凭证有效期，默认 15 分钟
+func (c *AttachmentConfig) GetTicketTTL() time.Duration {
+	if c.TicketTTL <= 0 {
+		return 15 * time.Minute
+	}
+	return c.TicketTTL
+}
+
+// BlobStoreConfig 附件存储配置
+type BlobStoreConfig struct {
+	Driver   string `mapstructure:"driver"`    // 存储驱动，当前仅支持 local
+	LocalDir string `mapstructure:"local_dir"` // 本地存储根目录
+}
+
+// GetDriver 获取存储驱动，默认 "local"
+func (c *BlobStoreConfig) GetDriver() string {
+	if c.Driver == "" {
+		return "local"
+	}
+	return c.Driver
+}
+
+// GetLocalDir 获取本地存储根目录，默认 "data/attachments"
+func (c *BlobStoreConfig) GetLocalDir() string {
+	if c.LocalDir == "" {
+		return "data/attachments"
+	}
+	return c.LocalDir
+}
+
+// MessageConfig 消息相关配置
+type MessageConfig struct {
+	RecallWindow time.Duration `mapstructure:"recall_window"` // 发送者可撤回消息的时间窗口
+	EditWindow   time.Duration `mapstructure:"edit_window"`   // 发送者可编辑消息的时间窗口
+	DedupWindow  time.Duration `mapstructure:"dedup_window"`  // 客户端消息ID去重的保留窗口
+}
+
+// GetRecallWindow 获取撤回时间窗口，默认 2 分钟
+func (c *MessageConfig) GetRecallWindow() time.Duration {
+	if c.RecallWindow <= 0 {
+		return 2 * time.Minute
+	}
+	return c.RecallWindow
+}
+
+// GetEditWindow 获取编辑时间窗口，默认 15 分钟
+func (c *MessageConfig) GetEditWindow() time.Duration {
+	if c.EditWindow <= 0 {
+		return 15 * time.Minute
+	}
+	return c.EditWindow
+}
+
+// GetDedupWindow 获取客户端消息ID去重的保留窗口，默认 24 小时
+func (c *MessageConfig) GetDedupWindow() time.Duration {
+	if c.DedupWindow <= 0 {
+		return 24 * time.Hour
+	}
+	return c.DedupWindow
 }
 
 // OutboxConfig Outbox Job 配置
@@ -152,6 +401,14 @@ func (c *Config) GetHost() string {
 	return "localhost"
 }
 
+// GetGatewayServiceName 获取 Gateway 服务名称，默认 gateway-service
+func (c *Config) GetGatewayServiceName() string {
+	if c.GatewayServiceName == "" {
+		return "gateway-service"
+	}
+	return c.GatewayServiceName
+}
+
 // GetServerAddr 获取监听地址，默认 :15090
 func (c *Config) GetServerAddr() string {
 	if strings.TrimSpace(c.Service.ServerAddr) == "" {
@@ -0,0 +1,114 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/mq"
+	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/logic/service"
+	"github.com/ceyewan/resonance/pkg/blobstore"
+	"github.com/ceyewan/resonance/repo"
+)
+
+// MessageExpirer 负责物理删除已过期的消息（会话开启消息自动删除），并推送过期事件通知客户端清理本地缓存
+// 多实例并发清理同一批消息时删除是幂等的，重复的过期事件由客户端幂等处理
+// 消息引用的附件随消息删除，不再被任何附件记录引用的存储内容在事务提交后从 BlobStore 删除
+type MessageExpirer struct {
+	messageRepo repo.MessageRepo
+	blobStore   blobstore.BlobStore // 为 nil 时不删除存储内容
+	mqClient    mq.MQ
+	logger      clog.Logger
+	config      *config.ExpiryConfig
+}
+
+func NewMessageExpirer(messageRepo repo.MessageRepo, blobStore blobstore.BlobStore, mqClient mq.MQ, logger clog.Logger, cfg *config.ExpiryConfig) *MessageExpirer {
+	return &MessageExpirer{
+		messageRepo: messageRepo,
+		blobStore:   blobStore,
+		mqClient:    mqClient,
+		logger:      logger.WithNamespace("message_expirer"),
+		config:      cfg,
+	}
+}
+
+// Start 启动过期消息清理任务
+func (j *MessageExpirer) Start(ctx context.Context) {
+	j.logger.Info("starting message expirer job")
+	ticker := time.NewTicker(j.config.GetTickerTime())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			j.logger.Info("message expirer job stopped")
+			return
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						j.logger.Error("panic in message expirer job", clog.Any("panic", r))
+					}
+				}()
+				j.processExpiredMessages(ctx)
+			}()
+		}
+	}
+}
+
+func (j *MessageExpirer) processExpiredMessages(ctx context.Context) {
+	// 1. 获取已过期消息
+	msgs, err := j.messageRepo.GetExpiredMessages(ctx, time.Now(), j.config.GetBatchSize())
+	if err != nil {
+		j.logger.Error("failed to get expired messages", clog.Error(err))
+		return
+	}
+
+	if len(msgs) == 0 {
+		return
+	}
+
+	j.logger.Debug("processing expired messages", clog.Int("count", len(msgs)))
+
+	// 2. 构造过期事件，与删除在同一事务内写入 Outbox
+	now := time.Now().Unix()
+	msgIDs := make([]int64, 0, len(msgs))
+	events := make([]*mqv1.PushEvent, 0, len(msgs))
+	for _, msg := range msgs {
+		msgIDs = append(msgIDs, msg.MsgID)
+		events = append(events, &mqv1.PushEvent{
+			MsgId:        msg.MsgID,
+			SeqId:        msg.SeqID,
+			SessionId:    msg.SessionID,
+			FromUsername: msg.SenderUsername,
+			Type:         msg.MsgType,
+			Timestamp:    now,
+			EventType:    mqv1.EventType_EVENT_TYPE_EXPIRE,
+			ThreadRootId: msg.ThreadRootID,
+		})
+	}
+
+	results, storageKeys, err := service.PublishExpireToMQ(ctx, j.messageRepo, events, msgIDs, j.logger)
+	if err != nil {
+		j.logger.Error("failed to delete expired messages", clog.Error(err))
+		return
+	}
+
+	// 删除附件存储内容：记录已删除，失败时只能遗留孤儿文件，记录日志便于人工清理
+	if j.blobStore != nil {
+		for _, key := range storageKeys {
+			if err := j.blobStore.Delete(ctx, key); err != nil {
+				j.logger.Error("failed to delete expired attachment", clog.String("storage_key", key), clog.Error(err))
+			}
+		}
+	}
+
+	// 3. 异步投递过期事件，失败由 OutboxRelay 补发
+	for _, result := range results {
+		service.PublishMessageToMQAsync(j.mqClient, result.OutboxID, result.Topic, result.EventData, j.logger)
+	}
+
+	j.logger.Info("expired messages deleted", clog.Int("count", len(msgIDs)))
+}
@@ -0,0 +1,144 @@
+package job
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/errcode"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// MessageSender 发送消息的入口，由 service.ChatService 实现
+type MessageSender interface {
+	SendMessage(ctx context.Context, req *logicv1.SendMessageRequest) (*logicv1.SendMessageResponse, error)
+}
+
+// ScheduledSender 负责领取到期的定时消息并经正常发送链路（ChatService.SendMessage）发送
+type ScheduledSender struct {
+	scheduledRepo repo.ScheduledMessageRepo
+	sender        MessageSender
+	logger        clog.Logger
+	config        *config.ScheduleConfig
+}
+
+func NewScheduledSender(scheduledRepo repo.ScheduledMessageRepo, sender MessageSender, logger clog.Logger, cfg *config.ScheduleConfig) *ScheduledSender {
+	return &ScheduledSender{
+		scheduledRepo: scheduledRepo,
+		sender:        sender,
+		logger:        logger.WithNamespace("scheduled_sender"),
+		config:        cfg,
+	}
+}
+
+// Start 启动定时消息发送任务
+func (j *ScheduledSender) Start(ctx context.Context) {
+	j.logger.Info("starting scheduled sender job")
+	ticker := time.NewTicker(j.config.GetTickerTime())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			j.logger.Info("scheduled sender job stopped")
+			return
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						j.logger.Error("panic in scheduled sender job", clog.Any("panic", r))
+					}
+				}()
+				j.processDueMessages(ctx)
+			}()
+		}
+	}
+}
+
+func (j *ScheduledSender) processDueMessages(ctx context.Context) {
+	// 1. 领取到期消息
+	msgs, err := j.scheduledRepo.ClaimDueScheduledMessages(ctx, time.Now(), j.config.GetClaimTimeout(), j.config.GetBatchSize())
+	if err != nil {
+		j.logger.Error("failed to claim scheduled messages", clog.Error(err))
+		return
+	}
+
+	if len(msgs) == 0 {
+		return
+	}
+
+	j.logger.Debug("processing due scheduled messages", clog.Int("count", len(msgs)))
+
+	// 2. 按计划时间顺序逐条发送，保证同一会话内的发送顺序
+	for _, msg := range msgs {
+		j.sendMessage(ctx, msg)
+	}
+}
+
+func (j *ScheduledSender) sendMessage(ctx context.Context, msg *model.ScheduledMessage) {
+	req := &logicv1.SendMessageRequest{}
+	if err := proto.Unmarshal(msg.Payload, req); err != nil {
+		j.logger.Error("invalid scheduled message payload",
+			clog.Int64("schedule_id", msg.ScheduleID),
+			clog.Error(err))
+		j.markFailed(ctx, msg.ScheduleID, "invalid scheduled message")
+		return
+	}
+	// 以定时消息 ID 作为客户端消息ID：领取超时后被重新领取时，由 SendMessage 去重返回首次发送的结果
+	req.ClientMsgId = "scheduled:" + strconv.FormatInt(msg.ScheduleID, 10)
+	req.Timestamp = time.Now().Unix()
+
+	resp, err := j.sender.SendMessage(ctx, req)
+	if err != nil {
+		// 调用失败时保持发送中状态，领取超时后重试
+		j.logger.Warn("failed to send scheduled message",
+			clog.Int64("schedule_id", msg.ScheduleID),
+			clog.Error(err))
+		return
+	}
+	if resp.Error != "" {
+		// 服务端暂时性失败保持发送中状态，领取超时后重试
+		if retryable(resp.ErrorCode) {
+			j.logger.Warn("scheduled message send failed, will retry",
+				clog.Int64("schedule_id", msg.ScheduleID),
+				clog.String("error_code", resp.ErrorCode),
+				clog.String("error", resp.Error))
+			return
+		}
+		// 业务失败（如发送者已不是会话成员、被禁言、内容被拒绝）不再重试
+		j.logger.Info("scheduled message rejected",
+			clog.Int64("schedule_id", msg.ScheduleID),
+			clog.String("error", resp.Error))
+		j.markFailed(ctx, msg.ScheduleID, resp.Error)
+		return
+	}
+
+	// 3. 标记为已发送
+	if err := j.scheduledRepo.MarkScheduledMessageSent(ctx, msg.ScheduleID, resp.MsgId); err != nil {
+		j.logger.Error("failed to update status after send",
+			clog.Int64("schedule_id", msg.ScheduleID),
+			clog.Error(err))
+	}
+}
+
+// retryable 判断发送错误码是否为暂时性失败
+func retryable(code string) bool {
+	switch code {
+	case errcode.Internal, errcode.InProgress, errcode.ModerationFailed:
+		return true
+	}
+	return false
+}
+
+func (j *ScheduledSender) markFailed(ctx context.Context, scheduleID int64, reason string) {
+	if err := j.scheduledRepo.MarkScheduledMessageFailed(ctx, scheduleID, reason); err != nil {
+		j.logger.Error("failed to mark scheduled message failed",
+			clog.Int64("schedule_id", scheduleID),
+			clog.Error(err))
+	}
+}
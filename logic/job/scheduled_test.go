@@ -0,0 +1,67 @@
+package job
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/errcode"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// testScheduledRepo 仅记录状态变更，其余方法未使用
+type testScheduledRepo struct {
+	repo.ScheduledMessageRepo
+	sent   map[int64]int64
+	failed map[int64]string
+}
+
+func (r *testScheduledRepo) MarkScheduledMessageSent(ctx context.Context, scheduleID, msgID int64) error {
+	r.sent[scheduleID] = msgID
+	return nil
+}
+
+func (r *testScheduledRepo) MarkScheduledMessageFailed(ctx context.Context, scheduleID int64, reason string) error {
+	r.failed[scheduleID] = reason
+	return nil
+}
+
+type testSender struct {
+	resp *logicv1.SendMessageResponse
+}
+
+func (s *testSender) SendMessage(ctx context.Context, req *logicv1.SendMessageRequest) (*logicv1.SendMessageResponse, error) {
+	return s.resp, nil
+}
+
+func TestScheduledSender_SendMessage(t *testing.T) {
+	payload, err := proto.Marshal(&logicv1.SendMessageRequest{SessionId: "g1", FromUsername: "alice", Content: "hi", Type: "text"})
+	require.NoError(t, err)
+	scheduledRepo := &testScheduledRepo{sent: map[int64]int64{}, failed: map[int64]string{}}
+	sender := &testSender{}
+	j := NewScheduledSender(scheduledRepo, sender, clog.Discard(), &config.ScheduleConfig{})
+	ctx := context.Background()
+
+	// 暂时性失败保持发送中状态，等待重新领取
+	for _, code := range []string{errcode.Internal, errcode.InProgress} {
+		sender.resp = &logicv1.SendMessageResponse{Error: "failed to save message", ErrorCode: code}
+		j.sendMessage(ctx, &model.ScheduledMessage{ScheduleID: 1, Payload: payload})
+	}
+	assert.Empty(t, scheduledRepo.failed)
+	assert.Empty(t, scheduledRepo.sent)
+
+	// 业务失败不再重试
+	sender.resp = &logicv1.SendMessageResponse{Error: "not a session member", ErrorCode: errcode.NotSessionMember}
+	j.sendMessage(ctx, &model.ScheduledMessage{ScheduleID: 1, Payload: payload})
+	assert.Equal(t, "not a session member", scheduledRepo.failed[1])
+
+	sender.resp = &logicv1.SendMessageResponse{MsgId: 42}
+	j.sendMessage(ctx, &model.ScheduledMessage{ScheduleID: 2, Payload: payload})
+	assert.Equal(t, int64(42), scheduledRepo.sent[2])
+}
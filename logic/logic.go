@@ -14,9 +14,12 @@ import (
 	"github.com/ceyewan/genesis/registry"
 	"github.com/ceyewan/resonance/logic/config"
 	"github.com/ceyewan/resonance/logic/job"
+	"github.com/ceyewan/resonance/logic/moderation"
 	"github.com/ceyewan/resonance/logic/observability"
+	"github.com/ceyewan/resonance/logic/push"
 	"github.com/ceyewan/resonance/logic/server"
 	"github.com/ceyewan/resonance/logic/service"
+	"github.com/ceyewan/resonance/pkg/blobstore"
 	"github.com/ceyewan/resonance/pkg/health"
 	"github.com/ceyewan/resonance/repo"
 )
@@ -33,7 +36,13 @@ type Logic struct {
 	healthServer *health.Server
 
 	// 后台任务
-	outboxRelay *job.OutboxRelay
+	outboxRelay     *job.OutboxRelay
+	scheduledSender *job.ScheduledSender
+	messageExpirer  *job.MessageExpirer
+	moderator       *moderation.Moderator // 内容审核规则热加载（未启用审核时为 nil）
+
+	// 直连 Gateway 推送（正在输入等瞬时信号）
+	gatewayPusher *push.Pusher
 
 	// 资源
 	resources *resources
@@ -56,10 +65,17 @@ type resources struct {
 	instanceIDStop func() // 实例 ID 保活停止函数
 
 	// Repos
-	userRepo    repo.UserRepo
-	sessionRepo repo.SessionRepo
-	messageRepo repo.MessageRepo
-	routerRepo  repo.RouterRepo
+	userRepo       repo.UserRepo
+	sessionRepo    repo.SessionRepo
+	messageRepo    repo.MessageRepo
+	routerRepo     repo.RouterRepo
+	dedupRepo      repo.MessageDedupRepo
+	attachmentRepo repo.AttachmentRepo
+	scheduledRepo  repo.ScheduledMessageRepo
+	announceRepo   repo.AnnouncementRepo
+	webhookRepo    repo.WebhookRepo
+	botRepo        repo.BotRepo
+	moderationRepo repo.ModerationRepo
 }
 
 // New 创建 Logic 实例
@@ -153,13 +169,36 @@ func (l *Logic) initComponents() error {
 	}()
 
 	// 4. 服务层
-	authSvc := service.NewAuthService(res.userRepo, res.sessionRepo, res.authenticator, logger)
+	authSvc := service.NewAuthService(res.userRepo, res.sessionRepo, res.authenticator, res.mqClient, logger)
 	sessionSvc := service.NewSessionService(res.sessionRepo, res.messageRepo, res.userRepo, res.sessionIDGen, res.msgIDGen, res.sequencer, res.mqClient, logger)
-	chatSvc := service.NewChatService(res.sessionRepo, res.messageRepo, res.msgIDGen, res.sequencer, res.mqClient, logger)
+	chatSvc := service.NewChatService(res.sessionRepo, res.messageRepo, res.dedupRepo, res.attachmentRepo, res.msgIDGen, res.sequencer, res.mqClient, &l.config.Message, logger)
+	commands := service.NewCommandRegistry(res.botRepo, &l.config.Command, logger)
+	chatSvc.SetCommandRegistry(commands)
+	l.gatewayPusher = push.NewPusher(l.registry, l.config.GetGatewayServiceName(), logger)
+	chatSvc.SetTypingRelay(res.routerRepo, l.gatewayPusher)
+	if l.config.Moderation.Enabled {
+		l.moderator = moderation.NewModerator(res.moderationRepo, &l.config.Moderation, logger)
+		// 首次加载失败时以空规则启动，由热加载任务重试
+		if err := l.moderator.Reload(l.ctx); err != nil {
+			logger.Error("failed to load moderation rules", clog.Error(err))
+		}
+		chatSvc.SetModerator(l.moderator)
+	}
 	presenceSvc := service.NewPresenceService(res.routerRepo, logger)
+	attachmentSvc := service.NewAttachmentService(res.attachmentRepo, res.sessionRepo, res.msgIDGen, &l.config.Attachment, logger)
+	scheduleSvc := service.NewScheduleService(res.sessionRepo, res.scheduledRepo, res.msgIDGen, &l.config.Schedule, logger)
+	announcementSvc := service.NewAnnouncementService(res.announceRepo, res.msgIDGen, res.mqClient, &l.config.Admin, logger)
+	webhookSvc := service.NewWebhookService(res.sessionRepo, res.webhookRepo, res.msgIDGen, logger)
+	botSvc := service.NewBotService(res.userRepo, res.botRepo, commands, logger)
 
 	// 5. 后台任务
+	blobStore, err := newBlobStore(&l.config.BlobStore)
+	if err != nil {
+		return fmt.Errorf("blob store init: %w", err)
+	}
 	l.outboxRelay = job.NewOutboxRelay(res.messageRepo, res.mqClient, logger, &l.config.Outbox)
+	l.scheduledSender = job.NewScheduledSender(res.scheduledRepo, chatSvc, logger, &l.config.Schedule)
+	l.messageExpirer = job.NewMessageExpirer(res.messageRepo, blobStore, res.mqClient, logger, &l.config.Expiry)
 
 	// 6. gRPC Server
 	l.grpcServer = server.NewGRPCServer(
@@ -169,6 +208,11 @@ func (l *Logic) initComponents() error {
 		sessionSvc,
 		chatSvc,
 		presenceSvc,
+		attachmentSvc,
+		scheduleSvc,
+		announcementSvc,
+		webhookSvc,
+		botSvc,
 	)
 
 	// 7. 健康检查 Server
@@ -177,6 +221,16 @@ func (l *Logic) initComponents() error {
 	return nil
 }
 
+// newBlobStore 按配置创建附件存储
+func newBlobStore(cfg *config.BlobStoreConfig) (blobstore.BlobStore, error) {
+	switch cfg.GetDriver() {
+	case "local":
+		return blobstore.NewLocalStore(cfg.GetLocalDir())
+	default:
+		return nil, fmt.Errorf("unsupported blob store driver: %s", cfg.GetDriver())
+	}
+}
+
 // initResources 初始化资源
 func (l *Logic) initResources() (*resources, error) {
 	// DB (PostgreSQL)
@@ -262,22 +316,57 @@ func (l *Logic) initResources() (*resources, error) {
 	if err != nil {
 		return nil, fmt.Errorf("router repo init: %w", err)
 	}
+	dedupRepo, err := repo.NewMessageDedupRepo(redisConn, repo.WithMessageDedupRepoLogger(l.logger))
+	if err != nil {
+		return nil, fmt.Errorf("dedup repo init: %w", err)
+	}
+	attachmentRepo, err := repo.NewAttachmentRepo(dbInstance, repo.WithAttachmentRepoLogger(l.logger))
+	if err != nil {
+		return nil, fmt.Errorf("attachment repo init: %w", err)
+	}
+	scheduledRepo, err := repo.NewScheduledMessageRepo(dbInstance, repo.WithScheduledMessageRepoLogger(l.logger))
+	if err != nil {
+		return nil, fmt.Errorf("scheduled message repo init: %w", err)
+	}
+	announceRepo, err := repo.NewAnnouncementRepo(dbInstance, repo.WithAnnouncementRepoLogger(l.logger))
+	if err != nil {
+		return nil, fmt.Errorf("announcement repo init: %w", err)
+	}
+	webhookRepo, err := repo.NewWebhookRepo(dbInstance, repo.WithWebhookRepoLogger(l.logger))
+	if err != nil {
+		return nil, fmt.Errorf("webhook repo init: %w", err)
+	}
+	botRepo, err := repo.NewBotRepo(dbInstance, repo.WithBotRepoLogger(l.logger))
+	if err != nil {
+		return nil, fmt.Errorf("bot repo init: %w", err)
+	}
+	moderationRepo, err := repo.NewModerationRepo(dbInstance, repo.WithModerationRepoLogger(l.logger))
+	if err != nil {
+		return nil, fmt.Errorf("moderation repo init: %w", err)
+	}
 
 	return &resources{
-		postgresConn:  postgresConn,
-		redisConn:     redisConn,
-		natsConn:      natsConn,
-		etcdConn:      etcdConn,
-		mqClient:      mqClient,
-		dbInstance:    dbInstance,
-		authenticator: authenticator,
-		msgIDGen:      msgIDGen,
-		sessionIDGen:  sessionIDGen,
-		sequencer:     sequencer,
-		userRepo:      userRepo,
-		sessionRepo:   sessionRepo,
-		messageRepo:   messageRepo,
-		routerRepo:    routerRepo,
+		postgresConn:   postgresConn,
+		redisConn:      redisConn,
+		natsConn:       natsConn,
+		etcdConn:       etcdConn,
+		mqClient:       mqClient,
+		dbInstance:     dbInstance,
+		authenticator:  authenticator,
+		msgIDGen:       msgIDGen,
+		sessionIDGen:   sessionIDGen,
+		sequencer:      sequencer,
+		userRepo:       userRepo,
+		sessionRepo:    sessionRepo,
+		messageRepo:    messageRepo,
+		routerRepo:     routerRepo,
+		dedupRepo:      dedupRepo,
+		attachmentRepo: attachmentRepo,
+		scheduledRepo:  scheduledRepo,
+		announceRepo:   announceRepo,
+		webhookRepo:    webhookRepo,
+		botRepo:        botRepo,
+		moderationRepo: moderationRepo,
 	}, nil
 }
 
@@ -292,6 +381,11 @@ func (l *Logic) Run() error {
 
 	// 启动后台任务
 	go l.outboxRelay.Start(l.ctx)
+	go l.scheduledSender.Start(l.ctx)
+	go l.messageExpirer.Start(l.ctx)
+	if l.moderator != nil {
+		go l.moderator.Start(l.ctx)
+	}
 
 	// 启动 gRPC Server
 	go func() {
@@ -354,6 +448,9 @@ func (l *Logic) Close() error {
 	if l.grpcServer != nil {
 		l.grpcServer.Stop()
 	}
+	if l.gatewayPusher != nil {
+		l.gatewayPusher.Close()
+	}
 
 	// 4. 释放资源（带超时控制）
 	if l.resources != nil {
@@ -374,6 +471,13 @@ func (l *Logic) Close() error {
 			l.resources.sessionRepo.Close()
 			l.resources.userRepo.Close()
 			l.resources.messageRepo.Close()
+			l.resources.dedupRepo.Close()
+			l.resources.attachmentRepo.Close()
+			l.resources.scheduledRepo.Close()
+			l.resources.announceRepo.Close()
+			l.resources.webhookRepo.Close()
+			l.resources.botRepo.Close()
+			l.resources.moderationRepo.Close()
 
 			l.resources.etcdConn.Close()
 			l.resources.natsConn.Close()
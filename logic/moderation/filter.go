@@ -0,0 +1,265 @@
+package moderation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+)
+
+// maxClassifierResponseSize 外部分类器响应体的最大字节数
+const maxClassifierResponseSize = 64 * 1024
+
+// WordRule 敏感词规则
+type WordRule struct {
+	Word   string
+	Action Action
+}
+
+// WordFilter 敏感词过滤器，基于 Aho-Corasick 自动机一次扫描匹配全部敏感词
+// 命中多个词时取最严格的动作；动作为 mask 的词会被打码，其余动作的词保留原文
+type WordFilter struct {
+	matcher  *Matcher
+	rules    []WordRule
+	maskChar rune
+}
+
+// NewWordFilter 创建敏感词过滤器
+func NewWordFilter(rules []WordRule, maskChar rune) *WordFilter {
+	words := make([]string, len(rules))
+	for i, rule := range rules {
+		words[i] = rule.Word
+	}
+	return &WordFilter{
+		matcher:  NewMatcher(words),
+		rules:    rules,
+		maskChar: maskChar,
+	}
+}
+
+// Name 实现 Filter
+func (f *WordFilter) Name() string { return "word" }
+
+// Check 实现 Filter
+func (f *WordFilter) Check(_ context.Context, in *Input) (*Verdict, error) {
+	text := []rune(in.Content)
+	matches := f.matcher.FindAll(text)
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	verdict := &Verdict{Action: ActionAllow}
+	masked := false
+	var hits []string
+	seen := make(map[int]struct{}, len(matches))
+	for _, m := range matches {
+		rule := f.rules[m.Index]
+		if rule.Action > verdict.Action {
+			verdict.Action = rule.Action
+		}
+		if rule.Action == ActionMask {
+			for i := m.Start; i < m.End; i++ {
+				text[i] = f.maskChar
+			}
+			masked = true
+		}
+		if _, ok := seen[m.Index]; !ok {
+			seen[m.Index] = struct{}{}
+			hits = append(hits, rule.Word)
+		}
+	}
+	if masked {
+		verdict.Content = string(text)
+	}
+	verdict.Reason = "word:" + strings.Join(hits, ",")
+	return verdict, nil
+}
+
+// RegexRule 正则规则
+type RegexRule struct {
+	Pattern *regexp.Regexp
+	Action  Action
+}
+
+// RegexFilter 正则过滤器，规则按顺序匹配，语义与 WordFilter 一致
+type RegexFilter struct {
+	rules    []RegexRule
+	maskChar rune
+}
+
+// NewRegexFilter 创建正则过滤器
+func NewRegexFilter(rules []RegexRule, maskChar rune) *RegexFilter {
+	return &RegexFilter{rules: rules, maskChar: maskChar}
+}
+
+// Name 实现 Filter
+func (f *RegexFilter) Name() string { return "regex" }
+
+// Check 实现 Filter
+func (f *RegexFilter) Check(_ context.Context, in *Input) (*Verdict, error) {
+	verdict := &Verdict{Action: ActionAllow}
+	content := in.Content
+	masked := false
+	var hits []string
+	for _, rule := range f.rules {
+		if !rule.Pattern.MatchString(content) {
+			continue
+		}
+		hits = append(hits, rule.Pattern.String())
+		if rule.Action > verdict.Action {
+			verdict.Action = rule.Action
+		}
+		if rule.Action == ActionMask {
+			content = rule.Pattern.ReplaceAllStringFunc(content, func(s string) string {
+				return strings.Repeat(string(f.maskChar), len([]rune(s)))
+			})
+			masked = true
+		}
+	}
+	if len(hits) == 0 {
+		return nil, nil
+	}
+	if masked {
+		verdict.Content = content
+	}
+	verdict.Reason = "regex:" + strings.Join(hits, ",")
+	return verdict, nil
+}
+
+// Classifier 外部内容分类器（如第三方审核服务、模型推理服务）
+// 返回 nil 或 ActionAllow 表示放行
+type Classifier interface {
+	Classify(ctx context.Context, in *Input) (*Verdict, error)
+}
+
+// HookFilter 外部分类器过滤器
+// 分类器超时或出错时默认放行（fail-open），避免外部服务故障导致无法发消息；failClosed 为 true 时拒绝
+type HookFilter struct {
+	classifier Classifier
+	timeout    time.Duration
+	failClosed bool
+	logger     clog.Logger
+}
+
+// NewHookFilter 创建外部分类器过滤器
+func NewHookFilter(classifier Classifier, timeout time.Duration, failClosed bool, logger clog.Logger) *HookFilter {
+	return &HookFilter{
+		classifier: classifier,
+		timeout:    timeout,
+		failClosed: failClosed,
+		logger:     logger,
+	}
+}
+
+// Name 实现 Filter
+func (f *HookFilter) Name() string { return "hook" }
+
+// Check 实现 Filter
+func (f *HookFilter) Check(ctx context.Context, in *Input) (*Verdict, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	verdict, err := f.classifier.Classify(ctx, in)
+	if err != nil {
+		f.logger.Warn("moderation classifier unavailable",
+			clog.String("session_id", in.SessionID),
+			clog.Error(err))
+		if f.failClosed {
+			return &Verdict{Action: ActionReject, Reason: "hook:unavailable"}, nil
+		}
+		return nil, nil
+	}
+	if verdict != nil && verdict.Reason == "" && verdict.Action != ActionAllow {
+		verdict.Reason = "hook:" + verdict.Action.String()
+	}
+	return verdict, nil
+}
+
+// classifierRequest HTTPClassifier 的请求体
+type classifierRequest struct {
+	SessionID string `json:"session_id"`
+	Username  string `json:"username"`
+	Content   string `json:"content"`
+}
+
+// classifierResponse HTTPClassifier 的响应体
+// action 为 allow / mask / flag / reject；mask 时 content 为打码后的内容
+type classifierResponse struct {
+	Action  string `json:"action"`
+	Content string `json:"content"`
+	Reason  string `json:"reason"`
+}
+
+// HTTPClassifier 通过 HTTP 调用外部分类器：POST JSON {session_id, username, content}
+// 响应 JSON {action, content, reason}
+type HTTPClassifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPClassifier 创建 HTTP 外部分类器，超时由 HookFilter 通过 context 控制
+func NewHTTPClassifier(url string) *HTTPClassifier {
+	return &HTTPClassifier{
+		url:    url,
+		client: &http.Client{},
+	}
+}
+
+// Classify 实现 Classifier
+func (c *HTTPClassifier) Classify(ctx context.Context, in *Input) (*Verdict, error) {
+	body, err := json.Marshal(&classifierRequest{
+		SessionID: in.SessionID,
+		Username:  in.Username,
+		Content:   in.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Resonance-Moderation/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("classifier returned status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxClassifierResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	var reply classifierResponse
+	if err := json.Unmarshal(data, &reply); err != nil {
+		return nil, fmt.Errorf("invalid classifier response: %w", err)
+	}
+	if reply.Action == "" {
+		return nil, nil
+	}
+	action, err := ParseAction(reply.Action)
+	if err != nil {
+		return nil, err
+	}
+	verdict := &Verdict{Action: action, Reason: reply.Reason}
+	if action == ActionMask {
+		if reply.Content == "" {
+			return nil, fmt.Errorf("mask response requires content")
+		}
+		verdict.Content = reply.Content
+	}
+	return verdict, nil
+}
@@ -0,0 +1,105 @@
+package moderation
+
+import "unicode"
+
+// WordMatch 一次敏感词命中，Start/End 为 rune 下标（左闭右开）
+type WordMatch struct {
+	Start int
+	End   int
+	Index int // 命中的词在构建时的下标
+}
+
+// acNode Aho-Corasick 自动机节点
+type acNode struct {
+	next map[rune]int32
+	fail int32
+	out  []int32 // 以该节点结尾的词（含失败链上的词）
+}
+
+// Matcher 基于 Aho-Corasick 自动机的多模式匹配器，大小写不敏感
+// 构建后只读，可并发使用；单次扫描的复杂度与文本长度及命中数成正比，与词库大小无关
+type Matcher struct {
+	nodes   []acNode
+	lengths []int // 每个词的 rune 长度
+}
+
+// NewMatcher 构建匹配器，空词会被忽略（下标仍保留，以便与调用方的规则一一对应）
+func NewMatcher(words []string) *Matcher {
+	m := &Matcher{
+		nodes:   []acNode{{next: make(map[rune]int32)}},
+		lengths: make([]int, len(words)),
+	}
+
+	// 1. 构建字典树
+	for i, word := range words {
+		runes := []rune(word)
+		m.lengths[i] = len(runes)
+		if len(runes) == 0 {
+			continue
+		}
+		cur := int32(0)
+		for _, r := range runes {
+			r = unicode.ToLower(r)
+			next, ok := m.nodes[cur].next[r]
+			if !ok {
+				next = int32(len(m.nodes))
+				m.nodes = append(m.nodes, acNode{next: make(map[rune]int32)})
+				m.nodes[cur].next[r] = next
+			}
+			cur = next
+		}
+		m.nodes[cur].out = append(m.nodes[cur].out, int32(i))
+	}
+
+	// 2. BFS 构建失败指针，并合并失败链上的输出
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			fail := m.nodes[cur].fail
+			for {
+				if next, ok := m.nodes[fail].next[r]; ok && next != child {
+					m.nodes[child].fail = next
+					break
+				}
+				if fail == 0 {
+					m.nodes[child].fail = 0
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			if out := m.nodes[m.nodes[child].fail].out; len(out) > 0 {
+				m.nodes[child].out = append(m.nodes[child].out, out...)
+			}
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// FindAll 返回文本中所有命中（可能重叠）
+func (m *Matcher) FindAll(text []rune) []WordMatch {
+	var matches []WordMatch
+	cur := int32(0)
+	for i, r := range text {
+		r = unicode.ToLower(r)
+		for {
+			if next, ok := m.nodes[cur].next[r]; ok {
+				cur = next
+				break
+			}
+			if cur == 0 {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		for _, idx := range m.nodes[cur].out {
+			matches = append(matches, WordMatch{Start: i + 1 - m.lengths[idx], End: i + 1, Index: int(idx)})
+		}
+	}
+	return matches
+}
@@ -0,0 +1,123 @@
+// Package moderation 实现消息发送前的内容审核管道
+// 管道由有序的过滤器组成（敏感词、正则、外部分类器），每个过滤器给出 allow / mask / flag / reject 之一：
+//   - mask：将命中内容替换为打码字符，后续过滤器基于打码后的内容继续检查
+//   - flag：消息照常发送，同时写入待复核队列
+//   - reject：拒绝发送，立即停止后续过滤器
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Action 审核动作，数值越大越严格
+type Action int
+
+const (
+	ActionAllow Action = iota
+	ActionMask
+	ActionFlag
+	ActionReject
+)
+
+// String 返回动作名
+func (a Action) String() string {
+	switch a {
+	case ActionMask:
+		return "mask"
+	case ActionFlag:
+		return "flag"
+	case ActionReject:
+		return "reject"
+	}
+	return "allow"
+}
+
+// ParseAction 解析动作名（allow / mask / flag / reject）
+func ParseAction(s string) (Action, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "allow":
+		return ActionAllow, nil
+	case "mask":
+		return ActionMask, nil
+	case "flag":
+		return ActionFlag, nil
+	case "reject":
+		return ActionReject, nil
+	}
+	return ActionAllow, fmt.Errorf("unknown moderation action: %s", s)
+}
+
+// Input 待审核的消息
+type Input struct {
+	SessionID string
+	Username  string
+	Content   string
+}
+
+// Verdict 单个过滤器的审核结论
+type Verdict struct {
+	Action  Action
+	Content string // 非空时为打码后的内容（ActionReject 时忽略）
+	Reason  string // 命中原因，写入待复核队列与日志
+}
+
+// Filter 审核过滤器
+// 未命中时返回 nil 或 ActionAllow；返回错误时整个审核失败，由调用方决定如何处理
+type Filter interface {
+	Name() string
+	Check(ctx context.Context, in *Input) (*Verdict, error)
+}
+
+// Result 审核管道的最终结论
+type Result struct {
+	Action  Action   // 各过滤器结论中最严格的动作
+	Content string   // 审核后的内容（可能已打码）
+	Reasons []string // 各过滤器的命中原因
+}
+
+// Pipeline 按顺序执行的过滤器链，创建后只读，可并发使用
+type Pipeline struct {
+	filters []Filter
+}
+
+// NewPipeline 创建审核管道，filters 按给定顺序执行，nil 会被忽略
+func NewPipeline(filters ...Filter) *Pipeline {
+	p := &Pipeline{}
+	for _, f := range filters {
+		if f != nil {
+			p.filters = append(p.filters, f)
+		}
+	}
+	return p
+}
+
+// Moderate 执行审核
+func (p *Pipeline) Moderate(ctx context.Context, in *Input) (*Result, error) {
+	result := &Result{Action: ActionAllow, Content: in.Content}
+	cur := *in
+	for _, f := range p.filters {
+		v, err := f.Check(ctx, &cur)
+		if err != nil {
+			return nil, fmt.Errorf("moderation filter %s: %w", f.Name(), err)
+		}
+		if v == nil || v.Action == ActionAllow {
+			continue
+		}
+		if v.Reason != "" {
+			result.Reasons = append(result.Reasons, v.Reason)
+		}
+		if v.Content != "" && v.Action != ActionReject {
+			cur.Content = v.Content
+			result.Content = v.Content
+		}
+		if v.Action > result.Action {
+			result.Action = v.Action
+		}
+		if v.Action == ActionReject {
+			return result, nil
+		}
+	}
+	return result, nil
+}
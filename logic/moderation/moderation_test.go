@@ -0,0 +1,180 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatcherFindAll(t *testing.T) {
+	m := NewMatcher([]string{"he", "she", "his", "hers", ""})
+
+	matches := m.FindAll([]rune("uSHErs"))
+	got := make(map[int][2]int)
+	for _, match := range matches {
+		got[match.Index] = [2]int{match.Start, match.End}
+	}
+	assert.Equal(t, map[int][2]int{
+		0: {2, 4}, // he
+		1: {1, 4}, // she（大小写不敏感）
+		3: {2, 6}, // hers
+	}, got)
+
+	assert.Empty(t, m.FindAll([]rune("nothing")))
+}
+
+func TestMatcherMultiByte(t *testing.T) {
+	m := NewMatcher([]string{"敏感词", "感"})
+
+	matches := m.FindAll([]rune("这是敏感词"))
+	require.Len(t, matches, 2)
+	assert.Equal(t, WordMatch{Start: 3, End: 4, Index: 1}, matches[0])
+	assert.Equal(t, WordMatch{Start: 2, End: 5, Index: 0}, matches[1])
+}
+
+func TestWordFilter(t *testing.T) {
+	f := NewWordFilter([]WordRule{
+		{Word: "bad", Action: ActionMask},
+		{Word: "spam", Action: ActionFlag},
+	}, '*')
+	ctx := context.Background()
+
+	v, err := f.Check(ctx, &Input{Content: "hello"})
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	v, err = f.Check(ctx, &Input{Content: "a BAD word"})
+	require.NoError(t, err)
+	assert.Equal(t, ActionMask, v.Action)
+	assert.Equal(t, "a *** word", v.Content)
+	assert.Equal(t, "word:bad", v.Reason)
+
+	// 命中多个词时取最严格的动作，mask 词仍被打码
+	v, err = f.Check(ctx, &Input{Content: "bad spam bad"})
+	require.NoError(t, err)
+	assert.Equal(t, ActionFlag, v.Action)
+	assert.Equal(t, "*** spam ***", v.Content)
+	assert.Equal(t, "word:bad,spam", v.Reason)
+}
+
+func TestRegexFilter(t *testing.T) {
+	f := NewRegexFilter([]RegexRule{
+		{Pattern: regexp.MustCompile(`https?://\S+`), Action: ActionMask},
+	}, '#')
+
+	v, err := f.Check(context.Background(), &Input{Content: "看 http://a.io 吧"})
+	require.NoError(t, err)
+	assert.Equal(t, ActionMask, v.Action)
+	assert.Equal(t, "看 ########### 吧", v.Content)
+}
+
+type stubClassifier struct {
+	verdict *Verdict
+	err     error
+}
+
+func (c *stubClassifier) Classify(context.Context, *Input) (*Verdict, error) {
+	return c.verdict, c.err
+}
+
+func TestHookFilterFailOpen(t *testing.T) {
+	failing := &stubClassifier{err: errors.New("unavailable")}
+
+	v, err := NewHookFilter(failing, time.Second, false, clog.Discard()).Check(context.Background(), &Input{})
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	v, err = NewHookFilter(failing, time.Second, true, clog.Discard()).Check(context.Background(), &Input{})
+	require.NoError(t, err)
+	assert.Equal(t, ActionReject, v.Action)
+}
+
+func TestPipelineOrder(t *testing.T) {
+	words := NewWordFilter([]WordRule{{Word: "bad", Action: ActionMask}}, '*')
+	hook := NewHookFilter(&stubClassifier{verdict: &Verdict{Action: ActionFlag}}, time.Second, false, clog.Discard())
+	reject := NewRegexFilter([]RegexRule{{Pattern: regexp.MustCompile(`forbidden`), Action: ActionReject}}, '*')
+	ctx := context.Background()
+
+	// 打码结果传递给后续过滤器，标记累积
+	result, err := NewPipeline(words, hook).Moderate(ctx, &Input{Content: "bad"})
+	require.NoError(t, err)
+	assert.Equal(t, ActionFlag, result.Action)
+	assert.Equal(t, "***", result.Content)
+	assert.Equal(t, []string{"word:bad", "hook:flag"}, result.Reasons)
+
+	// 拒绝后不再执行后续过滤器
+	result, err = NewPipeline(reject, hook).Moderate(ctx, &Input{Content: "forbidden"})
+	require.NoError(t, err)
+	assert.Equal(t, ActionReject, result.Action)
+	assert.Len(t, result.Reasons, 1)
+
+	result, err = NewPipeline().Moderate(ctx, &Input{Content: "bad"})
+	require.NoError(t, err)
+	assert.Equal(t, ActionAllow, result.Action)
+	assert.Equal(t, "bad", result.Content)
+}
+
+type testModerationRepo struct {
+	repo.ModerationRepo
+	rules   []*model.ModerationRule
+	reviews []*model.ModerationReview
+}
+
+func (r *testModerationRepo) ListEnabledRules(context.Context) ([]*model.ModerationRule, error) {
+	return r.rules, nil
+}
+
+func (r *testModerationRepo) CreateReview(_ context.Context, review *model.ModerationReview) error {
+	r.reviews = append(r.reviews, review)
+	return nil
+}
+
+func TestModeratorReload(t *testing.T) {
+	wordFile := filepath.Join(t.TempDir(), "words.txt")
+	require.NoError(t, os.WriteFile(wordFile, []byte("# comment\nfoo\nbar|reject\n"), 0o644))
+
+	moderationRepo := &testModerationRepo{rules: []*model.ModerationRule{
+		{ID: 1, Kind: model.ModerationRuleRegex, Pattern: `\d{11}`, Action: "flag"},
+		{ID: 2, Kind: model.ModerationRuleRegex, Pattern: `(`, Action: "mask"}, // 非法正则被跳过
+	}}
+	m := NewModerator(moderationRepo, &config.ModerationConfig{WordFile: wordFile}, clog.Discard())
+	ctx := context.Background()
+	require.NoError(t, m.Reload(ctx))
+
+	result, err := m.Moderate(ctx, &Input{Content: "foo 13800000000"})
+	require.NoError(t, err)
+	assert.Equal(t, ActionFlag, result.Action)
+	assert.Equal(t, "*** 13800000000", result.Content)
+
+	result, err = m.Moderate(ctx, &Input{Content: "bar"})
+	require.NoError(t, err)
+	assert.Equal(t, ActionReject, result.Action)
+
+	// 热加载：文件与规则表变化后立即生效
+	require.NoError(t, os.WriteFile(wordFile, []byte("foo|allow\n"), 0o644))
+	moderationRepo.rules = append(moderationRepo.rules, &model.ModerationRule{ID: 3, Kind: model.ModerationRuleWord, Pattern: "baz", Action: "reject"})
+	require.NoError(t, m.Reload(ctx))
+
+	result, err = m.Moderate(ctx, &Input{Content: "foo bar"})
+	require.NoError(t, err)
+	assert.Equal(t, ActionAllow, result.Action)
+
+	result, err = m.Moderate(ctx, &Input{Content: "BAZ"})
+	require.NoError(t, err)
+	assert.Equal(t, ActionReject, result.Action)
+
+	require.NoError(t, m.EnqueueReview(ctx, &model.ModerationReview{MsgID: 1}))
+	require.Len(t, moderationRepo.reviews, 1)
+	assert.Equal(t, model.ModerationReviewPending, moderationRepo.reviews[0].Status)
+}
@@ -0,0 +1,233 @@
+package moderation
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+)
+
+// ModeratorOption 配置 Moderator 的选项
+type ModeratorOption func(*Moderator)
+
+// WithClassifier 设置外部分类器，覆盖配置中的 hook.url
+func WithClassifier(classifier Classifier) ModeratorOption {
+	return func(m *Moderator) {
+		m.classifier = classifier
+	}
+}
+
+// Moderator 内容审核器：持有当前生效的审核管道，并定期从敏感词文件与规则表热加载
+// 加载失败时保留上一版管道，规则未变化时不重建
+type Moderator struct {
+	repo       repo.ModerationRepo
+	config     *config.ModerationConfig
+	logger     clog.Logger
+	classifier Classifier
+
+	pipeline    atomic.Pointer[Pipeline]
+	fingerprint string // 上一次加载的规则指纹，仅在 Reload 中读写
+}
+
+// NewModerator 创建内容审核器，需调用 Reload 加载规则后才会生效
+func NewModerator(moderationRepo repo.ModerationRepo, cfg *config.ModerationConfig, logger clog.Logger, opts ...ModeratorOption) *Moderator {
+	m := &Moderator{
+		repo:   moderationRepo,
+		config: cfg,
+		logger: logger.WithNamespace("moderator"),
+	}
+	if cfg.Hook.URL != "" {
+		m.classifier = NewHTTPClassifier(cfg.Hook.URL)
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.pipeline.Store(NewPipeline())
+	return m
+}
+
+// Start 启动规则热加载任务
+func (m *Moderator) Start(ctx context.Context) {
+	m.logger.Info("starting moderation rule reloader")
+	ticker := time.NewTicker(m.config.GetReloadInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			m.logger.Info("moderation rule reloader stopped")
+			return
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						m.logger.Error("panic in moderation rule reloader", clog.Any("panic", r))
+					}
+				}()
+				if err := m.Reload(ctx); err != nil {
+					m.logger.Error("failed to reload moderation rules", clog.Error(err))
+				}
+			}()
+		}
+	}
+}
+
+// Reload 重新读取敏感词文件与规则表，规则变化时原子替换审核管道
+// Start 之外仅应在启动时调用一次
+func (m *Moderator) Reload(ctx context.Context) error {
+	// 1. 读取规则源
+	var fileData []byte
+	if m.config.WordFile != "" {
+		data, err := os.ReadFile(m.config.WordFile)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to read word file: %w", err)
+		}
+		if err != nil {
+			m.logger.Warn("moderation word file not found", clog.String("path", m.config.WordFile))
+		}
+		fileData = data
+	}
+	var rules []*model.ModerationRule
+	if m.repo != nil {
+		var err error
+		if rules, err = m.repo.ListEnabledRules(ctx); err != nil {
+			return err
+		}
+	}
+
+	// 2. 规则未变化时跳过
+	fingerprint := rulesFingerprint(fileData, rules)
+	if fingerprint == m.fingerprint {
+		return nil
+	}
+
+	// 3. 构建过滤器
+	defaultAction, err := ParseAction(m.config.GetWordAction())
+	if err != nil {
+		return err
+	}
+	words := m.parseWordFile(fileData, defaultAction)
+	var regexes []RegexRule
+	for _, rule := range rules {
+		action, err := ParseAction(rule.Action)
+		if err != nil {
+			m.logger.Warn("skip moderation rule with invalid action",
+				clog.Int64("rule_id", rule.ID),
+				clog.String("action", rule.Action))
+			continue
+		}
+		switch rule.Kind {
+		case model.ModerationRuleWord:
+			if word := strings.TrimSpace(rule.Pattern); word != "" {
+				words = append(words, WordRule{Word: word, Action: action})
+			}
+		case model.ModerationRuleRegex:
+			re, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				m.logger.Warn("skip moderation rule with invalid regex",
+					clog.Int64("rule_id", rule.ID),
+					clog.Error(err))
+				continue
+			}
+			regexes = append(regexes, RegexRule{Pattern: re, Action: action})
+		default:
+			m.logger.Warn("skip moderation rule with unknown kind",
+				clog.Int64("rule_id", rule.ID),
+				clog.String("kind", rule.Kind))
+		}
+	}
+
+	maskChar := m.config.GetMaskChar()
+	var filters []Filter
+	for _, name := range m.config.GetFilters() {
+		switch name {
+		case "word":
+			if len(words) > 0 {
+				filters = append(filters, NewWordFilter(words, maskChar))
+			}
+		case "regex":
+			if len(regexes) > 0 {
+				filters = append(filters, NewRegexFilter(regexes, maskChar))
+			}
+		case "hook":
+			if m.classifier != nil {
+				filters = append(filters, NewHookFilter(m.classifier, m.config.GetHookTimeout(), m.config.Hook.FailClosed, m.logger))
+			}
+		default:
+			m.logger.Warn("unknown moderation filter", clog.String("filter", name))
+		}
+	}
+
+	// 4. 原子替换，进行中的审核继续使用旧管道
+	m.pipeline.Store(NewPipeline(filters...))
+	m.fingerprint = fingerprint
+	m.logger.Info("moderation rules reloaded",
+		clog.Int("words", len(words)),
+		clog.Int("regexes", len(regexes)),
+		clog.Int("filters", len(filters)))
+	return nil
+}
+
+// Moderate 使用当前生效的管道审核消息
+func (m *Moderator) Moderate(ctx context.Context, in *Input) (*Result, error) {
+	return m.pipeline.Load().Moderate(ctx, in)
+}
+
+// EnqueueReview 将标记的消息写入待复核队列
+func (m *Moderator) EnqueueReview(ctx context.Context, review *model.ModerationReview) error {
+	if m.repo == nil {
+		return fmt.Errorf("moderation repo not configured")
+	}
+	review.Status = model.ModerationReviewPending
+	return m.repo.CreateReview(ctx, review)
+}
+
+// parseWordFile 解析敏感词文件：一行一个词，"词|动作" 指定动作，# 开头为注释
+func (m *Moderator) parseWordFile(data []byte, defaultAction Action) []WordRule {
+	var words []WordRule
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rule := WordRule{Word: line, Action: defaultAction}
+		if i := strings.LastIndex(line, "|"); i > 0 {
+			action, err := ParseAction(line[i+1:])
+			if err != nil {
+				m.logger.Warn("invalid action in word file, using default", clog.String("line", line))
+			} else {
+				rule.Word = strings.TrimSpace(line[:i])
+				rule.Action = action
+			}
+		}
+		if rule.Word != "" {
+			words = append(words, rule)
+		}
+	}
+	return words
+}
+
+// rulesFingerprint 计算规则源的指纹，用于判断是否需要重建管道
+func rulesFingerprint(fileData []byte, rules []*model.ModerationRule) string {
+	h := sha256.New()
+	h.Write(fileData)
+	for _, rule := range rules {
+		fmt.Fprintf(h, "\n%d|%s|%s|%s|%d", rule.ID, rule.Kind, rule.Action, rule.Pattern, rule.UpdatedAt.UnixNano())
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,121 @@
+// Package push 提供 Logic 直连 Gateway 推送瞬时信号的能力
+// 正在输入等瞬时信号不落库、不经过 MQ，由 Logic 查询路由表后直接调用目标 Gateway 的 PushService
+package push
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/registry"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Pusher 按 Gateway 实例 ID 直接调用 PushService.Push
+// 连接在首次推送到某个 Gateway 时按服务发现结果建立并缓存，推送失败时丢弃，下次重新发现
+type Pusher struct {
+	registry    registry.Registry
+	serviceName string
+	logger      clog.Logger
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn // gatewayID -> conn
+}
+
+// NewPusher 创建 Gateway 直连推送器
+func NewPusher(reg registry.Registry, serviceName string, logger clog.Logger) *Pusher {
+	return &Pusher{
+		registry:    reg,
+		serviceName: serviceName,
+		logger:      logger,
+		conns:       make(map[string]*grpc.ClientConn),
+	}
+}
+
+// Push 将推送请求发给指定 Gateway
+func (p *Pusher) Push(ctx context.Context, gatewayID string, req *gatewayv1.PushRequest) error {
+	conn, err := p.getConn(ctx, gatewayID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := gatewayv1.NewPushServiceClient(conn).Push(ctx, req); err != nil {
+		// Gateway 可能已下线或迁移，丢弃连接以便下次重新发现
+		p.dropConn(gatewayID, conn)
+		return fmt.Errorf("push to gateway %s: %w", gatewayID, err)
+	}
+	return nil
+}
+
+// getConn 获取 Gateway 连接，未缓存时通过服务发现查找地址
+func (p *Pusher) getConn(ctx context.Context, gatewayID string) (*grpc.ClientConn, error) {
+	p.mu.Lock()
+	conn, ok := p.conns[gatewayID]
+	p.mu.Unlock()
+	if ok {
+		return conn, nil
+	}
+
+	services, err := p.registry.GetService(ctx, p.serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get services: %w", err)
+	}
+	var addr string
+	for _, svc := range services {
+		if svc.ID == gatewayID && len(svc.Endpoints) > 0 {
+			// endpoints: ["grpc://127.0.0.1:9091"]
+			addr = strings.TrimPrefix(svc.Endpoints[0], "grpc://")
+			break
+		}
+	}
+	if addr == "" {
+		return nil, fmt.Errorf("gateway not found: %s", gatewayID)
+	}
+
+	conn, err = grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect gateway %s: %w", addr, err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if existing, ok := p.conns[gatewayID]; ok {
+		// 并发建立了连接，保留先缓存的
+		conn.Close()
+		return existing, nil
+	}
+	p.conns[gatewayID] = conn
+	p.logger.Info("gateway push client connected",
+		clog.String("id", gatewayID),
+		clog.String("addr", addr))
+	return conn, nil
+}
+
+// dropConn 丢弃失效的连接
+func (p *Pusher) dropConn(gatewayID string, conn *grpc.ClientConn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.conns[gatewayID] != conn {
+		return
+	}
+	delete(p.conns, gatewayID)
+	if err := conn.Close(); err != nil {
+		p.logger.Error("failed to close gateway conn", clog.String("id", gatewayID), clog.Error(err))
+	}
+}
+
+// Close 关闭所有连接
+func (p *Pusher) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for id, conn := range p.conns {
+		if err := conn.Close(); err != nil {
+			p.logger.Error("failed to close gateway conn", clog.String("id", id), clog.Error(err))
+		}
+	}
+	p.conns = make(map[string]*grpc.ClientConn)
+}
@@ -16,13 +16,18 @@ import (
 
 // GRPCServer gRPC 服务包装器
 type GRPCServer struct {
-	logger      clog.Logger
-	server      *grpc.Server
-	addr        string
-	authSvc     *service.AuthService
-	sessionSvc  *service.SessionService
-	chatSvc     *service.ChatService
-	presenceSvc *service.PresenceService
+	logger        clog.Logger
+	server        *grpc.Server
+	addr          string
+	authSvc       *service.AuthService
+	sessionSvc    *service.SessionService
+	chatSvc       *service.ChatService
+	presenceSvc   *service.PresenceService
+	attachmentSvc *service.AttachmentService
+	scheduleSvc   *service.ScheduleService
+	announceSvc   *service.AnnouncementService
+	webhookSvc    *service.WebhookService
+	botSvc        *service.BotService
 }
 
 // NewGRPCServer 创建 gRPC 服务
@@ -33,14 +38,24 @@ func NewGRPCServer(
 	sessionSvc *service.SessionService,
 	chatSvc *service.ChatService,
 	presenceSvc *service.PresenceService,
+	attachmentSvc *service.AttachmentService,
+	scheduleSvc *service.ScheduleService,
+	announceSvc *service.AnnouncementService,
+	webhookSvc *service.WebhookService,
+	botSvc *service.BotService,
 ) *GRPCServer {
 	return &GRPCServer{
-		addr:        addr,
-		logger:      logger,
-		authSvc:     authSvc,
-		sessionSvc:  sessionSvc,
-		chatSvc:     chatSvc,
-		presenceSvc: presenceSvc,
+		addr:          addr,
+		logger:        logger,
+		authSvc:       authSvc,
+		sessionSvc:    sessionSvc,
+		chatSvc:       chatSvc,
+		presenceSvc:   presenceSvc,
+		attachmentSvc: attachmentSvc,
+		scheduleSvc:   scheduleSvc,
+		announceSvc:   announceSvc,
+		webhookSvc:    webhookSvc,
+		botSvc:        botSvc,
 	}
 }
 
@@ -63,6 +78,11 @@ func (s *GRPCServer) Start() error {
 	logicv1.RegisterSessionServiceServer(s.server, s.sessionSvc)
 	logicv1.RegisterChatServiceServer(s.server, s.chatSvc)
 	logicv1.RegisterPresenceServiceServer(s.server, s.presenceSvc)
+	logicv1.RegisterAttachmentServiceServer(s.server, s.attachmentSvc)
+	logicv1.RegisterScheduleServiceServer(s.server, s.scheduleSvc)
+	logicv1.RegisterAnnouncementServiceServer(s.server, s.announceSvc)
+	logicv1.RegisterWebhookServiceServer(s.server, s.webhookSvc)
+	logicv1.RegisterBotServiceServer(s.server, s.botSvc)
 
 	lis, err := net.Listen("tcp", s.addr)
 	if err != nil {
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/idgen"
+	"github.com/ceyewan/genesis/mq"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxAnnouncementTitleLength 公告标题最大字符数
+	maxAnnouncementTitleLength = 128
+	// maxAnnouncementContentLength 公告正文最大字符数
+	maxAnnouncementContentLength = 4096
+	// defaultAnnouncementLimit ListAnnouncements 默认返回条数
+	defaultAnnouncementLimit = 20
+	// maxAnnouncementLimit ListAnnouncements 最大返回条数
+	maxAnnouncementLimit = 50
+)
+
+// AnnouncementService 系统公告服务
+// 公告经 Outbox 投递到 MQ，由 Task 广播到所有 Gateway 的在线用户
+type AnnouncementService struct {
+	logicv1.UnimplementedAnnouncementServiceServer
+	announcementRepo repo.AnnouncementRepo
+	idGen            idgen.Generator // Snowflake ID 生成器
+	mqClient         mq.MQ
+	adminConfig      *config.AdminConfig
+	logger           clog.Logger
+}
+
+// NewAnnouncementService 创建系统公告服务
+// 仅 adminCfg 中配置的系统管理员可发布公告
+func NewAnnouncementService(
+	announcementRepo repo.AnnouncementRepo,
+	idGen idgen.Generator,
+	mqClient mq.MQ,
+	adminCfg *config.AdminConfig,
+	logger clog.Logger,
+) *AnnouncementService {
+	return &AnnouncementService{
+		announcementRepo: announcementRepo,
+		idGen:            idGen,
+		mqClient:         mqClient,
+		adminConfig:      adminCfg,
+		logger:           logger,
+	}
+}
+
+// Announce 实现 AnnouncementService.Announce
+func (s *AnnouncementService) Announce(ctx context.Context, req *logicv1.AnnounceRequest) (*logicv1.AnnounceResponse, error) {
+	s.logger.Info("announce",
+		clog.String("username", req.Username),
+		clog.Int("content_length", len(req.Content)))
+
+	if req.Username == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username is required")
+	}
+	if s.adminConfig == nil || s.adminConfig.Username == "" || req.Username != s.adminConfig.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "only system admin can announce")
+	}
+
+	title := strings.TrimSpace(req.Title)
+	content := strings.TrimSpace(req.Content)
+	if content == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content is required")
+	}
+	if utf8.RuneCountInString(title) > maxAnnouncementTitleLength {
+		return nil, status.Errorf(codes.InvalidArgument, "title exceeds %d characters", maxAnnouncementTitleLength)
+	}
+	if utf8.RuneCountInString(content) > maxAnnouncementContentLength {
+		return nil, status.Errorf(codes.InvalidArgument, "content exceeds %d characters", maxAnnouncementContentLength)
+	}
+
+	now := time.Now()
+	announcement := &model.Announcement{
+		AnnouncementID: s.idGen.Next(),
+		Title:          title,
+		Content:        content,
+		FromUsername:   req.Username,
+		CreatedAt:      now,
+	}
+	pb := toAnnouncementProto(announcement)
+	event := &mqv1.PushEvent{
+		MsgId:        announcement.AnnouncementID,
+		FromUsername: req.Username,
+		Content:      content,
+		Timestamp:    now.Unix(),
+		EventType:    mqv1.EventType_EVENT_TYPE_ANNOUNCEMENT,
+		Announcement: pb,
+	}
+
+	result, err := PublishAnnouncementToMQ(ctx, s.announcementRepo, event, announcement, s.logger)
+	if err != nil {
+		s.logger.Error("failed to publish announcement", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to publish announcement")
+	}
+	PublishMessageToMQAsync(s.mqClient, result.OutboxID, result.Topic, result.EventData, s.logger)
+
+	s.logger.Info("announcement published", clog.Int64("announcement_id", announcement.AnnouncementID))
+	return &logicv1.AnnounceResponse{
+		Announcement: pb,
+	}, nil
+}
+
+// ListAnnouncements 实现 AnnouncementService.ListAnnouncements
+// 客户端登录后以本地已看到的最新公告 ID 为游标补拉离线期间错过的公告
+func (s *AnnouncementService) ListAnnouncements(ctx context.Context, req *logicv1.ListAnnouncementsRequest) (*logicv1.ListAnnouncementsResponse, error) {
+	if req.Username == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username is required")
+	}
+	if req.AfterId < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "after_id must not be negative")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultAnnouncementLimit
+	}
+	if limit > maxAnnouncementLimit {
+		limit = maxAnnouncementLimit
+	}
+
+	announcements, err := s.announcementRepo.ListAnnouncements(ctx, req.AfterId, limit)
+	if err != nil {
+		s.logger.Error("failed to list announcements", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list announcements")
+	}
+
+	result := make([]*gatewayv1.Announcement, 0, len(announcements))
+	for _, a := range announcements {
+		result = append(result, toAnnouncementProto(a))
+	}
+	return &logicv1.ListAnnouncementsResponse{
+		Announcements: result,
+	}, nil
+}
+
+// toAnnouncementProto 将公告模型转换为推送给客户端的结构
+func toAnnouncementProto(a *model.Announcement) *gatewayv1.Announcement {
+	return &gatewayv1.Announcement{
+		AnnouncementId: a.AnnouncementID,
+		Title:          a.Title,
+		Content:        a.Content,
+		FromUsername:   a.FromUsername,
+		CreatedAt:      a.CreatedAt.Unix(),
+	}
+}
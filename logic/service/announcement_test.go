@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testAnnouncementRepo struct {
+	created   []*model.Announcement
+	listAfter int64
+	listLimit int
+}
+
+func (r *testAnnouncementRepo) CreateAnnouncementWithOutbox(ctx context.Context, a *model.Announcement, outbox *model.MessageOutbox) error {
+	r.created = append(r.created, a)
+	return nil
+}
+func (r *testAnnouncementRepo) ListAnnouncements(ctx context.Context, afterID int64, limit int) ([]*model.Announcement, error) {
+	r.listAfter, r.listLimit = afterID, limit
+	return []*model.Announcement{{AnnouncementID: afterID + 1, Content: "maintenance", FromUsername: "admin", CreatedAt: time.Unix(100, 0)}}, nil
+}
+func (r *testAnnouncementRepo) Close() error { return nil }
+
+func TestAnnouncementService_Announce_OnlySystemAdmin(t *testing.T) {
+	repo := &testAnnouncementRepo{}
+	svc := NewAnnouncementService(repo, nil, testMQ{}, &config.AdminConfig{Username: "admin"}, clog.Discard())
+
+	_, err := svc.Announce(context.Background(), &logicv1.AnnounceRequest{Username: "alice", Content: "hi"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// 未配置系统管理员时任何人都不能发布公告
+	svc = NewAnnouncementService(repo, nil, testMQ{}, &config.AdminConfig{}, clog.Discard())
+	_, err = svc.Announce(context.Background(), &logicv1.AnnounceRequest{Username: "", Content: "hi"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = svc.Announce(context.Background(), &logicv1.AnnounceRequest{Username: "admin", Content: "hi"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Empty(t, repo.created)
+}
+
+func TestAnnouncementService_Announce_ValidatesContent(t *testing.T) {
+	repo := &testAnnouncementRepo{}
+	svc := NewAnnouncementService(repo, nil, testMQ{}, &config.AdminConfig{Username: "admin"}, clog.Discard())
+	ctx := context.Background()
+
+	_, err := svc.Announce(ctx, &logicv1.AnnounceRequest{Username: "admin", Content: "   "})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = svc.Announce(ctx, &logicv1.AnnounceRequest{Username: "admin", Title: strings.Repeat("公", maxAnnouncementTitleLength+1), Content: "hi"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = svc.Announce(ctx, &logicv1.AnnounceRequest{Username: "admin", Content: strings.Repeat("告", maxAnnouncementContentLength+1)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Empty(t, repo.created)
+}
+
+func TestAnnouncementService_ListAnnouncements_ClampsLimit(t *testing.T) {
+	repo := &testAnnouncementRepo{}
+	svc := NewAnnouncementService(repo, nil, testMQ{}, &config.AdminConfig{Username: "admin"}, clog.Discard())
+	ctx := context.Background()
+
+	resp, err := svc.ListAnnouncements(ctx, &logicv1.ListAnnouncementsRequest{Username: "alice", AfterId: 7})
+	require.NoError(t, err)
+	require.Equal(t, int64(7), repo.listAfter)
+	require.Equal(t, defaultAnnouncementLimit, repo.listLimit)
+	require.Len(t, resp.Announcements, 1)
+	require.Equal(t, int64(8), resp.Announcements[0].AnnouncementId)
+	require.Equal(t, int64(100), resp.Announcements[0].CreatedAt)
+
+	_, err = svc.ListAnnouncements(ctx, &logicv1.ListAnnouncementsRequest{Username: "alice", Limit: 1000})
+	require.NoError(t, err)
+	require.Equal(t, maxAnnouncementLimit, repo.listLimit)
+
+	_, err = svc.ListAnnouncements(ctx, &logicv1.ListAnnouncementsRequest{Username: "alice", AfterId: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
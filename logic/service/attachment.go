@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/idgen"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAttachmentFileNameRunes 附件文件名的最大长度（与 t_attachment.file_name 列宽一致）
+const maxAttachmentFileNameRunes = 255
+
+// AttachmentService 附件服务
+type AttachmentService struct {
+	logicv1.UnimplementedAttachmentServiceServer
+	attachmentRepo repo.AttachmentRepo
+	sessionRepo    repo.SessionRepo
+	idGen          idgen.Generator // Snowflake ID 生成器
+	config         *config.AttachmentConfig
+	logger         clog.Logger
+}
+
+// NewAttachmentService 创建附件服务
+func NewAttachmentService(
+	attachmentRepo repo.AttachmentRepo,
+	sessionRepo repo.SessionRepo,
+	idGen idgen.Generator,
+	cfg *config.AttachmentConfig,
+	logger clog.Logger,
+) *AttachmentService {
+	return &AttachmentService{
+		attachmentRepo: attachmentRepo,
+		sessionRepo:    sessionRepo,
+		idGen:          idGen,
+		config:         cfg,
+		logger:         logger,
+	}
+}
+
+// CreateUploadTicket 实现 AttachmentService.CreateUploadTicket
+func (s *AttachmentService) CreateUploadTicket(ctx context.Context, req *logicv1.CreateUploadTicketRequest) (*logicv1.CreateUploadTicketResponse, error) {
+	s.logger.Info("create upload ticket",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.Int64("size", req.Size))
+
+	fileName := strings.TrimSpace(req.FileName)
+	if req.Username == "" || req.SessionId == "" || fileName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username, session_id and file_name are required")
+	}
+	if utf8.RuneCountInString(fileName) > maxAttachmentFileNameRunes {
+		return nil, status.Errorf(codes.InvalidArgument, "file name too long")
+	}
+	if req.Size <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "size is required")
+	}
+	if req.Size > s.config.GetMaxSize() {
+		return nil, status.Errorf(codes.InvalidArgument, "attachment exceeds max size %d", s.config.GetMaxSize())
+	}
+
+	// 仅会话成员可上传附件
+	if err := s.checkSessionMember(ctx, req.Username, req.SessionId); err != nil {
+		return nil, err
+	}
+
+	ticket, ticketHash, err := newUploadTicket()
+	if err != nil {
+		s.logger.Error("failed to generate upload ticket", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create upload ticket")
+	}
+
+	now := time.Now()
+	attachmentID := s.idGen.Next()
+	attachment := &model.Attachment{
+		AttachmentID:     attachmentID,
+		SessionID:        req.SessionId,
+		UploaderUsername: req.Username,
+		FileName:         fileName,
+		MimeType:         req.MimeType,
+		Size:             req.Size,
+		StorageKey:       fmt.Sprintf("attachments/%s/%d", now.Format("2006/01/02"), attachmentID),
+		Status:           model.AttachmentStatusPending,
+		TicketHash:       ticketHash,
+		TicketExpiresAt:  now.Add(s.config.GetTicketTTL()),
+	}
+	if err := s.attachmentRepo.CreateAttachment(ctx, attachment); err != nil {
+		s.logger.Error("failed to create attachment", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create upload ticket")
+	}
+
+	return &logicv1.CreateUploadTicketResponse{
+		AttachmentId: attachmentID,
+		Ticket:       ticket,
+		ExpiresAt:    attachment.TicketExpiresAt.Unix(),
+	}, nil
+}
+
+// ValidateUploadTicket 实现 AttachmentService.ValidateUploadTicket
+func (s *AttachmentService) ValidateUploadTicket(ctx context.Context, req *logicv1.ValidateUploadTicketRequest) (*logicv1.ValidateUploadTicketResponse, error) {
+	attachment, err := s.getTicketAttachment(ctx, req.Username, req.AttachmentId, req.Ticket)
+	if err != nil {
+		return nil, err
+	}
+	// 每次上传写入独立的存储位置，同一凭证的并发上传不会互相覆盖，由 CompleteUpload 的 CAS 决定最终内容
+	storageKey, err := uploadStorageKey(attachment.StorageKey)
+	if err != nil {
+		s.logger.Error("failed to generate storage key", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to validate upload ticket")
+	}
+	return &logicv1.ValidateUploadTicketResponse{
+		StorageKey: storageKey,
+		Size:       attachment.Size,
+	}, nil
+}
+
+// CompleteUpload 实现 AttachmentService.CompleteUpload
+func (s *AttachmentService) CompleteUpload(ctx context.Context, req *logicv1.CompleteUploadRequest) (*logicv1.CompleteUploadResponse, error) {
+	s.logger.Info("complete upload",
+		clog.String("username", req.Username),
+		clog.Int64("attachment_id", req.AttachmentId),
+		clog.Int64("size", req.Size))
+
+	attachment, err := s.getTicketAttachment(ctx, req.Username, req.AttachmentId, req.Ticket)
+	if err != nil {
+		return nil, err
+	}
+	if req.Size != attachment.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "size mismatch")
+	}
+	if len(req.Checksum) != sha256.Size*2 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid checksum")
+	}
+	if !strings.HasPrefix(req.StorageKey, attachment.StorageKey+"/") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid storage key")
+	}
+	mimeType := req.MimeType
+	if mimeType == "" {
+		mimeType = attachment.MimeType
+	}
+
+	now := time.Now()
+	if err := s.attachmentRepo.MarkUploaded(ctx, attachment.AttachmentID, req.StorageKey, req.Size, mimeType, req.Checksum, now); err != nil {
+		if errors.Is(err, repo.ErrAlreadyUploaded) {
+			return nil, status.Errorf(codes.FailedPrecondition, "attachment already uploaded")
+		}
+		s.logger.Error("failed to mark attachment uploaded", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to complete upload")
+	}
+
+	attachment.StorageKey = req.StorageKey
+	attachment.MimeType = mimeType
+	attachment.Checksum = req.Checksum
+	return &logicv1.CompleteUploadResponse{
+		Attachment: toAttachmentProto(attachment),
+	}, nil
+}
+
+// GetAttachment 实现 AttachmentService.GetAttachment
+// 与 GetHistoryMessages 一致，按附件所属会话的成员关系鉴权
+func (s *AttachmentService) GetAttachment(ctx context.Context, req *logicv1.GetAttachmentRequest) (*logicv1.GetAttachmentResponse, error) {
+	if req.Username == "" || req.AttachmentId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "username and attachment_id are required")
+	}
+
+	attachment, err := s.attachmentRepo.GetAttachment(ctx, req.AttachmentId)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "attachment not found")
+		}
+		s.logger.Error("failed to get attachment", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get attachment")
+	}
+	if attachment.Status != model.AttachmentStatusUploaded {
+		return nil, status.Errorf(codes.NotFound, "attachment not found")
+	}
+
+	if err := s.checkSessionMember(ctx, req.Username, attachment.SessionID); err != nil {
+		return nil, err
+	}
+
+	return &logicv1.GetAttachmentResponse{
+		Attachment: toAttachmentProto(attachment),
+	}, nil
+}
+
+// checkSessionMember 校验会话成员关系，避免越权访问他人会话的附件
+func (s *AttachmentService) checkSessionMember(ctx context.Context, username, sessionID string) error {
+	if _, err := s.sessionRepo.GetUserSession(ctx, username, sessionID); err != nil {
+		s.logger.Warn("attachment access denied",
+			clog.String("username", username),
+			clog.String("session_id", sessionID),
+			clog.Error(err))
+		if errors.Is(err, repo.ErrNotFound) {
+			return status.Errorf(codes.PermissionDenied, "no permission to access session")
+		}
+		return status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+	return nil
+}
+
+// getTicketAttachment 按上传凭证获取待上传的附件：凭证需匹配、未过期，且由上传者本人使用
+func (s *AttachmentService) getTicketAttachment(ctx context.Context, username string, attachmentID int64, ticket string) (*model.Attachment, error) {
+	if username == "" || attachmentID == 0 || ticket == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username, attachment_id and ticket are required")
+	}
+
+	attachment, err := s.attachmentRepo.GetAttachment(ctx, attachmentID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "attachment not found")
+		}
+		s.logger.Error("failed to get attachment", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get attachment")
+	}
+	if attachment.UploaderUsername != username {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid upload ticket")
+	}
+	if attachment.Status != model.AttachmentStatusPending {
+		return nil, status.Errorf(codes.FailedPrecondition, "attachment already uploaded")
+	}
+	if subtle.ConstantTimeCompare([]byte(hashUploadTicket(ticket)), []byte(attachment.TicketHash)) != 1 {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid upload ticket")
+	}
+	if time.Now().After(attachment.TicketExpiresAt) {
+		return nil, status.Errorf(codes.FailedPrecondition, "upload ticket expired")
+	}
+	return attachment, nil
+}
+
+// newUploadTicket 生成随机上传凭证，返回凭证及其哈希（数据库只保存哈希）
+func newUploadTicket() (ticket, ticketHash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	ticket = hex.EncodeToString(buf)
+	return ticket, hashUploadTicket(ticket), nil
+}
+
+// uploadStorageKey 在附件的存储前缀下生成本次上传专用的存储位置
+func uploadStorageKey(prefix string) (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return prefix + "/" + hex.EncodeToString(buf), nil
+}
+
+// hashUploadTicket 计算上传凭证的 SHA-256
+func hashUploadTicket(ticket string) string {
+	sum := sha256.Sum256([]byte(ticket))
+	return hex.EncodeToString(sum[:])
+}
+
+// toAttachmentProto 将附件记录转换为 proto
+func toAttachmentProto(a *model.Attachment) *logicv1.Attachment {
+	return &logicv1.Attachment{
+		AttachmentId:     a.AttachmentID,
+		SessionId:        a.SessionID,
+		UploaderUsername: a.UploaderUsername,
+		FileName:         a.FileName,
+		MimeType:         a.MimeType,
+		Size:             a.Size,
+		Checksum:         a.Checksum,
+		StorageKey:       a.StorageKey,
+		CreatedAt:        a.CreatedAt.Unix(),
+	}
+}
+
+// bodyAttachmentIDs 返回消息体（含合并转发的聊天记录）引用的附件 ID
+func bodyAttachmentIDs(body *gatewayv1.MessageBody) []int64 {
+	var ids []int64
+	_ = forEachAttachment(body, func(attachmentID *int64, _ *string) error {
+		ids = append(ids, *attachmentID)
+		return nil
+	})
+	return ids
+}
+
+// errInvalidAttachment 消息体引用的附件不存在、未上传完成、不属于发送者或已被其他消息引用
+var errInvalidAttachment = errors.New("attachment not found")
+
+// resolveBodyAttachment 校验消息体引用的附件并以附件记录填充大小、MIME 类型与文件名
+// 附件必须已上传、属于同一会话、由发送者本人上传，且尚未被其他消息引用
+func resolveBodyAttachment(ctx context.Context, attachmentRepo repo.AttachmentRepo, body *gatewayv1.MessageBody, sender, sessionID string) error {
+	var attachmentID int64
+	switch b := body.GetBody().(type) {
+	case *gatewayv1.MessageBody_Image:
+		attachmentID = b.Image.GetAttachmentId()
+	case *gatewayv1.MessageBody_File:
+		attachmentID = b.File.GetAttachmentId()
+	case *gatewayv1.MessageBody_Audio:
+		attachmentID = b.Audio.GetAttachmentId()
+	}
+	if attachmentID == 0 {
+		return nil
+	}
+	if attachmentRepo == nil {
+		return errors.New("attachment not supported")
+	}
+
+	attachment, err := attachmentRepo.GetAttachment(ctx, attachmentID)
+	if err != nil && !errors.Is(err, repo.ErrNotFound) {
+		return fmt.Errorf("failed to get attachment: %w", err)
+	}
+	if attachment == nil || attachment.Status != model.AttachmentStatusUploaded ||
+		attachment.SessionID != sessionID || attachment.UploaderUsername != sender || attachment.MsgID != 0 {
+		return errInvalidAttachment
+	}
+
+	switch b := body.GetBody().(type) {
+	case *gatewayv1.MessageBody_Image:
+		b.Image.Size, b.Image.MimeType = attachment.Size, attachment.MimeType
+	case *gatewayv1.MessageBody_File:
+		b.File.Size, b.File.MimeType = attachment.Size, attachment.MimeType
+		if strings.TrimSpace(b.File.Name) == "" {
+			b.File.Name = attachment.FileName
+		}
+	case *gatewayv1.MessageBody_Audio:
+		b.Audio.Size, b.Audio.MimeType = attachment.Size, attachment.MimeType
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testAttachmentRepo struct {
+	attachments map[int64]*model.Attachment
+}
+
+func (r *testAttachmentRepo) CreateAttachment(ctx context.Context, a *model.Attachment) error {
+	r.attachments[a.AttachmentID] = a
+	return nil
+}
+func (r *testAttachmentRepo) GetAttachment(ctx context.Context, attachmentID int64) (*model.Attachment, error) {
+	a, ok := r.attachments[attachmentID]
+	if !ok {
+		return nil, fmt.Errorf("attachment %w: %d", repo.ErrNotFound, attachmentID)
+	}
+	return a, nil
+}
+func (r *testAttachmentRepo) MarkUploaded(ctx context.Context, attachmentID int64, storageKey string, size int64, mimeType, checksum string, uploadedAt time.Time) error {
+	a := r.attachments[attachmentID]
+	if a.Status != model.AttachmentStatusPending {
+		return fmt.Errorf("attachment %w or not found: %d", repo.ErrAlreadyUploaded, attachmentID)
+	}
+	a.Status, a.StorageKey, a.Size, a.MimeType, a.Checksum, a.TicketHash = model.AttachmentStatusUploaded, storageKey, size, mimeType, checksum, ""
+	return nil
+}
+func (r *testAttachmentRepo) Close() error { return nil }
+
+func newAttachmentTestService(att *model.Attachment) *AttachmentService {
+	sessionRepo := &testSessionRepo{
+		getUserSessionFn: func(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
+			if username == "mallory" {
+				return nil, fmt.Errorf("user session %w: username=%s, session_id=%s", repo.ErrNotFound, username, sessionID)
+			}
+			return &model.SessionMember{SessionID: sessionID, Username: username}, nil
+		},
+	}
+	attachmentRepo := &testAttachmentRepo{attachments: map[int64]*model.Attachment{att.AttachmentID: att}}
+	return NewAttachmentService(attachmentRepo, sessionRepo, nil, &config.AttachmentConfig{}, clog.Discard())
+}
+
+func TestAttachmentService_UploadTicketValidation(t *testing.T) {
+	ticket, ticketHash, err := newUploadTicket()
+	require.NoError(t, err)
+	att := &model.Attachment{
+		AttachmentID:     1,
+		SessionID:        "s_1",
+		UploaderUsername: "alice",
+		Size:             10,
+		StorageKey:       "attachments/1",
+		Status:           model.AttachmentStatusPending,
+		TicketHash:       ticketHash,
+		TicketExpiresAt:  time.Now().Add(time.Minute),
+	}
+	svc := newAttachmentTestService(att)
+	ctx := context.Background()
+
+	_, err = svc.ValidateUploadTicket(ctx, &logicv1.ValidateUploadTicketRequest{Username: "alice", AttachmentId: 1, Ticket: "forged"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = svc.ValidateUploadTicket(ctx, &logicv1.ValidateUploadTicketRequest{Username: "bob", AttachmentId: 1, Ticket: ticket})
+	require.Equal(t, codes.PermissionDenied, status.Code(err), "凭证只能由申请者本人使用")
+
+	validated, err := svc.ValidateUploadTicket(ctx, &logicv1.ValidateUploadTicketRequest{Username: "alice", AttachmentId: 1, Ticket: ticket})
+	require.NoError(t, err)
+
+	_, err = svc.CompleteUpload(ctx, &logicv1.CompleteUploadRequest{Username: "alice", AttachmentId: 1, Ticket: ticket, Size: 11, Checksum: hashUploadTicket("x"), StorageKey: validated.StorageKey})
+	require.Equal(t, codes.InvalidArgument, status.Code(err), "大小需与申请时一致")
+
+	_, err = svc.CompleteUpload(ctx, &logicv1.CompleteUploadRequest{Username: "alice", AttachmentId: 1, Ticket: ticket, Size: 10, Checksum: hashUploadTicket("x"), StorageKey: "attachments/2"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err), "存储位置需属于该附件")
+
+	resp, err := svc.CompleteUpload(ctx, &logicv1.CompleteUploadRequest{Username: "alice", AttachmentId: 1, Ticket: ticket, Size: 10, MimeType: "image/png", Checksum: hashUploadTicket("x"), StorageKey: validated.StorageKey})
+	require.NoError(t, err)
+	require.Equal(t, "image/png", resp.Attachment.MimeType)
+	require.Equal(t, validated.StorageKey, resp.Attachment.StorageKey)
+
+	_, err = svc.ValidateUploadTicket(ctx, &logicv1.ValidateUploadTicketRequest{Username: "alice", AttachmentId: 1, Ticket: ticket})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err), "上传完成后凭证失效")
+}
+
+func TestAttachmentService_ConcurrentUpload(t *testing.T) {
+	ticket, ticketHash, err := newUploadTicket()
+	require.NoError(t, err)
+	att := &model.Attachment{
+		AttachmentID:     1,
+		SessionID:        "s_1",
+		UploaderUsername: "alice",
+		Size:             10,
+		StorageKey:       "attachments/1",
+		Status:           model.AttachmentStatusPending,
+		TicketHash:       ticketHash,
+		TicketExpiresAt:  time.Now().Add(time.Minute),
+	}
+	svc := newAttachmentTestService(att)
+	ctx := context.Background()
+
+	// 同一凭证的两次上传写入不同的存储位置
+	first, err := svc.ValidateUploadTicket(ctx, &logicv1.ValidateUploadTicketRequest{Username: "alice", AttachmentId: 1, Ticket: ticket})
+	require.NoError(t, err)
+	second, err := svc.ValidateUploadTicket(ctx, &logicv1.ValidateUploadTicketRequest{Username: "alice", AttachmentId: 1, Ticket: ticket})
+	require.NoError(t, err)
+	require.NotEqual(t, first.StorageKey, second.StorageKey)
+
+	// 仅先确认者生效，后确认者失败且不覆盖存储位置（网关随后删除其写入的内容）
+	_, err = svc.CompleteUpload(ctx, &logicv1.CompleteUploadRequest{Username: "alice", AttachmentId: 1, Ticket: ticket, Size: 10, Checksum: hashUploadTicket("y"), StorageKey: second.StorageKey})
+	require.NoError(t, err)
+	_, err = svc.CompleteUpload(ctx, &logicv1.CompleteUploadRequest{Username: "alice", AttachmentId: 1, Ticket: ticket, Size: 10, Checksum: hashUploadTicket("x"), StorageKey: first.StorageKey})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Equal(t, second.StorageKey, att.StorageKey)
+}
+
+func TestAttachmentService_UploadTicketExpired(t *testing.T) {
+	ticket, ticketHash, err := newUploadTicket()
+	require.NoError(t, err)
+	svc := newAttachmentTestService(&model.Attachment{
+		AttachmentID:     1,
+		SessionID:        "s_1",
+		UploaderUsername: "alice",
+		Status:           model.AttachmentStatusPending,
+		TicketHash:       ticketHash,
+		TicketExpiresAt:  time.Now().Add(-time.Second),
+	})
+
+	_, err = svc.ValidateUploadTicket(context.Background(), &logicv1.ValidateUploadTicketRequest{Username: "alice", AttachmentId: 1, Ticket: ticket})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestAttachmentService_GetAttachment_DeniedForNonMember(t *testing.T) {
+	svc := newAttachmentTestService(&model.Attachment{
+		AttachmentID:     1,
+		SessionID:        "s_1",
+		UploaderUsername: "alice",
+		Status:           model.AttachmentStatusUploaded,
+	})
+
+	_, err := svc.GetAttachment(context.Background(), &logicv1.GetAttachmentRequest{Username: "mallory", AttachmentId: 1})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	resp, err := svc.GetAttachment(context.Background(), &logicv1.GetAttachmentRequest{Username: "bob", AttachmentId: 1})
+	require.NoError(t, err)
+	require.Equal(t, "s_1", resp.Attachment.SessionId)
+}
+
+func TestResolveBodyAttachment_RejectsForeignAttachment(t *testing.T) {
+	attachmentRepo := &testAttachmentRepo{attachments: map[int64]*model.Attachment{
+		1: {AttachmentID: 1, SessionID: "s_1", UploaderUsername: "alice", FileName: "a.pdf", MimeType: "application/pdf", Size: 42, Status: model.AttachmentStatusUploaded},
+	}}
+	ctx := context.Background()
+	fileBody := func() *gatewayv1.MessageBody {
+		return &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_File{File: &gatewayv1.FileBody{AttachmentId: 1}}}
+	}
+
+	require.Error(t, resolveBodyAttachment(ctx, attachmentRepo, fileBody(), "alice", "s_2"), "附件不可跨会话引用")
+	require.Error(t, resolveBodyAttachment(ctx, attachmentRepo, fileBody(), "bob", "s_1"), "只能引用自己上传的附件")
+
+	attachmentRepo.attachments[1].MsgID = 100
+	require.Error(t, resolveBodyAttachment(ctx, attachmentRepo, fileBody(), "alice", "s_1"), "附件只能被一条消息引用")
+	attachmentRepo.attachments[1].MsgID = 0
+
+	body := fileBody()
+	require.NoError(t, resolveBodyAttachment(ctx, attachmentRepo, body, "alice", "s_1"))
+	require.Equal(t, int64(42), body.GetFile().GetSize())
+	require.Equal(t, "a.pdf", body.GetFile().GetName())
+}
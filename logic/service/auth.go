@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/ceyewan/genesis/auth"
 	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/mq"
 	commonv1 "github.com/ceyewan/resonance/api/gen/go/common/v1"
 	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
 	"github.com/ceyewan/resonance/model"
 	"github.com/ceyewan/resonance/repo"
 	"github.com/golang-jwt/jwt/v5"
@@ -21,6 +24,7 @@ type AuthService struct {
 	userRepo      repo.UserRepo
 	sessionRepo   repo.SessionRepo
 	authenticator auth.Authenticator
+	mqClient      mq.MQ
 	logger        clog.Logger
 }
 
@@ -29,12 +33,14 @@ func NewAuthService(
 	userRepo repo.UserRepo,
 	sessionRepo repo.SessionRepo,
 	authenticator auth.Authenticator,
+	mqClient mq.MQ,
 	logger clog.Logger,
 ) *AuthService {
 	return &AuthService{
 		userRepo:      userRepo,
 		sessionRepo:   sessionRepo,
 		authenticator: authenticator,
+		mqClient:      mqClient,
 		logger:        logger,
 	}
 }
@@ -52,6 +58,11 @@ func (s *AuthService) Login(ctx context.Context, req *logicv1.LoginRequest) (*lo
 		return nil, status.Errorf(codes.Unauthenticated, "invalid username or password")
 	}
 
+	// 机器人账号不能登录，只能凭 API Token 调用机器人接口
+	if user.Type == model.UserTypeBot {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid username or password")
+	}
+
 	// 验证密码
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
 		// 日志脱敏：不记录用户名，避免用户枚举攻击
@@ -188,7 +199,7 @@ func (s *AuthService) joinDefaultRoom(ctx context.Context, username string) erro
 	member := &model.SessionMember{
 		SessionID: defaultSessionID,
 		Username:  username,
-		Role:      0, // 普通成员
+		Role:      model.MemberRoleMember,
 	}
 	if err := s.sessionRepo.AddMember(ctx, member); err != nil {
 		s.logger.Error("failed to add member to default room",
@@ -203,5 +214,20 @@ func (s *AuthService) joinDefaultRoom(ctx context.Context, username string) erro
 		clog.String("session_id", defaultSessionID),
 		clog.String("session_name", session.Name))
 
+	// 成员变更事件（目前供 Webhook 订阅），发布失败不影响注册
+	event := &mqv1.PushEvent{
+		SessionId:        defaultSessionID,
+		SessionName:      session.Name,
+		SessionType:      int32(session.Type),
+		OperatorUsername: username,
+		Timestamp:        time.Now().Unix(),
+		EventType:        mqv1.EventType_EVENT_TYPE_MEMBER,
+		MemberChange:     mqv1.MemberChange_MEMBER_CHANGE_JOINED,
+		MemberUsernames:  []string{username},
+	}
+	if err := PublishEventToMQAsync(ctx, s.mqClient, event, s.logger); err != nil {
+		s.logger.Warn("failed to publish member event", clog.Error(err))
+	}
+
 	return nil
 }
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	"github.com/ceyewan/resonance/model"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxTextBodyRunes 文本消息的最大长度（码点）
+	maxTextBodyRunes = 4096
+	// maxTextEntities 单条文本消息的最大富文本标记数
+	maxTextEntities = 100
+	// maxPollQuestionRunes 投票问题的最大长度（码点）
+	maxPollQuestionRunes = 200
+	// maxPollOptionRunes 投票选项的最大长度（码点）
+	maxPollOptionRunes = 100
+	// minPollOptions / maxPollOptions 投票选项数的范围
+	minPollOptions = 2
+	maxPollOptions = 10
+)
+
+// normalizeMessageBody 校验客户端提交的结构化消息体，并派生消息类型与纯文本摘要
+// 摘要写入 content，供旧客户端、会话列表和引用快照展示
+func normalizeMessageBody(body *gatewayv1.MessageBody) (msgType, content string, err error) {
+	switch b := body.GetBody().(type) {
+	case *gatewayv1.MessageBody_Text:
+		if err := validateTextBody(b.Text); err != nil {
+			return "", "", err
+		}
+		return model.MessageTypeText, b.Text.GetText(), nil
+
+	case *gatewayv1.MessageBody_Image:
+		img := b.Image
+		if !hasMediaSource(img.GetUrl(), img.GetAttachmentId()) {
+			return "", "", errors.New("invalid image url")
+		}
+		if img.GetThumbnailUrl() != "" && !isValidMediaURL(img.GetThumbnailUrl()) {
+			return "", "", errors.New("invalid image thumbnail url")
+		}
+		if img.GetWidth() < 0 || img.GetHeight() < 0 || img.GetSize() < 0 {
+			return "", "", errors.New("invalid image dimensions")
+		}
+		return model.MessageTypeImage, "[图片]", nil
+
+	case *gatewayv1.MessageBody_File:
+		file := b.File
+		if !hasMediaSource(file.GetUrl(), file.GetAttachmentId()) {
+			return "", "", errors.New("invalid file url")
+		}
+		if strings.TrimSpace(file.GetName()) == "" && file.GetAttachmentId() == 0 {
+			return "", "", errors.New("file name is required")
+		}
+		if file.GetSize() < 0 {
+			return "", "", errors.New("invalid file size")
+		}
+		return model.MessageTypeFile, "[文件] " + file.GetName(), nil
+
+	case *gatewayv1.MessageBody_Audio:
+		audio := b.Audio
+		if !hasMediaSource(audio.GetUrl(), audio.GetAttachmentId()) {
+			return "", "", errors.New("invalid audio url")
+		}
+		if audio.GetDurationMs() <= 0 || audio.GetSize() < 0 {
+			return "", "", errors.New("invalid audio duration")
+		}
+		return model.MessageTypeAudio, "[语音]", nil
+
+	case *gatewayv1.MessageBody_Location:
+		loc := b.Location
+		lat, lng := loc.GetLatitude(), loc.GetLongitude()
+		if math.IsNaN(lat) || math.IsNaN(lng) || lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+			return "", "", errors.New("invalid location coordinates")
+		}
+		return model.MessageTypeLocation, locationSummary(loc), nil
+
+	case *gatewayv1.MessageBody_Contact:
+		contact := b.Contact
+		if contact.GetUsername() == "" {
+			return "", "", errors.New("contact username is required")
+		}
+		return model.MessageTypeContact, contactSummary(contact), nil
+
+	case *gatewayv1.MessageBody_Poll:
+		if err := validatePollBody(b.Poll); err != nil {
+			return "", "", err
+		}
+		return model.MessageTypePoll, "[投票] " + b.Poll.GetQuestion(), nil
+
+	case *gatewayv1.MessageBody_System:
+		return "", "", errors.New("system message cannot be sent by client")
+
+	case *gatewayv1.MessageBody_Forward:
+		return "", "", errors.New("forward message must be sent via ForwardMessages")
+
+	default:
+		return "", "", errors.New("message body is empty")
+	}
+}
+
+// locationSummary 位置消息的纯文本摘要，优先展示地点名称
+func locationSummary(loc *gatewayv1.LocationBody) string {
+	label := loc.GetName()
+	if label == "" {
+		label = loc.GetAddress()
+	}
+	return strings.TrimSpace("[位置] " + label)
+}
+
+// contactSummary 名片消息的纯文本摘要，昵称为空时展示用户名
+func contactSummary(contact *gatewayv1.ContactCardBody) string {
+	return "[名片] " + displayName(contact.GetUsername(), contact.GetNickname())
+}
+
+// validateTextBody 校验文本消息及其富文本标记
+func validateTextBody(text *gatewayv1.TextBody) error {
+	if strings.TrimSpace(text.GetText()) == "" {
+		return errors.New("text body is empty")
+	}
+	runes := int32(utf8.RuneCountInString(text.GetText()))
+	if runes > maxTextBodyRunes {
+		return fmt.Errorf("text exceeds %d characters", maxTextBodyRunes)
+	}
+	if len(text.GetEntities()) > maxTextEntities {
+		return fmt.Errorf("text has more than %d entities", maxTextEntities)
+	}
+	for _, e := range text.GetEntities() {
+		// 以减法比较，避免 offset+length 在 int32 下溢出绕过校验
+		if e.GetOffset() < 0 || e.GetLength() <= 0 || e.GetOffset() > runes || e.GetLength() > runes-e.GetOffset() {
+			return errors.New("text entity out of range")
+		}
+		switch e.GetType() {
+		case gatewayv1.TextEntityType_TEXT_ENTITY_TYPE_MENTION:
+			if e.GetUsername() == "" {
+				return errors.New("mention entity requires username")
+			}
+		case gatewayv1.TextEntityType_TEXT_ENTITY_TYPE_LINK:
+			if !isValidMediaURL(e.GetUrl()) {
+				return errors.New("invalid link entity url")
+			}
+		case gatewayv1.TextEntityType_TEXT_ENTITY_TYPE_BOLD,
+			gatewayv1.TextEntityType_TEXT_ENTITY_TYPE_ITALIC,
+			gatewayv1.TextEntityType_TEXT_ENTITY_TYPE_CODE:
+		default:
+			return errors.New("invalid text entity type")
+		}
+	}
+	return nil
+}
+
+// validatePollBody 校验投票消息：问题与选项非空且不超长，选项不重复，截止时间晚于当前时间
+func validatePollBody(poll *gatewayv1.PollBody) error {
+	question := strings.TrimSpace(poll.GetQuestion())
+	if question == "" {
+		return errors.New("poll question is required")
+	}
+	if utf8.RuneCountInString(question) > maxPollQuestionRunes {
+		return fmt.Errorf("poll question exceeds %d characters", maxPollQuestionRunes)
+	}
+	options := poll.GetOptions()
+	if len(options) < minPollOptions || len(options) > maxPollOptions {
+		return fmt.Errorf("poll must have %d to %d options", minPollOptions, maxPollOptions)
+	}
+	seen := make(map[string]struct{}, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			return errors.New("poll option is empty")
+		}
+		if utf8.RuneCountInString(option) > maxPollOptionRunes {
+			return fmt.Errorf("poll option exceeds %d characters", maxPollOptionRunes)
+		}
+		if _, ok := seen[option]; ok {
+			return errors.New("poll options must be unique")
+		}
+		seen[option] = struct{}{}
+	}
+	if poll.GetDeadline() < 0 || (poll.GetDeadline() > 0 && poll.GetDeadline() <= time.Now().Unix()) {
+		return errors.New("poll deadline must be in the future")
+	}
+	return nil
+}
+
+// isValidMediaURL 判断是否为合法的 http(s) 地址
+func isValidMediaURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// hasMediaSource 媒体消息需引用已上传的附件，或提供合法的外部 http(s) 地址
+func hasMediaSource(rawURL string, attachmentID int64) bool {
+	if attachmentID > 0 {
+		return rawURL == "" || isValidMediaURL(rawURL)
+	}
+	return isValidMediaURL(rawURL)
+}
+
+// bodyMentions 提取文本消息中 @提及标记的用户名
+func bodyMentions(body *gatewayv1.MessageBody) []string {
+	var usernames []string
+	for _, e := range body.GetText().GetEntities() {
+		if e.GetType() == gatewayv1.TextEntityType_TEXT_ENTITY_TYPE_MENTION {
+			usernames = append(usernames, e.GetUsername())
+		}
+	}
+	return usernames
+}
+
+// marshalMessageBody 编码消息体用于持久化，nil 返回 nil
+func marshalMessageBody(body *gatewayv1.MessageBody) ([]byte, error) {
+	if body == nil {
+		return nil, nil
+	}
+	return proto.Marshal(body)
+}
+
+// unmarshalMessageBody 解码持久化的消息体，为空或损坏时返回 nil（以 content 为准）
+func unmarshalMessageBody(data []byte) *gatewayv1.MessageBody {
+	if len(data) == 0 {
+		return nil
+	}
+	body := &gatewayv1.MessageBody{}
+	if err := proto.Unmarshal(data, body); err != nil {
+		return nil
+	}
+	return body
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxBotsPerOwner 单个用户可创建的最大机器人数
+	maxBotsPerOwner = 10
+	// maxBotNicknameLength 机器人昵称最大字符数
+	maxBotNicknameLength = 64
+	// botTokenPrefix API Token 前缀，便于在日志与密钥扫描中识别
+	botTokenPrefix = "rbt_"
+	// maxCommandsPerBot 单个机器人可注册的最大命令数
+	maxCommandsPerBot = 20
+	// maxCommandDescriptionLength 命令描述最大字符数
+	maxCommandDescriptionLength = 255
+)
+
+// botUsernamePattern 机器人用户名规则
+var botUsernamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{3,64}$`)
+
+// BotService 机器人账号服务
+// 机器人是 Type=UserTypeBot 的用户，由普通用户创建；以 API Token 鉴权，经网关机器人接口调用 ChatService.SendMessage 发消息
+type BotService struct {
+	logicv1.UnimplementedBotServiceServer
+	userRepo repo.UserRepo
+	botRepo  repo.BotRepo
+	commands *CommandRegistry // 用于校验机器人命令不与内置命令重名（为 nil 时不校验）
+	logger   clog.Logger
+}
+
+// NewBotService 创建机器人账号服务
+func NewBotService(userRepo repo.UserRepo, botRepo repo.BotRepo, commands *CommandRegistry, logger clog.Logger) *BotService {
+	return &BotService{
+		userRepo: userRepo,
+		botRepo:  botRepo,
+		commands: commands,
+		logger:   logger,
+	}
+}
+
+// CreateBot 实现 BotService.CreateBot
+func (s *BotService) CreateBot(ctx context.Context, req *logicv1.CreateBotRequest) (*logicv1.CreateBotResponse, error) {
+	s.logger.Info("create bot",
+		clog.String("owner", req.OwnerUsername),
+		clog.String("username", req.Username))
+
+	if req.OwnerUsername == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "owner_username is required")
+	}
+	if !botUsernamePattern.MatchString(req.Username) {
+		return nil, status.Errorf(codes.InvalidArgument, "username must be 3-64 letters, digits, '_', '.' or '-'")
+	}
+	nickname := strings.TrimSpace(req.Nickname)
+	if nickname == "" {
+		nickname = req.Username
+	}
+	if utf8.RuneCountInString(nickname) > maxBotNicknameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "nickname cannot exceed %d characters", maxBotNicknameLength)
+	}
+
+	// 机器人不能再创建机器人
+	owner, err := s.userRepo.GetUserByUsername(ctx, req.OwnerUsername)
+	if err != nil {
+		s.logger.Error("failed to get owner", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create bot")
+	}
+	if owner.Type == model.UserTypeBot {
+		return nil, status.Errorf(codes.PermissionDenied, "bot cannot create bots")
+	}
+
+	bots, err := s.botRepo.ListBots(ctx, req.OwnerUsername)
+	if err != nil {
+		s.logger.Error("failed to list bots", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create bot")
+	}
+	if len(bots) >= maxBotsPerOwner {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot create more than %d bots", maxBotsPerOwner)
+	}
+	if _, err := s.userRepo.GetUserByUsername(ctx, req.Username); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "username already exists")
+	} else if !errors.Is(err, repo.ErrNotFound) {
+		s.logger.Error("failed to check username", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create bot")
+	}
+
+	token, tokenHash, err := newBotToken()
+	if err != nil {
+		s.logger.Error("failed to generate bot token", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create bot")
+	}
+	// 机器人没有密码，不能登录
+	bot := &model.User{
+		Username:      req.Username,
+		Nickname:      nickname,
+		Type:          model.UserTypeBot,
+		OwnerUsername: req.OwnerUsername,
+	}
+	if err := s.botRepo.CreateBot(ctx, bot, tokenHash); err != nil {
+		s.logger.Error("failed to create bot", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create bot")
+	}
+
+	return &logicv1.CreateBotResponse{
+		Bot:   toBotProto(bot),
+		Token: token,
+	}, nil
+}
+
+// ListBots 实现 BotService.ListBots
+func (s *BotService) ListBots(ctx context.Context, req *logicv1.ListBotsRequest) (*logicv1.ListBotsResponse, error) {
+	if req.OwnerUsername == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "owner_username is required")
+	}
+
+	bots, err := s.botRepo.ListBots(ctx, req.OwnerUsername)
+	if err != nil {
+		s.logger.Error("failed to list bots", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list bots")
+	}
+
+	result := make([]*gatewayv1.Bot, 0, len(bots))
+	for _, bot := range bots {
+		result = append(result, toBotProto(bot))
+	}
+	return &logicv1.ListBotsResponse{
+		Bots: result,
+	}, nil
+}
+
+// RotateBotToken 实现 BotService.RotateBotToken
+func (s *BotService) RotateBotToken(ctx context.Context, req *logicv1.RotateBotTokenRequest) (*logicv1.RotateBotTokenResponse, error) {
+	s.logger.Info("rotate bot token",
+		clog.String("owner", req.OwnerUsername),
+		clog.String("username", req.Username))
+
+	if req.OwnerUsername == "" || req.Username == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "owner_username and username are required")
+	}
+
+	// 非创建者与机器人不存在时返回相同错误，避免泄露他人的机器人
+	bot, err := s.userRepo.GetUserByUsername(ctx, req.Username)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "bot not found")
+		}
+		s.logger.Error("failed to get bot", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to rotate bot token")
+	}
+	if bot.Type != model.UserTypeBot || bot.OwnerUsername != req.OwnerUsername {
+		return nil, status.Errorf(codes.NotFound, "bot not found")
+	}
+
+	token, tokenHash, err := newBotToken()
+	if err != nil {
+		s.logger.Error("failed to generate bot token", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to rotate bot token")
+	}
+	if err := s.botRepo.ReplaceBotToken(ctx, bot.Username, tokenHash); err != nil {
+		s.logger.Error("failed to replace bot token", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to rotate bot token")
+	}
+
+	return &logicv1.RotateBotTokenResponse{
+		Token: token,
+	}, nil
+}
+
+// ValidateBotToken 实现 BotService.ValidateBotToken
+func (s *BotService) ValidateBotToken(ctx context.Context, req *logicv1.ValidateBotTokenRequest) (*logicv1.ValidateBotTokenResponse, error) {
+	if !strings.HasPrefix(req.Token, botTokenPrefix) {
+		return &logicv1.ValidateBotTokenResponse{Valid: false}, nil
+	}
+
+	bot, err := s.botRepo.GetBotByTokenHash(ctx, hashBotToken(req.Token))
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return &logicv1.ValidateBotTokenResponse{Valid: false}, nil
+		}
+		s.logger.Error("failed to validate bot token", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to validate bot token")
+	}
+
+	return &logicv1.ValidateBotTokenResponse{
+		Valid:    true,
+		Username: bot.Username,
+	}, nil
+}
+
+// RegisterBotCommand 实现 BotService.RegisterBotCommand
+func (s *BotService) RegisterBotCommand(ctx context.Context, req *logicv1.RegisterBotCommandRequest) (*logicv1.RegisterBotCommandResponse, error) {
+	s.logger.Info("register bot command",
+		clog.String("bot", req.BotUsername),
+		clog.String("name", req.Name))
+
+	if req.BotUsername == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "bot_username is required")
+	}
+	name := strings.ToLower(req.Name)
+	if !commandNamePattern.MatchString(name) {
+		return nil, status.Errorf(codes.InvalidArgument, "name must start with a letter and contain at most 32 lowercase letters, digits, '_' or '-'")
+	}
+	if s.commands != nil && s.commands.IsReserved(name) {
+		return nil, status.Errorf(codes.AlreadyExists, "command name is reserved: %s", name)
+	}
+	if utf8.RuneCountInString(req.Description) > maxCommandDescriptionLength {
+		return nil, status.Errorf(codes.InvalidArgument, "description cannot exceed %d characters", maxCommandDescriptionLength)
+	}
+	if err := validateWebhookURL(req.Url); err != nil {
+		return nil, err
+	}
+	secret := req.Secret
+	if len(secret) > maxWebhookSecretLength {
+		return nil, status.Errorf(codes.InvalidArgument, "secret cannot exceed %d characters", maxWebhookSecretLength)
+	}
+	var err error
+	if secret == "" {
+		if secret, err = newWebhookSecret(); err != nil {
+			s.logger.Error("failed to generate command secret", clog.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to register bot command")
+		}
+	}
+
+	existing, err := s.botRepo.ListBotCommands(ctx, req.BotUsername)
+	if err != nil {
+		s.logger.Error("failed to list bot commands", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to register bot command")
+	}
+	replacing := false
+	for _, cmd := range existing {
+		if cmd.Name == name {
+			replacing = true
+			break
+		}
+	}
+	if !replacing && len(existing) >= maxCommandsPerBot {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot register more than %d commands", maxCommandsPerBot)
+	}
+
+	cmd := &model.BotCommand{
+		BotUsername: req.BotUsername,
+		Name:        name,
+		Description: req.Description,
+		URL:         req.Url,
+		Secret:      secret,
+	}
+	if err := s.botRepo.UpsertBotCommand(ctx, cmd); err != nil {
+		s.logger.Error("failed to upsert bot command", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to register bot command")
+	}
+
+	return &logicv1.RegisterBotCommandResponse{
+		Command: toBotCommandProto(cmd),
+		Secret:  secret,
+	}, nil
+}
+
+// DeleteBotCommand 实现 BotService.DeleteBotCommand
+func (s *BotService) DeleteBotCommand(ctx context.Context, req *logicv1.DeleteBotCommandRequest) (*logicv1.DeleteBotCommandResponse, error) {
+	if req.BotUsername == "" || req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "bot_username and name are required")
+	}
+
+	deleted, err := s.botRepo.DeleteBotCommand(ctx, req.BotUsername, strings.ToLower(req.Name))
+	if err != nil {
+		s.logger.Error("failed to delete bot command", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete bot command")
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "command not found")
+	}
+	return &logicv1.DeleteBotCommandResponse{}, nil
+}
+
+// ListBotCommands 实现 BotService.ListBotCommands
+func (s *BotService) ListBotCommands(ctx context.Context, req *logicv1.ListBotCommandsRequest) (*logicv1.ListBotCommandsResponse, error) {
+	if req.BotUsername == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "bot_username is required")
+	}
+
+	cmds, err := s.botRepo.ListBotCommands(ctx, req.BotUsername)
+	if err != nil {
+		s.logger.Error("failed to list bot commands", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list bot commands")
+	}
+
+	result := make([]*logicv1.BotCommand, 0, len(cmds))
+	for _, cmd := range cmds {
+		result = append(result, toBotCommandProto(cmd))
+	}
+	return &logicv1.ListBotCommandsResponse{
+		Commands: result,
+	}, nil
+}
+
+// newBotToken 生成随机 API Token，返回 Token 及其哈希（数据库只保存哈希）
+func newBotToken() (token, tokenHash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token = botTokenPrefix + hex.EncodeToString(buf)
+	return token, hashBotToken(token), nil
+}
+
+// hashBotToken 计算 API Token 的 SHA-256
+func hashBotToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// toBotProto 将机器人账号转换为 proto
+func toBotProto(u *model.User) *gatewayv1.Bot {
+	return &gatewayv1.Bot{
+		Username:      u.Username,
+		Nickname:      u.Nickname,
+		OwnerUsername: u.OwnerUsername,
+		CreatedAt:     u.CreatedAt.Unix(),
+	}
+}
+
+// toBotCommandProto 将机器人命令转换为 proto（不包含签名密钥）
+func toBotCommandProto(c *model.BotCommand) *logicv1.BotCommand {
+	return &logicv1.BotCommand{
+		BotUsername: c.BotUsername,
+		Name:        c.Name,
+		Description: c.Description,
+		Url:         c.URL,
+		CreatedAt:   c.CreatedAt.Unix(),
+	}
+}
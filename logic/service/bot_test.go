@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testBotUserRepo 以内存 map 保存用户，机器人与普通用户共用
+type testBotUserRepo struct {
+	testUserRepo
+	users map[string]*model.User
+}
+
+func (r *testBotUserRepo) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
+	u, ok := r.users[username]
+	if !ok {
+		return nil, fmt.Errorf("user %w: %s", repo.ErrNotFound, username)
+	}
+	return u, nil
+}
+
+type testBotRepo struct {
+	users  map[string]*model.User
+	tokens map[string]string // tokenHash -> botUsername
+}
+
+func (r *testBotRepo) CreateBot(ctx context.Context, bot *model.User, tokenHash string) error {
+	r.users[bot.Username] = bot
+	r.tokens[tokenHash] = bot.Username
+	return nil
+}
+func (r *testBotRepo) ListBots(ctx context.Context, ownerUsername string) ([]*model.User, error) {
+	var bots []*model.User
+	for _, u := range r.users {
+		if u.Type == model.UserTypeBot && u.OwnerUsername == ownerUsername {
+			bots = append(bots, u)
+		}
+	}
+	return bots, nil
+}
+func (r *testBotRepo) GetBotByTokenHash(ctx context.Context, tokenHash string) (*model.User, error) {
+	username, ok := r.tokens[tokenHash]
+	if !ok {
+		return nil, fmt.Errorf("bot token %w", repo.ErrNotFound)
+	}
+	return r.users[username], nil
+}
+func (r *testBotRepo) ReplaceBotToken(ctx context.Context, botUsername, tokenHash string) error {
+	for hash, username := range r.tokens {
+		if username == botUsername {
+			delete(r.tokens, hash)
+		}
+	}
+	r.tokens[tokenHash] = botUsername
+	return nil
+}
+func (r *testBotRepo) UpsertBotCommand(ctx context.Context, cmd *model.BotCommand) error { return nil }
+func (r *testBotRepo) DeleteBotCommand(ctx context.Context, botUsername, name string) (bool, error) {
+	return false, nil
+}
+func (r *testBotRepo) ListBotCommands(ctx context.Context, botUsername string) ([]*model.BotCommand, error) {
+	return nil, nil
+}
+func (r *testBotRepo) ListSessionBotCommands(ctx context.Context, sessionID, name string) ([]*model.BotCommand, error) {
+	return nil, nil
+}
+func (r *testBotRepo) Close() error { return nil }
+
+func newBotTestService() *BotService {
+	users := map[string]*model.User{
+		"alice": {Username: "alice"},
+		"bob":   {Username: "bob"},
+	}
+	return NewBotService(&testBotUserRepo{users: users}, &testBotRepo{users: users, tokens: map[string]string{}}, nil, clog.Discard())
+}
+
+func TestBotService_TokenLifecycle(t *testing.T) {
+	svc := newBotTestService()
+	ctx := context.Background()
+
+	created, err := svc.CreateBot(ctx, &logicv1.CreateBotRequest{OwnerUsername: "alice", Username: "ci_bot"})
+	require.NoError(t, err)
+	require.Equal(t, "ci_bot", created.Bot.Nickname, "昵称缺省为用户名")
+
+	validated, err := svc.ValidateBotToken(ctx, &logicv1.ValidateBotTokenRequest{Token: created.Token})
+	require.NoError(t, err)
+	require.True(t, validated.Valid)
+	require.Equal(t, "ci_bot", validated.Username)
+
+	_, err = svc.RotateBotToken(ctx, &logicv1.RotateBotTokenRequest{OwnerUsername: "bob", Username: "ci_bot"})
+	require.Equal(t, codes.NotFound, status.Code(err), "只有创建者可以轮换 Token")
+
+	rotated, err := svc.RotateBotToken(ctx, &logicv1.RotateBotTokenRequest{OwnerUsername: "alice", Username: "ci_bot"})
+	require.NoError(t, err)
+
+	validated, err = svc.ValidateBotToken(ctx, &logicv1.ValidateBotTokenRequest{Token: created.Token})
+	require.NoError(t, err)
+	require.False(t, validated.Valid, "轮换后旧 Token 失效")
+
+	validated, err = svc.ValidateBotToken(ctx, &logicv1.ValidateBotTokenRequest{Token: rotated.Token})
+	require.NoError(t, err)
+	require.True(t, validated.Valid)
+}
+
+func TestBotService_CreateBot_Rejects(t *testing.T) {
+	svc := newBotTestService()
+	ctx := context.Background()
+
+	_, err := svc.CreateBot(ctx, &logicv1.CreateBotRequest{OwnerUsername: "alice", Username: "bob"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = svc.CreateBot(ctx, &logicv1.CreateBotRequest{OwnerUsername: "alice", Username: "a b"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = svc.CreateBot(ctx, &logicv1.CreateBotRequest{OwnerUsername: "alice", Username: "ci_bot"})
+	require.NoError(t, err)
+	_, err = svc.CreateBot(ctx, &logicv1.CreateBotRequest{OwnerUsername: "ci_bot", Username: "nested_bot"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err), "机器人不能创建机器人")
+}
@@ -2,48 +2,89 @@ package service
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	"github.com/ceyewan/genesis/clog"
 	"github.com/ceyewan/genesis/idgen"
 	"github.com/ceyewan/genesis/mq"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
 	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
 	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/logic/moderation"
 	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/errcode"
 	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ChatService 聊天服务
 type ChatService struct {
 	logicv1.UnimplementedChatServiceServer
-	sessionRepo repo.SessionRepo
-	messageRepo repo.MessageRepo
-	idGen       idgen.Generator // Snowflake ID 生成器
-	sequencer   idgen.Sequencer
-	mqClient    mq.MQ
-	logger      clog.Logger
+	sessionRepo    repo.SessionRepo
+	messageRepo    repo.MessageRepo
+	dedupRepo      repo.MessageDedupRepo // 客户端消息ID去重（为 nil 时不去重）
+	attachmentRepo repo.AttachmentRepo   // 附件元数据（为 nil 时不支持引用附件）
+	idGen          idgen.Generator       // Snowflake ID 生成器
+	sequencer      idgen.Sequencer
+	mqClient       mq.MQ
+	msgConfig      *config.MessageConfig
+	commands       *CommandRegistry // 斜杠命令（为 nil 时以 / 开头的内容按普通文本发送）
+	moderator      ContentModerator // 内容审核（为 nil 时不审核）
+	routerRepo     repo.RouterRepo  // 用户网关路由（与 pusher 同时为 nil 时不转发正在输入）
+	pusher         GatewayPusher
+	logger         clog.Logger
+}
+
+// GatewayPusher 直接推送到指定 Gateway，用于正在输入等不落库、不经过 MQ 的瞬时信号
+type GatewayPusher interface {
+	Push(ctx context.Context, gatewayID string, req *gatewayv1.PushRequest) error
 }
 
 // NewChatService 创建聊天服务
 func NewChatService(
 	sessionRepo repo.SessionRepo,
 	messageRepo repo.MessageRepo,
+	dedupRepo repo.MessageDedupRepo,
+	attachmentRepo repo.AttachmentRepo,
 	idGen idgen.Generator,
 	sequencer idgen.Sequencer,
 	mqClient mq.MQ,
+	msgConfig *config.MessageConfig,
 	logger clog.Logger,
 ) *ChatService {
 	return &ChatService{
-		sessionRepo: sessionRepo,
-		messageRepo: messageRepo,
-		idGen:       idGen,
-		sequencer:   sequencer,
-		mqClient:    mqClient,
-		logger:      logger,
+		sessionRepo:    sessionRepo,
+		messageRepo:    messageRepo,
+		dedupRepo:      dedupRepo,
+		attachmentRepo: attachmentRepo,
+		idGen:          idGen,
+		sequencer:      sequencer,
+		mqClient:       mqClient,
+		msgConfig:      msgConfig,
+		logger:         logger,
 	}
 }
 
+// SetTypingRelay 启用正在输入转发
+func (s *ChatService) SetTypingRelay(routerRepo repo.RouterRepo, pusher GatewayPusher) {
+	s.routerRepo = routerRepo
+	s.pusher = pusher
+}
+
+// SetCommandRegistry 启用斜杠命令
+func (s *ChatService) SetCommandRegistry(commands *CommandRegistry) {
+	s.commands = commands
+}
+
 // SendMessage 实现 ChatService.SendMessage（Unary 调用）
 func (s *ChatService) SendMessage(ctx context.Context, req *logicv1.SendMessageRequest) (*logicv1.SendMessageResponse, error) {
+	// sent 为本次发送成功的结果，用于记录去重结果
+	var sent *repo.SentMessageRecord
+
 	s.logger.Debug("handling message",
 		clog.String("from", req.FromUsername),
 		clog.String("session_id", req.SessionId))
@@ -53,26 +94,202 @@ func (s *ChatService) SendMessage(ctx context.Context, req *logicv1.SendMessageR
 	if err != nil {
 		s.logger.Error("failed to get session members", clog.Error(err))
 		return &logicv1.SendMessageResponse{
-			Error: "failed to get session members",
+			Error:     "failed to get session members",
+			ErrorCode: errcode.Internal,
 		}, nil
 	}
 
 	// 检查发送者是否在会话中
-	isMember := false
+	var sender *model.SessionMember
 	for _, m := range members {
 		if m.Username == req.FromUsername {
-			isMember = true
+			sender = m
 			break
 		}
 	}
-	if !isMember {
+	if sender == nil {
 		s.logger.Warn("user is not session member",
 			clog.String("username", req.FromUsername),
 			clog.String("session_id", req.SessionId))
 		return &logicv1.SendMessageResponse{
-			Error: "not a session member",
+			Error:     "not a session member",
+			ErrorCode: errcode.NotSessionMember,
+		}, nil
+	}
+	if sender.MutedAt(time.Now()) {
+		return &logicv1.SendMessageResponse{
+			Error:     errMutedInSession,
+			ErrorCode: errcode.Muted,
+		}, nil
+	}
+
+	// 客户端消息ID去重：保留窗口内的重试直接返回首次发送的结果
+	// 须在斜杠命令之前占用去重键，避免重试重复调用机器人回调；命令可能改写发送者，因此按原始发送者记录
+	if req.ClientMsgId != "" && s.dedupRepo != nil {
+		record, acquired, err := s.dedupRepo.Acquire(ctx, req.FromUsername, req.ClientMsgId, s.msgConfig.GetDedupWindow())
+		switch {
+		case err != nil:
+			// 去重存储不可用时降级为普通发送
+			s.logger.Warn("failed to acquire dedup key", clog.Error(err))
+		case acquired:
+			// 首次发送：成功时记录结果，失败时释放去重键以允许重试
+			dedupSender, clientMsgID := req.FromUsername, req.ClientMsgId
+			defer func() { s.finishDedup(dedupSender, clientMsgID, sent) }()
+		case record != nil:
+			s.logger.Info("duplicate message, return original result",
+				clog.String("client_msg_id", req.ClientMsgId),
+				clog.Int64("msg_id", record.MsgID))
+			return sentRecordResponse(record), nil
+		default:
+			return &logicv1.SendMessageResponse{
+				Error:     "message is being processed, retry later",
+				ErrorCode: errcode.InProgress,
+			}, nil
+		}
+	}
+
+	// 斜杠命令：在落库前拦截，可能仅回复发送者、拒绝，或改写为普通消息后继续发送
+	// 命令结果同样记录到去重键，重试时直接返回而不再调用机器人回调
+	if resp, handled := s.handleCommand(ctx, req); handled {
+		sent = commandSentRecord(resp)
+		return resp, nil
+	}
+
+	// 结构化消息体：校验并派生 type 与纯文本摘要；未携带时按 content/type 作为纯文本处理（兼容旧客户端）
+	msgType, content := req.Type, req.Content
+	var bodyData []byte
+	if req.Body != nil {
+		msgType, content, err = normalizeMessageBody(req.Body)
+		if err != nil {
+			return &logicv1.SendMessageResponse{
+				Error:     err.Error(),
+				ErrorCode: errcode.InvalidArgument,
+			}, nil
+		}
+		// 引用附件：以附件记录为准填充元数据
+		if err := resolveBodyAttachment(ctx, s.attachmentRepo, req.Body, req.FromUsername, req.SessionId); err != nil {
+			s.logger.Warn("invalid message attachment",
+				clog.String("session_id", req.SessionId),
+				clog.Error(err))
+			resp := &logicv1.SendMessageResponse{Error: err.Error(), ErrorCode: errcode.InvalidArgument}
+			if !errors.Is(err, errInvalidAttachment) {
+				resp.ErrorCode = errcode.Internal
+			}
+			return resp, nil
+		}
+		if bodyData, err = marshalMessageBody(req.Body); err != nil {
+			s.logger.Error("failed to marshal message body", clog.Error(err))
+			return &logicv1.SendMessageResponse{
+				Error:     "invalid message body",
+				ErrorCode: errcode.InvalidArgument,
+			}, nil
+		}
+	}
+
+	// 投票仅可在群聊中发起
+	if msgType == model.MessageTypePoll {
+		if resp := s.checkPollSession(ctx, req.SessionId); resp != nil {
+			return resp, nil
+		}
+	}
+
+	// @所有人：按权限矩阵校验（群聊管理员及群主）
+	if req.MentionAll {
+		session, err := s.sessionRepo.GetSession(ctx, req.SessionId)
+		if err != nil {
+			s.logger.Error("failed to get session", clog.Error(err))
+			return &logicv1.SendMessageResponse{
+				Error:     "failed to get session",
+				ErrorCode: errcode.Internal,
+			}, nil
+		}
+		if !allowed(session, sender, permMentionAll) {
+			return &logicv1.SendMessageResponse{
+				Error:     permissionDenied(permMentionAll),
+				ErrorCode: errcode.PermissionDenied,
+			}, nil
+		}
+	}
+	mentions := filterMentions(append(req.Mentions, bodyMentions(req.Body)...), members, req.FromUsername)
+
+	// 引用回复：被引用的消息必须属于同一会话
+	if req.ReplyToMsgId != 0 {
+		quoted, err := s.messageRepo.GetMessage(ctx, req.ReplyToMsgId)
+		if err != nil && !errors.Is(err, repo.ErrNotFound) {
+			s.logger.Error("failed to get quoted message", clog.Error(err))
+			return &logicv1.SendMessageResponse{
+				Error:     "failed to get quoted message",
+				ErrorCode: errcode.Internal,
+			}, nil
+		}
+		if quoted == nil || quoted.SessionID != req.SessionId {
+			s.logger.Warn("quoted message not in session",
+				clog.Int64("reply_to_msg_id", req.ReplyToMsgId),
+				clog.String("session_id", req.SessionId))
+			return &logicv1.SendMessageResponse{
+				Error:     "quoted message not found in session",
+				ErrorCode: errcode.InvalidArgument,
+			}, nil
+		}
+	}
+
+	// 话题消息：根消息必须属于同一会话，且自身不是话题消息（不支持嵌套话题）
+	var threadRoot *model.MessageContent
+	if req.ThreadRootId != 0 {
+		root, err := s.messageRepo.GetMessage(ctx, req.ThreadRootId)
+		if err != nil && !errors.Is(err, repo.ErrNotFound) {
+			s.logger.Error("failed to get thread root", clog.Error(err))
+			return &logicv1.SendMessageResponse{
+				Error:     "failed to get thread root",
+				ErrorCode: errcode.Internal,
+			}, nil
+		}
+		if root == nil || root.SessionID != req.SessionId || root.ThreadRootID != 0 {
+			s.logger.Warn("invalid thread root",
+				clog.Int64("thread_root_id", req.ThreadRootId),
+				clog.String("session_id", req.SessionId))
+			return &logicv1.SendMessageResponse{
+				Error:     "thread root not found in session",
+				ErrorCode: errcode.InvalidArgument,
+			}, nil
+		}
+		if root.Recalled {
+			return &logicv1.SendMessageResponse{
+				Error:     "thread root has been recalled",
+				ErrorCode: errcode.InvalidArgument,
+			}, nil
+		}
+		threadRoot = root
+	}
+
+	// 内容审核：拒绝时不落库；打码后以打码内容投递；标记时照常投递，成功后入队复核
+	original := content
+	modAction, modReasons, err := s.moderateMessage(ctx, req.SessionId, req.FromUsername, &content, req.Body)
+	if err != nil {
+		s.logger.Error("failed to moderate message",
+			clog.String("session_id", req.SessionId),
+			clog.Error(err))
+		return &logicv1.SendMessageResponse{
+			Error:     "failed to moderate message",
+			ErrorCode: errcode.ModerationFailed,
+		}, nil
+	}
+	if modAction == moderation.ActionReject {
+		return &logicv1.SendMessageResponse{
+			Error:     "message rejected by content moderation",
+			ErrorCode: errcode.ContentRejected,
 		}, nil
 	}
+	flagged := modAction == moderation.ActionFlag
+	if modAction != moderation.ActionAllow && req.Body != nil {
+		if bodyData, err = marshalMessageBody(req.Body); err != nil {
+			s.logger.Error("failed to marshal message body", clog.Error(err))
+			return &logicv1.SendMessageResponse{
+				Error:     "invalid message body",
+				ErrorCode: errcode.InvalidArgument,
+			}, nil
+		}
+	}
 
 	// 生成消息 ID (Snowflake)
 	msgID := s.idGen.Next()
@@ -82,19 +299,39 @@ func (s *ChatService) SendMessage(ctx context.Context, req *logicv1.SendMessageR
 	// 如果该 session 已有历史消息（MaxSeqID > 0），会导致 seq_id 冲突
 	// 解决方案：在调用 sequencer.Next 之前，检查 session.MaxSeqID
 	// 如果 MaxSeqID > 0 且 Redis key 不存在，使用 sequencer.SetIfNotExists 初始化
+	// 话题消息使用独立的序号空间，以根消息记录的话题最大序号初始化
+	// 会话开启消息保留时长时，按发送时间计算过期时间（话题消息同样适用）
+	// 会话读取失败时拒绝发送：无法确定保留时长，消息不能以永久保留落库
+	seqKey := req.SessionId
 	session, err := s.sessionRepo.GetSession(ctx, req.SessionId)
-	if err == nil && session.MaxSeqID > 0 {
+	if err != nil {
+		s.logger.Error("failed to get session", clog.Error(err), clog.String("session_id", req.SessionId))
+		return &logicv1.SendMessageResponse{
+			MsgId:     msgID,
+			Error:     "failed to get session",
+			ErrorCode: errcode.Internal,
+		}, nil
+	}
+	if threadRoot != nil {
+		seqKey = threadSeqKey(threadRoot.MsgID)
+		// 旧数据未记录 thread_max_seq，其回复数即话题内最大序号
+		if maxSeq := max(threadRoot.ThreadMaxSeq, int64(threadRoot.ThreadReplyCount)); maxSeq > 0 {
+			s.sequencer.SetIfNotExists(ctx, seqKey, maxSeq)
+		}
+	} else if session != nil && session.MaxSeqID > 0 {
 		// Session 存在且有历史消息，初始化 Redis 计数器（仅当 key 不存在时）
 		s.sequencer.SetIfNotExists(ctx, req.SessionId, session.MaxSeqID)
 	}
+	expiresAt := messageExpiresAt(session, time.Now())
 
 	// 使用 Redis 原子递增获取会话 SeqID，修复并发竞态问题
-	seqID, err := s.sequencer.Next(ctx, req.SessionId)
+	seqID, err := s.sequencer.Next(ctx, seqKey)
 	if err != nil {
 		s.logger.Error("failed to generate seq id", clog.Error(err), clog.String("session_id", req.SessionId))
 		return &logicv1.SendMessageResponse{
-			MsgId: msgID,
-			Error: "server busy: failed to generate sequence",
+			MsgId:     msgID,
+			Error:     "server busy: failed to generate sequence",
+			ErrorCode: errcode.Internal,
 		}, nil
 	}
 
@@ -104,8 +341,14 @@ func (s *ChatService) SendMessage(ctx context.Context, req *logicv1.SendMessageR
 		SessionID:      req.SessionId,
 		SenderUsername: req.FromUsername,
 		SeqID:          seqID,
-		Content:        req.Content,
-		MsgType:        req.Type,
+		Content:        content,
+		MsgType:        msgType,
+		ReplyToMsgID:   req.ReplyToMsgId,
+		ThreadRootID:   req.ThreadRootId,
+		MentionAll:     req.MentionAll,
+		FromBot:        req.FromBot,
+		Body:           bodyData,
+		ExpiresAt:      expiresAt,
 	}
 
 	// 准备 MQ 事件
@@ -115,9 +358,28 @@ func (s *ChatService) SendMessage(ctx context.Context, req *logicv1.SendMessageR
 		SessionId:    req.SessionId,
 		FromUsername: req.FromUsername,
 		ToUsername:   req.ToUsername,
-		Content:      req.Content,
-		Type:         req.Type,
+		Content:      content,
+		Type:         msgType,
 		Timestamp:    req.Timestamp,
+		ReplyToMsgId: req.ReplyToMsgId,
+		ThreadRootId: req.ThreadRootId,
+		Mentions:     mentions,
+		MentionAll:   req.MentionAll,
+		Body:         req.Body,
+		ExpiresAt:    expiresAtUnix(expiresAt),
+		FromBot:      req.FromBot,
+	}
+
+	// 话题根消息作者与回复者自动关注话题（需在事件投递前完成，以便推送时命中关注者）
+	if threadRoot != nil {
+		for _, username := range []string{threadRoot.SenderUsername, req.FromUsername} {
+			if err := s.messageRepo.FollowThread(ctx, threadRoot.MsgID, username); err != nil {
+				s.logger.Warn("failed to auto follow thread",
+					clog.Int64("thread_root_id", threadRoot.MsgID),
+					clog.String("username", username),
+					clog.Error(err))
+			}
+		}
 	}
 
 	// 发布消息到 MQ 并保存到 Outbox
@@ -125,9 +387,10 @@ func (s *ChatService) SendMessage(ctx context.Context, req *logicv1.SendMessageR
 	if err != nil {
 		s.logger.Error("failed to publish message to mq", clog.Error(err))
 		return &logicv1.SendMessageResponse{
-			MsgId: msgID,
-			SeqId: seqID,
-			Error: "failed to save message",
+			MsgId:     msgID,
+			SeqId:     seqID,
+			Error:     "failed to save message",
+			ErrorCode: errcode.Internal,
 		}, nil
 	}
 
@@ -138,9 +401,475 @@ func (s *ChatService) SendMessage(ctx context.Context, req *logicv1.SendMessageR
 		clog.Int64("msg_id", msgID),
 		clog.Int64("seq_id", seqID))
 
+	sent = &repo.SentMessageRecord{MsgID: msgID, SeqID: seqID}
+
+	if flagged {
+		// 复核队列保留原文，便于人工判断
+		s.enqueueReview(msgID, req.SessionId, req.FromUsername, original, modReasons)
+	}
+
 	return &logicv1.SendMessageResponse{
 		MsgId: msgID,
 		SeqId: seqID,
 		Error: "",
 	}, nil
 }
+
+// finishDedup 完成去重键：发送成功时记录结果，失败时释放
+// 使用独立的超时 context，避免 RPC 取消导致去重键残留为处理中
+func (s *ChatService) finishDedup(sender, clientMsgID string, sent *repo.SentMessageRecord) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if sent == nil {
+		if err := s.dedupRepo.Release(ctx, sender, clientMsgID); err != nil {
+			s.logger.Warn("failed to release dedup key", clog.Error(err))
+		}
+		return
+	}
+	if err := s.dedupRepo.Complete(ctx, sender, clientMsgID, sent, s.msgConfig.GetDedupWindow()); err != nil {
+		s.logger.Warn("failed to complete dedup key", clog.Error(err))
+	}
+}
+
+// commandSentRecord 将已处理的命令结果转换为去重记录
+// 命令执行失败（解析失败、回调出错）时返回 nil 以释放去重键，允许客户端重试
+func commandSentRecord(resp *logicv1.SendMessageResponse) *repo.SentMessageRecord {
+	if resp.ErrorCode == errcode.CommandFailed {
+		return nil
+	}
+	record := &repo.SentMessageRecord{Error: resp.Error, ErrorCode: resp.ErrorCode}
+	if reply := resp.CommandReply; reply != nil {
+		record.ReplyCommand = reply.Command
+		record.ReplyFrom = reply.FromUsername
+		record.ReplyContent = reply.Content
+	}
+	return record
+}
+
+// sentRecordResponse 由去重记录还原首次发送的结果
+func sentRecordResponse(record *repo.SentMessageRecord) *logicv1.SendMessageResponse {
+	resp := &logicv1.SendMessageResponse{
+		MsgId:     record.MsgID,
+		SeqId:     record.SeqID,
+		Error:     record.Error,
+		ErrorCode: record.ErrorCode,
+	}
+	if record.ReplyCommand != "" {
+		resp.CommandReply = &gatewayv1.CommandReply{
+			Command:      record.ReplyCommand,
+			FromUsername: record.ReplyFrom,
+			Content:      record.ReplyContent,
+		}
+	}
+	return resp
+}
+
+// filterMentions 过滤 @提及列表：去重，仅保留会话成员，并排除发送者自己
+func filterMentions(mentions []string, members []*model.SessionMember, sender string) []string {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	memberSet := make(map[string]struct{}, len(members))
+	for _, m := range members {
+		memberSet[m.Username] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(mentions))
+	filtered := make([]string, 0, len(mentions))
+	for _, username := range mentions {
+		if username == sender {
+			continue
+		}
+		if _, ok := memberSet[username]; !ok {
+			continue
+		}
+		if _, ok := seen[username]; ok {
+			continue
+		}
+		seen[username] = struct{}{}
+		filtered = append(filtered, username)
+	}
+	return filtered
+}
+
+// RecallMessage 实现 ChatService.RecallMessage
+// 发送者可在撤回时间窗口内撤回自己的消息；群管理员可撤回群内任意消息，不受时间窗口限制
+func (s *ChatService) RecallMessage(ctx context.Context, req *logicv1.RecallMessageRequest) (*logicv1.RecallMessageResponse, error) {
+	s.logger.Info("recall message",
+		clog.String("operator", req.OperatorUsername),
+		clog.String("session_id", req.SessionId),
+		clog.Int64("msg_id", req.MsgId))
+
+	if req.OperatorUsername == "" || req.SessionId == "" || req.MsgId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "operator_username, session_id and msg_id are required")
+	}
+
+	// 校验操作者是会话成员
+	operator, err := s.sessionRepo.GetUserSession(ctx, req.OperatorUsername, req.SessionId)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.PermissionDenied, "not a session member")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+
+	msg, err := s.messageRepo.GetMessage(ctx, req.MsgId)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message not found")
+		}
+		s.logger.Error("failed to get message", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get message")
+	}
+	if msg.SessionID != req.SessionId {
+		return nil, status.Errorf(codes.NotFound, "message not found")
+	}
+
+	// 重复撤回视为成功（幂等）
+	if msg.Recalled {
+		return &logicv1.RecallMessageResponse{}, nil
+	}
+
+	// 权限校验：具备消息管理权限者（群管理员及群主）可撤回任意消息，发送者受时间窗口限制
+	session, err := s.sessionRepo.GetSession(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get session", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get session")
+	}
+	if !allowed(session, operator, permManageMessages) {
+		if msg.SenderUsername != req.OperatorUsername {
+			return nil, status.Errorf(codes.PermissionDenied, "only sender or group admin can recall message")
+		}
+		if time.Since(msg.CreatedAt) > s.msgConfig.GetRecallWindow() {
+			return nil, status.Errorf(codes.FailedPrecondition, "recall window expired")
+		}
+	}
+
+	now := time.Now()
+	event := &mqv1.PushEvent{
+		MsgId:            msg.MsgID,
+		SeqId:            msg.SeqID,
+		SessionId:        msg.SessionID,
+		FromUsername:     msg.SenderUsername,
+		Type:             msg.MsgType,
+		Timestamp:        now.Unix(),
+		EventType:        mqv1.EventType_EVENT_TYPE_RECALL,
+		OperatorUsername: req.OperatorUsername,
+		ThreadRootId:     msg.ThreadRootID,
+	}
+
+	// 标记撤回并保存到 Outbox
+	result, err := PublishRecallToMQ(ctx, s.messageRepo, event, now, s.logger)
+	if errors.Is(err, repo.ErrAlreadyRecalled) {
+		// 并发撤回：其他请求已完成撤回并发布事件，同样视为成功
+		return &logicv1.RecallMessageResponse{}, nil
+	}
+	if err != nil {
+		s.logger.Error("failed to publish recall event", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to recall message")
+	}
+
+	// 立即尝试发布到 MQ (Look-aside 优化)
+	PublishMessageToMQAsync(s.mqClient, result.OutboxID, result.Topic, result.EventData, s.logger)
+
+	s.logger.Info("message recalled",
+		clog.Int64("msg_id", msg.MsgID),
+		clog.String("operator", req.OperatorUsername))
+
+	return &logicv1.RecallMessageResponse{}, nil
+}
+
+// EditMessage 实现 ChatService.EditMessage
+// 仅发送者可在编辑时间窗口内编辑自己的消息，旧内容保存到编辑历史
+func (s *ChatService) EditMessage(ctx context.Context, req *logicv1.EditMessageRequest) (*logicv1.EditMessageResponse, error) {
+	s.logger.Info("edit message",
+		clog.String("operator", req.OperatorUsername),
+		clog.String("session_id", req.SessionId),
+		clog.Int64("msg_id", req.MsgId))
+
+	if req.OperatorUsername == "" || req.SessionId == "" || req.MsgId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "operator_username, session_id and msg_id are required")
+	}
+	if strings.TrimSpace(req.Content) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content is required")
+	}
+
+	// 校验操作者是会话成员
+	if _, err := s.sessionRepo.GetUserSession(ctx, req.OperatorUsername, req.SessionId); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.PermissionDenied, "not a session member")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+
+	msg, err := s.messageRepo.GetMessage(ctx, req.MsgId)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message not found")
+		}
+		s.logger.Error("failed to get message", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get message")
+	}
+	if msg.SessionID != req.SessionId {
+		return nil, status.Errorf(codes.NotFound, "message not found")
+	}
+
+	if msg.SenderUsername != req.OperatorUsername {
+		return nil, status.Errorf(codes.PermissionDenied, "only sender can edit message")
+	}
+	if msg.Recalled {
+		return nil, status.Errorf(codes.FailedPrecondition, "message has been recalled")
+	}
+	// 仅文本消息可编辑；编辑后结构化消息体失效，以 content 为准
+	if msg.MsgType != "" && msg.MsgType != model.MessageTypeText {
+		return nil, status.Errorf(codes.FailedPrecondition, "only text message can be edited")
+	}
+	if time.Since(msg.CreatedAt) > s.msgConfig.GetEditWindow() {
+		return nil, status.Errorf(codes.FailedPrecondition, "edit window expired")
+	}
+
+	// 内容审核：与发送一致，拒绝时不修改；打码后保存打码内容；标记时照常编辑，成功后入队复核
+	content := req.Content
+	modAction, modReasons, err := s.moderateMessage(ctx, req.SessionId, req.OperatorUsername, &content, nil)
+	if err != nil {
+		s.logger.Error("failed to moderate message",
+			clog.String("session_id", req.SessionId),
+			clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to moderate message")
+	}
+	if modAction == moderation.ActionReject {
+		return nil, status.Errorf(codes.InvalidArgument, "message rejected by content moderation")
+	}
+
+	now := time.Now()
+	revision := &model.MessageRevision{
+		MsgID:    msg.MsgID,
+		Version:  msg.EditVersion,
+		Content:  msg.Content,
+		EditedBy: req.OperatorUsername,
+	}
+	event := &mqv1.PushEvent{
+		MsgId:            msg.MsgID,
+		SeqId:            msg.SeqID,
+		SessionId:        msg.SessionID,
+		FromUsername:     msg.SenderUsername,
+		Content:          content,
+		Type:             msg.MsgType,
+		Timestamp:        msg.CreatedAt.Unix(),
+		EventType:        mqv1.EventType_EVENT_TYPE_EDIT,
+		OperatorUsername: req.OperatorUsername,
+		EditVersion:      msg.EditVersion + 1,
+		EditedAt:         now.Unix(),
+		ThreadRootId:     msg.ThreadRootID,
+		FromBot:          msg.FromBot,
+	}
+
+	// 更新内容、记录编辑历史并保存到 Outbox
+	result, err := PublishEditToMQ(ctx, s.messageRepo, event, revision, now, s.logger)
+	if err != nil {
+		if errors.Is(err, repo.ErrVersionConflict) {
+			return nil, status.Errorf(codes.Aborted, "message modified concurrently, please retry")
+		}
+		s.logger.Error("failed to publish edit event", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to edit message")
+	}
+
+	// 立即尝试发布到 MQ (Look-aside 优化)
+	PublishMessageToMQAsync(s.mqClient, result.OutboxID, result.Topic, result.EventData, s.logger)
+
+	s.logger.Info("message edited",
+		clog.Int64("msg_id", msg.MsgID),
+		clog.Int("version", int(event.EditVersion)))
+
+	if modAction == moderation.ActionFlag {
+		// 复核队列保留原文，便于人工判断
+		s.enqueueReview(msg.MsgID, msg.SessionID, req.OperatorUsername, req.Content, modReasons)
+	}
+
+	return &logicv1.EditMessageResponse{
+		Version:  event.EditVersion,
+		EditedAt: event.EditedAt,
+	}, nil
+}
+
+// maxReactionEmojiLength 表情回应的最大字节数（与 t_message_reaction.emoji 列宽一致）
+const maxReactionEmojiLength = 32
+
+// AddReaction 实现 ChatService.AddReaction
+func (s *ChatService) AddReaction(ctx context.Context, req *logicv1.AddReactionRequest) (*logicv1.AddReactionResponse, error) {
+	reactions, err := s.changeReaction(ctx, req.SessionId, req.MsgId, req.OperatorUsername, req.Emoji, false)
+	if err != nil {
+		return nil, err
+	}
+	return &logicv1.AddReactionResponse{Reactions: reactions}, nil
+}
+
+// RemoveReaction 实现 ChatService.RemoveReaction
+func (s *ChatService) RemoveReaction(ctx context.Context, req *logicv1.RemoveReactionRequest) (*logicv1.RemoveReactionResponse, error) {
+	reactions, err := s.changeReaction(ctx, req.SessionId, req.MsgId, req.OperatorUsername, req.Emoji, true)
+	if err != nil {
+		return nil, err
+	}
+	return &logicv1.RemoveReactionResponse{Reactions: reactions}, nil
+}
+
+// changeReaction 添加或取消表情回应，回应状态发生变化时通过 Outbox 发布回应事件
+// 返回变更后该消息的回应聚合
+func (s *ChatService) changeReaction(ctx context.Context, sessionID string, msgID int64, operator, emoji string, removed bool) ([]*gatewayv1.Reaction, error) {
+	s.logger.Info("change reaction",
+		clog.String("operator", operator),
+		clog.String("session_id", sessionID),
+		clog.Int64("msg_id", msgID),
+		clog.String("emoji", emoji))
+
+	if operator == "" || sessionID == "" || msgID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "operator_username, session_id and msg_id are required")
+	}
+	if emoji == "" || len(emoji) > maxReactionEmojiLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid emoji")
+	}
+
+	// 校验操作者是会话成员
+	if _, err := s.sessionRepo.GetUserSession(ctx, operator, sessionID); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.PermissionDenied, "not a session member")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+
+	msg, err := s.messageRepo.GetMessage(ctx, msgID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message not found")
+		}
+		s.logger.Error("failed to get message", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get message")
+	}
+	if msg.SessionID != sessionID {
+		return nil, status.Errorf(codes.NotFound, "message not found")
+	}
+	if msg.Recalled && !removed {
+		return nil, status.Errorf(codes.FailedPrecondition, "message has been recalled")
+	}
+
+	event := &mqv1.PushEvent{
+		MsgId:            msg.MsgID,
+		SeqId:            msg.SeqID,
+		SessionId:        msg.SessionID,
+		FromUsername:     msg.SenderUsername,
+		Type:             msg.MsgType,
+		Timestamp:        time.Now().Unix(),
+		EventType:        mqv1.EventType_EVENT_TYPE_REACTION,
+		OperatorUsername: operator,
+		ThreadRootId:     msg.ThreadRootID,
+		ReactionEmoji:    emoji,
+		ReactionRemoved:  removed,
+	}
+
+	// 变更回应并保存到 Outbox（状态未变化时不产生事件）
+	result, changed, err := PublishReactionToMQ(ctx, s.messageRepo, event, s.logger)
+	if err != nil {
+		s.logger.Error("failed to publish reaction event", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to change reaction")
+	}
+	if changed {
+		// 立即尝试发布到 MQ (Look-aside 优化)
+		PublishMessageToMQAsync(s.mqClient, result.OutboxID, result.Topic, result.EventData, s.logger)
+	}
+
+	summaries, err := s.messageRepo.GetReactionSummaries(ctx, []int64{msg.MsgID}, operator)
+	if err != nil {
+		s.logger.Error("failed to get reactions", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get reactions")
+	}
+
+	return groupReactions(summaries)[msg.MsgID], nil
+}
+
+// typingPushTimeout 单个 Gateway 转发正在输入的超时
+const typingPushTimeout = time.Second
+
+// SendTyping 实现 ChatService.SendTyping
+// 正在输入是瞬时信号：校验成员身份后查询路由表，直接调用在线成员所在 Gateway 的 PushService 转发，
+// 不写数据库也不经过 Outbox/MQ，推送失败即丢弃（接收方依靠 expires_in 自动清除）
+func (s *ChatService) SendTyping(ctx context.Context, req *logicv1.SendTypingRequest) (*logicv1.SendTypingResponse, error) {
+	s.logger.Debug("send typing",
+		clog.String("from", req.FromUsername),
+		clog.String("session_id", req.SessionId),
+		clog.String("state", req.State.String()))
+
+	if req.FromUsername == "" || req.SessionId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "from_username and session_id are required")
+	}
+	if req.State != gatewayv1.TypingState_TYPING_STATE_STARTED && req.State != gatewayv1.TypingState_TYPING_STATE_STOPPED {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid typing state")
+	}
+
+	// 校验输入者是会话成员
+	if _, err := s.sessionRepo.GetUserSession(ctx, req.FromUsername, req.SessionId); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.PermissionDenied, "not a session member")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+
+	if s.routerRepo == nil || s.pusher == nil {
+		return &logicv1.SendTypingResponse{}, nil
+	}
+
+	members, err := s.sessionRepo.GetMembers(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get session members", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get session members")
+	}
+	usernames := make([]string, 0, len(members))
+	for _, m := range members {
+		if m.Username != req.FromUsername {
+			usernames = append(usernames, m.Username)
+		}
+	}
+	if len(usernames) == 0 {
+		return &logicv1.SendTypingResponse{}, nil
+	}
+
+	// 按 Gateway 分组，离线成员没有路由，直接忽略
+	routers, err := s.routerRepo.BatchGetUsersGateway(ctx, usernames)
+	if err != nil {
+		s.logger.Warn("failed to batch get user gateways", clog.Error(err))
+		return &logicv1.SendTypingResponse{}, nil
+	}
+	gatewayGroups := make(map[string][]string) // gatewayID -> []username
+	for _, router := range routers {
+		if router == nil {
+			continue
+		}
+		gatewayGroups[router.GatewayID] = append(gatewayGroups[router.GatewayID], router.Username)
+	}
+
+	typing := &gatewayv1.Typing{
+		SessionId:    req.SessionId,
+		State:        req.State,
+		FromUsername: req.FromUsername,
+		ExpiresIn:    req.ExpiresIn,
+	}
+	for gatewayID, users := range gatewayGroups {
+		// 输入信号时效性很强，失败不重试
+		pushCtx, cancel := context.WithTimeout(ctx, typingPushTimeout)
+		err := s.pusher.Push(pushCtx, gatewayID, &gatewayv1.PushRequest{ToUsernames: users, Typing: typing})
+		cancel()
+		if err != nil {
+			s.logger.Warn("failed to push typing",
+				clog.String("gateway_id", gatewayID),
+				clog.Int("user_count", len(users)),
+				clog.Error(err))
+		}
+	}
+
+	return &logicv1.SendTypingResponse{}, nil
+}
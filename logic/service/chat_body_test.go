@@ -0,0 +1,112 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeMessageBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    *gatewayv1.MessageBody
+		msgType string
+		content string
+		wantErr bool
+	}{
+		{
+			name: "text with mention entity",
+			body: &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Text{Text: &gatewayv1.TextBody{
+				Text: "你好 @bob",
+				Entities: []*gatewayv1.TextEntity{
+					{Type: gatewayv1.TextEntityType_TEXT_ENTITY_TYPE_MENTION, Offset: 3, Length: 4, Username: "bob"},
+				},
+			}}},
+			msgType: model.MessageTypeText,
+			content: "你好 @bob",
+		},
+		{
+			name: "text entity out of range",
+			body: &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Text{Text: &gatewayv1.TextBody{
+				Text:     "hi",
+				Entities: []*gatewayv1.TextEntity{{Type: gatewayv1.TextEntityType_TEXT_ENTITY_TYPE_BOLD, Offset: 1, Length: 5}},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "text entity offset overflow",
+			body: &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Text{Text: &gatewayv1.TextBody{
+				Text:     "hi",
+				Entities: []*gatewayv1.TextEntity{{Type: gatewayv1.TextEntityType_TEXT_ENTITY_TYPE_BOLD, Offset: math.MaxInt32, Length: 1}},
+			}}},
+			wantErr: true,
+		},
+		{
+			name:    "image",
+			body:    &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Image{Image: &gatewayv1.ImageBody{Url: "https://cdn.example.com/a.png", Width: 100, Height: 80}}},
+			msgType: model.MessageTypeImage,
+			content: "[图片]",
+		},
+		{
+			name:    "image with invalid url",
+			body:    &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Image{Image: &gatewayv1.ImageBody{Url: "javascript:alert(1)"}}},
+			wantErr: true,
+		},
+		{
+			name:    "file",
+			body:    &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_File{File: &gatewayv1.FileBody{Url: "https://cdn.example.com/a.pdf", Name: "a.pdf", Size: 1024}}},
+			msgType: model.MessageTypeFile,
+			content: "[文件] a.pdf",
+		},
+		{
+			name:    "audio without duration",
+			body:    &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Audio{Audio: &gatewayv1.AudioBody{Url: "https://cdn.example.com/a.ogg"}}},
+			wantErr: true,
+		},
+		{
+			name:    "location out of range",
+			body:    &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Location{Location: &gatewayv1.LocationBody{Latitude: 91}}},
+			wantErr: true,
+		},
+		{
+			name:    "contact",
+			body:    &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Contact{Contact: &gatewayv1.ContactCardBody{Username: "bob"}}},
+			msgType: model.MessageTypeContact,
+			content: "[名片] bob",
+		},
+		{
+			name:    "system is server only",
+			body:    &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_System{System: &gatewayv1.SystemBody{Text: "fake"}}},
+			wantErr: true,
+		},
+		{
+			name:    "empty body",
+			body:    &gatewayv1.MessageBody{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgType, content, err := normalizeMessageBody(tt.body)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.msgType, msgType)
+			require.Equal(t, tt.content, content)
+		})
+	}
+}
+
+func TestMessageBody_RoundTrip(t *testing.T) {
+	body := &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Text{Text: &gatewayv1.TextBody{Text: "hello"}}}
+	data, err := marshalMessageBody(body)
+	require.NoError(t, err)
+	require.Equal(t, "hello", unmarshalMessageBody(data).GetText().GetText())
+	require.Nil(t, unmarshalMessageBody(nil))
+}
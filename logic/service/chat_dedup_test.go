@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/require"
+)
+
+// testDedupRepo 内存实现的 MessageDedupRepo，值为 nil 表示处理中
+type testDedupRepo struct {
+	mu      sync.Mutex
+	records map[string]*repo.SentMessageRecord
+}
+
+func newTestDedupRepo() *testDedupRepo {
+	return &testDedupRepo{records: make(map[string]*repo.SentMessageRecord)}
+}
+
+func (r *testDedupRepo) Acquire(ctx context.Context, sender, clientMsgID string, ttl time.Duration) (*repo.SentMessageRecord, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := sender + ":" + clientMsgID
+	if record, ok := r.records[key]; ok {
+		return record, false, nil
+	}
+	r.records[key] = nil
+	return nil, true, nil
+}
+
+func (r *testDedupRepo) Complete(ctx context.Context, sender, clientMsgID string, record *repo.SentMessageRecord, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records[sender+":"+clientMsgID] = record
+	return nil
+}
+
+func (r *testDedupRepo) Release(ctx context.Context, sender, clientMsgID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.records, sender+":"+clientMsgID)
+	return nil
+}
+
+func (r *testDedupRepo) Close() error { return nil }
+
+func newDedupTestService(dedupRepo repo.MessageDedupRepo) *ChatService {
+	sessionRepo := &testSessionRepo{
+		getMembersFn: func(ctx context.Context, sessionID string) ([]*model.SessionMember, error) {
+			return []*model.SessionMember{{SessionID: sessionID, Username: "alice"}}, nil
+		},
+	}
+	return NewChatService(sessionRepo, &testMessageRepo{}, dedupRepo, nil, nil, nil, nil, &config.MessageConfig{}, clog.Discard())
+}
+
+func TestChatService_SendMessage_RetryReturnsOriginalResult(t *testing.T) {
+	dedupRepo := newTestDedupRepo()
+	require.NoError(t, dedupRepo.Complete(context.Background(), "alice", "tmp-1", &repo.SentMessageRecord{MsgID: 1001, SeqID: 7}, time.Hour))
+	svc := newDedupTestService(dedupRepo)
+
+	// 命中去重记录时不会生成新的 ID（idGen/sequencer 为 nil）
+	resp, err := svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{
+		SessionId:    "s_123",
+		FromUsername: "alice",
+		Content:      "hello",
+		Type:         "text",
+		ClientMsgId:  "tmp-1",
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Error)
+	require.Equal(t, int64(1001), resp.MsgId)
+	require.Equal(t, int64(7), resp.SeqId)
+}
+
+func TestChatService_SendMessage_ReleasesDedupKeyOnFailure(t *testing.T) {
+	dedupRepo := newTestDedupRepo()
+	svc := newDedupTestService(dedupRepo)
+
+	// 引用不存在的消息导致发送失败，去重键应被释放以允许重试
+	resp, err := svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{
+		SessionId:    "s_123",
+		FromUsername: "alice",
+		Content:      "hello",
+		Type:         "text",
+		ReplyToMsgId: 42,
+		ClientMsgId:  "tmp-2",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Error)
+	require.Empty(t, dedupRepo.records)
+}
+
+func TestChatService_SendMessage_DedupBeforeCommand(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_ = json.NewEncoder(w).Encode(&botCommandResponse{ResponseType: "in_channel", Text: "deployed"})
+	}))
+	defer server.Close()
+
+	dedupRepo := newTestDedupRepo()
+	require.NoError(t, dedupRepo.Complete(context.Background(), "alice", "tmp-3", &repo.SentMessageRecord{MsgID: 1003, SeqID: 9}, time.Hour))
+	_, _, err := dedupRepo.Acquire(context.Background(), "alice", "tmp-4", time.Hour)
+	require.NoError(t, err)
+	svc := newDedupTestService(dedupRepo)
+	svc.SetCommandRegistry(NewCommandRegistry(&testCommandBotRepo{cmds: []*model.BotCommand{
+		{BotUsername: "ci_bot", Name: "deploy", URL: server.URL, Secret: "secret"},
+	}}, &config.CommandConfig{AllowPrivateNetwork: true}, clog.Discard()))
+
+	// 已完成的命令消息重试：直接返回首次结果，不再调用机器人回调
+	resp, err := svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{
+		SessionId:    "s_123",
+		FromUsername: "alice",
+		Content:      "/deploy prod",
+		ClientMsgId:  "tmp-3",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1003), resp.MsgId)
+
+	// 处理中的命令消息重试同样不调用回调
+	resp, err = svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{
+		SessionId:    "s_123",
+		FromUsername: "alice",
+		Content:      "/deploy prod",
+		ClientMsgId:  "tmp-4",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "message is being processed, retry later", resp.Error)
+	require.Zero(t, calls.Load())
+}
+
+func TestChatService_SendMessage_RetryCommandReply(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_ = json.NewEncoder(w).Encode(&botCommandResponse{ResponseType: "ephemeral", Text: "build #42 queued"})
+	}))
+	defer server.Close()
+
+	dedupRepo := newTestDedupRepo()
+	svc := newDedupTestService(dedupRepo)
+	svc.SetCommandRegistry(NewCommandRegistry(&testCommandBotRepo{cmds: []*model.BotCommand{
+		{BotUsername: "ci_bot", Name: "build", URL: server.URL, Secret: "secret"},
+	}}, &config.CommandConfig{AllowPrivateNetwork: true}, clog.Discard()))
+
+	req := &logicv1.SendMessageRequest{
+		SessionId:    "s_123",
+		FromUsername: "alice",
+		Content:      "/build",
+		ClientMsgId:  "tmp-5",
+	}
+	first, err := svc.SendMessage(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, "build #42 queued", first.CommandReply.GetContent())
+
+	// 仅回复发送者的命令重试：返回相同的回复，不再调用机器人回调
+	retry, err := svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{
+		SessionId:    "s_123",
+		FromUsername: "alice",
+		Content:      "/build",
+		ClientMsgId:  "tmp-5",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), calls.Load())
+	require.Equal(t, "build", retry.CommandReply.GetCommand())
+	require.Equal(t, "ci_bot", retry.CommandReply.GetFromUsername())
+	require.Equal(t, "build #42 queued", retry.CommandReply.GetContent())
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChatService_EditMessage_DeniedForNonSender(t *testing.T) {
+	// 群管理员也不能编辑他人的消息
+	svc := newRecallTestService(&model.MessageContent{
+		MsgID:          1,
+		SessionID:      "s_123",
+		SenderUsername: "alice",
+		Content:        "hello",
+		CreatedAt:      time.Now(),
+	}, 1)
+
+	_, err := svc.EditMessage(context.Background(), &logicv1.EditMessageRequest{
+		SessionId:        "s_123",
+		MsgId:            1,
+		OperatorUsername: "bob",
+		Content:          "hacked",
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestChatService_EditMessage_RejectsRecalledMessage(t *testing.T) {
+	svc := newRecallTestService(&model.MessageContent{
+		MsgID:          1,
+		SessionID:      "s_123",
+		SenderUsername: "alice",
+		Content:        "hello",
+		Recalled:       true,
+		CreatedAt:      time.Now(),
+	}, 0)
+
+	_, err := svc.EditMessage(context.Background(), &logicv1.EditMessageRequest{
+		SessionId:        "s_123",
+		MsgId:            1,
+		OperatorUsername: "alice",
+		Content:          "hello again",
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
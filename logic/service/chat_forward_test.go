@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newForwardTestService alice 是 s_src 与 s_dst 的成员，不是 s_other 的成员
+func newForwardTestService(msgs ...*model.MessageContent) *ChatService {
+	sessionRepo := &testSessionRepo{
+		getUserSessionFn: func(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
+			if sessionID == "s_other" {
+				return nil, fmt.Errorf("user session %w: username=%s, session_id=%s", repo.ErrNotFound, username, sessionID)
+			}
+			return &model.SessionMember{SessionID: sessionID, Username: username}, nil
+		},
+	}
+	messageRepo := &testMessageRepo{
+		getMessagesByIDsFn: func(ctx context.Context, msgIDs []int64, username string) ([]*model.MessageContent, error) {
+			return msgs, nil
+		},
+	}
+	return NewChatService(sessionRepo, messageRepo, nil, nil, nil, nil, nil, &config.MessageConfig{}, clog.Discard())
+}
+
+func TestChatService_ForwardMessages_RejectsInvalidSource(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *model.MessageContent
+		code codes.Code
+	}{
+		{"cross session", &model.MessageContent{MsgID: 1, SessionID: "s_other", MsgType: model.MessageTypeText}, codes.NotFound},
+		{"recalled", &model.MessageContent{MsgID: 1, SessionID: "s_src", MsgType: model.MessageTypeText, Recalled: true}, codes.FailedPrecondition},
+		{"system", &model.MessageContent{MsgID: 1, SessionID: "s_src", MsgType: model.MessageTypeSystem}, codes.FailedPrecondition},
+		{"poll", &model.MessageContent{MsgID: 1, SessionID: "s_src", MsgType: model.MessageTypePoll}, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newForwardTestService(tt.msg)
+			_, err := svc.ForwardMessages(context.Background(), &logicv1.ForwardMessagesRequest{
+				OperatorUsername: "alice",
+				SourceSessionId:  "s_src",
+				MsgIds:           []int64{1},
+				TargetSessionIds: []string{"s_dst"},
+			})
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestChatService_ForwardMessages_DeniedForNonMemberOfSource(t *testing.T) {
+	svc := newForwardTestService()
+	_, err := svc.ForwardMessages(context.Background(), &logicv1.ForwardMessagesRequest{
+		OperatorUsername: "alice",
+		SourceSessionId:  "s_other",
+		MsgIds:           []int64{1},
+		TargetSessionIds: []string{"s_dst"},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestChatService_ForwardMessages_NonMemberTargetReportedPerTarget(t *testing.T) {
+	svc := newForwardTestService(&model.MessageContent{MsgID: 1, SessionID: "s_src", MsgType: model.MessageTypeText})
+	resp, err := svc.ForwardMessages(context.Background(), &logicv1.ForwardMessagesRequest{
+		OperatorUsername: "alice",
+		SourceSessionId:  "s_src",
+		MsgIds:           []int64{1},
+		TargetSessionIds: []string{"s_other"},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	require.Equal(t, "s_other", resp.Results[0].SessionId)
+	require.NotEmpty(t, resp.Results[0].Error)
+	require.Empty(t, resp.Results[0].MsgIds)
+}
+
+func TestBuildForwardBody_EmbedsSnapshots(t *testing.T) {
+	imageBody, err := marshalMessageBody(&gatewayv1.MessageBody{
+		Body: &gatewayv1.MessageBody_Image{Image: &gatewayv1.ImageBody{Url: "https://example.com/a.png"}},
+	})
+	require.NoError(t, err)
+	now := time.Now()
+	msgs := []*model.MessageContent{
+		{MsgID: 1, SenderUsername: "alice", Content: "hi", MsgType: model.MessageTypeText, CreatedAt: now},
+		{MsgID: 2, SenderUsername: "bob", Content: "[图片]", MsgType: model.MessageTypeImage, Body: imageBody, CreatedAt: now},
+	}
+
+	body := buildForwardBody("s_src", msgs)
+	forward := body.GetForward()
+	require.NotNil(t, forward)
+	require.Equal(t, "alice 和 bob 的聊天记录", forward.Title)
+	require.Equal(t, "s_src", forward.SourceSessionId)
+	require.Len(t, forward.Messages, 2)
+	require.Nil(t, forward.Messages[0].Body, "旧消息无结构化消息体时以 content 为准")
+	require.Equal(t, "https://example.com/a.png", forward.Messages[1].Body.GetImage().GetUrl())
+	require.Equal(t, "[聊天记录] alice 和 bob 的聊天记录", forwardSummary(forward))
+}
+
+// testIDGen 按调用顺序递增的 ID 生成器
+type testIDGen struct {
+	next int64
+}
+
+func (g *testIDGen) Next() int64 {
+	g.next++
+	return g.next
+}
+
+func (g *testIDGen) NextString() string {
+	return strconv.FormatInt(g.Next(), 10)
+}
+
+func TestChatService_RescopeAttachments(t *testing.T) {
+	attachmentRepo := &testAttachmentRepo{attachments: map[int64]*model.Attachment{
+		1: {AttachmentID: 1, SessionID: "s_src", UploaderUsername: "bob", FileName: "a.pdf", StorageKey: "attachments/1", Status: model.AttachmentStatusUploaded},
+	}}
+	svc := NewChatService(&testSessionRepo{}, &testMessageRepo{}, nil, attachmentRepo, &testIDGen{next: 100}, nil, nil, &config.MessageConfig{}, clog.Discard())
+
+	// 合并转发：原消息快照中的附件同样复制
+	body := &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Forward{Forward: &gatewayv1.ForwardBody{
+		Messages: []*gatewayv1.ForwardedMessage{{Body: &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_File{
+			File: &gatewayv1.FileBody{AttachmentId: 1, Url: "/attachments/1", Name: "a.pdf"},
+		}}}},
+	}}}
+	require.NoError(t, svc.rescopeAttachments(context.Background(), body, "s_dst", "alice"))
+
+	file := body.GetForward().Messages[0].Body.GetFile()
+	require.Equal(t, int64(101), file.AttachmentId)
+	require.Empty(t, file.Url)
+	copied := attachmentRepo.attachments[101]
+	require.NotNil(t, copied)
+	require.Equal(t, "s_dst", copied.SessionID)
+	require.Equal(t, "alice", copied.UploaderUsername)
+	require.Equal(t, "attachments/1", copied.StorageKey)
+	require.Equal(t, model.AttachmentStatusUploaded, copied.Status)
+	require.Equal(t, "s_src", attachmentRepo.attachments[1].SessionID)
+
+	// 原附件不存在时转发失败
+	missing := &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Image{Image: &gatewayv1.ImageBody{AttachmentId: 9}}}
+	require.Error(t, svc.rescopeAttachments(context.Background(), missing, "s_dst", "alice"))
+}
+
+// testSequencer 按键递增的内存序号生成器
+type testSequencer struct {
+	seqs map[string]int64
+}
+
+func (s *testSequencer) Next(ctx context.Context, key string) (int64, error) {
+	s.seqs[key]++
+	return s.seqs[key], nil
+}
+func (s *testSequencer) NextBatch(ctx context.Context, key string, count int) ([]int64, error) {
+	return nil, errors.New("not implemented")
+}
+func (s *testSequencer) Set(ctx context.Context, key string, value int64) error {
+	s.seqs[key] = value
+	return nil
+}
+func (s *testSequencer) SetIfNotExists(ctx context.Context, key string, value int64) (bool, error) {
+	if _, ok := s.seqs[key]; ok {
+		return false, nil
+	}
+	s.seqs[key] = value
+	return true, nil
+}
+
+func TestChatService_ForwardMessages_KeepsSourceExpiry(t *testing.T) {
+	soon, later := time.Now().Add(time.Hour), time.Now().Add(2*time.Hour)
+	msgs := []*model.MessageContent{
+		{MsgID: 1, SessionID: "s_src", MsgType: model.MessageTypeText, Content: "a", ExpiresAt: &later},
+		{MsgID: 2, SessionID: "s_src", MsgType: model.MessageTypeText, Content: "b", ExpiresAt: &soon},
+		{MsgID: 3, SessionID: "s_src", MsgType: model.MessageTypeText, Content: "c"},
+	}
+	sessionRepo := &testSessionRepo{
+		getUserSessionFn: func(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
+			return &model.SessionMember{SessionID: sessionID, Username: username}, nil
+		},
+		getSessionFn: func(ctx context.Context, sessionID string) (*model.Session, error) {
+			return &model.Session{SessionID: sessionID, Type: 2}, nil
+		},
+	}
+	messageRepo := &testMessageRepo{
+		getMessagesByIDsFn: func(ctx context.Context, msgIDs []int64, username string) ([]*model.MessageContent, error) {
+			return msgs, nil
+		},
+	}
+	svc := NewChatService(sessionRepo, messageRepo, nil, nil, &testIDGen{next: 100}, &testSequencer{seqs: map[string]int64{}}, testMQ{}, &config.MessageConfig{}, clog.Discard())
+	req := &logicv1.ForwardMessagesRequest{
+		OperatorUsername: "alice",
+		SourceSessionId:  "s_src",
+		MsgIds:           []int64{1, 2, 3},
+		TargetSessionIds: []string{"s_dst"},
+	}
+
+	// 目标会话未开启自动删除：逐条转发的副本沿用各自原消息的过期时间
+	_, err := svc.ForwardMessages(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, messageRepo.saved, 3)
+	require.Equal(t, later.Unix(), expiresAtUnix(messageRepo.saved[0].ExpiresAt))
+	require.Equal(t, soon.Unix(), expiresAtUnix(messageRepo.saved[1].ExpiresAt))
+	require.Nil(t, messageRepo.saved[2].ExpiresAt)
+
+	// 合并转发的快照取最早过期的原消息
+	messageRepo.saved = nil
+	req.Mode = gatewayv1.ForwardMode_FORWARD_MODE_MERGED
+	_, err = svc.ForwardMessages(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, messageRepo.saved, 1)
+	require.Equal(t, soon.Unix(), expiresAtUnix(messageRepo.saved[0].ExpiresAt))
+}
+
+func TestChatService_ForwardMessages_Moderated(t *testing.T) {
+	svc := newForwardTestService(&model.MessageContent{MsgID: 1, SessionID: "s_src", MsgType: model.MessageTypeText, Content: "evil"})
+	svc.SetModerator(maskModerator{})
+
+	resp, err := svc.ForwardMessages(context.Background(), &logicv1.ForwardMessagesRequest{
+		OperatorUsername: "alice",
+		SourceSessionId:  "s_src",
+		MsgIds:           []int64{1},
+		TargetSessionIds: []string{"s_dst"},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	require.Equal(t, "message rejected by content moderation", resp.Results[0].Error)
+	require.Empty(t, resp.Results[0].MsgIds)
+}
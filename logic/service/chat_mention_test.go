@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatService_SendMessage_MentionAllRequiresAdmin(t *testing.T) {
+	sessionRepo := &testSessionRepo{
+		getMembersFn: func(ctx context.Context, sessionID string) ([]*model.SessionMember, error) {
+			return []*model.SessionMember{
+				{SessionID: sessionID, Username: "alice", Role: 0},
+				{SessionID: sessionID, Username: "bob", Role: 1},
+			}, nil
+		},
+	}
+	svc := NewChatService(sessionRepo, &testMessageRepo{}, nil, nil, nil, nil, nil, &config.MessageConfig{}, clog.Discard())
+
+	resp, err := svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{
+		SessionId:    "0",
+		FromUsername: "alice",
+		Content:      "@all hello",
+		Type:         "text",
+		MentionAll:   true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "only admin can mention all", resp.Error)
+}
+
+func TestFilterMentions(t *testing.T) {
+	members := []*model.SessionMember{
+		{Username: "alice"},
+		{Username: "bob"},
+		{Username: "carol"},
+	}
+
+	got := filterMentions([]string{"bob", "mallory", "alice", "bob", "carol"}, members, "alice")
+	require.Equal(t, []string{"bob", "carol"}, got)
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChatService_AddReaction_RejectsInvalidEmoji(t *testing.T) {
+	svc := newRecallTestService(&model.MessageContent{
+		MsgID:          1,
+		SessionID:      "s_123",
+		SenderUsername: "alice",
+		CreatedAt:      time.Now(),
+	}, 0)
+
+	for _, emoji := range []string{"", strings.Repeat("x", maxReactionEmojiLength+1)} {
+		_, err := svc.AddReaction(context.Background(), &logicv1.AddReactionRequest{
+			SessionId:        "s_123",
+			MsgId:            1,
+			OperatorUsername: "bob",
+			Emoji:            emoji,
+		})
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func TestChatService_AddReaction_RejectsRecalledMessage(t *testing.T) {
+	svc := newRecallTestService(&model.MessageContent{
+		MsgID:          1,
+		SessionID:      "s_123",
+		SenderUsername: "alice",
+		Recalled:       true,
+		CreatedAt:      time.Now(),
+	}, 0)
+
+	_, err := svc.AddReaction(context.Background(), &logicv1.AddReactionRequest{
+		SessionId:        "s_123",
+		MsgId:            1,
+		OperatorUsername: "bob",
+		Emoji:            "👍",
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
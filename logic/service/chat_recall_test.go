@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newRecallTestService(msg *model.MessageContent, role int) *ChatService {
+	sessionRepo := &testSessionRepo{
+		getUserSessionFn: func(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
+			return &model.SessionMember{SessionID: sessionID, Username: username, Role: role}, nil
+		},
+	}
+	messageRepo := &testMessageRepo{
+		getMessageFn: func(ctx context.Context, msgID int64) (*model.MessageContent, error) {
+			return msg, nil
+		},
+	}
+	return NewChatService(sessionRepo, messageRepo, nil, nil, nil, nil, nil, &config.MessageConfig{RecallWindow: time.Minute}, clog.Discard())
+}
+
+func TestChatService_RecallMessage_DeniedForOtherMember(t *testing.T) {
+	svc := newRecallTestService(&model.MessageContent{
+		MsgID:          1,
+		SessionID:      "s_123",
+		SenderUsername: "alice",
+		CreatedAt:      time.Now(),
+	}, 0)
+
+	_, err := svc.RecallMessage(context.Background(), &logicv1.RecallMessageRequest{
+		SessionId:        "s_123",
+		MsgId:            1,
+		OperatorUsername: "mallory",
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestChatService_RecallMessage_WindowExpired(t *testing.T) {
+	svc := newRecallTestService(&model.MessageContent{
+		MsgID:          1,
+		SessionID:      "s_123",
+		SenderUsername: "alice",
+		CreatedAt:      time.Now().Add(-2 * time.Minute),
+	}, 0)
+
+	_, err := svc.RecallMessage(context.Background(), &logicv1.RecallMessageRequest{
+		SessionId:        "s_123",
+		MsgId:            1,
+		OperatorUsername: "alice",
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestChatService_RecallMessage_RejectsCrossSessionMessage(t *testing.T) {
+	svc := newRecallTestService(&model.MessageContent{
+		MsgID:          1,
+		SessionID:      "s_other",
+		SenderUsername: "alice",
+		CreatedAt:      time.Now(),
+	}, 0)
+
+	_, err := svc.RecallMessage(context.Background(), &logicv1.RecallMessageRequest{
+		SessionId:        "s_123",
+		MsgId:            1,
+		OperatorUsername: "alice",
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestChatService_RecallMessage_ConcurrentRecallSucceeds(t *testing.T) {
+	svc := newRecallTestService(&model.MessageContent{
+		MsgID:          1,
+		SessionID:      "s_123",
+		SenderUsername: "alice",
+		CreatedAt:      time.Now(),
+	}, 0)
+	// 读取时尚未撤回，CAS 时已被并发请求撤回
+	svc.messageRepo.(*testMessageRepo).recallMessageFn = func(ctx context.Context, msgID int64, recalledBy string, recalledAt time.Time, outbox *model.MessageOutbox) error {
+		return fmt.Errorf("message %w or not found: %d", repo.ErrAlreadyRecalled, msgID)
+	}
+
+	_, err := svc.RecallMessage(context.Background(), &logicv1.RecallMessageRequest{
+		SessionId:        "s_123",
+		MsgId:            1,
+		OperatorUsername: "alice",
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/errcode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatService_SendMessage_RejectsCrossSessionReply(t *testing.T) {
+	sessionRepo := &testSessionRepo{
+		getMembersFn: func(ctx context.Context, sessionID string) ([]*model.SessionMember, error) {
+			return []*model.SessionMember{{SessionID: sessionID, Username: "alice"}}, nil
+		},
+	}
+	messageRepo := &testMessageRepo{
+		getMessageFn: func(ctx context.Context, msgID int64) (*model.MessageContent, error) {
+			return &model.MessageContent{
+				MsgID:          msgID,
+				SessionID:      "s_other",
+				SenderUsername: "bob",
+				Content:        "secret",
+				CreatedAt:      time.Now(),
+			}, nil
+		},
+	}
+	svc := NewChatService(sessionRepo, messageRepo, nil, nil, nil, nil, nil, &config.MessageConfig{}, clog.Discard())
+
+	resp, err := svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{
+		SessionId:    "s_123",
+		FromUsername: "alice",
+		Content:      "reply",
+		Type:         "text",
+		ReplyToMsgId: 42,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "quoted message not found in session", resp.Error)
+	require.Equal(t, errcode.InvalidArgument, resp.ErrorCode)
+	require.Zero(t, resp.MsgId)
+}
+
+func TestSessionService_GetHistoryMessages_AttachesQuotedSnapshot(t *testing.T) {
+	quoted := map[int64]*model.MessageContent{
+		1: {MsgID: 1, SessionID: "s_123", SenderUsername: "bob", Content: strings.Repeat("长", quotedExcerptMaxRunes+10), MsgType: "text"},
+		3: {MsgID: 3, SessionID: "s_123", SenderUsername: "bob", Content: "recalled", MsgType: "text", Recalled: true},
+		5: {MsgID: 5, SessionID: "s_123", SenderUsername: "bob", Content: "hidden", MsgType: "text"},
+		7: {MsgID: 7, SessionID: "s_other", SenderUsername: "bob", Content: "secret", MsgType: "text"},
+	}
+	messageRepo := &testMessageRepo{
+		getHistoryFn: func(ctx context.Context, sessionID, username string, beforeSeq int64, limit int) ([]*model.MessageContent, error) {
+			msgs := make([]*model.MessageContent, 0, 4)
+			for i, replyTo := range []int64{1, 3, 5, 7} {
+				msgs = append(msgs, &model.MessageContent{MsgID: int64(10 + i), SessionID: sessionID, SenderUsername: "carol", Content: "reply", MsgType: "text", ReplyToMsgID: replyTo})
+			}
+			return msgs, nil
+		},
+		getMessagesByIDsFn: func(ctx context.Context, msgIDs []int64, username string) ([]*model.MessageContent, error) {
+			msgs := make([]*model.MessageContent, 0, len(msgIDs))
+			for _, id := range msgIDs {
+				// alice 已删除（仅自己）消息 5
+				if id == 5 && username == "alice" {
+					continue
+				}
+				msgs = append(msgs, quoted[id])
+			}
+			return msgs, nil
+		},
+	}
+	svc := NewSessionService(&testSessionRepo{}, messageRepo, &memberTestUserRepo{}, nil, nil, nil, nil, clog.Discard())
+
+	resp, err := svc.GetHistoryMessages(context.Background(), &logicv1.GetHistoryMessagesRequest{Username: "alice", SessionId: "s_123"})
+	require.NoError(t, err)
+	require.Len(t, resp.Messages, 4)
+	for _, m := range resp.Messages {
+		require.NotZero(t, m.ReplyToMsgId)
+	}
+
+	// 快照包含发送者与截断后的内容摘要
+	reply := resp.Messages[0].ReplyTo
+	require.NotNil(t, reply)
+	require.Equal(t, "bob", reply.FromUsername)
+	require.Equal(t, quotedExcerptMaxRunes+1, utf8.RuneCountInString(reply.Content))
+
+	// 被引用消息撤回后快照只显示撤回提示
+	reply = resp.Messages[1].ReplyTo
+	require.NotNil(t, reply)
+	require.True(t, reply.Recalled)
+	require.Equal(t, model.RecalledMessageContent, reply.Content)
+
+	// 查看者已删除的消息与其他会话的消息不附带快照
+	require.Nil(t, resp.Messages[2].ReplyTo)
+	require.Nil(t, resp.Messages[3].ReplyTo)
+
+	// 其他成员仍能看到消息 5 的快照
+	resp, err = svc.GetHistoryMessages(context.Background(), &logicv1.GetHistoryMessagesRequest{Username: "bob", SessionId: "s_123"})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Messages[2].ReplyTo)
+	require.Equal(t, "hidden", resp.Messages[2].ReplyTo.Content)
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/errcode"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChatService_SendMessage_RejectsNestedThread(t *testing.T) {
+	sessionRepo := &testSessionRepo{
+		getMembersFn: func(ctx context.Context, sessionID string) ([]*model.SessionMember, error) {
+			return []*model.SessionMember{{SessionID: sessionID, Username: "alice"}}, nil
+		},
+	}
+	messageRepo := &testMessageRepo{
+		getMessageFn: func(ctx context.Context, msgID int64) (*model.MessageContent, error) {
+			// 目标本身是话题内的消息
+			return &model.MessageContent{
+				MsgID:          msgID,
+				SessionID:      "0",
+				SenderUsername: "bob",
+				ThreadRootID:   7,
+				CreatedAt:      time.Now(),
+			}, nil
+		},
+	}
+	svc := NewChatService(sessionRepo, messageRepo, nil, nil, nil, nil, nil, &config.MessageConfig{}, clog.Discard())
+
+	resp, err := svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{
+		SessionId:    "0",
+		FromUsername: "alice",
+		Content:      "in thread",
+		Type:         "text",
+		ThreadRootId: 42,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "thread root not found in session", resp.Error)
+	require.Equal(t, errcode.InvalidArgument, resp.ErrorCode)
+}
+
+func TestSessionService_FollowThread(t *testing.T) {
+	messageRepo := &testMessageRepo{
+		followers: make(map[int64]map[string]bool),
+		getMessagesByIDsFn: func(ctx context.Context, msgIDs []int64, username string) ([]*model.MessageContent, error) {
+			if msgIDs[0] == 43 {
+				// 话题内的消息不能作为话题根
+				return []*model.MessageContent{{MsgID: 43, SessionID: "s_123", ThreadRootID: 42}}, nil
+			}
+			return []*model.MessageContent{{MsgID: msgIDs[0], SessionID: "s_123", ThreadReplyCount: 2, ThreadMaxSeq: 5}}, nil
+		},
+	}
+	svc := NewSessionService(&testSessionRepo{}, messageRepo, &memberTestUserRepo{}, nil, nil, nil, nil, clog.Discard())
+	ctx := context.Background()
+
+	_, err := svc.FollowThread(ctx, &logicv1.FollowThreadRequest{Username: "alice", SessionId: "s_123", RootMsgId: 43})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svc.FollowThread(ctx, &logicv1.FollowThreadRequest{Username: "alice", SessionId: "s_123", RootMsgId: 42})
+	require.NoError(t, err)
+	resp, err := svc.GetThreadMessages(ctx, &logicv1.GetThreadMessagesRequest{Username: "alice", SessionId: "s_123", RootMsgId: 42})
+	require.NoError(t, err)
+	require.True(t, resp.Following)
+	// 回复数取根消息记录的存活回复数，而非话题最大序号
+	require.Equal(t, int32(2), resp.Root.ThreadReplyCount)
+
+	_, err = svc.UnfollowThread(ctx, &logicv1.UnfollowThreadRequest{Username: "alice", SessionId: "s_123", RootMsgId: 42})
+	require.NoError(t, err)
+	resp, err = svc.GetThreadMessages(ctx, &logicv1.GetThreadMessagesRequest{Username: "alice", SessionId: "s_123", RootMsgId: 42})
+	require.NoError(t, err)
+	require.False(t, resp.Following)
+}
+
+func TestSessionService_GetThreadMessages_Visibility(t *testing.T) {
+	var threadUser string
+	messageRepo := &testMessageRepo{
+		getMessagesByIDsFn: func(ctx context.Context, msgIDs []int64, username string) ([]*model.MessageContent, error) {
+			// bob 删除了根消息（或根消息早于其入群）
+			if username == "bob" {
+				return nil, nil
+			}
+			return []*model.MessageContent{{MsgID: msgIDs[0], SessionID: "s_123"}}, nil
+		},
+		getThreadFn: func(ctx context.Context, rootMsgID int64, username string, afterSeq int64, limit int) ([]*model.MessageContent, error) {
+			threadUser = username
+			return []*model.MessageContent{{MsgID: 100, SessionID: "s_123", SeqID: 1, ThreadRootID: rootMsgID}}, nil
+		},
+	}
+	svc := NewSessionService(&testSessionRepo{}, messageRepo, &memberTestUserRepo{}, nil, nil, nil, nil, clog.Discard())
+	ctx := context.Background()
+
+	resp, err := svc.GetThreadMessages(ctx, &logicv1.GetThreadMessagesRequest{Username: "alice", SessionId: "s_123", RootMsgId: 42})
+	require.NoError(t, err)
+	require.Equal(t, "alice", threadUser)
+	require.Len(t, resp.Messages, 1)
+
+	_, err = svc.GetThreadMessages(ctx, &logicv1.GetThreadMessagesRequest{Username: "bob", SessionId: "s_123", RootMsgId: 42})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
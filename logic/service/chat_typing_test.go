@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChatService_SendTyping_RejectsInvalidState(t *testing.T) {
+	svc := newRecallTestService(nil, 0)
+
+	_, err := svc.SendTyping(context.Background(), &logicv1.SendTypingRequest{
+		SessionId:    "s_123",
+		FromUsername: "alice",
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestChatService_SendTyping_DeniedForNonMember(t *testing.T) {
+	sessionRepo := &testSessionRepo{
+		getUserSessionFn: func(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
+			return nil, repo.ErrNotFound
+		},
+	}
+	svc := NewChatService(sessionRepo, &testMessageRepo{}, nil, nil, nil, nil, nil, &config.MessageConfig{}, clog.Discard())
+
+	_, err := svc.SendTyping(context.Background(), &logicv1.SendTypingRequest{
+		SessionId:    "s_123",
+		FromUsername: "mallory",
+		State:        gatewayv1.TypingState_TYPING_STATE_STARTED,
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+type testTypingRouterRepo struct {
+	routers map[string]*model.Router
+}
+
+func (r *testTypingRouterRepo) SetUserGateway(ctx context.Context, router *model.Router) error {
+	return nil
+}
+func (r *testTypingRouterRepo) GetUserGateway(ctx context.Context, username string) (*model.Router, error) {
+	return r.routers[username], nil
+}
+func (r *testTypingRouterRepo) DeleteUserGateway(ctx context.Context, username string) error {
+	return nil
+}
+func (r *testTypingRouterRepo) BatchSetUserGateway(ctx context.Context, routers []*model.Router) error {
+	return nil
+}
+func (r *testTypingRouterRepo) BatchDeleteUserGateway(ctx context.Context, usernames []string) error {
+	return nil
+}
+func (r *testTypingRouterRepo) BatchGetUsersGateway(ctx context.Context, usernames []string) ([]*model.Router, error) {
+	routers := make([]*model.Router, 0, len(usernames))
+	for _, username := range usernames {
+		routers = append(routers, r.routers[username])
+	}
+	return routers, nil
+}
+func (r *testTypingRouterRepo) Close() error { return nil }
+
+type testGatewayPusher struct {
+	pushed map[string]*gatewayv1.PushRequest
+}
+
+func (p *testGatewayPusher) Push(ctx context.Context, gatewayID string, req *gatewayv1.PushRequest) error {
+	p.pushed[gatewayID] = req
+	return nil
+}
+
+func TestChatService_SendTyping_RelaysToOnlineMembers(t *testing.T) {
+	sessionRepo := &testSessionRepo{
+		getMembersFn: func(ctx context.Context, sessionID string) ([]*model.SessionMember, error) {
+			return []*model.SessionMember{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}, {Username: "dave"}}, nil
+		},
+	}
+	routerRepo := &testTypingRouterRepo{routers: map[string]*model.Router{
+		"alice": {Username: "alice", GatewayID: "gw-1"},
+		"bob":   {Username: "bob", GatewayID: "gw-1"},
+		"carol": {Username: "carol", GatewayID: "gw-2"},
+	}}
+	pusher := &testGatewayPusher{pushed: make(map[string]*gatewayv1.PushRequest)}
+	svc := NewChatService(sessionRepo, &testMessageRepo{}, nil, nil, nil, nil, nil, &config.MessageConfig{}, clog.Discard())
+	svc.SetTypingRelay(routerRepo, pusher)
+
+	_, err := svc.SendTyping(context.Background(), &logicv1.SendTypingRequest{
+		SessionId:    "s_123",
+		FromUsername: "alice",
+		State:        gatewayv1.TypingState_TYPING_STATE_STARTED,
+		ExpiresIn:    6,
+	})
+	require.NoError(t, err)
+
+	// 不推送给输入者本人，离线成员（dave）直接忽略
+	require.Len(t, pusher.pushed, 2)
+	require.Equal(t, []string{"bob"}, pusher.pushed["gw-1"].ToUsernames)
+	require.Equal(t, []string{"carol"}, pusher.pushed["gw-2"].ToUsernames)
+	require.Nil(t, pusher.pushed["gw-1"].Message)
+	require.Equal(t, "alice", pusher.pushed["gw-1"].Typing.FromUsername)
+	require.Equal(t, int32(6), pusher.pushed["gw-2"].Typing.ExpiresIn)
+}
@@ -0,0 +1,441 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/errcode"
+	"github.com/ceyewan/resonance/pkg/safehttp"
+	"github.com/ceyewan/resonance/repo"
+)
+
+// CommandAction 斜杠命令的处理方式
+type CommandAction int
+
+const (
+	// CommandReplyEphemeral 仅回复发送者，不生成消息
+	CommandReplyEphemeral CommandAction = iota + 1
+	// CommandPost 以 CommandResult.Content 作为普通消息发送到会话
+	CommandPost
+	// CommandReject 拒绝命令，CommandResult.Content 为拒绝原因
+	CommandReject
+)
+
+// 机器人命令回调请求头，签名方式与出站 Webhook 一致
+const (
+	commandHeaderTimestamp = "X-Resonance-Timestamp"
+	commandHeaderSignature = "X-Resonance-Signature"
+)
+
+// commandNamePattern 命令名规则（小写）
+var commandNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
+// CommandRequest 一次斜杠命令调用
+type CommandRequest struct {
+	Name      string // 命令名（小写，不含 /）
+	Args      string // 命令名之后的参数（已去除首尾空白）
+	SessionID string
+	Username  string // 命令发起者
+}
+
+// CommandResult 斜杠命令的处理结果
+type CommandResult struct {
+	Action  CommandAction
+	Content string
+	// FromUsername 仅 CommandPost 有效：消息发送者，为空时以命令发起者身份发送
+	FromUsername string
+	// FromBot 仅 CommandPost 有效：FromUsername 为机器人
+	FromBot bool
+}
+
+// CommandHandler 斜杠命令处理器
+type CommandHandler interface {
+	HandleCommand(ctx context.Context, req *CommandRequest) (*CommandResult, error)
+}
+
+// CommandHandlerFunc 将函数适配为 CommandHandler
+type CommandHandlerFunc func(ctx context.Context, req *CommandRequest) (*CommandResult, error)
+
+// HandleCommand 实现 CommandHandler
+func (f CommandHandlerFunc) HandleCommand(ctx context.Context, req *CommandRequest) (*CommandResult, error) {
+	return f(ctx, req)
+}
+
+// Command 斜杠命令
+type Command struct {
+	Name        string
+	Description string
+	BotUsername string // 机器人命令的提供者，内置命令为空
+	Handler     CommandHandler
+}
+
+// CommandRegistry 斜杠命令注册表
+// 内置命令在进程内通过 Register 注册；机器人命令保存在 t_bot_command，按会话内的机器人成员解析。
+// 同名时内置命令优先，机器人不能注册与内置命令同名的命令。
+type CommandRegistry struct {
+	mu       sync.RWMutex
+	commands map[string]*Command
+	botRepo  repo.BotRepo // 为 nil 时不解析机器人命令
+	client   *http.Client
+	cfg      *config.CommandConfig
+	logger   clog.Logger
+}
+
+// NewCommandRegistry 创建斜杠命令注册表，并注册内置命令
+func NewCommandRegistry(botRepo repo.BotRepo, cfg *config.CommandConfig, logger clog.Logger) *CommandRegistry {
+	r := &CommandRegistry{
+		commands: make(map[string]*Command),
+		botRepo:  botRepo,
+		client:   safehttp.NewClient(cfg.GetTimeout(), cfg.AllowPrivateNetwork),
+		cfg:      cfg,
+		logger:   logger,
+	}
+	r.registerBuiltins()
+	return r
+}
+
+// Register 注册进程内命令，命令名冲突时返回错误
+func (r *CommandRegistry) Register(cmd *Command) error {
+	if cmd == nil || cmd.Handler == nil {
+		return fmt.Errorf("command handler is required")
+	}
+	if !commandNamePattern.MatchString(cmd.Name) {
+		return fmt.Errorf("invalid command name: %s", cmd.Name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.commands[cmd.Name]; ok {
+		return fmt.Errorf("command already registered: %s", cmd.Name)
+	}
+	r.commands[cmd.Name] = cmd
+	return nil
+}
+
+// IsReserved 命令名是否已被进程内命令占用
+func (r *CommandRegistry) IsReserved(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.commands[name]
+	return ok
+}
+
+// Resolve 解析会话内可用的命令，不存在时返回 nil
+func (r *CommandRegistry) Resolve(ctx context.Context, sessionID, name string) (*Command, error) {
+	r.mu.RLock()
+	cmd, ok := r.commands[name]
+	r.mu.RUnlock()
+	if ok {
+		return cmd, nil
+	}
+	if r.botRepo == nil {
+		return nil, nil
+	}
+
+	botCmds, err := r.botRepo.ListSessionBotCommands(ctx, sessionID, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(botCmds) == 0 {
+		return nil, nil
+	}
+	// 多个机器人注册了同名命令时，取用户名排序靠前的机器人
+	return r.botCommand(botCmds[0]), nil
+}
+
+// List 列出会话内可用的命令（按命令名排序）
+func (r *CommandRegistry) List(ctx context.Context, sessionID string) ([]*Command, error) {
+	r.mu.RLock()
+	result := make([]*Command, 0, len(r.commands))
+	for _, cmd := range r.commands {
+		result = append(result, cmd)
+	}
+	r.mu.RUnlock()
+
+	if r.botRepo != nil {
+		botCmds, err := r.botRepo.ListSessionBotCommands(ctx, sessionID, "")
+		if err != nil {
+			return nil, err
+		}
+		seen := make(map[string]struct{}, len(result)+len(botCmds))
+		for _, cmd := range result {
+			seen[cmd.Name] = struct{}{}
+		}
+		for _, botCmd := range botCmds {
+			if _, ok := seen[botCmd.Name]; ok {
+				continue
+			}
+			seen[botCmd.Name] = struct{}{}
+			result = append(result, r.botCommand(botCmd))
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+// registerBuiltins 注册内置命令
+func (r *CommandRegistry) registerBuiltins() {
+	builtins := []*Command{
+		{
+			Name:        "help",
+			Description: "列出当前会话可用的命令",
+			Handler: CommandHandlerFunc(func(ctx context.Context, req *CommandRequest) (*CommandResult, error) {
+				cmds, err := r.List(ctx, req.SessionID)
+				if err != nil {
+					return nil, err
+				}
+				var b strings.Builder
+				b.WriteString("可用命令：")
+				for _, cmd := range cmds {
+					fmt.Fprintf(&b, "\n/%s - %s", cmd.Name, cmd.Description)
+				}
+				return &CommandResult{Action: CommandReplyEphemeral, Content: b.String()}, nil
+			}),
+		},
+		{
+			Name:        "me",
+			Description: "以第三人称描述动作，如 /me 去吃饭了",
+			Handler: CommandHandlerFunc(func(ctx context.Context, req *CommandRequest) (*CommandResult, error) {
+				if req.Args == "" {
+					return &CommandResult{Action: CommandReject, Content: "usage: /me <action>"}, nil
+				}
+				return &CommandResult{Action: CommandPost, Content: "* " + req.Username + " " + req.Args}, nil
+			}),
+		},
+		{
+			Name:        "shrug",
+			Description: `在消息末尾追加 ¯\_(ツ)_/¯`,
+			Handler: CommandHandlerFunc(func(ctx context.Context, req *CommandRequest) (*CommandResult, error) {
+				return &CommandResult{Action: CommandPost, Content: strings.TrimSpace(req.Args + ` ¯\_(ツ)_/¯`)}, nil
+			}),
+		},
+	}
+	for _, cmd := range builtins {
+		if err := r.Register(cmd); err != nil {
+			r.logger.Error("failed to register builtin command", clog.String("name", cmd.Name), clog.Error(err))
+		}
+	}
+}
+
+// botCommand 将机器人注册的命令包装为 Command
+func (r *CommandRegistry) botCommand(botCmd *model.BotCommand) *Command {
+	return &Command{
+		Name:        botCmd.Name,
+		Description: botCmd.Description,
+		BotUsername: botCmd.BotUsername,
+		Handler:     &botCommandHandler{registry: r, cmd: botCmd},
+	}
+}
+
+// botCommandPayload 机器人命令回调的请求体
+type botCommandPayload struct {
+	Command   string `json:"command"`
+	Args      string `json:"args"`
+	SessionID string `json:"session_id"`
+	Username  string `json:"username"`
+	Bot       string `json:"bot"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// botCommandResponse 机器人命令回调的响应体
+// response_type：ephemeral（默认，仅回复发送者）/ in_channel（以机器人身份发到会话）/ reject（拒绝，text 为原因）
+type botCommandResponse struct {
+	ResponseType string `json:"response_type"`
+	Text         string `json:"text"`
+}
+
+// botCommandHandler 同步回调机器人注册的 URL 处理命令
+type botCommandHandler struct {
+	registry *CommandRegistry
+	cmd      *model.BotCommand
+}
+
+// HandleCommand 实现 CommandHandler
+func (h *botCommandHandler) HandleCommand(ctx context.Context, req *CommandRequest) (*CommandResult, error) {
+	now := time.Now().Unix()
+	body, err := json.Marshal(&botCommandPayload{
+		Command:   req.Name,
+		Args:      req.Args,
+		SessionID: req.SessionID,
+		Username:  req.Username,
+		Bot:       h.cmd.BotUsername,
+		Timestamp: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, h.cmd.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "Resonance-Command/1.0")
+	httpReq.Header.Set(commandHeaderTimestamp, strconv.FormatInt(now, 10))
+	httpReq.Header.Set(commandHeaderSignature, signCommandPayload(h.cmd.Secret, now, body))
+
+	resp, err := h.registry.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("bot command callback returned status %d", resp.StatusCode)
+	}
+
+	maxSize := h.registry.cfg.GetMaxResponseSize()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("bot command response exceeds %d bytes", maxSize)
+	}
+	var reply botCommandResponse
+	if err := json.Unmarshal(data, &reply); err != nil {
+		return nil, fmt.Errorf("invalid bot command response: %w", err)
+	}
+
+	switch reply.ResponseType {
+	case "", "ephemeral":
+		return &CommandResult{Action: CommandReplyEphemeral, Content: reply.Text}, nil
+	case "in_channel":
+		if reply.Text == "" {
+			return nil, fmt.Errorf("in_channel response requires text")
+		}
+		return &CommandResult{Action: CommandPost, Content: reply.Text, FromUsername: h.cmd.BotUsername, FromBot: true}, nil
+	case "reject":
+		return &CommandResult{Action: CommandReject, Content: reply.Text}, nil
+	}
+	return nil, fmt.Errorf("unknown response_type: %s", reply.ResponseType)
+}
+
+// signCommandPayload 计算回调签名：HMAC-SHA256(secret, "{timestamp}.{body}") 的十六进制编码
+func signCommandPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// parseCommand 解析斜杠命令，返回小写命令名与参数；内容不是合法命令时 ok 为 false（按普通文本发送）
+func parseCommand(content string) (name, args string, ok bool) {
+	rest, found := strings.CutPrefix(content, "/")
+	if !found {
+		return "", "", false
+	}
+	name, args = rest, ""
+	if i := strings.IndexFunc(rest, unicode.IsSpace); i >= 0 {
+		name, args = rest[:i], rest[i:]
+	}
+	name = strings.ToLower(name)
+	if !commandNamePattern.MatchString(name) {
+		return "", "", false
+	}
+	return name, strings.TrimSpace(args), true
+}
+
+// handleCommand 拦截以 / 开头的纯文本消息并交给命令处理器
+// handled 为 true 时直接以 resp 作为 SendMessage 的结果；CommandPost 会改写 req 后继续走正常发送链路
+// 机器人发送的消息与结构化消息不解析命令；以 // 开头时去掉一个 / 按普通文本发送
+func (s *ChatService) handleCommand(ctx context.Context, req *logicv1.SendMessageRequest) (resp *logicv1.SendMessageResponse, handled bool) {
+	if s.commands == nil || req.FromBot || req.Body != nil || (req.Type != "" && req.Type != model.MessageTypeText) {
+		return nil, false
+	}
+	if strings.HasPrefix(req.Content, "//") {
+		req.Content = req.Content[1:]
+		return nil, false
+	}
+	name, args, ok := parseCommand(req.Content)
+	if !ok {
+		return nil, false
+	}
+
+	cmd, err := s.commands.Resolve(ctx, req.SessionId, name)
+	if err != nil {
+		s.logger.Error("failed to resolve command", clog.String("name", name), clog.Error(err))
+		return &logicv1.SendMessageResponse{
+			Error:     "failed to resolve command",
+			ErrorCode: errcode.CommandFailed,
+		}, true
+	}
+	if cmd == nil {
+		return &logicv1.SendMessageResponse{
+			Error:     "unknown command: /" + name,
+			ErrorCode: errcode.UnknownCommand,
+		}, true
+	}
+
+	result, err := cmd.Handler.HandleCommand(ctx, &CommandRequest{
+		Name:      name,
+		Args:      args,
+		SessionID: req.SessionId,
+		Username:  req.FromUsername,
+	})
+	if err != nil || result == nil {
+		s.logger.Warn("command failed",
+			clog.String("name", name),
+			clog.String("bot", cmd.BotUsername),
+			clog.String("session_id", req.SessionId),
+			clog.Error(err))
+		return &logicv1.SendMessageResponse{
+			Error:     "command failed: /" + name,
+			ErrorCode: errcode.CommandFailed,
+		}, true
+	}
+
+	switch result.Action {
+	case CommandReplyEphemeral:
+		return &logicv1.SendMessageResponse{
+			CommandReply: &gatewayv1.CommandReply{
+				Command:      name,
+				FromUsername: cmd.BotUsername,
+				Content:      result.Content,
+			},
+		}, true
+	case CommandPost:
+		req.Content = result.Content
+		req.Type = model.MessageTypeText
+		req.Mentions = nil
+		req.MentionAll = false
+		if result.FromUsername != "" {
+			req.FromUsername = result.FromUsername
+			req.FromBot = result.FromBot
+		}
+		return nil, false
+	case CommandReject:
+		reason := result.Content
+		if reason == "" {
+			reason = "command rejected: /" + name
+		}
+		return &logicv1.SendMessageResponse{
+			Error:     reason,
+			ErrorCode: errcode.CommandRejected,
+		}, true
+	}
+	return &logicv1.SendMessageResponse{
+		Error:     "command failed: /" + name,
+		ErrorCode: errcode.CommandFailed,
+	}, true
+}
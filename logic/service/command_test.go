@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/errcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCommandBotRepo 只实现会话机器人命令的解析
+type testCommandBotRepo struct {
+	testBotRepo
+	cmds []*model.BotCommand
+}
+
+func (r *testCommandBotRepo) ListSessionBotCommands(ctx context.Context, sessionID, name string) ([]*model.BotCommand, error) {
+	var result []*model.BotCommand
+	for _, cmd := range r.cmds {
+		if name == "" || cmd.Name == name {
+			result = append(result, cmd)
+		}
+	}
+	return result, nil
+}
+
+func newCommandTestService(cmds ...*model.BotCommand) *ChatService {
+	sessionRepo := &testSessionRepo{
+		getMembersFn: func(ctx context.Context, sessionID string) ([]*model.SessionMember, error) {
+			return []*model.SessionMember{{SessionID: sessionID, Username: "alice"}}, nil
+		},
+	}
+	svc := NewChatService(sessionRepo, &testMessageRepo{}, nil, nil, nil, nil, nil, &config.MessageConfig{}, clog.Discard())
+	svc.SetCommandRegistry(NewCommandRegistry(&testCommandBotRepo{cmds: cmds}, &config.CommandConfig{AllowPrivateNetwork: true}, clog.Discard()))
+	return svc
+}
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		content string
+		name    string
+		args    string
+		ok      bool
+	}{
+		{"/help", "help", "", true},
+		{"/Me  waves \n", "me", "waves", true},
+		{"/deploy\tprod", "deploy", "prod", true},
+		{"hello", "", "", false},
+		{"/ hello", "", "", false},
+		{"/usr/bin", "", "", false},
+	}
+	for _, tt := range tests {
+		name, args, ok := parseCommand(tt.content)
+		require.Equal(t, tt.ok, ok, tt.content)
+		require.Equal(t, tt.name, name, tt.content)
+		require.Equal(t, tt.args, args, tt.content)
+	}
+}
+
+func TestChatService_SendMessage_UnknownCommand(t *testing.T) {
+	svc := newCommandTestService()
+	resp, err := svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{
+		SessionId:    "s_1",
+		FromUsername: "alice",
+		Content:      "/nope arg",
+		Type:         model.MessageTypeText,
+	})
+	require.NoError(t, err)
+	require.Equal(t, errcode.UnknownCommand, resp.ErrorCode)
+	require.Zero(t, resp.MsgId, "未知命令不应作为文本发送")
+}
+
+func TestChatService_HandleCommand_Builtins(t *testing.T) {
+	svc := newCommandTestService()
+	ctx := context.Background()
+
+	req := &logicv1.SendMessageRequest{SessionId: "s_1", FromUsername: "alice", Content: "/help"}
+	resp, handled := svc.handleCommand(ctx, req)
+	require.True(t, handled)
+	require.Contains(t, resp.CommandReply.Content, "/shrug")
+
+	req = &logicv1.SendMessageRequest{SessionId: "s_1", FromUsername: "alice", Content: "/me waves", Mentions: []string{"bob"}}
+	_, handled = svc.handleCommand(ctx, req)
+	require.False(t, handled, "CommandPost 改写后继续正常发送")
+	require.Equal(t, "* alice waves", req.Content)
+	require.Empty(t, req.Mentions)
+
+	req = &logicv1.SendMessageRequest{SessionId: "s_1", FromUsername: "alice", Content: "/me"}
+	resp, handled = svc.handleCommand(ctx, req)
+	require.True(t, handled)
+	require.Equal(t, errcode.CommandRejected, resp.ErrorCode)
+
+	req = &logicv1.SendMessageRequest{SessionId: "s_1", FromUsername: "alice", Content: "//nope"}
+	_, handled = svc.handleCommand(ctx, req)
+	require.False(t, handled)
+	require.Equal(t, "/nope", req.Content, "// 转义为字面量 /")
+}
+
+func TestChatService_HandleCommand_BotCallback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		var payload botCommandPayload
+		assert.NoError(t, json.Unmarshal(raw, &payload))
+		ts, _ := strconv.ParseInt(r.Header.Get(commandHeaderTimestamp), 10, 64)
+		assert.Equal(t, signCommandPayload("secret", ts, raw), r.Header.Get(commandHeaderSignature))
+
+		responseType := "in_channel"
+		if payload.Args == "dry-run" {
+			responseType = "ephemeral"
+		}
+		_ = json.NewEncoder(w).Encode(&botCommandResponse{ResponseType: responseType, Text: "deploying " + payload.Args})
+	}))
+	defer server.Close()
+
+	svc := newCommandTestService(&model.BotCommand{BotUsername: "ci_bot", Name: "deploy", URL: server.URL, Secret: "secret"})
+	ctx := context.Background()
+
+	req := &logicv1.SendMessageRequest{SessionId: "s_1", FromUsername: "alice", Content: "/deploy prod"}
+	_, handled := svc.handleCommand(ctx, req)
+	require.False(t, handled)
+	require.Equal(t, "ci_bot", req.FromUsername, "in_channel 以机器人身份发送")
+	require.True(t, req.FromBot)
+	require.Equal(t, "deploying prod", req.Content)
+
+	req = &logicv1.SendMessageRequest{SessionId: "s_1", FromUsername: "alice", Content: "/deploy dry-run"}
+	resp, handled := svc.handleCommand(ctx, req)
+	require.True(t, handled)
+	require.Equal(t, "ci_bot", resp.CommandReply.FromUsername)
+	require.Equal(t, "deploying dry-run", resp.CommandReply.Content)
+}
@@ -0,0 +1,389 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
+	"github.com/ceyewan/resonance/logic/moderation"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxForwardMessages 单次转发的最大消息数
+	maxForwardMessages = 100
+	// maxForwardTargets 单次转发的最大目标会话数
+	maxForwardTargets = 10
+)
+
+// ForwardMessages 实现 ChatService.ForwardMessages
+// 逐条转发时每条原消息生成一条新消息；合并转发时生成一条内嵌原消息快照的聊天记录消息
+// 转发产生的消息不晚于原消息过期（合并转发取最早过期的原消息），原会话的自动删除不会因转发失效
+// 每条新消息都经 PublishMessageToMQ 投递，信箱写扩散与推送链路保持不变
+func (s *ChatService) ForwardMessages(ctx context.Context, req *logicv1.ForwardMessagesRequest) (*logicv1.ForwardMessagesResponse, error) {
+	s.logger.Info("forward messages",
+		clog.String("operator", req.OperatorUsername),
+		clog.String("source_session_id", req.SourceSessionId),
+		clog.Int("msg_count", len(req.MsgIds)),
+		clog.Int("target_count", len(req.TargetSessionIds)))
+
+	if req.OperatorUsername == "" || req.SourceSessionId == "" || len(req.MsgIds) == 0 || len(req.TargetSessionIds) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "operator_username, source_session_id, msg_ids and target_session_ids are required")
+	}
+	msgIDs := uniqueInt64s(req.MsgIds)
+	targets := uniqueStrings(req.TargetSessionIds)
+	if len(msgIDs) == 0 || len(targets) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "msg_ids and target_session_ids are required")
+	}
+	if len(msgIDs) > maxForwardMessages {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot forward more than %d messages", maxForwardMessages)
+	}
+	if len(targets) > maxForwardTargets {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot forward to more than %d sessions", maxForwardTargets)
+	}
+
+	// 校验转发者是源会话成员
+	if err := s.checkSessionMember(ctx, req.OperatorUsername, req.SourceSessionId); err != nil {
+		return nil, err
+	}
+
+	// 转发者已删除（仅自己）的消息对其不可见，视为不存在
+	msgs, err := s.messageRepo.GetMessagesByIDs(ctx, msgIDs, req.OperatorUsername)
+	if err != nil {
+		s.logger.Error("failed to get messages", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get messages")
+	}
+	if len(msgs) != len(msgIDs) {
+		return nil, status.Errorf(codes.NotFound, "message not found in source session")
+	}
+	for _, msg := range msgs {
+		if msg.SessionID != req.SourceSessionId {
+			return nil, status.Errorf(codes.NotFound, "message not found in source session")
+		}
+		if msg.Recalled {
+			return nil, status.Errorf(codes.FailedPrecondition, "recalled message cannot be forwarded")
+		}
+		if msg.MsgType == model.MessageTypeSystem {
+			return nil, status.Errorf(codes.FailedPrecondition, "system message cannot be forwarded")
+		}
+		// 逐条转发的投票会成为目标会话中可投票的新投票（并绕过仅群聊的限制），只允许以合并转发的快照形式转发
+		if msg.MsgType == model.MessageTypePoll && req.Mode != gatewayv1.ForwardMode_FORWARD_MODE_MERGED {
+			return nil, status.Errorf(codes.FailedPrecondition, "poll can only be forwarded as chat history")
+		}
+	}
+	// Snowflake ID 随时间递增，按 ID 排序即按发送顺序排序（话题消息与主时间线消息的 seq 不可比）
+	sort.Slice(msgs, func(i, j int) bool { return msgs[i].MsgID < msgs[j].MsgID })
+
+	var merged *gatewayv1.MessageBody
+	var mergedExpiresAt *time.Time
+	if req.Mode == gatewayv1.ForwardMode_FORWARD_MODE_MERGED {
+		merged = buildForwardBody(req.SourceSessionId, msgs)
+		for _, msg := range msgs {
+			mergedExpiresAt = earlierExpiry(mergedExpiresAt, msg.ExpiresAt)
+		}
+	}
+
+	// 按目标会话逐个转发，单个目标失败不影响其他目标
+	results := make([]*gatewayv1.ForwardResult, 0, len(targets))
+	for _, target := range targets {
+		result := &gatewayv1.ForwardResult{SessionId: target}
+		results = append(results, result)
+
+		if err := s.checkCanPost(ctx, req.OperatorUsername, target); err != nil {
+			result.Error = status.Convert(err).Message()
+			continue
+		}
+
+		if merged != nil {
+			msgID, errMsg := s.forwardTo(ctx, target, req.OperatorUsername, model.MessageTypeForward, forwardSummary(merged.GetForward()), proto.Clone(merged).(*gatewayv1.MessageBody), mergedExpiresAt)
+			if errMsg != "" {
+				result.Error = errMsg
+				continue
+			}
+			result.MsgIds = append(result.MsgIds, msgID)
+			continue
+		}
+
+		for _, msg := range msgs {
+			msgID, errMsg := s.forwardTo(ctx, target, req.OperatorUsername, msg.MsgType, msg.Content, unmarshalMessageBody(msg.Body), msg.ExpiresAt)
+			if errMsg != "" {
+				result.Error = errMsg
+				break
+			}
+			result.MsgIds = append(result.MsgIds, msgID)
+		}
+	}
+
+	return &logicv1.ForwardMessagesResponse{
+		Results: results,
+	}, nil
+}
+
+// checkSessionMember 校验用户是会话成员
+func (s *ChatService) checkSessionMember(ctx context.Context, username, sessionID string) error {
+	if _, err := s.sessionRepo.GetUserSession(ctx, username, sessionID); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return status.Errorf(codes.PermissionDenied, "not a session member")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+	return nil
+}
+
+// errMutedInSession 被禁言的成员发送消息时的错误信息
+const errMutedInSession = "you are muted in this session"
+
+// checkCanPost 校验用户可在会话中发送消息：是会话成员且未被禁言
+func (s *ChatService) checkCanPost(ctx context.Context, username, sessionID string) error {
+	member, err := s.sessionRepo.GetUserSession(ctx, username, sessionID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return status.Errorf(codes.PermissionDenied, "not a session member")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+	if member.MutedAt(time.Now()) {
+		return status.Errorf(codes.PermissionDenied, errMutedInSession)
+	}
+	return nil
+}
+
+// forwardTo 将一条转发消息投递到目标会话，body 须为可改写的副本
+// 所引用的附件复制到目标会话，并与发送一致经过内容审核；失败时返回写入 ForwardResult.error 的错误信息
+func (s *ChatService) forwardTo(ctx context.Context, target, operator, msgType, content string, body *gatewayv1.MessageBody, maxExpiresAt *time.Time) (int64, string) {
+	original := content
+	action, reasons, err := s.moderateMessage(ctx, target, operator, &content, body)
+	if err != nil {
+		s.logger.Error("failed to moderate message",
+			clog.String("session_id", target),
+			clog.Error(err))
+		return 0, "failed to moderate message"
+	}
+	if action == moderation.ActionReject {
+		return 0, "message rejected by content moderation"
+	}
+
+	// 审核通过后再复制附件，被拒绝的转发不会留下未被消息引用的附件副本
+	if err := s.rescopeAttachments(ctx, body, target, operator); err != nil {
+		s.logger.Warn("failed to copy forwarded attachment",
+			clog.String("session_id", target),
+			clog.Error(err))
+		return 0, "attachment not available"
+	}
+
+	msgID, err := s.postMessage(ctx, target, operator, msgType, content, body, maxExpiresAt)
+	if err != nil {
+		return 0, "failed to forward message"
+	}
+	if action == moderation.ActionFlag {
+		s.enqueueReview(msgID, target, operator, original, reasons)
+	}
+	return msgID, ""
+}
+
+// rescopeAttachments 为消息体引用的附件（合并转发时含每条原消息快照）在目标会话下复制附件记录，
+// 并改写为新的附件ID。附件按所属会话的成员关系鉴权，复制后目标会话成员即可下载；附件内容按存储路径共享，不重复存储。
+// 原地址指向原附件、目标会话成员无权访问，一并清空，由客户端按附件ID下载
+func (s *ChatService) rescopeAttachments(ctx context.Context, body *gatewayv1.MessageBody, sessionID, operator string) error {
+	return forEachAttachment(body, func(attachmentID *int64, url *string) error {
+		if s.attachmentRepo == nil {
+			return errors.New("attachment not supported")
+		}
+		src, err := s.attachmentRepo.GetAttachment(ctx, *attachmentID)
+		if err != nil {
+			return err
+		}
+		if src.Status != model.AttachmentStatusUploaded {
+			return fmt.Errorf("attachment not uploaded: %d", src.AttachmentID)
+		}
+
+		copied := &model.Attachment{
+			AttachmentID:     s.idGen.Next(),
+			SessionID:        sessionID,
+			UploaderUsername: operator,
+			FileName:         src.FileName,
+			MimeType:         src.MimeType,
+			Size:             src.Size,
+			Checksum:         src.Checksum,
+			StorageKey:       src.StorageKey,
+			Status:           model.AttachmentStatusUploaded,
+			UploadedAt:       src.UploadedAt,
+		}
+		if err := s.attachmentRepo.CreateAttachment(ctx, copied); err != nil {
+			return err
+		}
+		*attachmentID, *url = copied.AttachmentID, ""
+		return nil
+	})
+}
+
+// forEachAttachment 遍历消息体引用的附件（合并转发时递归遍历原消息快照），fn 可改写附件ID与地址
+func forEachAttachment(body *gatewayv1.MessageBody, fn func(attachmentID *int64, url *string) error) error {
+	switch b := body.GetBody().(type) {
+	case *gatewayv1.MessageBody_Image:
+		if b.Image.AttachmentId != 0 {
+			return fn(&b.Image.AttachmentId, &b.Image.Url)
+		}
+	case *gatewayv1.MessageBody_File:
+		if b.File.AttachmentId != 0 {
+			return fn(&b.File.AttachmentId, &b.File.Url)
+		}
+	case *gatewayv1.MessageBody_Audio:
+		if b.Audio.AttachmentId != 0 {
+			return fn(&b.Audio.AttachmentId, &b.Audio.Url)
+		}
+	case *gatewayv1.MessageBody_Forward:
+		for _, m := range b.Forward.GetMessages() {
+			if err := forEachAttachment(m.Body, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// postMessage 在会话主时间线上生成一条新消息并经 Outbox 投递，返回消息 ID
+// 仅用于服务端构造的消息（如转发），不处理引用、话题与提及；过期时间取会话保留时长与 maxExpiresAt 中较早者
+func (s *ChatService) postMessage(ctx context.Context, sessionID, from, msgType, content string, body *gatewayv1.MessageBody, maxExpiresAt *time.Time) (int64, error) {
+	bodyData, err := marshalMessageBody(body)
+	if err != nil {
+		s.logger.Error("failed to marshal message body", clog.Error(err))
+		return 0, err
+	}
+
+	msgID := s.idGen.Next()
+	// 与 SendMessage 一致：Redis 计数器缺失时以会话 MaxSeqID 初始化，避免 seq_id 冲突；会话读取失败时不发送
+	session, err := s.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil {
+		s.logger.Error("failed to get session", clog.Error(err), clog.String("session_id", sessionID))
+		return 0, err
+	}
+	if session != nil && session.MaxSeqID > 0 {
+		s.sequencer.SetIfNotExists(ctx, sessionID, session.MaxSeqID)
+	}
+	expiresAt := earlierExpiry(messageExpiresAt(session, time.Now()), maxExpiresAt)
+	seqID, err := s.sequencer.Next(ctx, sessionID)
+	if err != nil {
+		s.logger.Error("failed to generate seq id", clog.Error(err), clog.String("session_id", sessionID))
+		return 0, err
+	}
+
+	msgContent := &model.MessageContent{
+		MsgID:          msgID,
+		SessionID:      sessionID,
+		SenderUsername: from,
+		SeqID:          seqID,
+		Content:        content,
+		MsgType:        msgType,
+		Body:           bodyData,
+		ExpiresAt:      expiresAt,
+	}
+	event := &mqv1.PushEvent{
+		MsgId:        msgID,
+		SeqId:        seqID,
+		SessionId:    sessionID,
+		FromUsername: from,
+		Content:      content,
+		Type:         msgType,
+		Timestamp:    time.Now().Unix(),
+		Body:         body,
+		ExpiresAt:    expiresAtUnix(expiresAt),
+	}
+
+	result, err := PublishMessageToMQ(ctx, s.messageRepo, event, msgContent, s.logger)
+	if err != nil {
+		s.logger.Error("failed to publish message to mq", clog.Error(err))
+		return 0, err
+	}
+	PublishMessageToMQAsync(s.mqClient, result.OutboxID, result.Topic, result.EventData, s.logger)
+
+	s.logger.Info("message posted",
+		clog.Int64("msg_id", msgID),
+		clog.Int64("seq_id", seqID),
+		clog.String("session_id", sessionID))
+	return msgID, nil
+}
+
+// buildForwardBody 构造合并转发的聊天记录消息体，msgs 需已按发送顺序排序
+func buildForwardBody(sourceSessionID string, msgs []*model.MessageContent) *gatewayv1.MessageBody {
+	forward := &gatewayv1.ForwardBody{
+		SourceSessionId: sourceSessionID,
+		Messages:        make([]*gatewayv1.ForwardedMessage, 0, len(msgs)),
+	}
+	var senders []string
+	seen := make(map[string]struct{})
+	for _, msg := range msgs {
+		forward.Messages = append(forward.Messages, &gatewayv1.ForwardedMessage{
+			MsgId:        msg.MsgID,
+			FromUsername: msg.SenderUsername,
+			Content:      msg.Content,
+			Type:         msg.MsgType,
+			Timestamp:    msg.CreatedAt.Unix(),
+			Body:         unmarshalMessageBody(msg.Body),
+		})
+		if _, ok := seen[msg.SenderUsername]; !ok {
+			seen[msg.SenderUsername] = struct{}{}
+			senders = append(senders, msg.SenderUsername)
+		}
+	}
+
+	// 标题：一至两位发送者时列出用户名，否则视为群聊记录
+	if len(senders) <= 2 {
+		forward.Title = strings.Join(senders, " 和 ") + " 的聊天记录"
+	} else {
+		forward.Title = "群聊的聊天记录"
+	}
+	return &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Forward{Forward: forward}}
+}
+
+// forwardSummary 合并转发消息的纯文本摘要
+func forwardSummary(forward *gatewayv1.ForwardBody) string {
+	return "[聊天记录] " + forward.GetTitle()
+}
+
+// uniqueInt64s 去重并保持原有顺序，忽略 0
+func uniqueInt64s(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id == 0 {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
+
+// uniqueStrings 去重并保持原有顺序，忽略空串
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
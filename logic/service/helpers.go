@@ -8,6 +8,7 @@ import (
 	"github.com/ceyewan/genesis/clog"
 	"github.com/ceyewan/genesis/mq"
 	commonv1 "github.com/ceyewan/resonance/api/gen/go/common/v1"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
 	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
 	"github.com/ceyewan/resonance/model"
 	"github.com/ceyewan/resonance/repo"
@@ -42,6 +43,173 @@ func PublishMessageToMQ(
 	msgContent *model.MessageContent,
 	logger clog.Logger,
 ) (*PublishMessageToMQResult, error) {
+	outbox, result, err := buildOutbox(ctx, event, msgContent.MsgID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 使用事务保存消息、更新序列号、绑定附件、记录 Outbox
+	if err := messageRepo.SaveMessageWithOutbox(ctx, msgContent, bodyAttachmentIDs(event.Body), outbox); err != nil {
+		return nil, fmt.Errorf("save message with outbox: %w", err)
+	}
+
+	result.OutboxID = outbox.ID
+	return result, nil
+}
+
+// PublishRecallToMQ 标记消息撤回并通过 Outbox 发布撤回事件
+// 与 PublishMessageToMQ 共用同一条 Outbox 链路，保证撤回标记与事件投递的原子性
+func PublishRecallToMQ(
+	ctx context.Context,
+	messageRepo repo.MessageRepo,
+	event *mqv1.PushEvent,
+	recalledAt time.Time,
+	logger clog.Logger,
+) (*PublishMessageToMQResult, error) {
+	outbox, result, err := buildOutbox(ctx, event, event.MsgId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := messageRepo.RecallMessageWithOutbox(ctx, event.MsgId, event.OperatorUsername, recalledAt, outbox); err != nil {
+		return nil, fmt.Errorf("recall message with outbox: %w", err)
+	}
+
+	result.OutboxID = outbox.ID
+	return result, nil
+}
+
+// PublishEditToMQ 更新消息内容、记录编辑历史并通过 Outbox 发布编辑事件
+func PublishEditToMQ(
+	ctx context.Context,
+	messageRepo repo.MessageRepo,
+	event *mqv1.PushEvent,
+	revision *model.MessageRevision,
+	editedAt time.Time,
+	logger clog.Logger,
+) (*PublishMessageToMQResult, error) {
+	outbox, result, err := buildOutbox(ctx, event, event.MsgId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := messageRepo.EditMessageWithOutbox(ctx, revision, event.Content, editedAt, outbox); err != nil {
+		return nil, fmt.Errorf("edit message with outbox: %w", err)
+	}
+
+	result.OutboxID = outbox.ID
+	return result, nil
+}
+
+// PublishReactionToMQ 变更表情回应并通过 Outbox 发布回应事件
+// 返回的 changed 为 false 表示回应状态未变化（重复添加或取消不存在的回应），此时不产生事件
+func PublishReactionToMQ(
+	ctx context.Context,
+	messageRepo repo.MessageRepo,
+	event *mqv1.PushEvent,
+	logger clog.Logger,
+) (*PublishMessageToMQResult, bool, error) {
+	outbox, result, err := buildOutbox(ctx, event, event.MsgId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	reaction := &model.MessageReaction{
+		MsgID:    event.MsgId,
+		Username: event.OperatorUsername,
+		Emoji:    event.ReactionEmoji,
+	}
+	var changed bool
+	if event.ReactionRemoved {
+		changed, err = messageRepo.RemoveReactionWithOutbox(ctx, reaction, outbox)
+	} else {
+		changed, err = messageRepo.AddReactionWithOutbox(ctx, reaction, outbox)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("change reaction with outbox: %w", err)
+	}
+
+	result.OutboxID = outbox.ID
+	return result, changed, nil
+}
+
+// PublishHideToMQ 记录用户删除（仅自己）的消息并写入 Outbox（同一事务）
+// 事件类型需为 EVENT_TYPE_HIDE，由 Task 仅推送给操作者本人以同步其他设备
+func PublishHideToMQ(
+	ctx context.Context,
+	messageRepo repo.MessageRepo,
+	event *mqv1.PushEvent,
+	logger clog.Logger,
+) (*PublishMessageToMQResult, error) {
+	outbox, result, err := buildOutbox(ctx, event, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := messageRepo.HideMessagesWithOutbox(ctx, event.OperatorUsername, event.SessionId, event.HiddenMsgIds, event.ClearedSeq, outbox); err != nil {
+		return nil, fmt.Errorf("hide messages with outbox: %w", err)
+	}
+
+	result.OutboxID = outbox.ID
+	return result, nil
+}
+
+// PublishAnnouncementToMQ 保存系统公告并写入公告事件 Outbox（同一事务）
+// 公告不属于任何消息，Outbox 的 msg_id 记为 0
+func PublishAnnouncementToMQ(
+	ctx context.Context,
+	announcementRepo repo.AnnouncementRepo,
+	event *mqv1.PushEvent,
+	announcement *model.Announcement,
+	logger clog.Logger,
+) (*PublishMessageToMQResult, error) {
+	outbox, result, err := buildOutbox(ctx, event, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := announcementRepo.CreateAnnouncementWithOutbox(ctx, announcement, outbox); err != nil {
+		return nil, fmt.Errorf("create announcement with outbox: %w", err)
+	}
+
+	result.OutboxID = outbox.ID
+	return result, nil
+}
+
+// PublishExpireToMQ 删除已过期的消息并写入过期事件 Outbox（同一事务）
+// events 与 msgIDs 一一对应，事件类型需为 EVENT_TYPE_EXPIRE
+// 同时返回已不再被引用的附件存储位置，由调用方删除存储内容
+func PublishExpireToMQ(
+	ctx context.Context,
+	messageRepo repo.MessageRepo,
+	events []*mqv1.PushEvent,
+	msgIDs []int64,
+	logger clog.Logger,
+) ([]*PublishMessageToMQResult, []string, error) {
+	outboxes := make([]*model.MessageOutbox, 0, len(events))
+	results := make([]*PublishMessageToMQResult, 0, len(events))
+	for _, event := range events {
+		outbox, result, err := buildOutbox(ctx, event, event.MsgId)
+		if err != nil {
+			return nil, nil, err
+		}
+		outboxes = append(outboxes, outbox)
+		results = append(results, result)
+	}
+
+	storageKeys, err := messageRepo.DeleteExpiredMessagesWithOutbox(ctx, msgIDs, outboxes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("delete expired messages with outbox: %w", err)
+	}
+
+	for i, outbox := range outboxes {
+		results[i].OutboxID = outbox.ID
+	}
+	return results, storageKeys, nil
+}
+
+// buildOutbox 注入 Trace Context、序列化事件并构造 Outbox 记录
+func buildOutbox(ctx context.Context, event *mqv1.PushEvent, msgID int64) (*model.MessageOutbox, *PublishMessageToMQResult, error) {
 	// 1. 注入 Trace Context 到 MQ 事件，用于链路追踪
 	event.TraceHeaders = make(map[string]string)
 	observability.InjectTraceContext(ctx, event.TraceHeaders)
@@ -49,7 +217,7 @@ func PublishMessageToMQ(
 	// 2. Marshal 事件
 	eventData, err := proto.Marshal(event)
 	if err != nil {
-		return nil, fmt.Errorf("marshal push event: %w", err)
+		return nil, nil, fmt.Errorf("marshal push event: %w", err)
 	}
 
 	// 3. 获取 Topic (从 protobuf 扩展字段)
@@ -57,25 +225,211 @@ func PublishMessageToMQ(
 
 	// 4. 创建 Outbox 记录
 	outbox := &model.MessageOutbox{
-		MsgID:         msgContent.MsgID,
+		MsgID:         msgID,
 		Topic:         topic,
 		Payload:       eventData,
 		Status:        model.OutboxStatusPending,
 		NextRetryTime: time.Now(),
 	}
 
-	// 5. 使用事务保存消息、更新序列号、记录 Outbox
-	if err := messageRepo.SaveMessageWithOutbox(ctx, msgContent, outbox); err != nil {
-		return nil, fmt.Errorf("save message with outbox: %w", err)
-	}
-
-	return &PublishMessageToMQResult{
-		OutboxID:  outbox.ID,
+	return outbox, &PublishMessageToMQResult{
 		Topic:     topic,
 		EventData: eventData,
 	}, nil
 }
 
+// applyRecallTombstone 将已撤回消息的内容替换为墓碑，不向客户端暴露原始内容
+func applyRecallTombstone(pushMsg *gatewayv1.PushMessage, recalledBy string) {
+	pushMsg.Content = model.RecalledMessageContent
+	pushMsg.Body = nil
+	pushMsg.Recalled = true
+	pushMsg.RecalledBy = recalledBy
+}
+
+// applyEditInfo 填充消息的编辑版本号与最后编辑时间
+func applyEditInfo(pushMsg *gatewayv1.PushMessage, version int32, editedAt *time.Time) {
+	pushMsg.Version = version
+	if editedAt != nil {
+		pushMsg.EditedAt = editedAt.Unix()
+	}
+}
+
+// threadSeqKey 返回话题独立序号空间在 Sequencer 中的 key
+func threadSeqKey(rootMsgID int64) string {
+	return fmt.Sprintf("thread:%d", rootMsgID)
+}
+
+// toPushMessage 将存储层消息转换为 PushMessage，并填充编辑、撤回、回复与话题信息
+func toPushMessage(msg *model.MessageContent) *gatewayv1.PushMessage {
+	pushMsg := &gatewayv1.PushMessage{
+		MsgId:            msg.MsgID,
+		SeqId:            msg.SeqID,
+		SessionId:        msg.SessionID,
+		FromUsername:     msg.SenderUsername,
+		Content:          msg.Content,
+		Type:             msg.MsgType,
+		Timestamp:        msg.CreatedAt.Unix(),
+		ReplyToMsgId:     msg.ReplyToMsgID,
+		ThreadRootId:     msg.ThreadRootID,
+		ThreadReplyCount: msg.ThreadReplyCount,
+		MentionAll:       msg.MentionAll,
+		Body:             unmarshalMessageBody(msg.Body),
+		ExpiresAt:        expiresAtUnix(msg.ExpiresAt),
+		FromBot:          msg.FromBot,
+	}
+	if msg.ThreadLastReplyAt != nil {
+		pushMsg.ThreadLastReplyAt = msg.ThreadLastReplyAt.Unix()
+	}
+	applyEditInfo(pushMsg, msg.EditVersion, msg.EditedAt)
+	if msg.Recalled {
+		applyRecallTombstone(pushMsg, msg.RecalledBy)
+	}
+	return pushMsg
+}
+
+// groupReactions 将回应聚合按消息 ID 分组并转换为 Reaction
+func groupReactions(summaries []*repo.ReactionSummary) map[int64][]*gatewayv1.Reaction {
+	grouped := make(map[int64][]*gatewayv1.Reaction)
+	for _, s := range summaries {
+		grouped[s.MsgID] = append(grouped[s.MsgID], &gatewayv1.Reaction{
+			Emoji:   s.Emoji,
+			Count:   s.Count,
+			Reacted: s.Reacted,
+		})
+	}
+	return grouped
+}
+
+// attachReactions 为消息批量附带表情回应聚合，并标记 username 是否回应过
+// 查询失败时仅记录日志，不影响消息本身的返回
+func attachReactions(ctx context.Context, messageRepo repo.MessageRepo, pushMsgs []*gatewayv1.PushMessage, username string, logger clog.Logger) {
+	if len(pushMsgs) == 0 {
+		return
+	}
+
+	ids := make([]int64, 0, len(pushMsgs))
+	for _, m := range pushMsgs {
+		if !m.Recalled {
+			ids = append(ids, m.MsgId)
+		}
+	}
+	summaries, err := messageRepo.GetReactionSummaries(ctx, ids, username)
+	if err != nil {
+		logger.Warn("failed to load reactions", clog.Error(err))
+		return
+	}
+
+	grouped := groupReactions(summaries)
+	for _, m := range pushMsgs {
+		m.Reactions = grouped[m.MsgId]
+	}
+}
+
+// attachMentions 为消息批量附带 @提及的用户名列表（@所有人的消息无需列出）
+// 查询失败时仅记录日志，不影响消息本身的返回
+func attachMentions(ctx context.Context, messageRepo repo.MessageRepo, pushMsgs []*gatewayv1.PushMessage, logger clog.Logger) {
+	ids := make([]int64, 0, len(pushMsgs))
+	for _, m := range pushMsgs {
+		if !m.MentionAll && m.ThreadRootId == 0 {
+			ids = append(ids, m.MsgId)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	mentions, err := messageRepo.GetMentionsByMsgIDs(ctx, ids)
+	if err != nil {
+		logger.Warn("failed to load mentions", clog.Error(err))
+		return
+	}
+
+	grouped := make(map[int64][]string)
+	for _, mention := range mentions {
+		grouped[mention.MsgID] = append(grouped[mention.MsgID], mention.Username)
+	}
+	for _, m := range pushMsgs {
+		if !m.MentionAll {
+			m.Mentions = grouped[m.MsgId]
+		}
+	}
+}
+
+// quotedExcerptMaxRunes 引用快照中内容摘要的最大字符数
+const quotedExcerptMaxRunes = 100
+
+// attachQuotedMessages 为回复消息批量附带被引用消息的快照
+// viewer 已删除（仅自己）的被引用消息不附带快照，与其历史记录保持一致
+// 查询失败时仅记录日志，不影响消息本身的返回
+func attachQuotedMessages(ctx context.Context, messageRepo repo.MessageRepo, pushMsgs []*gatewayv1.PushMessage, viewer string, logger clog.Logger) {
+	ids := make([]int64, 0)
+	seen := make(map[int64]bool)
+	for _, m := range pushMsgs {
+		if m.ReplyToMsgId != 0 && !seen[m.ReplyToMsgId] {
+			seen[m.ReplyToMsgId] = true
+			ids = append(ids, m.ReplyToMsgId)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	quotedMsgs, err := messageRepo.GetMessagesByIDs(ctx, ids, viewer)
+	if err != nil {
+		logger.Warn("failed to load quoted messages", clog.Error(err))
+		return
+	}
+	quotedMap := make(map[int64]*model.MessageContent, len(quotedMsgs))
+	for _, q := range quotedMsgs {
+		quotedMap[q.MsgID] = q
+	}
+
+	for _, m := range pushMsgs {
+		q, ok := quotedMap[m.ReplyToMsgId]
+		// 仅附带同会话的引用，防止越权读取其他会话内容
+		if !ok || q.SessionID != m.SessionId {
+			continue
+		}
+		quoted := &gatewayv1.QuotedMessage{
+			MsgId:        q.MsgID,
+			FromUsername: q.SenderUsername,
+			Content:      truncateRunes(q.Content, quotedExcerptMaxRunes),
+			Type:         q.MsgType,
+		}
+		if q.Recalled {
+			quoted.Content = model.RecalledMessageContent
+			quoted.Recalled = true
+		}
+		m.ReplyTo = quoted
+	}
+}
+
+// truncateRunes 按字符数截断字符串，超长时追加省略号
+func truncateRunes(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max]) + "…"
+}
+
+// PublishEventToMQAsync 异步发布无需持久化的瞬时事件（已读、输入状态等）
+// 不经过 Outbox，发布失败即丢弃，适用于可由查询接口兜底的事件
+func PublishEventToMQAsync(
+	ctx context.Context,
+	mqClient mq.MQ,
+	event *mqv1.PushEvent,
+	logger clog.Logger,
+) error {
+	_, result, err := buildOutbox(ctx, event, event.MsgId)
+	if err != nil {
+		return err
+	}
+
+	PublishMessageToMQAsync(mqClient, 0, result.Topic, result.EventData, logger)
+	return nil
+}
+
 // PublishMessageToMQAsync 异步发布消息到 MQ (Look-aside 优化)
 // 这个函数在后台尝试立即发布消息，不阻塞主流程
 //
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxHideMessages 单次仅自己删除的最大消息数
+const maxHideMessages = 100
+
+// DeleteMessagesForMe 实现 SessionService.DeleteMessagesForMe
+// 逐条删除写入 t_message_hidden，整段清空推进成员的 cleared_seq；两者仅影响操作者自己的视图
+// 删除结果经 Outbox 以 EVENT_TYPE_HIDE 推送给操作者本人，同步其他设备的本地缓存
+func (s *SessionService) DeleteMessagesForMe(ctx context.Context, req *logicv1.DeleteMessagesForMeRequest) (*logicv1.DeleteMessagesForMeResponse, error) {
+	s.logger.Info("delete messages for me",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.Int("msg_count", len(req.MsgIds)),
+		clog.Int64("clear_up_to_seq", req.ClearUpToSeq))
+
+	if req.Username == "" || req.SessionId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username and session_id are required")
+	}
+	msgIDs := uniqueInt64s(req.MsgIds)
+	if len(msgIDs) == 0 && req.ClearUpToSeq <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "msg_ids or clear_up_to_seq is required")
+	}
+	if len(msgIDs) > maxHideMessages {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot delete more than %d messages", maxHideMessages)
+	}
+
+	member, err := s.sessionRepo.GetUserSession(ctx, req.Username, req.SessionId)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.PermissionDenied, "not a session member")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+	var prevClearedSeq int64
+	if member != nil {
+		prevClearedSeq = member.ClearedSeq
+	}
+
+	if len(msgIDs) > 0 {
+		msgs, err := s.messageRepo.GetMessagesByIDs(ctx, msgIDs, "")
+		if err != nil {
+			s.logger.Error("failed to get messages", clog.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to get messages")
+		}
+		if len(msgs) != len(msgIDs) {
+			return nil, status.Errorf(codes.NotFound, "message not found in session")
+		}
+		for _, msg := range msgs {
+			if msg.SessionID != req.SessionId {
+				return nil, status.Errorf(codes.NotFound, "message not found in session")
+			}
+		}
+	}
+
+	// 清空位点不超过会话当前最新消息，避免隐藏之后才发送的消息
+	clearedSeq := req.ClearUpToSeq
+	if clearedSeq > 0 {
+		last, err := s.messageRepo.GetLastMessage(ctx, req.SessionId)
+		if err != nil && !errors.Is(err, repo.ErrNotFound) {
+			s.logger.Error("failed to get last message", clog.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to clear history")
+		}
+		if last == nil {
+			clearedSeq = 0
+		} else if clearedSeq > last.SeqID {
+			clearedSeq = last.SeqID
+		}
+		// 位点未推进时无需重复清空
+		if clearedSeq <= prevClearedSeq {
+			clearedSeq = 0
+		}
+	}
+	if len(msgIDs) == 0 && clearedSeq == 0 {
+		return &logicv1.DeleteMessagesForMeResponse{ClearedSeq: prevClearedSeq}, nil
+	}
+
+	event := &mqv1.PushEvent{
+		SessionId:        req.SessionId,
+		Timestamp:        time.Now().Unix(),
+		EventType:        mqv1.EventType_EVENT_TYPE_HIDE,
+		OperatorUsername: req.Username,
+		HiddenMsgIds:     msgIDs,
+		ClearedSeq:       clearedSeq,
+	}
+	result, err := PublishHideToMQ(ctx, s.messageRepo, event, s.logger)
+	if err != nil {
+		s.logger.Error("failed to hide messages", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete messages")
+	}
+	PublishMessageToMQAsync(s.mqClient, result.OutboxID, result.Topic, result.EventData, s.logger)
+
+	return &logicv1.DeleteMessagesForMeResponse{
+		ClearedSeq: max(prevClearedSeq, clearedSeq),
+	}, nil
+}
@@ -23,12 +23,20 @@ type SessionServiceInterface interface {
 	GetContactList(ctx context.Context, req *logicv1.GetContactListRequest) (*logicv1.GetContactListResponse, error)
 	SearchUser(ctx context.Context, req *logicv1.SearchUserRequest) (*logicv1.SearchUserResponse, error)
 	PullInboxDelta(ctx context.Context, req *logicv1.PullInboxDeltaRequest) (*logicv1.PullInboxDeltaResponse, error)
+	GetThreadMessages(ctx context.Context, req *logicv1.GetThreadMessagesRequest) (*logicv1.GetThreadMessagesResponse, error)
+	FollowThread(ctx context.Context, req *logicv1.FollowThreadRequest) (*logicv1.FollowThreadResponse, error)
+	UnfollowThread(ctx context.Context, req *logicv1.UnfollowThreadRequest) (*logicv1.UnfollowThreadResponse, error)
+	GetMessageReadStatus(ctx context.Context, req *logicv1.GetMessageReadStatusRequest) (*logicv1.GetMessageReadStatusResponse, error)
 }
 
 // ChatServiceInterface 聊天服务接口
 // 用于提高可测试性和可维护性，允许 mock 实现
 type ChatServiceInterface interface {
 	SendMessage(ctx context.Context, req *logicv1.SendMessageRequest) (*logicv1.SendMessageResponse, error)
+	RecallMessage(ctx context.Context, req *logicv1.RecallMessageRequest) (*logicv1.RecallMessageResponse, error)
+	EditMessage(ctx context.Context, req *logicv1.EditMessageRequest) (*logicv1.EditMessageResponse, error)
+	AddReaction(ctx context.Context, req *logicv1.AddReactionRequest) (*logicv1.AddReactionResponse, error)
+	RemoveReaction(ctx context.Context, req *logicv1.RemoveReactionRequest) (*logicv1.RemoveReactionResponse, error)
 }
 
 // PresenceServiceInterface 在线状态服务接口
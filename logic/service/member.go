@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAddMembers 单次邀请的最大人数
+const maxAddMembers = 100
+
+// AddMembers 实现 SessionService.AddMembers
+// 权限见权限矩阵（群聊管理员及群主）；被邀请者须为已注册用户，已是成员的忽略。成员关系与成员变更事件经 Outbox 原子写入，
+// Task 按实时成员列表扇出，新成员从下一条消息（即本次的系统消息）起开始接收
+func (s *SessionService) AddMembers(ctx context.Context, req *logicv1.AddMembersRequest) (*logicv1.AddMembersResponse, error) {
+	s.logger.Info("add members",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.Int("member_count", len(req.Members)))
+
+	if len(req.Members) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "members are required")
+	}
+	if len(req.Members) > maxAddMembers {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot add more than %d members at once", maxAddMembers)
+	}
+
+	session, _, err := s.checkPermission(ctx, req.Username, req.SessionId, permInvite)
+	if err != nil {
+		return nil, err
+	}
+
+	invitees := make([]string, 0, len(req.Members))
+	seen := make(map[string]struct{}, len(req.Members))
+	for _, username := range req.Members {
+		if username == "" || username == req.Username {
+			continue
+		}
+		if _, ok := seen[username]; ok {
+			continue
+		}
+		seen[username] = struct{}{}
+		invitees = append(invitees, username)
+	}
+	if len(invitees) == 0 {
+		return &logicv1.AddMembersResponse{}, nil
+	}
+
+	users, err := s.userRepo.GetUsersByUsernames(ctx, invitees)
+	if err != nil {
+		s.logger.Error("failed to get users", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to add members")
+	}
+	nicknames := make(map[string]string, len(users))
+	for _, u := range users {
+		nicknames[u.Username] = u.Nickname
+	}
+	for _, username := range invitees {
+		if _, ok := nicknames[username]; !ok {
+			return nil, status.Errorf(codes.NotFound, "user not found: %s", username)
+		}
+	}
+
+	// 预先排除已是成员的用户，成员变更事件只携带新成员；并发邀请时以仓储的实际写入结果为准
+	existing, err := s.sessionRepo.GetMembers(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get session members", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to add members")
+	}
+	memberSet := make(map[string]struct{}, len(existing))
+	for _, m := range existing {
+		memberSet[m.Username] = struct{}{}
+	}
+	members := make([]*model.SessionMember, 0, len(invitees))
+	newcomers := make([]string, 0, len(invitees))
+	for _, username := range invitees {
+		if _, ok := memberSet[username]; ok {
+			continue
+		}
+		members = append(members, &model.SessionMember{SessionID: req.SessionId, Username: username, Role: model.MemberRoleMember})
+		newcomers = append(newcomers, username)
+	}
+	if len(members) == 0 {
+		return &logicv1.AddMembersResponse{}, nil
+	}
+
+	names := make([]string, 0, len(newcomers))
+	for _, username := range newcomers {
+		names = append(names, displayName(username, nicknames[username]))
+	}
+	text := fmt.Sprintf("%s 邀请 %s 加入了群聊", s.nickname(ctx, req.Username), strings.Join(names, "、"))
+
+	event := memberEvent(session, req.Username, mqv1.MemberChange_MEMBER_CHANGE_JOINED, newcomers, text)
+	outbox, result, err := buildOutbox(ctx, event, 0)
+	if err != nil {
+		s.logger.Error("failed to build member event", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to add members")
+	}
+	added, err := s.sessionRepo.AddMembersWithOutbox(ctx, members, outbox)
+	if err != nil {
+		s.logger.Error("failed to add members", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to add members")
+	}
+	if len(added) == 0 {
+		return &logicv1.AddMembersResponse{}, nil
+	}
+	result.OutboxID = outbox.ID
+	PublishMessageToMQAsync(s.mqClient, result.OutboxID, result.Topic, result.EventData, s.logger)
+
+	system := &gatewayv1.SystemBody{Code: "member_invited", Text: text}
+	if err := s.sendSystemMessage(ctx, req.SessionId, system, session.Name, int32(session.Type)); err != nil {
+		s.logger.Error("failed to send member invited system message", clog.Error(err))
+	}
+
+	return &logicv1.AddMembersResponse{Added: added}, nil
+}
+
+// RemoveMember 实现 SessionService.RemoveMember
+// 权限见权限矩阵（群聊管理员及群主），且只能移除角色低于自己的成员：管理员可移除普通成员，群主可移除管理员。
+// 删除成员关系后被移除者不再进入推送与信箱扇出，历史消息、话题与搜索等接口的成员校验随之拒绝；已重复移除时视为成功（幂等）
+func (s *SessionService) RemoveMember(ctx context.Context, req *logicv1.RemoveMemberRequest) (*logicv1.RemoveMemberResponse, error) {
+	s.logger.Info("remove member",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.String("member", req.Member))
+
+	if req.Member == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "member is required")
+	}
+	if req.Member == req.Username {
+		return nil, status.Errorf(codes.InvalidArgument, "use LeaveSession to leave the group")
+	}
+
+	session, operator, err := s.checkPermission(ctx, req.Username, req.SessionId, permKick)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.sessionRepo.GetUserSession(ctx, req.Member, req.SessionId)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return &logicv1.RemoveMemberResponse{}, nil
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to remove member")
+	}
+	if !outranks(session, operator, target) {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot remove a member with equal or higher role")
+	}
+
+	text := fmt.Sprintf("%s 将 %s 移出了群聊", s.nickname(ctx, req.Username), s.nickname(ctx, req.Member))
+	event := memberEvent(session, req.Username, mqv1.MemberChange_MEMBER_CHANGE_REMOVED, []string{req.Member}, text)
+	if err := s.removeMember(ctx, session, req.Member, event); err != nil {
+		return nil, err
+	}
+	return &logicv1.RemoveMemberResponse{}, nil
+}
+
+// LeaveSession 实现 SessionService.LeaveSession
+// 群主须先转让群主后才能退出；退出后的可见性与被移除一致
+func (s *SessionService) LeaveSession(ctx context.Context, req *logicv1.LeaveSessionRequest) (*logicv1.LeaveSessionResponse, error) {
+	s.logger.Info("leave session",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId))
+
+	session, _, err := s.checkPermission(ctx, req.Username, req.SessionId, permLeave)
+	if err != nil {
+		return nil, err
+	}
+	if req.Username == session.OwnerUsername {
+		return nil, status.Errorf(codes.FailedPrecondition, "owner must transfer ownership before leaving the group")
+	}
+
+	text := fmt.Sprintf("%s 退出了群聊", s.nickname(ctx, req.Username))
+	event := memberEvent(session, req.Username, mqv1.MemberChange_MEMBER_CHANGE_LEFT, []string{req.Username}, text)
+	if err := s.removeMember(ctx, session, req.Username, event); err != nil {
+		return nil, err
+	}
+	return &logicv1.LeaveSessionResponse{}, nil
+}
+
+// removeMember 删除成员关系并经 Outbox 发布成员变更事件（Task 据此通知离开者本人），
+// 随后向剩余成员发送系统消息；成员关系已不存在时不做任何通知
+func (s *SessionService) removeMember(ctx context.Context, session *model.Session, username string, event *mqv1.PushEvent) error {
+	outbox, result, err := buildOutbox(ctx, event, 0)
+	if err != nil {
+		s.logger.Error("failed to build member event", clog.Error(err))
+		return status.Errorf(codes.Internal, "failed to remove member")
+	}
+	removed, err := s.sessionRepo.RemoveMemberWithOutbox(ctx, session.SessionID, username, outbox)
+	if err != nil {
+		s.logger.Error("failed to remove member", clog.Error(err))
+		return status.Errorf(codes.Internal, "failed to remove member")
+	}
+	if !removed {
+		return nil
+	}
+	result.OutboxID = outbox.ID
+	PublishMessageToMQAsync(s.mqClient, result.OutboxID, result.Topic, result.EventData, s.logger)
+
+	code := "member_removed"
+	if event.MemberChange == mqv1.MemberChange_MEMBER_CHANGE_LEFT {
+		code = "member_left"
+	}
+	system := &gatewayv1.SystemBody{Code: code, Text: event.Content}
+	if err := s.sendSystemMessage(ctx, session.SessionID, system, session.Name, int32(session.Type)); err != nil {
+		s.logger.Error("failed to send member system message", clog.Error(err))
+	}
+	return nil
+}
+
+// memberEvent 构造成员变更事件，content 为对应的系统消息文本，供 Task 通知离开者
+func memberEvent(session *model.Session, operator string, change mqv1.MemberChange, usernames []string, text string) *mqv1.PushEvent {
+	return &mqv1.PushEvent{
+		SessionId:        session.SessionID,
+		SessionName:      session.Name,
+		SessionType:      int32(session.Type),
+		Content:          text,
+		Type:             model.MessageTypeSystem,
+		Timestamp:        time.Now().Unix(),
+		EventType:        mqv1.EventType_EVENT_TYPE_MEMBER,
+		OperatorUsername: operator,
+		MemberChange:     change,
+		MemberUsernames:  usernames,
+	}
+}
+
+// displayName 昵称为空时退化为用户名
+func displayName(username, nickname string) string {
+	if nickname != "" {
+		return nickname
+	}
+	return username
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// memberTestUserRepo 仅包含 alice、bob、carol 三个用户，昵称与用户名相同
+type memberTestUserRepo struct {
+	testUserRepo
+}
+
+func (r *memberTestUserRepo) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
+	switch username {
+	case "alice", "bob", "carol":
+		return &model.User{Username: username, Nickname: username}, nil
+	}
+	return nil, fmt.Errorf("user %w: %s", repo.ErrNotFound, username)
+}
+
+func (r *memberTestUserRepo) GetUsersByUsernames(ctx context.Context, usernames []string) ([]*model.User, error) {
+	users := make([]*model.User, 0, len(usernames))
+	for _, username := range usernames {
+		if user, err := r.GetUserByUsername(ctx, username); err == nil {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+// newMemberTestRepo 构造群聊 g1：alice 为群主（角色记录为管理员的旧数据），bob、erin 为管理员，carol 为普通成员
+func newMemberTestRepo(sessionType int) *testSessionRepo {
+	roles := map[string]int{"alice": 1, "bob": 1, "carol": 0, "erin": 1}
+	return &testSessionRepo{
+		getUserSessionFn: func(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
+			role, ok := roles[username]
+			if !ok {
+				return nil, fmt.Errorf("user session %w: username=%s, session_id=%s", repo.ErrNotFound, username, sessionID)
+			}
+			return &model.SessionMember{SessionID: sessionID, Username: username, Role: role}, nil
+		},
+		getSessionFn: func(ctx context.Context, sessionID string) (*model.Session, error) {
+			return &model.Session{SessionID: sessionID, Type: sessionType, Name: "group", OwnerUsername: "alice"}, nil
+		},
+	}
+}
+
+func TestSessionService_MemberPermissions(t *testing.T) {
+	svc := NewSessionService(newMemberTestRepo(2), &testMessageRepo{}, &memberTestUserRepo{}, nil, nil, nil, nil, clog.Discard())
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+		code codes.Code
+	}{
+		{"member cannot invite", func() error {
+			_, err := svc.AddMembers(ctx, &logicv1.AddMembersRequest{Username: "carol", SessionId: "g1", Members: []string{"dave"}})
+			return err
+		}, codes.PermissionDenied},
+		{"non-member cannot invite", func() error {
+			_, err := svc.AddMembers(ctx, &logicv1.AddMembersRequest{Username: "mallory", SessionId: "g1", Members: []string{"dave"}})
+			return err
+		}, codes.PermissionDenied},
+		{"invitee must exist", func() error {
+			_, err := svc.AddMembers(ctx, &logicv1.AddMembersRequest{Username: "alice", SessionId: "g1", Members: []string{"ghost"}})
+			return err
+		}, codes.NotFound},
+		{"member cannot remove", func() error {
+			_, err := svc.RemoveMember(ctx, &logicv1.RemoveMemberRequest{Username: "carol", SessionId: "g1", Member: "bob"})
+			return err
+		}, codes.PermissionDenied},
+		{"admin cannot remove admin", func() error {
+			_, err := svc.RemoveMember(ctx, &logicv1.RemoveMemberRequest{Username: "bob", SessionId: "g1", Member: "erin"})
+			return err
+		}, codes.PermissionDenied},
+		{"owner cannot be removed", func() error {
+			_, err := svc.RemoveMember(ctx, &logicv1.RemoveMemberRequest{Username: "bob", SessionId: "g1", Member: "alice"})
+			return err
+		}, codes.PermissionDenied},
+		{"owner cannot leave", func() error {
+			_, err := svc.LeaveSession(ctx, &logicv1.LeaveSessionRequest{Username: "alice", SessionId: "g1"})
+			return err
+		}, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.code, status.Code(tt.call()))
+		})
+	}
+}
+
+func TestSessionService_LeaveSession_SingleChat(t *testing.T) {
+	svc := NewSessionService(newMemberTestRepo(1), &testMessageRepo{}, &memberTestUserRepo{}, nil, nil, nil, nil, clog.Discard())
+
+	_, err := svc.LeaveSession(context.Background(), &logicv1.LeaveSessionRequest{Username: "carol", SessionId: "s1"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestSessionService_RemoveMember_Event(t *testing.T) {
+	sessionRepo := newMemberTestRepo(2)
+	var event mqv1.PushEvent
+	sessionRepo.removeMemberFn = func(ctx context.Context, sessionID, username string, outbox *model.MessageOutbox) (bool, error) {
+		assert.Equal(t, "g1", sessionID)
+		assert.Equal(t, "carol", username)
+		require.NoError(t, proto.Unmarshal(outbox.Payload, &event))
+		// 返回未删除（并发移除），不触发 MQ 发布与系统消息
+		return false, nil
+	}
+	svc := NewSessionService(sessionRepo, &testMessageRepo{}, &memberTestUserRepo{}, nil, nil, nil, nil, clog.Discard())
+
+	_, err := svc.RemoveMember(context.Background(), &logicv1.RemoveMemberRequest{Username: "bob", SessionId: "g1", Member: "carol"})
+	require.NoError(t, err)
+	assert.Equal(t, mqv1.EventType_EVENT_TYPE_MEMBER, event.EventType)
+	assert.Equal(t, mqv1.MemberChange_MEMBER_CHANGE_REMOVED, event.MemberChange)
+	assert.Equal(t, []string{"carol"}, event.MemberUsernames)
+	assert.Equal(t, "bob", event.OperatorUsername)
+	assert.Equal(t, "bob 将 carol 移出了群聊", event.Content)
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	"github.com/ceyewan/resonance/logic/moderation"
+	"github.com/ceyewan/resonance/model"
+)
+
+// ContentModerator 内容审核器，由 moderation.Moderator 实现
+type ContentModerator interface {
+	// Moderate 审核消息内容
+	Moderate(ctx context.Context, in *moderation.Input) (*moderation.Result, error)
+	// EnqueueReview 将标记的消息写入待复核队列
+	EnqueueReview(ctx context.Context, review *model.ModerationReview) error
+}
+
+// SetModerator 启用内容审核
+func (s *ChatService) SetModerator(moderator ContentModerator) {
+	s.moderator = moderator
+}
+
+// moderateMessage 在落库前审核消息中所有用户可见的文本（见 moderatedFields）
+// 打码时原地改写 content 与消息体；返回各字段结论中最严格的动作与命中原因，未启用审核时返回 ActionAllow
+func (s *ChatService) moderateMessage(ctx context.Context, sessionID, username string, content *string, body *gatewayv1.MessageBody) (moderation.Action, []string, error) {
+	if s.moderator == nil {
+		return moderation.ActionAllow, nil, nil
+	}
+
+	action := moderation.ActionAllow
+	var reasons []string
+	for _, field := range moderatedFields(content, body) {
+		if strings.TrimSpace(field.text) == "" {
+			continue
+		}
+		result, err := s.moderator.Moderate(ctx, &moderation.Input{
+			SessionID: sessionID,
+			Username:  username,
+			Content:   field.text,
+		})
+		if err != nil {
+			return moderation.ActionAllow, nil, err
+		}
+		reasons = append(reasons, result.Reasons...)
+		if result.Action > action {
+			action = result.Action
+		}
+		if result.Action == moderation.ActionReject {
+			break
+		}
+		if result.Content != "" && result.Content != field.text {
+			field.apply(result.Content)
+		}
+	}
+
+	if action != moderation.ActionAllow {
+		s.logger.Info("message moderated",
+			clog.String("session_id", sessionID),
+			clog.String("from", username),
+			clog.String("action", action.String()),
+			clog.String("reasons", strings.Join(reasons, ";")))
+	}
+	return action, reasons, nil
+}
+
+// moderatedField 一段待审核的用户可见文本，apply 将打码后的文本写回消息
+type moderatedField struct {
+	text  string
+	apply func(masked string)
+}
+
+// moderatedFields 收集消息中所有用户可见的文本：文本内容、文件名、位置名称与地址、名片昵称、投票问题与选项，
+// 合并转发时递归收集每条原消息快照；无消息体时审核 content。写回时同步更新对应的纯文本摘要
+func moderatedFields(content *string, body *gatewayv1.MessageBody) []moderatedField {
+	switch b := body.GetBody().(type) {
+	case nil:
+		return []moderatedField{{text: *content, apply: func(masked string) { *content = masked }}}
+
+	case *gatewayv1.MessageBody_Text:
+		return []moderatedField{{text: b.Text.GetText(), apply: func(masked string) {
+			applyModeratedText(b.Text, masked)
+			*content = masked
+		}}}
+
+	case *gatewayv1.MessageBody_File:
+		return []moderatedField{{text: b.File.GetName(), apply: func(masked string) {
+			b.File.Name = masked
+			*content = "[文件] " + masked
+		}}}
+
+	case *gatewayv1.MessageBody_Location:
+		loc := b.Location
+		return []moderatedField{
+			{text: loc.GetName(), apply: func(masked string) { loc.Name = masked; *content = locationSummary(loc) }},
+			{text: loc.GetAddress(), apply: func(masked string) { loc.Address = masked; *content = locationSummary(loc) }},
+		}
+
+	case *gatewayv1.MessageBody_Contact:
+		contact := b.Contact
+		return []moderatedField{{text: contact.GetNickname(), apply: func(masked string) {
+			contact.Nickname = masked
+			*content = contactSummary(contact)
+		}}}
+
+	case *gatewayv1.MessageBody_Poll:
+		poll := b.Poll
+		fields := []moderatedField{{text: poll.GetQuestion(), apply: func(masked string) {
+			poll.Question = masked
+			*content = "[投票] " + masked
+		}}}
+		for i, option := range poll.GetOptions() {
+			fields = append(fields, moderatedField{text: option, apply: func(masked string) { poll.Options[i] = masked }})
+		}
+		return fields
+
+	case *gatewayv1.MessageBody_Forward:
+		var fields []moderatedField
+		for _, m := range b.Forward.GetMessages() {
+			fields = append(fields, moderatedFields(&m.Content, m.Body)...)
+		}
+		return fields
+	}
+	return nil
+}
+
+// applyModeratedText 将打码后的文本写回文本消息体
+// 长度不变时仅移除被打码区间内的链接标记（避免链接仍可点击），长度变化时富文本标记已无法对齐，全部移除
+func applyModeratedText(text *gatewayv1.TextBody, moderated string) {
+	original := []rune(text.GetText())
+	text.Text = moderated
+	if utf8.RuneCountInString(moderated) != len(original) {
+		text.Entities = nil
+		return
+	}
+
+	masked := []rune(moderated)
+	entities := text.Entities[:0]
+	for _, e := range text.GetEntities() {
+		if e.GetType() == gatewayv1.TextEntityType_TEXT_ENTITY_TYPE_LINK &&
+			string(original[e.GetOffset():e.GetOffset()+e.GetLength()]) != string(masked[e.GetOffset():e.GetOffset()+e.GetLength()]) {
+			continue
+		}
+		entities = append(entities, e)
+	}
+	text.Entities = entities
+}
+
+// enqueueReview 将已投递的标记消息写入待复核队列
+// 入队失败不影响发送结果，使用独立的超时 context，避免 RPC 取消导致漏记
+func (s *ChatService) enqueueReview(msgID int64, sessionID, sender, content string, reasons []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if err := s.moderator.EnqueueReview(ctx, &model.ModerationReview{
+		MsgID:          msgID,
+		SessionID:      sessionID,
+		SenderUsername: sender,
+		Content:        content,
+		Reasons:        strings.Join(reasons, ";"),
+	}); err != nil {
+		s.logger.Error("failed to enqueue moderation review",
+			clog.Int64("msg_id", msgID),
+			clog.Error(err))
+	}
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/logic/moderation"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/errcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testModerator 返回固定的审核结论
+type testModerator struct {
+	result *moderation.Result
+	err    error
+}
+
+func (m *testModerator) Moderate(context.Context, *moderation.Input) (*moderation.Result, error) {
+	return m.result, m.err
+}
+
+func (m *testModerator) EnqueueReview(context.Context, *model.ModerationReview) error {
+	return nil
+}
+
+func newModerationTestService(moderator ContentModerator) *ChatService {
+	sessionRepo := &testSessionRepo{
+		getMembersFn: func(ctx context.Context, sessionID string) ([]*model.SessionMember, error) {
+			return []*model.SessionMember{{SessionID: sessionID, Username: "alice"}}, nil
+		},
+	}
+	svc := NewChatService(sessionRepo, &testMessageRepo{}, nil, nil, nil, nil, nil, &config.MessageConfig{}, clog.Discard())
+	svc.SetModerator(moderator)
+	return svc
+}
+
+func TestChatService_SendMessage_ModerationReject(t *testing.T) {
+	svc := newModerationTestService(&testModerator{result: &moderation.Result{Action: moderation.ActionReject}})
+
+	resp, err := svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{
+		SessionId:    "0",
+		FromUsername: "alice",
+		Content:      "forbidden",
+		Type:         "text",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, errcode.ContentRejected, resp.ErrorCode)
+	assert.Zero(t, resp.MsgId)
+}
+
+func TestChatService_SendMessage_ModerationFailed(t *testing.T) {
+	svc := newModerationTestService(&testModerator{err: errors.New("boom")})
+
+	resp, err := svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{
+		SessionId:    "0",
+		FromUsername: "alice",
+		Content:      "hello",
+		Type:         "text",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, errcode.ModerationFailed, resp.ErrorCode)
+}
+
+func TestApplyModeratedText(t *testing.T) {
+	newText := func() *gatewayv1.TextBody {
+		return &gatewayv1.TextBody{
+			Text: "@bob see x.io",
+			Entities: []*gatewayv1.TextEntity{
+				{Type: gatewayv1.TextEntityType_TEXT_ENTITY_TYPE_MENTION, Offset: 0, Length: 4, Username: "bob"},
+				{Type: gatewayv1.TextEntityType_TEXT_ENTITY_TYPE_LINK, Offset: 9, Length: 4, Url: "https://x.io"},
+			},
+		}
+	}
+
+	// 长度不变：仅移除被打码的链接
+	text := newText()
+	applyModeratedText(text, "@bob see ****")
+	assert.Equal(t, "@bob see ****", text.Text)
+	require.Len(t, text.Entities, 1)
+	assert.Equal(t, gatewayv1.TextEntityType_TEXT_ENTITY_TYPE_MENTION, text.Entities[0].Type)
+
+	// 长度变化：富文本标记全部移除
+	text = newText()
+	applyModeratedText(text, "[removed]")
+	assert.Empty(t, text.Entities)
+}
+
+// maskModerator 将内容中的 bad 打码为 ***，包含 evil 时拒绝
+type maskModerator struct{}
+
+func (maskModerator) Moderate(_ context.Context, in *moderation.Input) (*moderation.Result, error) {
+	if strings.Contains(in.Content, "evil") {
+		return &moderation.Result{Action: moderation.ActionReject, Reasons: []string{"word:evil"}}, nil
+	}
+	if strings.Contains(in.Content, "bad") {
+		return &moderation.Result{Action: moderation.ActionMask, Content: strings.ReplaceAll(in.Content, "bad", "***"), Reasons: []string{"word:bad"}}, nil
+	}
+	return &moderation.Result{Action: moderation.ActionAllow, Content: in.Content}, nil
+}
+
+func (maskModerator) EnqueueReview(context.Context, *model.ModerationReview) error {
+	return nil
+}
+
+func TestChatService_ModerateMessage_AllFields(t *testing.T) {
+	svc := newModerationTestService(maskModerator{})
+	ctx := context.Background()
+
+	// 投票问题与选项
+	poll := &gatewayv1.PollBody{Question: "bad idea?", Options: []string{"yes", "bad"}}
+	content := "[投票] bad idea?"
+	action, _, err := svc.moderateMessage(ctx, "g1", "alice", &content, &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Poll{Poll: poll}})
+	require.NoError(t, err)
+	assert.Equal(t, moderation.ActionMask, action)
+	assert.Equal(t, "*** idea?", poll.Question)
+	assert.Equal(t, []string{"yes", "***"}, poll.Options)
+	assert.Equal(t, "[投票] *** idea?", content)
+
+	// 文件名
+	file := &gatewayv1.FileBody{Name: "bad.pdf", Url: "https://cdn.example.com/a.pdf"}
+	content = "[文件] bad.pdf"
+	_, _, err = svc.moderateMessage(ctx, "g1", "alice", &content, &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_File{File: file}})
+	require.NoError(t, err)
+	assert.Equal(t, "***.pdf", file.Name)
+	assert.Equal(t, "[文件] ***.pdf", content)
+
+	// 任一字段被拒绝即拒绝整条消息
+	content = "[投票] q"
+	action, _, err = svc.moderateMessage(ctx, "g1", "alice", &content, &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Poll{Poll: &gatewayv1.PollBody{Question: "q", Options: []string{"a", "evil"}}}})
+	require.NoError(t, err)
+	assert.Equal(t, moderation.ActionReject, action)
+}
+
+func TestChatService_EditMessage_Moderation(t *testing.T) {
+	var edited string
+	svc := newRecallTestService(&model.MessageContent{
+		MsgID:          1,
+		SessionID:      "s_123",
+		SenderUsername: "alice",
+		Content:        "hello",
+		MsgType:        model.MessageTypeText,
+		CreatedAt:      time.Now(),
+	}, 0)
+	svc.messageRepo.(*testMessageRepo).editMessageFn = func(ctx context.Context, revision *model.MessageRevision, content string, editedAt time.Time, outbox *model.MessageOutbox) error {
+		edited = content
+		return errors.New("stop before publish")
+	}
+	svc.SetModerator(maskModerator{})
+
+	_, err := svc.EditMessage(context.Background(), &logicv1.EditMessageRequest{
+		SessionId:        "s_123",
+		MsgId:            1,
+		OperatorUsername: "alice",
+		Content:          "evil",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, edited)
+
+	// 打码后的内容写入数据库
+	_, err = svc.EditMessage(context.Background(), &logicv1.EditMessageRequest{
+		SessionId:        "s_123",
+		MsgId:            1,
+		OperatorUsername: "alice",
+		Content:          "bad news",
+	})
+	require.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, "*** news", edited)
+}
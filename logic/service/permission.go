@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// permission 会话内受角色控制的操作
+type permission int
+
+const (
+	permRename            permission = iota + 1 // 修改群名称
+	permInvite                                  // 邀请成员
+	permKick                                    // 移除成员（还须高于被移除者的角色）
+	permLeave                                   // 退出群聊（群主须先转让）
+	permMute                                    // 禁言、解除禁言（还须高于被禁言者的角色）
+	permPin                                     // 置顶、取消置顶消息
+	permMentionAll                              // @所有人
+	permSetMessageTTL                           // 设置消息保留时长
+	permManageWebhooks                          // 管理会话 Webhook
+	permManageMessages                          // 撤回他人消息、结束他人发起的投票
+	permManageAdmins                            // 设置、取消管理员
+	permTransferOwnership                       // 转让群主
+)
+
+// permissionRule 权限矩阵中的一项
+type permissionRule struct {
+	action  string // 拒绝时错误信息中的操作描述
+	minRole int    // 群聊中所需的最低角色
+	direct  bool   // 单聊双方是否均可操作；为 false 时该操作仅适用于群聊
+}
+
+// permissionMatrix 会话权限矩阵：所有敏感操作的角色要求集中定义于此，由 allowed 与 checkSessionPermission 统一判定
+var permissionMatrix = map[permission]permissionRule{
+	permRename:            {action: "rename the group", minRole: model.MemberRoleAdmin},
+	permInvite:            {action: "invite members", minRole: model.MemberRoleAdmin},
+	permKick:              {action: "remove members", minRole: model.MemberRoleAdmin},
+	permLeave:             {action: "leave the group", minRole: model.MemberRoleMember},
+	permMute:              {action: "mute members", minRole: model.MemberRoleAdmin},
+	permPin:               {action: "pin messages", minRole: model.MemberRoleAdmin, direct: true},
+	permMentionAll:        {action: "mention all", minRole: model.MemberRoleAdmin, direct: true},
+	permSetMessageTTL:     {action: "set message ttl", minRole: model.MemberRoleAdmin, direct: true},
+	permManageWebhooks:    {action: "manage webhooks", minRole: model.MemberRoleAdmin, direct: true},
+	permManageMessages:    {action: "manage messages of others", minRole: model.MemberRoleAdmin},
+	permManageAdmins:      {action: "manage admins", minRole: model.MemberRoleOwner},
+	permTransferOwnership: {action: "transfer ownership", minRole: model.MemberRoleOwner},
+}
+
+// memberRole 成员在会话中的有效角色
+// 群主以 Session.OwnerUsername 为准：兼容创建者角色记录为管理员的旧数据，也避免角色记录与会话不一致时出现两个群主
+func memberRole(session *model.Session, member *model.SessionMember) int {
+	if session.OwnerUsername == member.Username {
+		return model.MemberRoleOwner
+	}
+	if member.Role >= model.MemberRoleOwner {
+		return model.MemberRoleAdmin
+	}
+	return member.Role
+}
+
+// outranks operator 的角色是否高于 target，移除、禁言等针对成员的操作只能作用于角色更低的成员
+func outranks(session *model.Session, operator, target *model.SessionMember) bool {
+	return memberRole(session, operator) > memberRole(session, target)
+}
+
+// roleName 角色名称，用于错误信息
+func roleName(role int) string {
+	switch role {
+	case model.MemberRoleOwner:
+		return "owner"
+	case model.MemberRoleAdmin:
+		return "admin"
+	default:
+		return "member"
+	}
+}
+
+// allowed 按权限矩阵判定成员在会话中是否拥有指定权限
+// 单聊不区分角色，按 direct 判定；会话或成员信息缺失时视为无权限
+func allowed(session *model.Session, member *model.SessionMember, perm permission) bool {
+	rule, ok := permissionMatrix[perm]
+	if !ok || session == nil || member == nil {
+		return false
+	}
+	if session.Type != 2 {
+		return rule.direct
+	}
+	return memberRole(session, member) >= rule.minRole
+}
+
+// permissionDenied 权限不足时的错误信息，如 "only admin can pin messages"
+func permissionDenied(perm permission) string {
+	rule := permissionMatrix[perm]
+	return fmt.Sprintf("only %s can %s", roleName(rule.minRole), rule.action)
+}
+
+// checkSessionPermission 会话权限校验的统一入口，供 SessionService 与 WebhookService 共用
+// 校验用户为会话成员后按权限矩阵判定，返回会话与操作者的成员信息
+func checkSessionPermission(ctx context.Context, sessionRepo repo.SessionRepo, logger clog.Logger, username, sessionID string, perm permission) (*model.Session, *model.SessionMember, error) {
+	if username == "" || sessionID == "" {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "username and session_id are required")
+	}
+
+	member, err := sessionRepo.GetUserSession(ctx, username, sessionID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, nil, status.Errorf(codes.PermissionDenied, "not a session member")
+		}
+		logger.Error("failed to get session member", clog.Error(err))
+		return nil, nil, status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+
+	session, err := sessionRepo.GetSession(ctx, sessionID)
+	if err != nil {
+		logger.Error("failed to get session", clog.Error(err))
+		return nil, nil, status.Errorf(codes.Internal, "failed to get session")
+	}
+	if session.Type != 2 && !permissionMatrix[perm].direct {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "cannot %s in a single chat", permissionMatrix[perm].action)
+	}
+	if !allowed(session, member, perm) {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "%s", permissionDenied(perm))
+	}
+	return session, member, nil
+}
+
+// checkPermission 校验 username 在会话中拥有指定权限
+func (s *SessionService) checkPermission(ctx context.Context, username, sessionID string, perm permission) (*model.Session, *model.SessionMember, error) {
+	return checkSessionPermission(ctx, s.sessionRepo, s.logger, username, sessionID, perm)
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxPinnedMessages 单个会话的最大置顶消息数
+const maxPinnedMessages = 20
+
+// PinMessage 实现 SessionService.PinMessage
+func (s *SessionService) PinMessage(ctx context.Context, req *logicv1.PinMessageRequest) (*logicv1.PinMessageResponse, error) {
+	s.logger.Info("pin message",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.Int64("msg_id", req.MsgId))
+
+	session, _, err := s.checkPermission(ctx, req.Username, req.SessionId, permPin)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := s.messageRepo.GetMessage(ctx, req.MsgId)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message not found")
+		}
+		s.logger.Error("failed to get message", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get message")
+	}
+	if msg.SessionID != req.SessionId {
+		return nil, status.Errorf(codes.NotFound, "message not found")
+	}
+	if msg.Recalled {
+		return nil, status.Errorf(codes.FailedPrecondition, "recalled message cannot be pinned")
+	}
+
+	pins, err := s.messageRepo.GetPinnedMessages(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get pinned messages", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to pin message")
+	}
+	if len(pins) >= maxPinnedMessages {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot pin more than %d messages", maxPinnedMessages)
+	}
+
+	created, err := s.messageRepo.PinMessage(ctx, &model.MessagePin{
+		SessionID: req.SessionId,
+		MsgID:     req.MsgId,
+		PinnedBy:  req.Username,
+	})
+	if err != nil {
+		s.logger.Error("failed to pin message", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to pin message")
+	}
+	// 重复置顶视为成功（幂等），不重复通知
+	if !created {
+		return &logicv1.PinMessageResponse{}, nil
+	}
+
+	text := fmt.Sprintf("%s 置顶了一条消息", s.nickname(ctx, req.Username))
+	system := &gatewayv1.SystemBody{Code: "message_pinned", Text: text, RefMsgId: req.MsgId}
+	if err := s.sendSystemMessage(ctx, req.SessionId, system, session.Name, int32(session.Type)); err != nil {
+		// 通知失败不影响置顶结果，客户端可通过 ListPinnedMessages 获取最新状态
+		s.logger.Error("failed to send pin system message", clog.Error(err))
+	}
+
+	return &logicv1.PinMessageResponse{}, nil
+}
+
+// UnpinMessage 实现 SessionService.UnpinMessage
+func (s *SessionService) UnpinMessage(ctx context.Context, req *logicv1.UnpinMessageRequest) (*logicv1.UnpinMessageResponse, error) {
+	s.logger.Info("unpin message",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.Int64("msg_id", req.MsgId))
+
+	session, _, err := s.checkPermission(ctx, req.Username, req.SessionId, permPin)
+	if err != nil {
+		return nil, err
+	}
+	if req.MsgId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "msg_id is required")
+	}
+
+	removed, err := s.messageRepo.UnpinMessage(ctx, req.SessionId, req.MsgId)
+	if err != nil {
+		s.logger.Error("failed to unpin message", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to unpin message")
+	}
+	// 未置顶时视为成功（幂等），不发送通知
+	if !removed {
+		return &logicv1.UnpinMessageResponse{}, nil
+	}
+
+	text := fmt.Sprintf("%s 取消了一条置顶消息", s.nickname(ctx, req.Username))
+	system := &gatewayv1.SystemBody{Code: "message_unpinned", Text: text, RefMsgId: req.MsgId}
+	if err := s.sendSystemMessage(ctx, req.SessionId, system, session.Name, int32(session.Type)); err != nil {
+		s.logger.Error("failed to send unpin system message", clog.Error(err))
+	}
+
+	return &logicv1.UnpinMessageResponse{}, nil
+}
+
+// ListPinnedMessages 实现 SessionService.ListPinnedMessages
+func (s *SessionService) ListPinnedMessages(ctx context.Context, req *logicv1.ListPinnedMessagesRequest) (*logicv1.ListPinnedMessagesResponse, error) {
+	if req.Username == "" || req.SessionId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username and session_id are required")
+	}
+
+	// 与 GetHistoryMessages 一致，仅会话成员可查看
+	if _, err := s.sessionRepo.GetUserSession(ctx, req.Username, req.SessionId); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.PermissionDenied, "no permission to access session")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+
+	pins, err := s.messageRepo.GetPinnedMessages(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get pinned messages", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get pinned messages")
+	}
+	if len(pins) == 0 {
+		return &logicv1.ListPinnedMessagesResponse{}, nil
+	}
+
+	msgIDs := make([]int64, 0, len(pins))
+	for _, pin := range pins {
+		msgIDs = append(msgIDs, pin.MsgID)
+	}
+	msgs, err := s.messageRepo.GetMessagesByIDs(ctx, msgIDs, "")
+	if err != nil {
+		s.logger.Error("failed to get pinned message contents", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get pinned messages")
+	}
+	msgMap := make(map[int64]*model.MessageContent, len(msgs))
+	for _, msg := range msgs {
+		msgMap[msg.MsgID] = msg
+	}
+
+	// 已撤回的消息不再展示在置顶栏中
+	result := make([]*gatewayv1.PinnedMessage, 0, len(pins))
+	pushMsgs := make([]*gatewayv1.PushMessage, 0, len(pins))
+	for _, pin := range pins {
+		msg, ok := msgMap[pin.MsgID]
+		if !ok || msg.Recalled {
+			continue
+		}
+		pushMsg := toPushMessage(msg)
+		pushMsgs = append(pushMsgs, pushMsg)
+		result = append(result, &gatewayv1.PinnedMessage{
+			Message:  pushMsg,
+			PinnedBy: pin.PinnedBy,
+			PinnedAt: pin.CreatedAt.Unix(),
+		})
+	}
+	attachReactions(ctx, s.messageRepo, pushMsgs, req.Username, s.logger)
+	attachPolls(ctx, s.messageRepo, pushMsgs, req.Username, s.logger)
+
+	return &logicv1.ListPinnedMessagesResponse{
+		Pins: result,
+	}, nil
+}
+
+// nickname 获取用户昵称，查询失败时退化为用户名
+func (s *SessionService) nickname(ctx context.Context, username string) string {
+	if user, err := s.userRepo.GetUserByUsername(ctx, username); err == nil && user.Nickname != "" {
+		return user.Nickname
+	}
+	return username
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/errcode"
+	"github.com/ceyewan/resonance/pkg/polltally"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CastVote 实现 ChatService.CastVote
+// 重新投票覆盖上次的选择，options 为空表示撤回投票；选择变化时通过 Outbox 发布计票变更事件
+func (s *ChatService) CastVote(ctx context.Context, req *logicv1.CastVoteRequest) (*logicv1.CastVoteResponse, error) {
+	s.logger.Info("cast vote",
+		clog.String("voter", req.VoterUsername),
+		clog.String("session_id", req.SessionId),
+		clog.Int64("msg_id", req.MsgId))
+
+	if req.VoterUsername == "" || req.SessionId == "" || req.MsgId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "voter_username, session_id and msg_id are required")
+	}
+	if err := s.checkSessionMember(ctx, req.VoterUsername, req.SessionId); err != nil {
+		return nil, err
+	}
+
+	msg, poll, err := s.getPollMessage(ctx, req.SessionId, req.MsgId)
+	if err != nil {
+		return nil, err
+	}
+	if polltally.Expired(poll, time.Now()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "poll has ended")
+	}
+
+	// 选项去重排序，与 t_poll_vote 的存储顺序一致
+	options := slices.Clone(req.Options)
+	slices.Sort(options)
+	options = slices.Compact(options)
+	for _, option := range options {
+		if option < 0 || int(option) >= len(poll.GetOptions()) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid poll option: %d", option)
+		}
+	}
+	if !poll.GetMultiChoice() && len(options) > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "poll allows only one option")
+	}
+
+	outbox, result, err := buildOutbox(ctx, s.pollEvent(msg, req.VoterUsername), msg.MsgID)
+	if err != nil {
+		s.logger.Error("failed to build poll event", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to cast vote")
+	}
+	changed, err := s.messageRepo.CastVoteWithOutbox(ctx, &model.Poll{MsgID: msg.MsgID, SessionID: msg.SessionID}, req.VoterUsername, options, outbox)
+	if err != nil {
+		if errors.Is(err, repo.ErrPollClosed) {
+			return nil, status.Errorf(codes.FailedPrecondition, "poll has ended")
+		}
+		s.logger.Error("failed to cast vote", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to cast vote")
+	}
+	if changed {
+		result.OutboxID = outbox.ID
+		// 立即尝试发布到 MQ (Look-aside 优化)
+		PublishMessageToMQAsync(s.mqClient, result.OutboxID, result.Topic, result.EventData, s.logger)
+	}
+
+	tally, err := s.getPollTally(ctx, msg.MsgID, poll, req.VoterUsername)
+	if err != nil {
+		return nil, err
+	}
+	return &logicv1.CastVoteResponse{Tally: tally}, nil
+}
+
+// ClosePoll 实现 ChatService.ClosePoll
+// 仅投票发起者或具备消息管理权限者（群管理员及群主）可提前结束；已结束时视为成功（幂等），不重复通知
+func (s *ChatService) ClosePoll(ctx context.Context, req *logicv1.ClosePollRequest) (*logicv1.ClosePollResponse, error) {
+	s.logger.Info("close poll",
+		clog.String("operator", req.OperatorUsername),
+		clog.String("session_id", req.SessionId),
+		clog.Int64("msg_id", req.MsgId))
+
+	if req.OperatorUsername == "" || req.SessionId == "" || req.MsgId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "operator_username, session_id and msg_id are required")
+	}
+	member, err := s.sessionRepo.GetUserSession(ctx, req.OperatorUsername, req.SessionId)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.PermissionDenied, "not a session member")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+
+	msg, poll, err := s.getPollMessage(ctx, req.SessionId, req.MsgId)
+	if err != nil {
+		return nil, err
+	}
+	if msg.SenderUsername != req.OperatorUsername {
+		session, err := s.sessionRepo.GetSession(ctx, req.SessionId)
+		if err != nil {
+			s.logger.Error("failed to get session", clog.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to get session")
+		}
+		if !allowed(session, member, permManageMessages) {
+			return nil, status.Errorf(codes.PermissionDenied, "only poll creator or admin can close poll")
+		}
+	}
+
+	now := time.Now()
+	if !polltally.Expired(poll, now) {
+		outbox, result, err := buildOutbox(ctx, s.pollEvent(msg, req.OperatorUsername), msg.MsgID)
+		if err != nil {
+			s.logger.Error("failed to build poll event", clog.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to close poll")
+		}
+		closed, err := s.messageRepo.ClosePollWithOutbox(ctx, &model.Poll{
+			MsgID:     msg.MsgID,
+			SessionID: msg.SessionID,
+			ClosedBy:  req.OperatorUsername,
+			ClosedAt:  &now,
+		}, outbox)
+		if err != nil {
+			s.logger.Error("failed to close poll", clog.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to close poll")
+		}
+		if closed {
+			result.OutboxID = outbox.ID
+			PublishMessageToMQAsync(s.mqClient, result.OutboxID, result.Topic, result.EventData, s.logger)
+		}
+	}
+
+	tally, err := s.getPollTally(ctx, msg.MsgID, poll, req.OperatorUsername)
+	if err != nil {
+		return nil, err
+	}
+	return &logicv1.ClosePollResponse{Tally: tally}, nil
+}
+
+// getPollMessage 获取会话中未撤回的投票消息及其投票定义
+func (s *ChatService) getPollMessage(ctx context.Context, sessionID string, msgID int64) (*model.MessageContent, *gatewayv1.PollBody, error) {
+	msg, err := s.messageRepo.GetMessage(ctx, msgID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, nil, status.Errorf(codes.NotFound, "message not found")
+		}
+		s.logger.Error("failed to get message", clog.Error(err))
+		return nil, nil, status.Errorf(codes.Internal, "failed to get message")
+	}
+	if msg.SessionID != sessionID {
+		return nil, nil, status.Errorf(codes.NotFound, "message not found")
+	}
+	if msg.Recalled {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "message has been recalled")
+	}
+	poll := unmarshalMessageBody(msg.Body).GetPoll()
+	if msg.MsgType != model.MessageTypePoll || poll == nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "message is not a poll")
+	}
+	return msg, poll, nil
+}
+
+// getPollTally 获取投票的最新计票状态（含 username 的选择）
+func (s *ChatService) getPollTally(ctx context.Context, msgID int64, poll *gatewayv1.PollBody, username string) (*gatewayv1.PollTally, error) {
+	tallies, err := s.messageRepo.GetPollTallies(ctx, []int64{msgID}, username)
+	if err != nil || len(tallies) == 0 {
+		s.logger.Error("failed to get poll tally", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get poll tally")
+	}
+	return toPollTally(poll, tallies[0], time.Now()), nil
+}
+
+// pollEvent 构造计票变更事件，携带投票定义供 Task 组装推送
+func (s *ChatService) pollEvent(msg *model.MessageContent, operator string) *mqv1.PushEvent {
+	return &mqv1.PushEvent{
+		MsgId:            msg.MsgID,
+		SeqId:            msg.SeqID,
+		SessionId:        msg.SessionID,
+		FromUsername:     msg.SenderUsername,
+		Content:          msg.Content,
+		Type:             msg.MsgType,
+		Timestamp:        time.Now().Unix(),
+		EventType:        mqv1.EventType_EVENT_TYPE_POLL,
+		OperatorUsername: operator,
+		ThreadRootId:     msg.ThreadRootID,
+		Body:             unmarshalMessageBody(msg.Body),
+	}
+}
+
+// checkPollSession 校验投票消息只能发送到群聊，不满足时返回错误响应
+func (s *ChatService) checkPollSession(ctx context.Context, sessionID string) *logicv1.SendMessageResponse {
+	session, err := s.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil {
+		s.logger.Error("failed to get session", clog.Error(err))
+		return &logicv1.SendMessageResponse{
+			Error:     "failed to get session",
+			ErrorCode: errcode.Internal,
+		}
+	}
+	if session.Type != 2 {
+		return &logicv1.SendMessageResponse{
+			Error:     "poll is only available in group sessions",
+			ErrorCode: errcode.InvalidArgument,
+		}
+	}
+	return nil
+}
+
+// toPollTally 将计票记录转换为 PollTally，并附带计票记录所属用户的选择
+// 匿名投票不返回 my_choices，仅以 voted 标记当前用户是否已投票
+func toPollTally(poll *gatewayv1.PollBody, t *repo.PollTally, now time.Time) *gatewayv1.PollTally {
+	tally := polltally.Build(poll, t, now)
+	tally.Voted = len(t.MyChoices) > 0
+	if !poll.GetAnonymous() {
+		tally.MyChoices = t.MyChoices
+	}
+	return tally
+}
+
+// attachPolls 为投票消息批量附带计票状态及 username 的选择
+// 查询失败时仅记录日志，不影响消息本身的返回
+func attachPolls(ctx context.Context, messageRepo repo.MessageRepo, pushMsgs []*gatewayv1.PushMessage, username string, logger clog.Logger) {
+	ids := make([]int64, 0)
+	for _, m := range pushMsgs {
+		if !m.Recalled && m.Body.GetPoll() != nil {
+			ids = append(ids, m.MsgId)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	tallies, err := messageRepo.GetPollTallies(ctx, ids, username)
+	if err != nil {
+		logger.Warn("failed to load poll tallies", clog.Error(err))
+		return
+	}
+	byID := make(map[int64]*repo.PollTally, len(tallies))
+	for _, t := range tallies {
+		byID[t.MsgID] = t
+	}
+	now := time.Now()
+	for _, m := range pushMsgs {
+		if t, ok := byID[m.MsgId]; ok && !m.Recalled {
+			m.PollTally = toPollTally(m.Body.GetPoll(), t, now)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/errcode"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newPollTestService(t *testing.T, poll *gatewayv1.PollBody, messageRepo *testMessageRepo) *ChatService {
+	t.Helper()
+	body, err := marshalMessageBody(&gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Poll{Poll: poll}})
+	require.NoError(t, err)
+
+	sessionRepo := &testSessionRepo{
+		getUserSessionFn: func(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
+			return &model.SessionMember{SessionID: sessionID, Username: username}, nil
+		},
+	}
+	messageRepo.getMessageFn = func(ctx context.Context, msgID int64) (*model.MessageContent, error) {
+		return &model.MessageContent{
+			MsgID:          msgID,
+			SessionID:      "g1",
+			SenderUsername: "alice",
+			MsgType:        model.MessageTypePoll,
+			Body:           body,
+		}, nil
+	}
+	return NewChatService(sessionRepo, messageRepo, nil, nil, nil, nil, nil, &config.MessageConfig{}, clog.Discard())
+}
+
+func TestChatService_CastVote(t *testing.T) {
+	var saved []int32
+	messageRepo := &testMessageRepo{
+		// 返回 false 表示选择未变化，不触发 MQ 发布
+		castVoteFn: func(ctx context.Context, poll *model.Poll, username string, options []int32, outbox *model.MessageOutbox) (bool, error) {
+			saved = options
+			return false, nil
+		},
+		getPollTalliesFn: func(ctx context.Context, msgIDs []int64, username string) ([]*repo.PollTally, error) {
+			return []*repo.PollTally{{
+				MsgID:     msgIDs[0],
+				Poll:      &model.Poll{MsgID: msgIDs[0], VoterCount: 1},
+				Counts:    map[int32]int32{0: 1, 2: 1},
+				MyChoices: saved,
+			}}, nil
+		},
+	}
+	svc := newPollTestService(t, &gatewayv1.PollBody{
+		Question:    "lunch?",
+		Options:     []string{"a", "b", "c"},
+		MultiChoice: true,
+	}, messageRepo)
+
+	resp, err := svc.CastVote(context.Background(), &logicv1.CastVoteRequest{
+		SessionId:     "g1",
+		MsgId:         1,
+		VoterUsername: "bob",
+		Options:       []int32{2, 0, 2},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{0, 2}, saved)
+	assert.Equal(t, []int32{1, 0, 1}, resp.Tally.Counts)
+	assert.Equal(t, []int32{0, 2}, resp.Tally.MyChoices)
+	assert.True(t, resp.Tally.Voted)
+
+	_, err = svc.CastVote(context.Background(), &logicv1.CastVoteRequest{
+		SessionId:     "g1",
+		MsgId:         1,
+		VoterUsername: "bob",
+		Options:       []int32{3},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestChatService_CastVote_SingleChoiceAndDeadline(t *testing.T) {
+	svc := newPollTestService(t, &gatewayv1.PollBody{
+		Question: "lunch?",
+		Options:  []string{"a", "b"},
+	}, &testMessageRepo{})
+	_, err := svc.CastVote(context.Background(), &logicv1.CastVoteRequest{
+		SessionId:     "g1",
+		MsgId:         1,
+		VoterUsername: "bob",
+		Options:       []int32{0, 1},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	svc = newPollTestService(t, &gatewayv1.PollBody{
+		Question: "lunch?",
+		Options:  []string{"a", "b"},
+		Deadline: time.Now().Add(-time.Minute).Unix(),
+	}, &testMessageRepo{})
+	_, err = svc.CastVote(context.Background(), &logicv1.CastVoteRequest{
+		SessionId:     "g1",
+		MsgId:         1,
+		VoterUsername: "bob",
+		Options:       []int32{0},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestChatService_ClosePoll_Permission(t *testing.T) {
+	svc := newPollTestService(t, &gatewayv1.PollBody{
+		Question: "lunch?",
+		Options:  []string{"a", "b"},
+	}, &testMessageRepo{})
+
+	// 非发起者且非管理员
+	_, err := svc.ClosePoll(context.Background(), &logicv1.ClosePollRequest{
+		SessionId:        "g1",
+		MsgId:            1,
+		OperatorUsername: "bob",
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestChatService_SendMessage_PollRequiresGroup(t *testing.T) {
+	sessionRepo := &testSessionRepo{
+		getMembersFn: func(ctx context.Context, sessionID string) ([]*model.SessionMember, error) {
+			return []*model.SessionMember{{SessionID: sessionID, Username: "alice"}}, nil
+		},
+		getSessionFn: func(ctx context.Context, sessionID string) (*model.Session, error) {
+			return &model.Session{SessionID: sessionID, Type: 1}, nil
+		},
+	}
+	svc := NewChatService(sessionRepo, &testMessageRepo{}, nil, nil, nil, nil, nil, &config.MessageConfig{}, clog.Discard())
+
+	resp, err := svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{
+		SessionId:    "s1",
+		FromUsername: "alice",
+		Body: &gatewayv1.MessageBody{Body: &gatewayv1.MessageBody_Poll{Poll: &gatewayv1.PollBody{
+			Question: "lunch?",
+			Options:  []string{"a", "b"},
+		}}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "poll is only available in group sessions", resp.Error)
+	assert.Equal(t, errcode.InvalidArgument, resp.ErrorCode)
+}
+
+func TestToPollTally(t *testing.T) {
+	closedAt := time.Unix(1700000000, 0)
+	poll := &gatewayv1.PollBody{Options: []string{"a", "b"}, Anonymous: true}
+
+	tally := toPollTally(poll, &repo.PollTally{
+		Poll:      &model.Poll{VoterCount: 2, ClosedBy: "alice", ClosedAt: &closedAt},
+		Counts:    map[int32]int32{1: 2, 5: 1}, // 越界的选项被忽略
+		MyChoices: []int32{1},
+	}, time.Now())
+	assert.Equal(t, []int32{0, 2}, tally.Counts)
+	assert.Empty(t, tally.MyChoices) // 匿名投票不返回本人选择
+	assert.True(t, tally.Voted)
+	assert.True(t, tally.Closed)
+	assert.Equal(t, closedAt.Unix(), tally.ClosedAt)
+
+	// 过截止时间视为已结束
+	poll = &gatewayv1.PollBody{Options: []string{"a", "b"}, Deadline: 100}
+	tally = toPollTally(poll, &repo.PollTally{Counts: map[int32]int32{}}, time.Unix(200, 0))
+	assert.True(t, tally.Closed)
+	assert.Equal(t, int64(100), tally.ClosedAt)
+	assert.Empty(t, tally.ClosedBy)
+}
+
+func TestValidatePollBody(t *testing.T) {
+	valid := func() *gatewayv1.PollBody {
+		return &gatewayv1.PollBody{Question: "q", Options: []string{"a", "b"}}
+	}
+	require.NoError(t, validatePollBody(valid()))
+
+	p := valid()
+	p.Options = []string{"a"}
+	require.Error(t, validatePollBody(p))
+
+	p = valid()
+	p.Options = []string{"a", " a "}
+	require.Error(t, validatePollBody(p))
+
+	p = valid()
+	p.Deadline = time.Now().Add(-time.Second).Unix()
+	require.Error(t, validatePollBody(p))
+}
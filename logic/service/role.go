@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSessionNameLength 群名称的最大长度（字符数），与 t_session.name 列宽一致
+const maxSessionNameLength = 128
+
+// RenameSession 实现 SessionService.RenameSession
+// 权限见权限矩阵（群聊管理员及群主）；名称未变化时视为成功（幂等），不发送通知
+func (s *SessionService) RenameSession(ctx context.Context, req *logicv1.RenameSessionRequest) (*logicv1.RenameSessionResponse, error) {
+	s.logger.Info("rename session",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId))
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if utf8.RuneCountInString(name) > maxSessionNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "name cannot exceed %d characters", maxSessionNameLength)
+	}
+
+	session, _, err := s.checkPermission(ctx, req.Username, req.SessionId, permRename)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := s.sessionRepo.UpdateSessionName(ctx, req.SessionId, name)
+	if err != nil {
+		s.logger.Error("failed to update session name", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to rename session")
+	}
+	if !changed {
+		return &logicv1.RenameSessionResponse{}, nil
+	}
+
+	// 系统消息携带新名称，客户端据此更新会话列表
+	text := fmt.Sprintf("%s 将群名称修改为「%s」", s.nickname(ctx, req.Username), name)
+	system := &gatewayv1.SystemBody{Code: "session_renamed", Text: text}
+	if err := s.sendSystemMessage(ctx, req.SessionId, system, name, int32(session.Type)); err != nil {
+		s.logger.Error("failed to send rename system message", clog.Error(err))
+	}
+
+	return &logicv1.RenameSessionResponse{}, nil
+}
+
+// PromoteAdmin 实现 SessionService.PromoteAdmin
+// 仅群主可设置管理员；已是管理员时视为成功（幂等）
+func (s *SessionService) PromoteAdmin(ctx context.Context, req *logicv1.PromoteAdminRequest) (*logicv1.PromoteAdminResponse, error) {
+	s.logger.Info("promote admin",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.String("member", req.Member))
+
+	changed, session, err := s.setMemberRole(ctx, req.Username, req.SessionId, req.Member, model.MemberRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		text := fmt.Sprintf("%s 将 %s 设为管理员", s.nickname(ctx, req.Username), s.nickname(ctx, req.Member))
+		s.sendRoleSystemMessage(ctx, session, "admin_promoted", text)
+	}
+	return &logicv1.PromoteAdminResponse{}, nil
+}
+
+// DemoteAdmin 实现 SessionService.DemoteAdmin
+// 仅群主可取消管理员；已是普通成员时视为成功（幂等）
+func (s *SessionService) DemoteAdmin(ctx context.Context, req *logicv1.DemoteAdminRequest) (*logicv1.DemoteAdminResponse, error) {
+	s.logger.Info("demote admin",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.String("member", req.Member))
+
+	changed, session, err := s.setMemberRole(ctx, req.Username, req.SessionId, req.Member, model.MemberRoleMember)
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		text := fmt.Sprintf("%s 取消了 %s 的管理员身份", s.nickname(ctx, req.Username), s.nickname(ctx, req.Member))
+		s.sendRoleSystemMessage(ctx, session, "admin_demoted", text)
+	}
+	return &logicv1.DemoteAdminResponse{}, nil
+}
+
+// TransferOwnership 实现 SessionService.TransferOwnership
+// 仅群主可转让，新群主须为群成员；原群主成为管理员
+func (s *SessionService) TransferOwnership(ctx context.Context, req *logicv1.TransferOwnershipRequest) (*logicv1.TransferOwnershipResponse, error) {
+	s.logger.Info("transfer ownership",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.String("member", req.Member))
+
+	if req.Member == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "member is required")
+	}
+	if req.Member == req.Username {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot transfer ownership to yourself")
+	}
+
+	session, _, err := s.checkPermission(ctx, req.Username, req.SessionId, permTransferOwnership)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.getTargetMember(ctx, req.Member, req.SessionId); err != nil {
+		return nil, err
+	}
+
+	if err := s.sessionRepo.TransferOwnership(ctx, req.SessionId, req.Username, req.Member); err != nil {
+		switch {
+		case errors.Is(err, repo.ErrOwnerChanged):
+			return nil, status.Errorf(codes.FailedPrecondition, "session owner has changed")
+		case errors.Is(err, repo.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "member not found")
+		}
+		s.logger.Error("failed to transfer ownership", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to transfer ownership")
+	}
+
+	text := fmt.Sprintf("%s 将群主转让给 %s", s.nickname(ctx, req.Username), s.nickname(ctx, req.Member))
+	s.sendRoleSystemMessage(ctx, session, "owner_transferred", text)
+	return &logicv1.TransferOwnershipResponse{}, nil
+}
+
+// maxMuteDuration 单次禁言的最长时长
+const maxMuteDuration = 30 * 24 * time.Hour
+
+// MuteMember 实现 SessionService.MuteMember
+// 权限见权限矩阵（群聊管理员及群主），且只能禁言角色低于自己的成员；重复禁言时以本次时长为准
+func (s *SessionService) MuteMember(ctx context.Context, req *logicv1.MuteMemberRequest) (*logicv1.MuteMemberResponse, error) {
+	s.logger.Info("mute member",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.String("member", req.Member),
+		clog.Int64("duration", req.Duration))
+
+	if req.Member == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "member is required")
+	}
+	if req.Member == req.Username {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot mute yourself")
+	}
+	if req.Duration < 0 || req.Duration > int64(maxMuteDuration/time.Second) {
+		return nil, status.Errorf(codes.InvalidArgument, "duration must be between 0 and %d seconds", int64(maxMuteDuration/time.Second))
+	}
+
+	session, target, err := s.checkMemberTarget(ctx, req.Username, req.SessionId, req.Member, permMute)
+	if err != nil {
+		return nil, err
+	}
+
+	var until *time.Time
+	if req.Duration > 0 {
+		t := time.Now().Add(time.Duration(req.Duration) * time.Second)
+		until = &t
+	}
+	changed, err := s.sessionRepo.SetMemberMute(ctx, req.SessionId, target.Username, true, until)
+	if err != nil {
+		s.logger.Error("failed to mute member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to mute member")
+	}
+	if !changed {
+		// 校验后被并发移出群聊
+		return nil, status.Errorf(codes.NotFound, "member not found")
+	}
+
+	text := fmt.Sprintf("%s 将 %s 禁言", s.nickname(ctx, req.Username), s.nickname(ctx, req.Member))
+	resp := &logicv1.MuteMemberResponse{}
+	if until != nil {
+		text += "至 " + until.Format("2006-01-02 15:04")
+		resp.MutedUntil = until.Unix()
+	}
+	s.sendRoleSystemMessage(ctx, session, "member_muted", text)
+	return resp, nil
+}
+
+// UnmuteMember 实现 SessionService.UnmuteMember
+// 权限与禁言一致；未被禁言时视为成功（幂等），不发送通知
+func (s *SessionService) UnmuteMember(ctx context.Context, req *logicv1.UnmuteMemberRequest) (*logicv1.UnmuteMemberResponse, error) {
+	s.logger.Info("unmute member",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.String("member", req.Member))
+
+	if req.Member == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "member is required")
+	}
+
+	session, target, err := s.checkMemberTarget(ctx, req.Username, req.SessionId, req.Member, permMute)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := s.sessionRepo.SetMemberMute(ctx, req.SessionId, target.Username, false, nil)
+	if err != nil {
+		s.logger.Error("failed to unmute member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to unmute member")
+	}
+	if changed {
+		text := fmt.Sprintf("%s 解除了 %s 的禁言", s.nickname(ctx, req.Username), s.nickname(ctx, req.Member))
+		s.sendRoleSystemMessage(ctx, session, "member_unmuted", text)
+	}
+	return &logicv1.UnmuteMemberResponse{}, nil
+}
+
+// checkMemberTarget 校验操作者拥有指定权限，且被操作的成员存在、角色低于操作者
+func (s *SessionService) checkMemberTarget(ctx context.Context, operator, sessionID, username string, perm permission) (*model.Session, *model.SessionMember, error) {
+	session, member, err := s.checkPermission(ctx, operator, sessionID, perm)
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := s.getTargetMember(ctx, username, sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !outranks(session, member, target) {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "cannot %s with equal or higher role", permissionMatrix[perm].action)
+	}
+	return session, target, nil
+}
+
+// setMemberRole 校验设置管理员的权限后更新成员角色，返回是否实际变更
+// 群主的角色只能通过转让变更
+func (s *SessionService) setMemberRole(ctx context.Context, operator, sessionID, username string, role int) (bool, *model.Session, error) {
+	if username == "" {
+		return false, nil, status.Errorf(codes.InvalidArgument, "member is required")
+	}
+
+	session, _, err := s.checkPermission(ctx, operator, sessionID, permManageAdmins)
+	if err != nil {
+		return false, nil, err
+	}
+	target, err := s.getTargetMember(ctx, username, sessionID)
+	if err != nil {
+		return false, nil, err
+	}
+	if memberRole(session, target) == model.MemberRoleOwner {
+		return false, nil, status.Errorf(codes.FailedPrecondition, "owner role can only be changed by transferring ownership")
+	}
+
+	changed, err := s.sessionRepo.UpdateMemberRole(ctx, sessionID, username, role)
+	if err != nil {
+		s.logger.Error("failed to update member role", clog.Error(err))
+		return false, nil, status.Errorf(codes.Internal, "failed to update member role")
+	}
+	return changed, session, nil
+}
+
+// getTargetMember 获取被操作的成员，不是成员时返回 NotFound
+func (s *SessionService) getTargetMember(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
+	member, err := s.sessionRepo.GetUserSession(ctx, username, sessionID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "member not found")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get session member")
+	}
+	return member, nil
+}
+
+// sendRoleSystemMessage 发送群管理操作（角色变更、禁言）的系统消息，失败不影响操作结果
+func (s *SessionService) sendRoleSystemMessage(ctx context.Context, session *model.Session, code, text string) {
+	system := &gatewayv1.SystemBody{Code: code, Text: text}
+	if err := s.sendSystemMessage(ctx, session.SessionID, system, session.Name, int32(session.Type)); err != nil {
+		s.logger.Error("failed to send role system message", clog.String("code", code), clog.Error(err))
+	}
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/errcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPermissionMatrix(t *testing.T) {
+	group := &model.Session{SessionID: "g1", Type: 2, OwnerUsername: "alice"}
+	single := &model.Session{SessionID: "s1", Type: 1, OwnerUsername: "alice"}
+	owner := &model.SessionMember{Username: "alice", Role: model.MemberRoleAdmin} // 旧数据：群主角色记录为管理员
+	admin := &model.SessionMember{Username: "bob", Role: model.MemberRoleAdmin}
+	member := &model.SessionMember{Username: "carol", Role: model.MemberRoleMember}
+	staleOwner := &model.SessionMember{Username: "dave", Role: model.MemberRoleOwner} // 已转让但角色记录未更新
+
+	assert.Equal(t, model.MemberRoleOwner, memberRole(group, owner))
+	assert.Equal(t, model.MemberRoleAdmin, memberRole(group, staleOwner))
+
+	for perm, rule := range permissionMatrix {
+		assert.Equal(t, rule.minRole <= model.MemberRoleOwner, allowed(group, owner, perm), rule.action)
+		assert.Equal(t, rule.minRole <= model.MemberRoleAdmin, allowed(group, admin, perm), rule.action)
+		assert.Equal(t, rule.minRole <= model.MemberRoleMember, allowed(group, member, perm), rule.action)
+		// 单聊不区分角色
+		assert.Equal(t, rule.direct, allowed(single, member, perm), rule.action)
+	}
+
+	assert.False(t, allowed(nil, admin, permPin))
+	assert.Equal(t, "only owner can manage admins", permissionDenied(permManageAdmins))
+}
+
+func TestSessionService_RoleManagement(t *testing.T) {
+	sessionRepo := newMemberTestRepo(2)
+	var updated []string
+	sessionRepo.updateRoleFn = func(ctx context.Context, sessionID, username string, role int) (bool, error) {
+		updated = append(updated, username)
+		return false, nil
+	}
+	var transferred [2]string
+	sessionRepo.transferFn = func(ctx context.Context, sessionID, from, to string) error {
+		transferred = [2]string{from, to}
+		return nil
+	}
+	svc := NewSessionService(sessionRepo, &testMessageRepo{}, &memberTestUserRepo{}, nil, nil, nil, nil, clog.Discard())
+	ctx := context.Background()
+
+	// 管理员不能设置管理员
+	_, err := svc.PromoteAdmin(ctx, &logicv1.PromoteAdminRequest{Username: "bob", SessionId: "g1", Member: "carol"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// 群主的角色只能通过转让变更
+	_, err = svc.DemoteAdmin(ctx, &logicv1.DemoteAdminRequest{Username: "alice", SessionId: "g1", Member: "alice"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = svc.PromoteAdmin(ctx, &logicv1.PromoteAdminRequest{Username: "alice", SessionId: "g1", Member: "ghost"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// 角色未变化时不发送系统消息
+	_, err = svc.PromoteAdmin(ctx, &logicv1.PromoteAdminRequest{Username: "alice", SessionId: "g1", Member: "carol"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"carol"}, updated)
+
+	_, err = svc.TransferOwnership(ctx, &logicv1.TransferOwnershipRequest{Username: "bob", SessionId: "g1", Member: "carol"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = svc.RenameSession(ctx, &logicv1.RenameSessionRequest{Username: "carol", SessionId: "g1", Name: "new"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = svc.TransferOwnership(ctx, &logicv1.TransferOwnershipRequest{Username: "alice", SessionId: "g1", Member: "ghost"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	assert.Empty(t, transferred[0])
+}
+
+func TestSessionService_RoleManagement_SingleChat(t *testing.T) {
+	svc := NewSessionService(newMemberTestRepo(1), &testMessageRepo{}, &memberTestUserRepo{}, nil, nil, nil, nil, clog.Discard())
+
+	_, err := svc.PromoteAdmin(context.Background(), &logicv1.PromoteAdminRequest{Username: "alice", SessionId: "s1", Member: "carol"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestSessionService_MuteMember(t *testing.T) {
+	sessionRepo := newMemberTestRepo(2)
+	var mutedUntil *time.Time
+	var muted []bool
+	sessionRepo.setMuteFn = func(ctx context.Context, sessionID, username string, mute bool, until *time.Time) (bool, error) {
+		mutedUntil = until
+		muted = append(muted, mute)
+		// 返回未变更，不触发系统消息
+		return false, nil
+	}
+	svc := NewSessionService(sessionRepo, &testMessageRepo{}, &memberTestUserRepo{}, nil, nil, nil, nil, clog.Discard())
+	ctx := context.Background()
+
+	_, err := svc.MuteMember(ctx, &logicv1.MuteMemberRequest{Username: "carol", SessionId: "g1", Member: "bob"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// 管理员不能禁言管理员及群主
+	_, err = svc.MuteMember(ctx, &logicv1.MuteMemberRequest{Username: "bob", SessionId: "g1", Member: "erin"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.MuteMember(ctx, &logicv1.MuteMemberRequest{Username: "bob", SessionId: "g1", Member: "alice"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = svc.MuteMember(ctx, &logicv1.MuteMemberRequest{Username: "bob", SessionId: "g1", Member: "carol", Duration: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, muted)
+
+	_, err = svc.MuteMember(ctx, &logicv1.MuteMemberRequest{Username: "bob", SessionId: "g1", Member: "carol", Duration: 600})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.NotNil(t, mutedUntil)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), *mutedUntil, time.Minute)
+
+	// 群主可禁言管理员；未被禁言时解除视为成功
+	_, err = svc.UnmuteMember(ctx, &logicv1.UnmuteMemberRequest{Username: "alice", SessionId: "g1", Member: "bob"})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, muted)
+}
+
+func TestChatService_SendMessage_Muted(t *testing.T) {
+	until := time.Now().Add(time.Minute)
+	sessionRepo := &testSessionRepo{
+		getMembersFn: func(ctx context.Context, sessionID string) ([]*model.SessionMember, error) {
+			return []*model.SessionMember{{SessionID: sessionID, Username: "carol", Muted: true, MutedUntil: &until}}, nil
+		},
+	}
+	svc := NewChatService(sessionRepo, &testMessageRepo{}, nil, nil, nil, nil, nil, &config.MessageConfig{}, clog.Discard())
+
+	resp, err := svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{SessionId: "g1", FromUsername: "carol", Content: "hi", Type: "text"})
+	require.NoError(t, err)
+	assert.Equal(t, errMutedInSession, resp.Error)
+	assert.Equal(t, errcode.Muted, resp.ErrorCode)
+
+	// 禁言到期后自动解除
+	expired := &model.SessionMember{Muted: true, MutedUntil: &until}
+	assert.False(t, expired.MutedAt(until.Add(time.Second)))
+	assert.True(t, (&model.SessionMember{Muted: true}).MutedAt(time.Now()))
+}
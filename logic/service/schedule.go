@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/idgen"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ScheduleService 定时消息服务
+// 定时消息以 SendMessageRequest 的形式落库，到期后由 job.ScheduledSender 经 ChatService.SendMessage 发送
+type ScheduleService struct {
+	logicv1.UnimplementedScheduleServiceServer
+	sessionRepo   repo.SessionRepo
+	scheduledRepo repo.ScheduledMessageRepo
+	idGen         idgen.Generator // Snowflake ID 生成器
+	config        *config.ScheduleConfig
+	logger        clog.Logger
+}
+
+// NewScheduleService 创建定时消息服务
+func NewScheduleService(
+	sessionRepo repo.SessionRepo,
+	scheduledRepo repo.ScheduledMessageRepo,
+	idGen idgen.Generator,
+	cfg *config.ScheduleConfig,
+	logger clog.Logger,
+) *ScheduleService {
+	return &ScheduleService{
+		sessionRepo:   sessionRepo,
+		scheduledRepo: scheduledRepo,
+		idGen:         idGen,
+		config:        cfg,
+		logger:        logger,
+	}
+}
+
+// ScheduleMessage 实现 ScheduleService.ScheduleMessage
+func (s *ScheduleService) ScheduleMessage(ctx context.Context, req *logicv1.ScheduleMessageRequest) (*logicv1.ScheduleMessageResponse, error) {
+	s.logger.Info("schedule message",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.Int64("scheduled_at", req.ScheduledAt))
+
+	if req.Username == "" || req.SessionId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username and session_id are required")
+	}
+	// 创建时校验成员关系，发送时由 SendMessage 再次校验
+	if err := s.checkSessionMember(ctx, req.Username, req.SessionId); err != nil {
+		return nil, err
+	}
+
+	sendReq := &logicv1.SendMessageRequest{
+		SessionId:    req.SessionId,
+		FromUsername: req.Username,
+		Content:      req.Content,
+		Type:         req.Type,
+		Body:         req.Body,
+		ReplyToMsgId: req.ReplyToMsgId,
+		ThreadRootId: req.ThreadRootId,
+		Mentions:     req.Mentions,
+		MentionAll:   req.MentionAll,
+	}
+	scheduledAt, payload, err := s.validate(sendReq, req.ScheduledAt)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.scheduledRepo.CountPendingScheduledMessages(ctx, req.Username)
+	if err != nil {
+		s.logger.Error("failed to count scheduled messages", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to schedule message")
+	}
+	if count >= int64(s.config.GetMaxPending()) {
+		return nil, status.Errorf(codes.ResourceExhausted, "cannot schedule more than %d messages", s.config.GetMaxPending())
+	}
+
+	msg := &model.ScheduledMessage{
+		ScheduleID:     s.idGen.Next(),
+		SessionID:      req.SessionId,
+		SenderUsername: req.Username,
+		Payload:        payload,
+		ScheduledAt:    scheduledAt,
+		Status:         model.ScheduledStatusPending,
+	}
+	if err := s.scheduledRepo.CreateScheduledMessage(ctx, msg); err != nil {
+		s.logger.Error("failed to create scheduled message", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to schedule message")
+	}
+
+	return &logicv1.ScheduleMessageResponse{
+		Scheduled: toScheduledMessage(msg),
+	}, nil
+}
+
+// ListScheduledMessages 实现 ScheduleService.ListScheduledMessages
+func (s *ScheduleService) ListScheduledMessages(ctx context.Context, req *logicv1.ListScheduledMessagesRequest) (*logicv1.ListScheduledMessagesResponse, error) {
+	if req.Username == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username is required")
+	}
+
+	msgs, err := s.scheduledRepo.ListScheduledMessages(ctx, req.Username, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to list scheduled messages", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled messages")
+	}
+
+	result := make([]*gatewayv1.ScheduledMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		result = append(result, toScheduledMessage(msg))
+	}
+	return &logicv1.ListScheduledMessagesResponse{
+		Scheduled: result,
+	}, nil
+}
+
+// UpdateScheduledMessage 实现 ScheduleService.UpdateScheduledMessage
+// 整体替换消息内容与计划时间，会话、引用回复与话题保持不变
+func (s *ScheduleService) UpdateScheduledMessage(ctx context.Context, req *logicv1.UpdateScheduledMessageRequest) (*logicv1.UpdateScheduledMessageResponse, error) {
+	s.logger.Info("update scheduled message",
+		clog.String("username", req.Username),
+		clog.Int64("schedule_id", req.ScheduleId),
+		clog.Int64("scheduled_at", req.ScheduledAt))
+
+	msg, err := s.getOwnScheduledMessage(ctx, req.Username, req.ScheduleId)
+	if err != nil {
+		return nil, err
+	}
+	prev := &logicv1.SendMessageRequest{}
+	if err := proto.Unmarshal(msg.Payload, prev); err != nil {
+		s.logger.Error("failed to unmarshal scheduled message", clog.Int64("schedule_id", msg.ScheduleID), clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to update scheduled message")
+	}
+
+	sendReq := &logicv1.SendMessageRequest{
+		SessionId:    msg.SessionID,
+		FromUsername: msg.SenderUsername,
+		Content:      req.Content,
+		Type:         req.Type,
+		Body:         req.Body,
+		ReplyToMsgId: prev.ReplyToMsgId,
+		ThreadRootId: prev.ThreadRootId,
+		Mentions:     req.Mentions,
+		MentionAll:   req.MentionAll,
+	}
+	scheduledAt, payload, err := s.validate(sendReq, req.ScheduledAt)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.scheduledRepo.UpdateScheduledMessage(ctx, msg.ScheduleID, payload, scheduledAt)
+	if err != nil {
+		s.logger.Error("failed to update scheduled message", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to update scheduled message")
+	}
+	if !updated {
+		return nil, status.Errorf(codes.FailedPrecondition, "scheduled message is no longer pending")
+	}
+
+	msg.Payload = payload
+	msg.ScheduledAt = scheduledAt
+	return &logicv1.UpdateScheduledMessageResponse{
+		Scheduled: toScheduledMessage(msg),
+	}, nil
+}
+
+// CancelScheduledMessage 实现 ScheduleService.CancelScheduledMessage
+func (s *ScheduleService) CancelScheduledMessage(ctx context.Context, req *logicv1.CancelScheduledMessageRequest) (*logicv1.CancelScheduledMessageResponse, error) {
+	s.logger.Info("cancel scheduled message",
+		clog.String("username", req.Username),
+		clog.Int64("schedule_id", req.ScheduleId))
+
+	msg, err := s.getOwnScheduledMessage(ctx, req.Username, req.ScheduleId)
+	if err != nil {
+		return nil, err
+	}
+	// 已取消视为成功（幂等）
+	if msg.Status == model.ScheduledStatusCanceled {
+		return &logicv1.CancelScheduledMessageResponse{}, nil
+	}
+
+	canceled, err := s.scheduledRepo.CancelScheduledMessage(ctx, msg.ScheduleID)
+	if err != nil {
+		s.logger.Error("failed to cancel scheduled message", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to cancel scheduled message")
+	}
+	if !canceled {
+		return nil, status.Errorf(codes.FailedPrecondition, "scheduled message is no longer pending")
+	}
+	return &logicv1.CancelScheduledMessageResponse{}, nil
+}
+
+// validate 校验消息内容与计划时间，返回计划时间与待落库的 payload
+func (s *ScheduleService) validate(req *logicv1.SendMessageRequest, scheduledAtUnix int64) (time.Time, []byte, error) {
+	if req.Body != nil {
+		if _, _, err := normalizeMessageBody(req.Body); err != nil {
+			return time.Time{}, nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+	} else if strings.TrimSpace(req.Content) == "" {
+		return time.Time{}, nil, status.Errorf(codes.InvalidArgument, "content or body is required")
+	}
+
+	scheduledAt := time.Unix(scheduledAtUnix, 0)
+	now := time.Now()
+	if !scheduledAt.After(now) {
+		return time.Time{}, nil, status.Errorf(codes.InvalidArgument, "scheduled_at must be in the future")
+	}
+	if scheduledAt.Sub(now) > s.config.GetMaxDelay() {
+		return time.Time{}, nil, status.Errorf(codes.InvalidArgument, "scheduled_at cannot be more than %s ahead", s.config.GetMaxDelay())
+	}
+
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		s.logger.Error("failed to marshal scheduled message", clog.Error(err))
+		return time.Time{}, nil, status.Errorf(codes.Internal, "failed to schedule message")
+	}
+	return scheduledAt, payload, nil
+}
+
+// getOwnScheduledMessage 获取当前用户自己的定时消息，他人的定时消息视为不存在
+func (s *ScheduleService) getOwnScheduledMessage(ctx context.Context, username string, scheduleID int64) (*model.ScheduledMessage, error) {
+	if username == "" || scheduleID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "username and schedule_id are required")
+	}
+	msg, err := s.scheduledRepo.GetScheduledMessage(ctx, scheduleID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "scheduled message not found")
+		}
+		s.logger.Error("failed to get scheduled message", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get scheduled message")
+	}
+	if msg.SenderUsername != username {
+		return nil, status.Errorf(codes.NotFound, "scheduled message not found")
+	}
+	return msg, nil
+}
+
+// checkSessionMember 校验用户是会话成员
+func (s *ScheduleService) checkSessionMember(ctx context.Context, username, sessionID string) error {
+	if _, err := s.sessionRepo.GetUserSession(ctx, username, sessionID); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return status.Errorf(codes.PermissionDenied, "not a session member")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+	return nil
+}
+
+// scheduledStatuses 定时消息状态到 proto 枚举的映射
+var scheduledStatuses = map[int]gatewayv1.ScheduledMessageStatus{
+	model.ScheduledStatusPending:  gatewayv1.ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_PENDING,
+	model.ScheduledStatusSending:  gatewayv1.ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_SENDING,
+	model.ScheduledStatusSent:     gatewayv1.ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_SENT,
+	model.ScheduledStatusCanceled: gatewayv1.ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_CANCELED,
+	model.ScheduledStatusFailed:   gatewayv1.ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_FAILED,
+}
+
+// toScheduledMessage 将定时消息记录转换为 proto 表示
+func toScheduledMessage(msg *model.ScheduledMessage) *gatewayv1.ScheduledMessage {
+	req := &logicv1.SendMessageRequest{}
+	// payload 由服务端编码，解码失败时仅返回元数据
+	_ = proto.Unmarshal(msg.Payload, req)
+	return &gatewayv1.ScheduledMessage{
+		ScheduleId:   msg.ScheduleID,
+		SessionId:    msg.SessionID,
+		Content:      req.Content,
+		Type:         req.Type,
+		Body:         req.Body,
+		ReplyToMsgId: req.ReplyToMsgId,
+		ThreadRootId: req.ThreadRootId,
+		Mentions:     req.Mentions,
+		MentionAll:   req.MentionAll,
+		ScheduledAt:  msg.ScheduledAt.Unix(),
+		Status:       scheduledStatuses[msg.Status],
+		Error:        msg.Error,
+		MsgId:        msg.MsgID,
+		CreatedAt:    msg.CreatedAt.Unix(),
+	}
+}
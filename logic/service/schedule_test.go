@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testScheduledRepo struct {
+	msgs map[int64]*model.ScheduledMessage
+}
+
+func (r *testScheduledRepo) CreateScheduledMessage(ctx context.Context, msg *model.ScheduledMessage) error {
+	r.msgs[msg.ScheduleID] = msg
+	return nil
+}
+func (r *testScheduledRepo) GetScheduledMessage(ctx context.Context, scheduleID int64) (*model.ScheduledMessage, error) {
+	msg, ok := r.msgs[scheduleID]
+	if !ok {
+		return nil, fmt.Errorf("scheduled message %w: %d", repo.ErrNotFound, scheduleID)
+	}
+	return msg, nil
+}
+func (r *testScheduledRepo) ListScheduledMessages(ctx context.Context, senderUsername, sessionID string) ([]*model.ScheduledMessage, error) {
+	return nil, nil
+}
+func (r *testScheduledRepo) CountPendingScheduledMessages(ctx context.Context, senderUsername string) (int64, error) {
+	return int64(len(r.msgs)), nil
+}
+func (r *testScheduledRepo) UpdateScheduledMessage(ctx context.Context, scheduleID int64, payload []byte, scheduledAt time.Time) (bool, error) {
+	msg := r.msgs[scheduleID]
+	if msg.Status != model.ScheduledStatusPending {
+		return false, nil
+	}
+	msg.Payload, msg.ScheduledAt = payload, scheduledAt
+	return true, nil
+}
+func (r *testScheduledRepo) CancelScheduledMessage(ctx context.Context, scheduleID int64) (bool, error) {
+	msg := r.msgs[scheduleID]
+	if msg.Status != model.ScheduledStatusPending && msg.Status != model.ScheduledStatusFailed {
+		return false, nil
+	}
+	msg.Status = model.ScheduledStatusCanceled
+	return true, nil
+}
+func (r *testScheduledRepo) ClaimDueScheduledMessages(ctx context.Context, now time.Time, claimTimeout time.Duration, limit int) ([]*model.ScheduledMessage, error) {
+	return nil, nil
+}
+func (r *testScheduledRepo) MarkScheduledMessageSent(ctx context.Context, scheduleID, msgID int64) error {
+	return nil
+}
+func (r *testScheduledRepo) MarkScheduledMessageFailed(ctx context.Context, scheduleID int64, reason string) error {
+	return nil
+}
+func (r *testScheduledRepo) Close() error { return nil }
+
+// newScheduleTestService mallory 不是任何会话的成员
+func newScheduleTestService(msgs ...*model.ScheduledMessage) *ScheduleService {
+	sessionRepo := &testSessionRepo{
+		getUserSessionFn: func(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
+			if username == "mallory" {
+				return nil, fmt.Errorf("user session %w: username=%s, session_id=%s", repo.ErrNotFound, username, sessionID)
+			}
+			return &model.SessionMember{SessionID: sessionID, Username: username}, nil
+		},
+	}
+	scheduledRepo := &testScheduledRepo{msgs: make(map[int64]*model.ScheduledMessage)}
+	for _, msg := range msgs {
+		scheduledRepo.msgs[msg.ScheduleID] = msg
+	}
+	return NewScheduleService(sessionRepo, scheduledRepo, nil, &config.ScheduleConfig{MaxPending: 1}, clog.Discard())
+}
+
+func TestScheduleService_ScheduleMessage_Validation(t *testing.T) {
+	future := time.Now().Add(time.Hour).Unix()
+	tests := []struct {
+		name string
+		req  *logicv1.ScheduleMessageRequest
+		code codes.Code
+	}{
+		{"non member", &logicv1.ScheduleMessageRequest{Username: "mallory", SessionId: "s_1", Content: "hi", ScheduledAt: future}, codes.PermissionDenied},
+		{"past time", &logicv1.ScheduleMessageRequest{Username: "alice", SessionId: "s_1", Content: "hi", ScheduledAt: time.Now().Add(-time.Minute).Unix()}, codes.InvalidArgument},
+		{"too far ahead", &logicv1.ScheduleMessageRequest{Username: "alice", SessionId: "s_1", Content: "hi", ScheduledAt: time.Now().Add(365 * 24 * time.Hour).Unix()}, codes.InvalidArgument},
+		{"empty content", &logicv1.ScheduleMessageRequest{Username: "alice", SessionId: "s_1", ScheduledAt: future}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newScheduleTestService().ScheduleMessage(context.Background(), tt.req)
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestScheduleService_ScheduleMessage_PendingLimit(t *testing.T) {
+	svc := newScheduleTestService(&model.ScheduledMessage{ScheduleID: 1, SenderUsername: "alice"})
+	_, err := svc.ScheduleMessage(context.Background(), &logicv1.ScheduleMessageRequest{
+		Username:    "alice",
+		SessionId:   "s_1",
+		Content:     "hi",
+		ScheduledAt: time.Now().Add(time.Hour).Unix(),
+	})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestScheduleService_EditAndCancel(t *testing.T) {
+	newMsg := func(status int) *model.ScheduledMessage {
+		return &model.ScheduledMessage{ScheduleID: 1, SessionID: "s_1", SenderUsername: "alice", Status: status}
+	}
+	ctx := context.Background()
+	future := time.Now().Add(time.Hour).Unix()
+
+	_, err := newScheduleTestService(newMsg(model.ScheduledStatusPending)).CancelScheduledMessage(ctx,
+		&logicv1.CancelScheduledMessageRequest{Username: "bob", ScheduleId: 1})
+	require.Equal(t, codes.NotFound, status.Code(err), "他人的定时消息视为不存在")
+
+	_, err = newScheduleTestService(newMsg(model.ScheduledStatusSent)).CancelScheduledMessage(ctx,
+		&logicv1.CancelScheduledMessageRequest{Username: "alice", ScheduleId: 1})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err), "已发送的消息不可取消")
+
+	_, err = newScheduleTestService(newMsg(model.ScheduledStatusSending)).UpdateScheduledMessage(ctx,
+		&logicv1.UpdateScheduledMessageRequest{Username: "alice", ScheduleId: 1, Content: "edited", ScheduledAt: future})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err), "发送中的消息不可编辑")
+
+	resp, err := newScheduleTestService(newMsg(model.ScheduledStatusPending)).UpdateScheduledMessage(ctx,
+		&logicv1.UpdateScheduledMessageRequest{Username: "alice", ScheduleId: 1, Content: "edited", ScheduledAt: future})
+	require.NoError(t, err)
+	require.Equal(t, "edited", resp.Scheduled.Content)
+	require.Equal(t, future, resp.Scheduled.ScheduledAt)
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"html"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxSearchQueryLength 搜索关键词的最大长度（字符数）
+	maxSearchQueryLength = 200
+	// defaultSearchLimit 默认每页搜索结果数
+	defaultSearchLimit = 20
+	// maxSearchLimit 每页搜索结果数上限
+	maxSearchLimit = 50
+)
+
+// SearchMessages 实现 SessionService.SearchMessages
+// 会话范围由 repo 层关联 t_session_member 限定，指定 session_id 时非成员同样返回空结果
+func (s *SessionService) SearchMessages(ctx context.Context, req *logicv1.SearchMessagesRequest) (*logicv1.SearchMessagesResponse, error) {
+	query := strings.TrimSpace(req.Query)
+	if req.Username == "" || query == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username and query are required")
+	}
+	if utf8.RuneCountInString(query) > maxSearchQueryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "query exceeds %d characters", maxSearchQueryLength)
+	}
+	if req.StartTime > 0 && req.EndTime > 0 && req.EndTime <= req.StartTime {
+		return nil, status.Errorf(codes.InvalidArgument, "end_time must be after start_time")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	filter := &repo.MessageSearchFilter{
+		Username:       req.Username,
+		Query:          query,
+		SessionID:      req.SessionId,
+		SenderUsername: req.SenderUsername,
+		MsgType:        req.MsgType,
+		BeforeMsgID:    req.Cursor,
+		Limit:          limit + 1, // 多取一条用于判断是否还有下一页
+	}
+	if req.StartTime > 0 {
+		filter.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		filter.EndTime = time.Unix(req.EndTime, 0)
+	}
+
+	items, err := s.messageRepo.SearchMessages(ctx, filter)
+	if err != nil {
+		s.logger.Error("failed to search messages", clog.String("username", req.Username), clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to search messages")
+	}
+
+	hasMore := len(items) > limit
+	if hasMore {
+		items = items[:limit]
+	}
+
+	results := make([]*gatewayv1.SearchResult, 0, len(items))
+	pushMsgs := make([]*gatewayv1.PushMessage, 0, len(items))
+	for _, item := range items {
+		pushMsg := toPushMessage(item.Message)
+		pushMsgs = append(pushMsgs, pushMsg)
+		results = append(results, &gatewayv1.SearchResult{
+			Message: pushMsg,
+			Snippet: highlightSnippet(item.Snippet),
+		})
+	}
+	attachReactions(ctx, s.messageRepo, pushMsgs, req.Username, s.logger)
+	attachPolls(ctx, s.messageRepo, pushMsgs, req.Username, s.logger)
+
+	var nextCursor int64
+	if len(items) > 0 {
+		nextCursor = items[len(items)-1].Message.MsgID
+	}
+
+	return &logicv1.SearchMessagesResponse{
+		Results:    results,
+		NextCursor: nextCursor,
+		HasMore:    hasMore,
+	}, nil
+}
+
+// highlightSnippet 对摘要做 HTML 转义，并将 repo 返回的命中标记替换为 <mark></mark>
+func highlightSnippet(snippet string) string {
+	escaped := html.EscapeString(snippet)
+	escaped = strings.ReplaceAll(escaped, repo.SearchHighlightStart, "<mark>")
+	return strings.ReplaceAll(escaped, repo.SearchHighlightStop, "</mark>")
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -71,17 +72,23 @@ func (s *SessionService) GetSessionList(ctx context.Context, req *logicv1.GetSes
 		}, nil
 	}
 
-	// 批量查询最后一条消息（避免 N+1 查询）
+	// 批量查询最后一条可见消息（避免 N+1 查询，排除用户已删除的消息）
 	sessionIDs := make([]string, len(sessions))
 	for i, sess := range sessions {
 		sessionIDs[i] = sess.SessionID
 	}
-	lastMessages, _ := s.messageRepo.GetLastMessagesBatch(ctx, sessionIDs)
+	lastMessages, _ := s.messageRepo.GetLastMessagesBatch(ctx, req.Username, sessionIDs)
 	msgMap := make(map[string]*model.MessageContent)
 	for _, msg := range lastMessages {
 		msgMap[msg.SessionID] = msg
 	}
 
+	// 批量统计未读提及数（失败时降级为 0，不影响会话列表）
+	mentionCounts, err := s.messageRepo.GetUnreadMentionCounts(ctx, req.Username)
+	if err != nil {
+		s.logger.Warn("failed to get unread mention counts", clog.Error(err))
+	}
+
 	// 批量查询用户会话信息（避免 N+1 查询）
 	userSessions, _ := s.sessionRepo.GetUserSessionsBatch(ctx, req.Username, sessionIDs)
 	userSessMap := make(map[string]*model.SessionMember)
@@ -127,11 +134,17 @@ func (s *SessionService) GetSessionList(ctx context.Context, req *logicv1.GetSes
 		}
 
 		if msg, ok := msgMap[sess.SessionID]; ok {
+			lastMsg.MsgId = msg.MsgID
 			lastMsg.SeqId = msg.SeqID
 			lastMsg.Content = msg.Content
 			lastMsg.Type = msg.MsgType
 			lastMsg.Timestamp = msg.CreatedAt.Unix()
 			lastMsg.FromUsername = msg.SenderUsername
+			lastMsg.ReplyToMsgId = msg.ReplyToMsgID
+			applyEditInfo(lastMsg, msg.EditVersion, msg.EditedAt)
+			if msg.Recalled {
+				applyRecallTombstone(lastMsg, msg.RecalledBy)
+			}
 		}
 
 		// 获取用户会话信息（包含未读数）
@@ -157,18 +170,23 @@ func (s *SessionService) GetSessionList(ctx context.Context, req *logicv1.GetSes
 		}
 
 		lastReadSeq := int64(0)
+		doNotDisturb := false
 		if userSess != nil {
 			lastReadSeq = userSess.LastReadSeq
+			doNotDisturb = userSess.DoNotDisturb
 		}
 
 		sessionInfos = append(sessionInfos, &logicv1.SessionInfo{
-			SessionId:   sess.SessionID,
-			Name:        sessionName,
-			Type:        int32(sess.Type),
-			AvatarUrl:   "",
-			UnreadCount: unread,
-			LastReadSeq: lastReadSeq,
-			LastMessage: lastMsg,
+			SessionId:    sess.SessionID,
+			Name:         sessionName,
+			Type:         int32(sess.Type),
+			AvatarUrl:    "",
+			UnreadCount:  unread,
+			MentionCount: mentionCounts[sess.SessionID],
+			LastReadSeq:  lastReadSeq,
+			LastMessage:  lastMsg,
+			MessageTtl:   sess.MessageTTL,
+			DoNotDisturb: doNotDisturb,
 		})
 	}
 
@@ -214,7 +232,7 @@ func (s *SessionService) CreateSession(ctx context.Context, req *logicv1.CreateS
 	if err := s.sessionRepo.AddMember(ctx, &model.SessionMember{
 		SessionID: sessionID,
 		Username:  req.CreatorUsername,
-		Role:      1, // 创建者是管理员
+		Role:      model.MemberRoleOwner, // 创建者是群主（单聊不区分角色）
 	}); err != nil {
 		s.logger.Error("failed to add creator to session", clog.Error(err))
 	}
@@ -225,7 +243,7 @@ func (s *SessionService) CreateSession(ctx context.Context, req *logicv1.CreateS
 			if err := s.sessionRepo.AddMember(ctx, &model.SessionMember{
 				SessionID: sessionID,
 				Username:  member,
-				Role:      0, // 普通成员
+				Role:      model.MemberRoleMember,
 			}); err != nil {
 				s.logger.Error("failed to add member to session", clog.Error(err), clog.String("member", member))
 			}
@@ -251,13 +269,47 @@ func (s *SessionService) sendSessionCreatedSystemMessage(ctx context.Context, se
 		return nil
 	}
 
-	// 生成消息 ID 和 SeqID
+	// 单聊未命名时以创建者昵称作为会话名称
+	sessionName := req.Name
+	if req.Type == 1 && sessionName == "" {
+		if user, err := s.userRepo.GetUserByUsername(ctx, req.CreatorUsername); err == nil {
+			sessionName = user.Nickname
+		} else {
+			sessionName = req.CreatorUsername
+		}
+	}
+
+	// 结构化系统消息体，code 供客户端本地化
+	system := &gatewayv1.SystemBody{Code: "session_created", Text: content}
+	return s.sendSystemMessage(ctx, sessionID, system, sessionName, int32(req.Type))
+}
+
+// sendSystemMessage 以 system 身份在会话中发送系统消息，经 Outbox 实时推送给全部成员
+// sessionName 与 sessionType 随推送携带，避免前端额外查询会话信息
+func (s *SessionService) sendSystemMessage(ctx context.Context, sessionID string, system *gatewayv1.SystemBody, sessionName string, sessionType int32) error {
+	body := &gatewayv1.MessageBody{
+		Body: &gatewayv1.MessageBody_System{System: system},
+	}
+	bodyData, err := marshalMessageBody(body)
+	if err != nil {
+		return fmt.Errorf("marshal message body: %w", err)
+	}
+
+	// 生成消息 ID 和 SeqID（会话已有历史消息时，先以 MaxSeqID 初始化 Redis 计数器）
+	// 会话读取失败时不发送：无法确定保留时长，消息不能以永久保留落库
 	msgID := s.msgIDGen.Next()
+	session, err := s.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("get session: %w", err)
+	}
+	if session != nil && session.MaxSeqID > 0 {
+		s.sequencer.SetIfNotExists(ctx, sessionID, session.MaxSeqID)
+	}
+	expiresAt := messageExpiresAt(session, time.Now())
 	seqID, err := s.sequencer.Next(ctx, sessionID)
 	if err != nil {
 		return fmt.Errorf("generate seq id: %w", err)
 	}
-	timestamp := time.Now().Unix()
 
 	// 保存消息到数据库
 	msgContent := &model.MessageContent{
@@ -265,38 +317,25 @@ func (s *SessionService) sendSessionCreatedSystemMessage(ctx context.Context, se
 		SessionID:      sessionID,
 		SenderUsername: "system",
 		SeqID:          seqID,
-		Content:        content,
-		MsgType:        "system",
-	}
-
-	// 收集所有接收者
-	toUsernames := make([]string, 0, len(req.Members))
-	for _, member := range req.Members {
-		if member != req.CreatorUsername {
-			toUsernames = append(toUsernames, member)
-		}
+		Content:        system.Text,
+		MsgType:        model.MessageTypeSystem,
+		Body:           bodyData,
+		ExpiresAt:      expiresAt,
 	}
 
 	// 准备 MQ 事件
-	sessionName := req.Name
-	if req.Type == 1 && sessionName == "" {
-		if user, err := s.userRepo.GetUserByUsername(ctx, req.CreatorUsername); err == nil {
-			sessionName = user.Nickname
-		} else {
-			sessionName = req.CreatorUsername
-		}
-	}
-
 	event := &mqv1.PushEvent{
 		MsgId:        msgID,
 		SeqId:        seqID,
 		SessionId:    sessionID,
 		FromUsername: "system",
-		Content:      content,
-		Type:         "system",
-		Timestamp:    timestamp,
+		Content:      system.Text,
+		Type:         model.MessageTypeSystem,
+		Timestamp:    time.Now().Unix(),
 		SessionName:  sessionName,
-		SessionType:  int32(req.Type),
+		SessionType:  sessionType,
+		Body:         body,
+		ExpiresAt:    expiresAtUnix(expiresAt),
 	}
 
 	// 发布消息到 MQ 并保存到 Outbox
@@ -312,7 +351,7 @@ func (s *SessionService) sendSessionCreatedSystemMessage(ctx context.Context, se
 		clog.Int64("msg_id", msgID),
 		clog.Int64("seq_id", seqID),
 		clog.String("session_id", sessionID),
-		clog.Int("recipients", len(toUsernames)))
+		clog.String("code", system.Code))
 
 	return nil
 }
@@ -358,14 +397,14 @@ func (s *SessionService) GetHistoryMessages(ctx context.Context, req *logicv1.Ge
 			clog.String("username", req.Username),
 			clog.String("session_id", req.SessionId),
 			clog.Error(err))
-		if strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, repo.ErrNotFound) {
 			return nil, status.Errorf(codes.PermissionDenied, "no permission to access session")
 		}
 		return nil, status.Errorf(codes.Internal, "failed to verify session permission")
 	}
 
 	// 获取历史消息
-	messages, err := s.messageRepo.GetHistoryMessages(ctx, req.SessionId, req.BeforeSeq, limit)
+	messages, err := s.messageRepo.GetHistoryMessages(ctx, req.SessionId, req.Username, req.BeforeSeq, limit)
 	if err != nil {
 		s.logger.Error("failed to get history messages", clog.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to get history messages")
@@ -374,17 +413,12 @@ func (s *SessionService) GetHistoryMessages(ctx context.Context, req *logicv1.Ge
 	// 转换为 PushMessage 格式
 	pushMessages := make([]*gatewayv1.PushMessage, 0, len(messages))
 	for _, msg := range messages {
-		pushMessages = append(pushMessages, &gatewayv1.PushMessage{
-			MsgId:        msg.MsgID,
-			SeqId:        msg.SeqID,
-			SessionId:    req.SessionId,
-			FromUsername: msg.SenderUsername,
-			ToUsername:   "",
-			Content:      msg.Content,
-			Type:         msg.MsgType,
-			Timestamp:    msg.CreatedAt.Unix(),
-		})
+		pushMessages = append(pushMessages, toPushMessage(msg))
 	}
+	attachQuotedMessages(ctx, s.messageRepo, pushMessages, req.Username, s.logger)
+	attachReactions(ctx, s.messageRepo, pushMessages, req.Username, s.logger)
+	attachPolls(ctx, s.messageRepo, pushMessages, req.Username, s.logger)
+	attachMentions(ctx, s.messageRepo, pushMessages, s.logger)
 
 	return &logicv1.GetHistoryMessagesResponse{
 		Messages: pushMessages,
@@ -462,12 +496,33 @@ func (s *SessionService) UpdateReadPosition(ctx context.Context, req *logicv1.Up
 		clog.String("username", req.Username),
 		clog.Int64("seq_id", req.SeqId))
 
+	// 记录更新前的已读位置，用于判断是否推进并确定已读区间
+	prevReadSeq := int64(-1)
+	if member, err := s.sessionRepo.GetUserSession(ctx, req.Username, req.SessionId); err == nil && member != nil {
+		prevReadSeq = member.LastReadSeq
+	}
+
 	// 更新已读位置
 	if err := s.sessionRepo.UpdateLastReadSeq(ctx, req.SessionId, req.Username, req.SeqId); err != nil {
 		s.logger.Error("failed to update read position", clog.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to update read position")
 	}
 
+	// 已读位置推进时发布已读事件，由 Task 聚合后推送已读回执给消息发送者
+	if prevReadSeq >= 0 && req.SeqId > prevReadSeq {
+		event := &mqv1.PushEvent{
+			SeqId:            req.SeqId,
+			SessionId:        req.SessionId,
+			Timestamp:        time.Now().Unix(),
+			EventType:        mqv1.EventType_EVENT_TYPE_READ,
+			OperatorUsername: req.Username,
+			ReadFromSeq:      prevReadSeq,
+		}
+		if err := PublishEventToMQAsync(ctx, s.mqClient, event, s.logger); err != nil {
+			s.logger.Warn("failed to publish read event", clog.Error(err))
+		}
+	}
+
 	// 获取当前会话最新 seq_id 以计算未读数
 	session, err := s.sessionRepo.GetSession(ctx, req.SessionId)
 	if err != nil {
@@ -509,27 +564,243 @@ func (s *SessionService) PullInboxDelta(ctx context.Context, req *logicv1.PullIn
 	events := make([]*logicv1.InboxEvent, 0, len(items))
 	nextCursorID := req.CursorId
 	for _, item := range items {
+		pushMsg := &gatewayv1.PushMessage{
+			MsgId:        item.MsgID,
+			SeqId:        item.SeqID,
+			SessionId:    item.SessionID,
+			FromUsername: item.SenderUsername,
+			ToUsername:   req.Username,
+			Content:      item.Content,
+			Type:         item.MsgType,
+			Timestamp:    item.CreatedAt.Unix(),
+			ReplyToMsgId: item.ReplyToMsgID,
+			MentionAll:   item.MentionAll,
+			Body:         unmarshalMessageBody(item.Body),
+			ExpiresAt:    expiresAtUnix(item.ExpiresAt),
+			FromBot:      item.FromBot,
+		}
+		applyEditInfo(pushMsg, item.EditVersion, item.EditedAt)
+		if item.Recalled {
+			applyRecallTombstone(pushMsg, item.RecalledBy)
+		}
 		events = append(events, &logicv1.InboxEvent{
 			InboxId: item.InboxID,
-			Message: &gatewayv1.PushMessage{
-				MsgId:        item.MsgID,
-				SeqId:        item.SeqID,
-				SessionId:    item.SessionID,
-				FromUsername: item.SenderUsername,
-				ToUsername:   req.Username,
-				Content:      item.Content,
-				Type:         item.MsgType,
-				Timestamp:    item.CreatedAt.Unix(),
-			},
+			Message: pushMsg,
 		})
 		if item.InboxID > nextCursorID {
 			nextCursorID = item.InboxID
 		}
 	}
 
+	pushMessages := make([]*gatewayv1.PushMessage, 0, len(events))
+	for _, e := range events {
+		pushMessages = append(pushMessages, e.Message)
+	}
+	attachQuotedMessages(ctx, s.messageRepo, pushMessages, req.Username, s.logger)
+	attachReactions(ctx, s.messageRepo, pushMessages, req.Username, s.logger)
+	attachPolls(ctx, s.messageRepo, pushMessages, req.Username, s.logger)
+	attachMentions(ctx, s.messageRepo, pushMessages, s.logger)
+
 	return &logicv1.PullInboxDeltaResponse{
 		Events:       events,
 		NextCursorId: nextCursorID,
 		HasMore:      len(items) == limit,
 	}, nil
 }
+
+// GetThreadMessages 实现 SessionService.GetThreadMessages
+func (s *SessionService) GetThreadMessages(ctx context.Context, req *logicv1.GetThreadMessagesRequest) (*logicv1.GetThreadMessagesResponse, error) {
+	s.logger.Info("get thread messages",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.Int64("root_msg_id", req.RootMsgId),
+		clog.Int64("after_seq", req.AfterSeq))
+
+	root, err := s.getThreadRoot(ctx, req.Username, req.SessionId, req.RootMsgId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	messages, err := s.messageRepo.GetThreadMessages(ctx, root.MsgID, req.Username, req.AfterSeq, limit)
+	if err != nil {
+		s.logger.Error("failed to get thread messages", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get thread messages")
+	}
+
+	// 根消息与话题消息一并补充引用、回应、投票与提及，与历史消息保持一致
+	rootMsg := toPushMessage(root)
+	pushMessages := make([]*gatewayv1.PushMessage, 0, len(messages))
+	for _, msg := range messages {
+		pushMessages = append(pushMessages, toPushMessage(msg))
+	}
+	all := append([]*gatewayv1.PushMessage{rootMsg}, pushMessages...)
+	attachQuotedMessages(ctx, s.messageRepo, all, req.Username, s.logger)
+	attachReactions(ctx, s.messageRepo, all, req.Username, s.logger)
+	attachPolls(ctx, s.messageRepo, all, req.Username, s.logger)
+	attachMentions(ctx, s.messageRepo, all, s.logger)
+
+	following := false
+	followers, err := s.messageRepo.GetThreadFollowers(ctx, root.MsgID)
+	if err != nil {
+		s.logger.Warn("failed to get thread followers", clog.Error(err))
+	}
+	for _, f := range followers {
+		if f == req.Username {
+			following = true
+			break
+		}
+	}
+
+	return &logicv1.GetThreadMessagesResponse{
+		Root:      rootMsg,
+		Messages:  pushMessages,
+		Following: following,
+	}, nil
+}
+
+// FollowThread 实现 SessionService.FollowThread
+func (s *SessionService) FollowThread(ctx context.Context, req *logicv1.FollowThreadRequest) (*logicv1.FollowThreadResponse, error) {
+	root, err := s.getThreadRoot(ctx, req.Username, req.SessionId, req.RootMsgId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.messageRepo.FollowThread(ctx, root.MsgID, req.Username); err != nil {
+		s.logger.Error("failed to follow thread", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to follow thread")
+	}
+
+	return &logicv1.FollowThreadResponse{}, nil
+}
+
+// UnfollowThread 实现 SessionService.UnfollowThread
+func (s *SessionService) UnfollowThread(ctx context.Context, req *logicv1.UnfollowThreadRequest) (*logicv1.UnfollowThreadResponse, error) {
+	root, err := s.getThreadRoot(ctx, req.Username, req.SessionId, req.RootMsgId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.messageRepo.UnfollowThread(ctx, root.MsgID, req.Username); err != nil {
+		s.logger.Error("failed to unfollow thread", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to unfollow thread")
+	}
+
+	return &logicv1.UnfollowThreadResponse{}, nil
+}
+
+// SetDoNotDisturb 实现 SessionService.SetDoNotDisturb
+// 免打扰只影响推送是否静默，消息照常写入信箱并计入未读
+func (s *SessionService) SetDoNotDisturb(ctx context.Context, req *logicv1.SetDoNotDisturbRequest) (*logicv1.SetDoNotDisturbResponse, error) {
+	if req.Username == "" || req.SessionId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username and session_id are required")
+	}
+
+	if _, err := s.sessionRepo.GetUserSession(ctx, req.Username, req.SessionId); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.PermissionDenied, "no permission to access session")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+
+	if err := s.sessionRepo.SetDoNotDisturb(ctx, req.SessionId, req.Username, req.Enabled); err != nil {
+		s.logger.Error("failed to set do not disturb", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to set do not disturb")
+	}
+
+	return &logicv1.SetDoNotDisturbResponse{}, nil
+}
+
+// getThreadRoot 校验会话成员关系并获取话题根消息
+func (s *SessionService) getThreadRoot(ctx context.Context, username, sessionID string, rootMsgID int64) (*model.MessageContent, error) {
+	if username == "" || sessionID == "" || rootMsgID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "username, session_id and root_msg_id are required")
+	}
+
+	if _, err := s.sessionRepo.GetUserSession(ctx, username, sessionID); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.PermissionDenied, "no permission to access session")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+
+	// 根消息须对用户可见（未删除且不早于入群），否则其话题同样不可见
+	roots, err := s.messageRepo.GetMessagesByIDs(ctx, []int64{rootMsgID}, username)
+	if err != nil {
+		s.logger.Error("failed to get thread root", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get thread root")
+	}
+	if len(roots) == 0 {
+		return nil, status.Errorf(codes.NotFound, "thread root not found")
+	}
+	root := roots[0]
+	if root.SessionID != sessionID || root.ThreadRootID != 0 {
+		return nil, status.Errorf(codes.NotFound, "thread root not found")
+	}
+
+	return root, nil
+}
+
+// GetMessageReadStatus 实现 SessionService.GetMessageReadStatus
+// 已读成员为 last_read_seq >= 消息 seq_id 的成员，仅仍在会话中的消息发送者可查询
+func (s *SessionService) GetMessageReadStatus(ctx context.Context, req *logicv1.GetMessageReadStatusRequest) (*logicv1.GetMessageReadStatusResponse, error) {
+	if req.Username == "" || req.SessionId == "" || req.MsgId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "username, session_id and msg_id are required")
+	}
+
+	// 先校验会话成员关系：已退出会话的发送者不能再查看成员的阅读进度
+	if _, err := s.sessionRepo.GetUserSession(ctx, req.Username, req.SessionId); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.PermissionDenied, "no permission to access session")
+		}
+		s.logger.Error("failed to get session member", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify session permission")
+	}
+
+	msg, err := s.messageRepo.GetMessage(ctx, req.MsgId)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message not found")
+		}
+		s.logger.Error("failed to get message", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get message")
+	}
+	if msg.SessionID != req.SessionId || msg.ThreadRootID != 0 {
+		return nil, status.Errorf(codes.NotFound, "message not found")
+	}
+	if msg.SenderUsername != req.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "only sender can view read status")
+	}
+
+	members, err := s.sessionRepo.GetMembers(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to get session members", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get session members")
+	}
+
+	resp := &logicv1.GetMessageReadStatusResponse{
+		ReadUsernames:   make([]string, 0),
+		UnreadUsernames: make([]string, 0),
+	}
+	for _, m := range members {
+		if m.Username == msg.SenderUsername {
+			continue
+		}
+		if m.LastReadSeq >= msg.SeqID {
+			resp.ReadUsernames = append(resp.ReadUsernames, m.Username)
+		} else {
+			resp.UnreadUsernames = append(resp.UnreadUsernames, m.Username)
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/mq"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testMQ struct{}
+
+func (testMQ) Publish(ctx context.Context, topic string, data []byte, opts ...mq.PublishOption) error {
+	return nil
+}
+func (testMQ) Subscribe(ctx context.Context, topic string, handler mq.Handler, opts ...mq.SubscribeOption) (mq.Subscription, error) {
+	return nil, nil
+}
+func (testMQ) Close() error { return nil }
+
+type hideCall struct {
+	msgIDs     []int64
+	clearedSeq int64
+}
+
+// newHideTestService alice 是 s_1 的成员（已清空至 seq 3），mallory 不是成员；s_1 最新消息 seq 为 10
+func newHideTestService(calls *[]hideCall) *SessionService {
+	sessionRepo := &testSessionRepo{
+		getUserSessionFn: func(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
+			if username == "mallory" {
+				return nil, fmt.Errorf("user session %w: username=%s, session_id=%s", repo.ErrNotFound, username, sessionID)
+			}
+			return &model.SessionMember{SessionID: sessionID, Username: username, ClearedSeq: 3}, nil
+		},
+	}
+	messageRepo := &testMessageRepo{
+		getMessagesByIDsFn: func(ctx context.Context, msgIDs []int64, username string) ([]*model.MessageContent, error) {
+			msgs := make([]*model.MessageContent, 0, len(msgIDs))
+			for _, id := range msgIDs {
+				sessionID := "s_1"
+				if id == 99 {
+					sessionID = "s_other"
+				}
+				msgs = append(msgs, &model.MessageContent{MsgID: id, SessionID: sessionID})
+			}
+			return msgs, nil
+		},
+		hideMessagesFn: func(ctx context.Context, username, sessionID string, msgIDs []int64, clearedSeq int64, outbox *model.MessageOutbox) error {
+			*calls = append(*calls, hideCall{msgIDs: msgIDs, clearedSeq: clearedSeq})
+			return nil
+		},
+		getLastMessageFn: func(ctx context.Context, sessionID string) (*model.MessageContent, error) {
+			return &model.MessageContent{SessionID: sessionID, SeqID: 10}, nil
+		},
+	}
+	return NewSessionService(sessionRepo, messageRepo, &testUserRepo{}, nil, nil, nil, testMQ{}, clog.Discard())
+}
+
+func TestSessionService_DeleteMessagesForMe_Validation(t *testing.T) {
+	var calls []hideCall
+	svc := newHideTestService(&calls)
+	ctx := context.Background()
+
+	_, err := svc.DeleteMessagesForMe(ctx, &logicv1.DeleteMessagesForMeRequest{Username: "alice", SessionId: "s_1"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = svc.DeleteMessagesForMe(ctx, &logicv1.DeleteMessagesForMeRequest{Username: "mallory", SessionId: "s_1", MsgIds: []int64{1}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = svc.DeleteMessagesForMe(ctx, &logicv1.DeleteMessagesForMeRequest{Username: "alice", SessionId: "s_1", MsgIds: []int64{1, 99}})
+	require.Equal(t, codes.NotFound, status.Code(err), "不能删除其他会话的消息")
+	require.Empty(t, calls)
+}
+
+func TestSessionService_DeleteMessagesForMe_ClearHistory(t *testing.T) {
+	var calls []hideCall
+	svc := newHideTestService(&calls)
+	ctx := context.Background()
+
+	// 清空位点不超过会话最新消息
+	resp, err := svc.DeleteMessagesForMe(ctx, &logicv1.DeleteMessagesForMeRequest{Username: "alice", SessionId: "s_1", ClearUpToSeq: 100})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), resp.ClearedSeq)
+	require.Len(t, calls, 1)
+	require.Empty(t, calls[0].msgIDs)
+	require.Equal(t, int64(10), calls[0].clearedSeq)
+
+	// 位点未推进时不重复写入
+	resp, err = svc.DeleteMessagesForMe(ctx, &logicv1.DeleteMessagesForMeRequest{Username: "alice", SessionId: "s_1", ClearUpToSeq: 2})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), resp.ClearedSeq)
+	require.Len(t, calls, 1)
+
+	_, err = svc.DeleteMessagesForMe(ctx, &logicv1.DeleteMessagesForMeRequest{Username: "alice", SessionId: "s_1", MsgIds: []int64{5, 5, 6}})
+	require.NoError(t, err)
+	require.Equal(t, []int64{5, 6}, calls[1].msgIDs)
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
 	"testing"
 	"time"
 
@@ -17,12 +18,22 @@ import (
 
 type testSessionRepo struct {
 	getUserSessionFn func(ctx context.Context, username, sessionID string) (*model.SessionMember, error)
+	getMembersFn     func(ctx context.Context, sessionID string) ([]*model.SessionMember, error)
+	getSessionFn     func(ctx context.Context, sessionID string) (*model.Session, error)
+	addMembersFn     func(ctx context.Context, members []*model.SessionMember, outbox *model.MessageOutbox) ([]string, error)
+	removeMemberFn   func(ctx context.Context, sessionID, username string, outbox *model.MessageOutbox) (bool, error)
+	updateRoleFn     func(ctx context.Context, sessionID, username string, role int) (bool, error)
+	transferFn       func(ctx context.Context, sessionID, from, to string) error
+	setMuteFn        func(ctx context.Context, sessionID, username string, muted bool, until *time.Time) (bool, error)
 }
 
 func (r *testSessionRepo) CreateSession(ctx context.Context, session *model.Session) error {
 	return nil
 }
 func (r *testSessionRepo) GetSession(ctx context.Context, sessionID string) (*model.Session, error) {
+	if r.getSessionFn != nil {
+		return r.getSessionFn(ctx, sessionID)
+	}
 	return nil, nil
 }
 func (r *testSessionRepo) GetUserSession(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
@@ -41,6 +52,9 @@ func (r *testSessionRepo) AddMember(ctx context.Context, member *model.SessionMe
 	return nil
 }
 func (r *testSessionRepo) GetMembers(ctx context.Context, sessionID string) ([]*model.SessionMember, error) {
+	if r.getMembersFn != nil {
+		return r.getMembersFn(ctx, sessionID)
+	}
 	return nil, nil
 }
 func (r *testSessionRepo) UpdateMaxSeqID(ctx context.Context, sessionID string, newSeqID int64) error {
@@ -52,24 +66,88 @@ func (r *testSessionRepo) GetContactList(ctx context.Context, username string) (
 func (r *testSessionRepo) UpdateLastReadSeq(ctx context.Context, sessionID, username string, lastReadSeq int64) error {
 	return nil
 }
+func (r *testSessionRepo) UpdateMessageTTL(ctx context.Context, sessionID string, ttlSeconds int64) (bool, error) {
+	return true, nil
+}
+func (r *testSessionRepo) AddMembersWithOutbox(ctx context.Context, members []*model.SessionMember, outbox *model.MessageOutbox) ([]string, error) {
+	if r.addMembersFn != nil {
+		return r.addMembersFn(ctx, members, outbox)
+	}
+	return nil, nil
+}
+func (r *testSessionRepo) RemoveMemberWithOutbox(ctx context.Context, sessionID, username string, outbox *model.MessageOutbox) (bool, error) {
+	if r.removeMemberFn != nil {
+		return r.removeMemberFn(ctx, sessionID, username, outbox)
+	}
+	return false, nil
+}
+func (r *testSessionRepo) UpdateMemberRole(ctx context.Context, sessionID, username string, role int) (bool, error) {
+	if r.updateRoleFn != nil {
+		return r.updateRoleFn(ctx, sessionID, username, role)
+	}
+	return false, nil
+}
+func (r *testSessionRepo) TransferOwnership(ctx context.Context, sessionID, from, to string) error {
+	if r.transferFn != nil {
+		return r.transferFn(ctx, sessionID, from, to)
+	}
+	return nil
+}
+func (r *testSessionRepo) UpdateSessionName(ctx context.Context, sessionID, name string) (bool, error) {
+	return false, nil
+}
+func (r *testSessionRepo) SetMemberMute(ctx context.Context, sessionID, username string, muted bool, until *time.Time) (bool, error) {
+	if r.setMuteFn != nil {
+		return r.setMuteFn(ctx, sessionID, username, muted, until)
+	}
+	return true, nil
+}
+func (r *testSessionRepo) SetDoNotDisturb(ctx context.Context, sessionID, username string, enabled bool) error {
+	return nil
+}
 func (r *testSessionRepo) Close() error { return nil }
 
 type testMessageRepo struct {
-	historyCalled bool
+	historyCalled      bool
+	followers          map[int64]map[string]bool // 为 nil 时不记录话题关注
+	getHistoryFn       func(ctx context.Context, sessionID, username string, beforeSeq int64, limit int) ([]*model.MessageContent, error)
+	getMessageFn       func(ctx context.Context, msgID int64) (*model.MessageContent, error)
+	getMessagesByIDsFn func(ctx context.Context, msgIDs []int64, username string) ([]*model.MessageContent, error)
+	searchMessagesFn   func(ctx context.Context, filter *repo.MessageSearchFilter) ([]*repo.MessageSearchItem, error)
+	getLastMessageFn   func(ctx context.Context, sessionID string) (*model.MessageContent, error)
+	hideMessagesFn     func(ctx context.Context, username, sessionID string, msgIDs []int64, clearedSeq int64, outbox *model.MessageOutbox) error
+	castVoteFn         func(ctx context.Context, poll *model.Poll, username string, options []int32, outbox *model.MessageOutbox) (bool, error)
+	getPollTalliesFn   func(ctx context.Context, msgIDs []int64, username string) ([]*repo.PollTally, error)
+	editMessageFn      func(ctx context.Context, revision *model.MessageRevision, newContent string, editedAt time.Time, outbox *model.MessageOutbox) error
+	getThreadFn        func(ctx context.Context, rootMsgID int64, username string, afterSeq int64, limit int) ([]*model.MessageContent, error)
+	recallMessageFn    func(ctx context.Context, msgID int64, recalledBy string, recalledAt time.Time, outbox *model.MessageOutbox) error
+	saved              []*model.MessageContent
 }
 
 func (r *testMessageRepo) SaveMessage(ctx context.Context, msg *model.MessageContent) error {
 	return nil
 }
+func (r *testMessageRepo) GetMessage(ctx context.Context, msgID int64) (*model.MessageContent, error) {
+	if r.getMessageFn != nil {
+		return r.getMessageFn(ctx, msgID)
+	}
+	return nil, nil
+}
 func (r *testMessageRepo) SaveInbox(ctx context.Context, inboxes []*model.Inbox) error { return nil }
-func (r *testMessageRepo) GetHistoryMessages(ctx context.Context, sessionID string, beforeSeq int64, limit int) ([]*model.MessageContent, error) {
+func (r *testMessageRepo) GetHistoryMessages(ctx context.Context, sessionID, username string, beforeSeq int64, limit int) ([]*model.MessageContent, error) {
 	r.historyCalled = true
+	if r.getHistoryFn != nil {
+		return r.getHistoryFn(ctx, sessionID, username, beforeSeq, limit)
+	}
 	return nil, nil
 }
 func (r *testMessageRepo) GetLastMessage(ctx context.Context, sessionID string) (*model.MessageContent, error) {
+	if r.getLastMessageFn != nil {
+		return r.getLastMessageFn(ctx, sessionID)
+	}
 	return nil, nil
 }
-func (r *testMessageRepo) GetLastMessagesBatch(ctx context.Context, sessionIDs []string) ([]*model.MessageContent, error) {
+func (r *testMessageRepo) GetLastMessagesBatch(ctx context.Context, username string, sessionIDs []string) ([]*model.MessageContent, error) {
 	return nil, nil
 }
 func (r *testMessageRepo) GetUnreadMessages(ctx context.Context, username string, limit int) ([]*model.Inbox, error) {
@@ -78,7 +156,100 @@ func (r *testMessageRepo) GetUnreadMessages(ctx context.Context, username string
 func (r *testMessageRepo) GetInboxDelta(ctx context.Context, username string, cursorID int64, limit int) ([]*repo.InboxDeltaItem, error) {
 	return nil, nil
 }
-func (r *testMessageRepo) SaveMessageWithOutbox(ctx context.Context, msg *model.MessageContent, outbox *model.MessageOutbox) error {
+func (r *testMessageRepo) SaveMessageWithOutbox(ctx context.Context, msg *model.MessageContent, attachmentIDs []int64, outbox *model.MessageOutbox) error {
+	r.saved = append(r.saved, msg)
+	return nil
+}
+func (r *testMessageRepo) RecallMessageWithOutbox(ctx context.Context, msgID int64, recalledBy string, recalledAt time.Time, outbox *model.MessageOutbox) error {
+	if r.recallMessageFn != nil {
+		return r.recallMessageFn(ctx, msgID, recalledBy, recalledAt, outbox)
+	}
+	return nil
+}
+func (r *testMessageRepo) GetMessagesByIDs(ctx context.Context, msgIDs []int64, username string) ([]*model.MessageContent, error) {
+	if r.getMessagesByIDsFn != nil {
+		return r.getMessagesByIDsFn(ctx, msgIDs, username)
+	}
+	return nil, nil
+}
+func (r *testMessageRepo) GetThreadMessages(ctx context.Context, rootMsgID int64, username string, afterSeq int64, limit int) ([]*model.MessageContent, error) {
+	if r.getThreadFn != nil {
+		return r.getThreadFn(ctx, rootMsgID, username, afterSeq, limit)
+	}
+	return nil, nil
+}
+func (r *testMessageRepo) FollowThread(ctx context.Context, rootMsgID int64, username string) error {
+	if r.followers != nil {
+		if r.followers[rootMsgID] == nil {
+			r.followers[rootMsgID] = make(map[string]bool)
+		}
+		r.followers[rootMsgID][username] = true
+	}
+	return nil
+}
+func (r *testMessageRepo) UnfollowThread(ctx context.Context, rootMsgID int64, username string) error {
+	if r.followers != nil {
+		delete(r.followers[rootMsgID], username)
+	}
+	return nil
+}
+func (r *testMessageRepo) GetThreadFollowers(ctx context.Context, rootMsgID int64) ([]string, error) {
+	followers := make([]string, 0, len(r.followers[rootMsgID]))
+	for username := range r.followers[rootMsgID] {
+		followers = append(followers, username)
+	}
+	sort.Strings(followers)
+	return followers, nil
+}
+func (r *testMessageRepo) PinMessage(ctx context.Context, pin *model.MessagePin) (bool, error) {
+	return true, nil
+}
+func (r *testMessageRepo) UnpinMessage(ctx context.Context, sessionID string, msgID int64) (bool, error) {
+	return true, nil
+}
+func (r *testMessageRepo) GetPinnedMessages(ctx context.Context, sessionID string) ([]*model.MessagePin, error) {
+	return nil, nil
+}
+func (r *testMessageRepo) AddReactionWithOutbox(ctx context.Context, reaction *model.MessageReaction, outbox *model.MessageOutbox) (bool, error) {
+	return true, nil
+}
+func (r *testMessageRepo) RemoveReactionWithOutbox(ctx context.Context, reaction *model.MessageReaction, outbox *model.MessageOutbox) (bool, error) {
+	return true, nil
+}
+func (r *testMessageRepo) GetReactionSummaries(ctx context.Context, msgIDs []int64, username string) ([]*repo.ReactionSummary, error) {
+	return nil, nil
+}
+func (r *testMessageRepo) CastVoteWithOutbox(ctx context.Context, poll *model.Poll, username string, options []int32, outbox *model.MessageOutbox) (bool, error) {
+	if r.castVoteFn != nil {
+		return r.castVoteFn(ctx, poll, username, options, outbox)
+	}
+	return true, nil
+}
+func (r *testMessageRepo) ClosePollWithOutbox(ctx context.Context, poll *model.Poll, outbox *model.MessageOutbox) (bool, error) {
+	return true, nil
+}
+func (r *testMessageRepo) GetPollTallies(ctx context.Context, msgIDs []int64, username string) ([]*repo.PollTally, error) {
+	if r.getPollTalliesFn != nil {
+		return r.getPollTalliesFn(ctx, msgIDs, username)
+	}
+	return nil, nil
+}
+func (r *testMessageRepo) SaveMentions(ctx context.Context, mentions []*model.MessageMention) error {
+	return nil
+}
+func (r *testMessageRepo) GetMentionsByMsgIDs(ctx context.Context, msgIDs []int64) ([]*model.MessageMention, error) {
+	return nil, nil
+}
+func (r *testMessageRepo) GetUnreadMentionCounts(ctx context.Context, username string) (map[string]int64, error) {
+	return nil, nil
+}
+func (r *testMessageRepo) GetReadReceipts(ctx context.Context, sessionID string, fromSeq, toSeq int64, reader string, limit int) ([]*repo.ReadReceiptItem, error) {
+	return nil, nil
+}
+func (r *testMessageRepo) EditMessageWithOutbox(ctx context.Context, revision *model.MessageRevision, newContent string, editedAt time.Time, outbox *model.MessageOutbox) error {
+	if r.editMessageFn != nil {
+		return r.editMessageFn(ctx, revision, newContent, editedAt, outbox)
+	}
 	return nil
 }
 func (r *testMessageRepo) UpdateOutboxStatus(ctx context.Context, id int64, status int) error {
@@ -90,6 +261,24 @@ func (r *testMessageRepo) UpdateOutboxRetry(ctx context.Context, id int64, nextR
 func (r *testMessageRepo) GetPendingOutboxMessages(ctx context.Context, limit int) ([]*model.MessageOutbox, error) {
 	return nil, nil
 }
+func (r *testMessageRepo) SearchMessages(ctx context.Context, filter *repo.MessageSearchFilter) ([]*repo.MessageSearchItem, error) {
+	if r.searchMessagesFn != nil {
+		return r.searchMessagesFn(ctx, filter)
+	}
+	return nil, nil
+}
+func (r *testMessageRepo) HideMessagesWithOutbox(ctx context.Context, username, sessionID string, msgIDs []int64, clearedSeq int64, outbox *model.MessageOutbox) error {
+	if r.hideMessagesFn != nil {
+		return r.hideMessagesFn(ctx, username, sessionID, msgIDs, clearedSeq, outbox)
+	}
+	return nil
+}
+func (r *testMessageRepo) GetExpiredMessages(ctx context.Context, now time.Time, limit int) ([]*model.MessageContent, error) {
+	return nil, nil
+}
+func (r *testMessageRepo) DeleteExpiredMessagesWithOutbox(ctx context.Context, msgIDs []int64, outboxes []*model.MessageOutbox) ([]string, error) {
+	return nil, nil
+}
 func (r *testMessageRepo) Close() error { return nil }
 
 type testUserRepo struct{}
@@ -110,7 +299,7 @@ func (r *testUserRepo) Close() error                                           {
 func TestSessionService_GetHistoryMessages_DeniedForNonMember(t *testing.T) {
 	sessionRepo := &testSessionRepo{
 		getUserSessionFn: func(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
-			return nil, fmt.Errorf("user session not found: username=%s, session_id=%s", username, sessionID)
+			return nil, fmt.Errorf("user session %w: username=%s, session_id=%s", repo.ErrNotFound, username, sessionID)
 		},
 	}
 	messageRepo := &testMessageRepo{}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newPinTestService alice 是群 g_1 的管理员，bob 是普通成员，mallory 不是成员
+func newPinTestService(messageRepo *testMessageRepo) *SessionService {
+	sessionRepo := &testSessionRepo{
+		getUserSessionFn: func(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
+			switch username {
+			case "alice":
+				return &model.SessionMember{SessionID: sessionID, Username: username, Role: 1}, nil
+			case "bob":
+				return &model.SessionMember{SessionID: sessionID, Username: username, Role: 0}, nil
+			}
+			return nil, fmt.Errorf("user session %w: username=%s, session_id=%s", repo.ErrNotFound, username, sessionID)
+		},
+		getSessionFn: func(ctx context.Context, sessionID string) (*model.Session, error) {
+			return &model.Session{SessionID: sessionID, Type: 2, Name: "group"}, nil
+		},
+	}
+	return NewSessionService(sessionRepo, messageRepo, &testUserRepo{}, nil, nil, nil, nil, clog.Discard())
+}
+
+func TestSessionService_PinMessage_RestrictedByRole(t *testing.T) {
+	svc := newPinTestService(&testMessageRepo{})
+
+	_, err := svc.PinMessage(context.Background(), &logicv1.PinMessageRequest{Username: "bob", SessionId: "g_1", MsgId: 1})
+	require.Equal(t, codes.PermissionDenied, status.Code(err), "群聊普通成员不能置顶")
+
+	_, err = svc.UnpinMessage(context.Background(), &logicv1.UnpinMessageRequest{Username: "mallory", SessionId: "g_1", MsgId: 1})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestSessionService_PinMessage_RejectsCrossSessionMessage(t *testing.T) {
+	messageRepo := &testMessageRepo{
+		getMessageFn: func(ctx context.Context, msgID int64) (*model.MessageContent, error) {
+			return &model.MessageContent{MsgID: msgID, SessionID: "g_other"}, nil
+		},
+	}
+	svc := newPinTestService(messageRepo)
+
+	_, err := svc.PinMessage(context.Background(), &logicv1.PinMessageRequest{Username: "alice", SessionId: "g_1", MsgId: 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSessionService_ListPinnedMessages_DeniedForNonMember(t *testing.T) {
+	svc := newPinTestService(&testMessageRepo{})
+
+	_, err := svc.ListPinnedMessages(context.Background(), &logicv1.ListPinnedMessagesRequest{Username: "mallory", SessionId: "g_1"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	resp, err := svc.ListPinnedMessages(context.Background(), &logicv1.ListPinnedMessagesRequest{Username: "bob", SessionId: "g_1"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Pins)
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newReadStatusTestService() *SessionService {
+	sessionRepo := &testSessionRepo{
+		getMembersFn: func(ctx context.Context, sessionID string) ([]*model.SessionMember, error) {
+			return []*model.SessionMember{
+				{SessionID: sessionID, Username: "alice", LastReadSeq: 10},
+				{SessionID: sessionID, Username: "bob", LastReadSeq: 5},
+				{SessionID: sessionID, Username: "carol", LastReadSeq: 4},
+			}, nil
+		},
+	}
+	messageRepo := &testMessageRepo{
+		getMessageFn: func(ctx context.Context, msgID int64) (*model.MessageContent, error) {
+			return &model.MessageContent{
+				MsgID:          msgID,
+				SessionID:      "s_123",
+				SenderUsername: "alice",
+				SeqID:          5,
+				CreatedAt:      time.Now(),
+			}, nil
+		},
+	}
+	return NewSessionService(sessionRepo, messageRepo, &testUserRepo{}, nil, nil, nil, nil, clog.Discard())
+}
+
+func TestSessionService_GetMessageReadStatus(t *testing.T) {
+	svc := newReadStatusTestService()
+
+	resp, err := svc.GetMessageReadStatus(context.Background(), &logicv1.GetMessageReadStatusRequest{
+		Username:  "alice",
+		SessionId: "s_123",
+		MsgId:     1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bob"}, resp.ReadUsernames)
+	require.Equal(t, []string{"carol"}, resp.UnreadUsernames)
+}
+
+func TestSessionService_GetMessageReadStatus_DeniedForNonSender(t *testing.T) {
+	svc := newReadStatusTestService()
+
+	_, err := svc.GetMessageReadStatus(context.Background(), &logicv1.GetMessageReadStatusRequest{
+		Username:  "bob",
+		SessionId: "s_123",
+		MsgId:     1,
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestSessionService_GetMessageReadStatus_DeniedForFormerMember(t *testing.T) {
+	svc := newReadStatusTestService()
+	svc.sessionRepo.(*testSessionRepo).getUserSessionFn = func(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
+		// 发送者已退出会话
+		return nil, repo.ErrNotFound
+	}
+
+	_, err := svc.GetMessageReadStatus(context.Background(), &logicv1.GetMessageReadStatusRequest{
+		Username:  "alice",
+		SessionId: "s_123",
+		MsgId:     1,
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSessionService_SearchMessages_Paginates(t *testing.T) {
+	var got *repo.MessageSearchFilter
+	messageRepo := &testMessageRepo{
+		searchMessagesFn: func(ctx context.Context, filter *repo.MessageSearchFilter) ([]*repo.MessageSearchItem, error) {
+			got = filter
+			items := make([]*repo.MessageSearchItem, 0, filter.Limit)
+			for i := 0; i < filter.Limit; i++ {
+				items = append(items, &repo.MessageSearchItem{
+					Message: &model.MessageContent{MsgID: int64(100 - i), SessionID: "s_1", Content: "hello world"},
+					Snippet: repo.SearchHighlightStart + "hello" + repo.SearchHighlightStop + " world",
+				})
+			}
+			return items, nil
+		},
+	}
+	svc := NewSessionService(&testSessionRepo{}, messageRepo, &testUserRepo{}, nil, nil, nil, nil, clog.Discard())
+
+	resp, err := svc.SearchMessages(context.Background(), &logicv1.SearchMessagesRequest{
+		Username: "alice",
+		Query:    " hello ",
+		Cursor:   200,
+		Limit:    2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "alice", got.Username)
+	require.Equal(t, "hello", got.Query)
+	require.Equal(t, int64(200), got.BeforeMsgID)
+	require.True(t, resp.HasMore)
+	require.Len(t, resp.Results, 2)
+	require.Equal(t, int64(99), resp.NextCursor)
+	require.Equal(t, "<mark>hello</mark> world", resp.Results[0].Snippet)
+}
+
+func TestSessionService_SearchMessages_Validation(t *testing.T) {
+	svc := NewSessionService(&testSessionRepo{}, &testMessageRepo{}, &testUserRepo{}, nil, nil, nil, nil, clog.Discard())
+	ctx := context.Background()
+
+	_, err := svc.SearchMessages(ctx, &logicv1.SearchMessagesRequest{Username: "alice", Query: "  "})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = svc.SearchMessages(ctx, &logicv1.SearchMessagesRequest{Username: "alice", Query: "hi", StartTime: 200, EndTime: 100})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestHighlightSnippet_EscapesContent(t *testing.T) {
+	raw := "<script>" + repo.SearchHighlightStart + "hi" + repo.SearchHighlightStop + "</script>"
+	require.Equal(t, "&lt;script&gt;<mark>hi</mark>&lt;/script&gt;", highlightSnippet(raw))
+}
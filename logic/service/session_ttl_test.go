@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/logic/config"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/errcode"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSessionService_SetMessageTTL_Validation(t *testing.T) {
+	svc := newPinTestService(&testMessageRepo{})
+	ctx := context.Background()
+
+	_, err := svc.SetMessageTTL(ctx, &logicv1.SetMessageTTLRequest{Username: "bob", SessionId: "g_1", TtlSeconds: 3600})
+	require.Equal(t, codes.PermissionDenied, status.Code(err), "群聊普通成员不能设置消息保留时长")
+
+	_, err = svc.SetMessageTTL(ctx, &logicv1.SetMessageTTLRequest{Username: "mallory", SessionId: "g_1", TtlSeconds: 3600})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	for _, ttl := range []int64{-1, 30, int64(366 * 24 * time.Hour / time.Second)} {
+		_, err = svc.SetMessageTTL(ctx, &logicv1.SetMessageTTLRequest{Username: "alice", SessionId: "g_1", TtlSeconds: ttl})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), "ttl=%d", ttl)
+	}
+}
+
+func TestMessageExpiresAt(t *testing.T) {
+	now := time.Now()
+
+	require.Nil(t, messageExpiresAt(nil, now))
+	require.Nil(t, messageExpiresAt(&model.Session{}, now), "未开启自动删除时永久保留")
+
+	expiresAt := messageExpiresAt(&model.Session{MessageTTL: 3600}, now)
+	require.NotNil(t, expiresAt)
+	require.Equal(t, now.Add(time.Hour).Unix(), expiresAtUnix(expiresAt))
+	require.Zero(t, expiresAtUnix(nil))
+}
+
+func TestChatService_SendMessage_SessionLookupFailed(t *testing.T) {
+	sessionRepo := &testSessionRepo{
+		getMembersFn: func(ctx context.Context, sessionID string) ([]*model.SessionMember, error) {
+			return []*model.SessionMember{{SessionID: sessionID, Username: "alice"}}, nil
+		},
+		getSessionFn: func(ctx context.Context, sessionID string) (*model.Session, error) {
+			return nil, errors.New("connection reset")
+		},
+	}
+	svc := NewChatService(sessionRepo, &testMessageRepo{}, nil, nil, &testIDGen{}, nil, nil, &config.MessageConfig{}, clog.Discard())
+
+	// 无法确定会话保留时长时拒绝发送，避免消息以永久保留落库
+	resp, err := svc.SendMessage(context.Background(), &logicv1.SendMessageRequest{SessionId: "g1", FromUsername: "alice", Content: "hi", Type: "text"})
+	require.NoError(t, err)
+	require.Equal(t, errcode.Internal, resp.ErrorCode)
+}
+
+func TestFormatTTL(t *testing.T) {
+	require.Equal(t, "7 天", formatTTL(7*24*time.Hour))
+	require.Equal(t, "2 小时", formatTTL(2*time.Hour))
+	require.Equal(t, "90 分钟", formatTTL(90*time.Minute))
+}
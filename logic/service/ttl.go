@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// minMessageTTL 会话消息保留时长的下限
+	minMessageTTL = time.Minute
+	// maxMessageTTL 会话消息保留时长的上限
+	maxMessageTTL = 365 * 24 * time.Hour
+)
+
+// SetMessageTTL 实现 SessionService.SetMessageTTL
+// 权限见权限矩阵：群聊管理员及群主可设置，单聊双方均可设置。新的保留时长仅对之后发送的消息生效
+func (s *SessionService) SetMessageTTL(ctx context.Context, req *logicv1.SetMessageTTLRequest) (*logicv1.SetMessageTTLResponse, error) {
+	s.logger.Info("set message ttl",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId),
+		clog.Int64("ttl_seconds", req.TtlSeconds))
+
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if req.TtlSeconds < 0 || (ttl > 0 && (ttl < minMessageTTL || ttl > maxMessageTTL)) {
+		return nil, status.Errorf(codes.InvalidArgument, "ttl_seconds must be 0 or between %d and %d",
+			int64(minMessageTTL/time.Second), int64(maxMessageTTL/time.Second))
+	}
+
+	session, _, err := s.checkPermission(ctx, req.Username, req.SessionId, permSetMessageTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := s.sessionRepo.UpdateMessageTTL(ctx, req.SessionId, req.TtlSeconds)
+	if err != nil {
+		s.logger.Error("failed to update message ttl", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to set message ttl")
+	}
+	// 未变化时视为成功（幂等），不发送通知
+	if !changed {
+		return &logicv1.SetMessageTTLResponse{}, nil
+	}
+
+	text := fmt.Sprintf("%s 关闭了消息自动删除", s.nickname(ctx, req.Username))
+	if req.TtlSeconds > 0 {
+		text = fmt.Sprintf("%s 将消息自动删除时间设置为 %s", s.nickname(ctx, req.Username), formatTTL(ttl))
+	}
+	system := &gatewayv1.SystemBody{Code: "message_ttl_updated", Text: text}
+	if err := s.sendSystemMessage(ctx, req.SessionId, system, session.Name, int32(session.Type)); err != nil {
+		s.logger.Error("failed to send message ttl system message", clog.Error(err))
+	}
+
+	return &logicv1.SetMessageTTLResponse{}, nil
+}
+
+// messageExpiresAt 按会话消息保留时长计算过期时间，会话未开启或不可用时返回 nil
+func messageExpiresAt(session *model.Session, sentAt time.Time) *time.Time {
+	if session == nil || session.MessageTTL <= 0 {
+		return nil
+	}
+	expiresAt := sentAt.Add(time.Duration(session.MessageTTL) * time.Second)
+	return &expiresAt
+}
+
+// earlierExpiry 返回两个过期时间中较早的一个，nil 表示永久保留
+func earlierExpiry(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.Before(*a)) {
+		return b
+	}
+	return a
+}
+
+// expiresAtUnix 过期时间的 Unix 秒表示，永久保留时为 0
+func expiresAtUnix(expiresAt *time.Time) int64 {
+	if expiresAt == nil {
+		return 0
+	}
+	return expiresAt.Unix()
+}
+
+// formatTTL 将保留时长格式化为系统消息中的可读文本
+func formatTTL(ttl time.Duration) string {
+	switch {
+	case ttl%(24*time.Hour) == 0:
+		return fmt.Sprintf("%d 天", ttl/(24*time.Hour))
+	case ttl%time.Hour == 0:
+		return fmt.Sprintf("%d 小时", ttl/time.Hour)
+	case ttl%time.Minute == 0:
+		return fmt.Sprintf("%d 分钟", ttl/time.Minute)
+	default:
+		return fmt.Sprintf("%d 秒", ttl/time.Second)
+	}
+}
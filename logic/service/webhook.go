@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/idgen"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxWebhooksPerSession 单个会话的最大 Webhook 订阅数
+	maxWebhooksPerSession = 10
+	// maxWebhookURLLength 回调地址最大长度
+	maxWebhookURLLength = 512
+	// maxWebhookSecretLength 签名密钥最大长度
+	maxWebhookSecretLength = 128
+	// defaultWebhookDeliveryLimit ListWebhookDeliveries 默认返回条数
+	defaultWebhookDeliveryLimit = 20
+	// maxWebhookDeliveryLimit ListWebhookDeliveries 最大返回条数
+	maxWebhookDeliveryLimit = 100
+)
+
+// webhookEvents 可订阅的事件
+var webhookEvents = map[string]struct{}{
+	model.WebhookEventMessageCreated: {},
+	model.WebhookEventMessageEdited:  {},
+	model.WebhookEventMemberChanged:  {},
+}
+
+// WebhookService 出站 Webhook 订阅管理服务
+// 投递由 Task 消费 MQ 事件完成，本服务只负责订阅的增删查与投递日志查询
+type WebhookService struct {
+	logicv1.UnimplementedWebhookServiceServer
+	sessionRepo repo.SessionRepo
+	webhookRepo repo.WebhookRepo
+	idGen       idgen.Generator // Snowflake ID 生成器
+	logger      clog.Logger
+}
+
+// NewWebhookService 创建 Webhook 服务
+func NewWebhookService(
+	sessionRepo repo.SessionRepo,
+	webhookRepo repo.WebhookRepo,
+	idGen idgen.Generator,
+	logger clog.Logger,
+) *WebhookService {
+	return &WebhookService{
+		sessionRepo: sessionRepo,
+		webhookRepo: webhookRepo,
+		idGen:       idGen,
+		logger:      logger,
+	}
+}
+
+// CreateWebhook 实现 WebhookService.CreateWebhook
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *logicv1.CreateWebhookRequest) (*logicv1.CreateWebhookResponse, error) {
+	s.logger.Info("create webhook",
+		clog.String("username", req.Username),
+		clog.String("session_id", req.SessionId))
+
+	if _, _, err := checkSessionPermission(ctx, s.sessionRepo, s.logger, req.Username, req.SessionId, permManageWebhooks); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookURL(req.Url); err != nil {
+		return nil, err
+	}
+	events, err := normalizeWebhookEvents(req.Events)
+	if err != nil {
+		return nil, err
+	}
+	secret := req.Secret
+	if len(secret) > maxWebhookSecretLength {
+		return nil, status.Errorf(codes.InvalidArgument, "secret cannot exceed %d characters", maxWebhookSecretLength)
+	}
+	if secret == "" {
+		if secret, err = newWebhookSecret(); err != nil {
+			s.logger.Error("failed to generate webhook secret", clog.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to create webhook")
+		}
+	}
+
+	existing, err := s.webhookRepo.ListWebhooks(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to list webhooks", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create webhook")
+	}
+	if len(existing) >= maxWebhooksPerSession {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot create more than %d webhooks", maxWebhooksPerSession)
+	}
+
+	webhook := &model.Webhook{
+		WebhookID: s.idGen.Next(),
+		SessionID: req.SessionId,
+		URL:       req.Url,
+		Secret:    secret,
+		Events:    strings.Join(events, ","),
+		CreatedBy: req.Username,
+	}
+	if err := s.webhookRepo.CreateWebhook(ctx, webhook); err != nil {
+		s.logger.Error("failed to create webhook", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create webhook")
+	}
+
+	return &logicv1.CreateWebhookResponse{
+		Webhook: toWebhookProto(webhook),
+		Secret:  secret,
+	}, nil
+}
+
+// ListWebhooks 实现 WebhookService.ListWebhooks
+func (s *WebhookService) ListWebhooks(ctx context.Context, req *logicv1.ListWebhooksRequest) (*logicv1.ListWebhooksResponse, error) {
+	if _, _, err := checkSessionPermission(ctx, s.sessionRepo, s.logger, req.Username, req.SessionId, permManageWebhooks); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.webhookRepo.ListWebhooks(ctx, req.SessionId)
+	if err != nil {
+		s.logger.Error("failed to list webhooks", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list webhooks")
+	}
+
+	result := make([]*gatewayv1.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		result = append(result, toWebhookProto(webhook))
+	}
+	return &logicv1.ListWebhooksResponse{
+		Webhooks: result,
+	}, nil
+}
+
+// DeleteWebhook 实现 WebhookService.DeleteWebhook
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *logicv1.DeleteWebhookRequest) (*logicv1.DeleteWebhookResponse, error) {
+	s.logger.Info("delete webhook",
+		clog.String("username", req.Username),
+		clog.Int64("webhook_id", req.WebhookId))
+
+	if _, err := s.getManagedWebhook(ctx, req.Username, req.WebhookId); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.webhookRepo.DeleteWebhook(ctx, req.WebhookId); err != nil {
+		s.logger.Error("failed to delete webhook", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook")
+	}
+	return &logicv1.DeleteWebhookResponse{}, nil
+}
+
+// ListWebhookDeliveries 实现 WebhookService.ListWebhookDeliveries
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *logicv1.ListWebhookDeliveriesRequest) (*logicv1.ListWebhookDeliveriesResponse, error) {
+	if _, err := s.getManagedWebhook(ctx, req.Username, req.WebhookId); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultWebhookDeliveryLimit
+	}
+	if limit > maxWebhookDeliveryLimit {
+		limit = maxWebhookDeliveryLimit
+	}
+
+	deliveries, err := s.webhookRepo.ListDeliveries(ctx, req.WebhookId, req.BeforeId, limit)
+	if err != nil {
+		s.logger.Error("failed to list webhook deliveries", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries")
+	}
+
+	result := make([]*gatewayv1.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, toWebhookDeliveryProto(delivery))
+	}
+	return &logicv1.ListWebhookDeliveriesResponse{
+		Deliveries: result,
+	}, nil
+}
+
+// getManagedWebhook 获取 Webhook 并校验操作者对其所属会话有管理权限
+// 非管理员与订阅不存在时均返回 NotFound，避免泄露其他会话的订阅
+func (s *WebhookService) getManagedWebhook(ctx context.Context, username string, webhookID int64) (*model.Webhook, error) {
+	if username == "" || webhookID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "username and webhook_id are required")
+	}
+
+	webhook, err := s.webhookRepo.GetWebhook(ctx, webhookID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "webhook not found")
+		}
+		s.logger.Error("failed to get webhook", clog.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get webhook")
+	}
+	if _, _, err := checkSessionPermission(ctx, s.sessionRepo, s.logger, username, webhook.SessionID, permManageWebhooks); err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return nil, status.Errorf(codes.NotFound, "webhook not found")
+		}
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// validateWebhookURL 校验回调地址：仅允许 http/https 且必须包含主机名
+func validateWebhookURL(raw string) error {
+	if raw == "" {
+		return status.Errorf(codes.InvalidArgument, "url is required")
+	}
+	if len(raw) > maxWebhookURLLength {
+		return status.Errorf(codes.InvalidArgument, "url cannot exceed %d characters", maxWebhookURLLength)
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return status.Errorf(codes.InvalidArgument, "url must be an absolute http or https url")
+	}
+	return nil
+}
+
+// normalizeWebhookEvents 校验并去重订阅的事件，为空表示订阅全部事件
+func normalizeWebhookEvents(events []string) ([]string, error) {
+	result := uniqueStrings(events)
+	for _, event := range result {
+		if _, ok := webhookEvents[event]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown webhook event: %s", event)
+		}
+	}
+	return result, nil
+}
+
+// newWebhookSecret 生成随机签名密钥
+func newWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// toWebhookProto 将 Webhook 订阅转换为 proto（不包含签名密钥）
+func toWebhookProto(w *model.Webhook) *gatewayv1.Webhook {
+	var events []string
+	if w.Events != "" {
+		events = strings.Split(w.Events, ",")
+	}
+	return &gatewayv1.Webhook{
+		WebhookId: w.WebhookID,
+		SessionId: w.SessionID,
+		Url:       w.URL,
+		Events:    events,
+		CreatedBy: w.CreatedBy,
+		CreatedAt: w.CreatedAt.Unix(),
+	}
+}
+
+// toWebhookDeliveryProto 将投递记录转换为 proto
+func toWebhookDeliveryProto(d *model.WebhookDelivery) *gatewayv1.WebhookDelivery {
+	deliveryStatus := gatewayv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	switch d.Status {
+	case model.WebhookDeliverySucceeded:
+		deliveryStatus = gatewayv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case model.WebhookDeliveryFailed:
+		deliveryStatus = gatewayv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	}
+	return &gatewayv1.WebhookDelivery{
+		DeliveryId:   d.ID,
+		WebhookId:    d.WebhookID,
+		Event:        d.Event,
+		Status:       deliveryStatus,
+		Attempts:     int32(d.Attempts),
+		ResponseCode: int32(d.ResponseCode),
+		Error:        d.Error,
+		CreatedAt:    d.CreatedAt.Unix(),
+		UpdatedAt:    d.UpdatedAt.Unix(),
+	}
+}
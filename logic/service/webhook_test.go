@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	logicv1 "github.com/ceyewan/resonance/api/gen/go/logic/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testWebhookRepo struct {
+	webhooks  map[int64]*model.Webhook
+	deleted   []int64
+	listLimit int
+}
+
+func (r *testWebhookRepo) CreateWebhook(ctx context.Context, webhook *model.Webhook) error {
+	r.webhooks[webhook.WebhookID] = webhook
+	return nil
+}
+func (r *testWebhookRepo) GetWebhook(ctx context.Context, webhookID int64) (*model.Webhook, error) {
+	webhook, ok := r.webhooks[webhookID]
+	if !ok {
+		return nil, fmt.Errorf("webhook %w: %d", repo.ErrNotFound, webhookID)
+	}
+	return webhook, nil
+}
+func (r *testWebhookRepo) ListWebhooks(ctx context.Context, sessionID string) ([]*model.Webhook, error) {
+	var result []*model.Webhook
+	for _, webhook := range r.webhooks {
+		if webhook.SessionID == sessionID {
+			result = append(result, webhook)
+		}
+	}
+	return result, nil
+}
+func (r *testWebhookRepo) DeleteWebhook(ctx context.Context, webhookID int64) (bool, error) {
+	r.deleted = append(r.deleted, webhookID)
+	return true, nil
+}
+func (r *testWebhookRepo) CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	return nil
+}
+func (r *testWebhookRepo) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.WebhookDelivery, error) {
+	return nil, nil
+}
+func (r *testWebhookRepo) UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	return nil
+}
+func (r *testWebhookRepo) ListDeliveries(ctx context.Context, webhookID, beforeID int64, limit int) ([]*model.WebhookDelivery, error) {
+	r.listLimit = limit
+	return []*model.WebhookDelivery{{ID: 1, WebhookID: webhookID, Event: model.WebhookEventMessageCreated, Status: model.WebhookDeliveryFailed, Attempts: 5, ResponseCode: 500}}, nil
+}
+func (r *testWebhookRepo) Close() error { return nil }
+
+// newWebhookTestService g_1 是群聊，alice 是管理员，bob 是普通成员，mallory 不是成员
+func newWebhookTestService(webhooks ...*model.Webhook) (*WebhookService, *testWebhookRepo) {
+	sessionRepo := &testSessionRepo{
+		getUserSessionFn: func(ctx context.Context, username, sessionID string) (*model.SessionMember, error) {
+			switch username {
+			case "alice":
+				return &model.SessionMember{SessionID: sessionID, Username: username, Role: 1}, nil
+			case "bob":
+				return &model.SessionMember{SessionID: sessionID, Username: username}, nil
+			}
+			return nil, fmt.Errorf("user session %w: username=%s, session_id=%s", repo.ErrNotFound, username, sessionID)
+		},
+		getSessionFn: func(ctx context.Context, sessionID string) (*model.Session, error) {
+			return &model.Session{SessionID: sessionID, Type: 2}, nil
+		},
+	}
+	webhookRepo := &testWebhookRepo{webhooks: make(map[int64]*model.Webhook)}
+	for _, webhook := range webhooks {
+		webhookRepo.webhooks[webhook.WebhookID] = webhook
+	}
+	return NewWebhookService(sessionRepo, webhookRepo, nil, clog.Discard()), webhookRepo
+}
+
+func TestWebhookService_CreateWebhook_Validation(t *testing.T) {
+	svc, repo := newWebhookTestService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  *logicv1.CreateWebhookRequest
+		code codes.Code
+	}{
+		{"non admin", &logicv1.CreateWebhookRequest{Username: "bob", SessionId: "g_1", Url: "https://example.com/hook"}, codes.PermissionDenied},
+		{"non member", &logicv1.CreateWebhookRequest{Username: "mallory", SessionId: "g_1", Url: "https://example.com/hook"}, codes.PermissionDenied},
+		{"bad scheme", &logicv1.CreateWebhookRequest{Username: "alice", SessionId: "g_1", Url: "ftp://example.com/hook"}, codes.InvalidArgument},
+		{"relative url", &logicv1.CreateWebhookRequest{Username: "alice", SessionId: "g_1", Url: "/hook"}, codes.InvalidArgument},
+		{"unknown event", &logicv1.CreateWebhookRequest{Username: "alice", SessionId: "g_1", Url: "https://example.com/hook", Events: []string{"message.deleted"}}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateWebhook(ctx, tt.req)
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+	require.Empty(t, repo.webhooks)
+}
+
+func TestWebhookService_ManageHidesForeignWebhooks(t *testing.T) {
+	svc, repo := newWebhookTestService(&model.Webhook{WebhookID: 1, SessionID: "g_1", URL: "https://example.com/hook"})
+	ctx := context.Background()
+
+	// 非管理员与订阅不存在时返回相同错误
+	_, err := svc.DeleteWebhook(ctx, &logicv1.DeleteWebhookRequest{Username: "bob", WebhookId: 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.DeleteWebhook(ctx, &logicv1.DeleteWebhookRequest{Username: "alice", WebhookId: 2})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Empty(t, repo.deleted)
+
+	_, err = svc.DeleteWebhook(ctx, &logicv1.DeleteWebhookRequest{Username: "alice", WebhookId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, repo.deleted)
+}
+
+func TestWebhookService_ListWebhookDeliveries(t *testing.T) {
+	svc, repo := newWebhookTestService(&model.Webhook{WebhookID: 1, SessionID: "g_1", URL: "https://example.com/hook"})
+
+	resp, err := svc.ListWebhookDeliveries(context.Background(), &logicv1.ListWebhookDeliveriesRequest{Username: "alice", WebhookId: 1, Limit: 1000})
+	require.NoError(t, err)
+	require.Equal(t, maxWebhookDeliveryLimit, repo.listLimit)
+	require.Len(t, resp.Deliveries, 1)
+	require.Equal(t, gatewayv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED, resp.Deliveries[0].Status)
+	require.Equal(t, int32(500), resp.Deliveries[0].ResponseCode)
+}
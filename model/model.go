@@ -26,14 +26,46 @@ type Router struct {
 //	表                 索引名                    列                                  类型       用途
 //	────────────────── ──────────────────────── ──────────────────────────────────── ────────── ─────────────────────────────────
 //	t_user             PK                       username                            主键       按用户名精确查询
+//	t_user             idx_user_owner           owner_username                      普通       按创建者列出机器人账号
+//	t_bot_token        PK                       bot_username                        主键       每个机器人一个有效 Token（轮换时覆盖）
+//	t_bot_token        uniq_bot_token_hash      token_hash                          唯一       按 Token 哈希鉴权机器人接口
+//	t_bot_command      PK                       (bot_username, name)                复合主键   机器人注册的斜杠命令，按会话成员解析
 //	t_session          PK                       session_id                          主键       按会话 ID 精确查询
 //	t_session_member   PK                       (session_id, username)              复合主键   按会话查成员 / 判断成员资格
 //	t_session_member   idx_member_username      username                            普通       按用户名反查所有会话（联系人列表）
 //	t_message_content  PK                       msg_id                              主键       按消息 ID 精确查询
 //	t_message_content  idx_sess_seq             (session_id, seq_id)                复合       按会话拉取历史消息（游标分页）
+//	t_message_content  idx_thread_seq           (thread_root_id, seq_id)            复合       按话题拉取话题消息（游标分页）
+//	t_message_content  idx_msg_expires          expires_at                          普通       定时任务扫描已过期的消息
+//	t_message_content  idx_msg_search           search_vector                       GIN        全文搜索消息内容
+//	t_message_revision PK                       id                                  自增主键   —
+//	t_message_revision uniq_msg_version         (msg_id, version)                   唯一复合  按消息查询编辑历史，防止重复记录同一版本
+//	t_message_reaction PK                       (msg_id, username, emoji)           复合主键   按消息聚合回应 / 防止重复回应
+//	t_message_mention  PK                       (msg_id, username)                  复合主键   按消息查被提及用户 / 写扩散去重
+//	t_message_mention  idx_mention_user_sess    (username, session_id, seq_id)      复合       统计用户各会话未读提及数
+//	t_thread_follower  PK                       (root_msg_id, username)             复合主键   按话题查关注者 / 判断是否关注
+//	t_message_pin      PK                       (session_id, msg_id)                复合主键   按会话列出置顶消息 / 防止重复置顶
+//	t_message_hidden   PK                       (username, msg_id)                  复合主键   过滤用户已删除（仅自己）的消息
+//	t_message_hidden   idx_hidden_msg_id        msg_id                              普通       消息过期时按消息 ID 删除隐藏记录
+//	t_poll             PK                       msg_id                              主键       投票状态（投票人数、是否结束），投票时行锁串行化
+//	t_poll_vote        PK                       (msg_id, username, option_index)    复合主键   按投票聚合票数 / 查询用户的选择
+//	t_attachment       PK                       attachment_id                       主键       按附件 ID 精确查询（上传确认 / 下载鉴权）
+//	t_scheduled_message PK                      schedule_id                         主键       按定时消息 ID 精确查询（编辑 / 取消）
+//	t_scheduled_message idx_sched_status_time   (status, scheduled_at)              复合       定时任务轮询到期的定时消息
+//	t_scheduled_message idx_sched_sender        (sender_username, status)           复合       按发送者列出待发送的定时消息
+//	t_announcement     PK                       announcement_id                     主键       按公告 ID 游标补拉离线期间的公告
+//	t_webhook          PK                       webhook_id                          主键       按 Webhook ID 精确查询（删除 / 投递）
+//	t_webhook          idx_webhook_session      session_id                          普通       按会话列出 Webhook 订阅
+//	t_webhook_delivery PK                       id                                  自增主键   按投递 ID 游标分页
+//	t_webhook_delivery idx_delivery_webhook     (webhook_id, id)                    复合       按 Webhook 倒序列出投递日志
+//	t_webhook_delivery idx_delivery_status_retry (status, next_retry_time)          复合       定时任务领取待重试的投递
+//	t_moderation_rule  PK                       id                                  自增主键   审核规则（敏感词 / 正则），Logic 定时全量加载
+//	t_moderation_review PK                      id                                  自增主键   —
+//	t_moderation_review idx_review_status       (status, id)                        复合       按状态顺序处理待复核消息
 //	t_inbox            PK                       id                                  自增主键   —
 //	t_inbox            uniq_owner_sess_seq      (owner_username, session_id, seq_id) 唯一复合  写扩散去重，防同一消息重复入信箱
 //	t_inbox            idx_owner_read           (owner_username, is_read)           复合       查询某用户未读消息 / 计算未读数
+//	t_inbox            idx_inbox_msg_id         msg_id                              普通       消息过期时按消息 ID 删除信箱记录
 //	t_message_outbox   PK                       id                                  自增主键   —
 //	t_message_outbox   idx_msg_id               msg_id                              普通       按消息 ID 查投递状态 / 幂等检查
 //	t_message_outbox   idx_status_next_retry    (status, next_retry_time)           复合       定时任务轮询待重试消息
@@ -41,14 +73,46 @@ type Router struct {
 // ============================================================================
 
 // User 用户表
-// 索引：PK(username)
+// 索引：PK(username) + idx_user_owner(owner_username)
+//   - idx_user_owner：按创建者列出其机器人账号
+//
+// 机器人账号（Type=UserTypeBot）不能登录，只能凭 BotToken 调用网关的机器人接口发消息。
 type User struct {
-	Username  string `gorm:"primaryKey;column:username;type:varchar(64);not null"`
-	Nickname  string `gorm:"column:nickname;type:varchar(64)"`
-	Password  string `gorm:"column:password;type:varchar(128);not null"`
-	Avatar    string `gorm:"column:avatar;type:varchar(255)"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Username      string `gorm:"primaryKey;column:username;type:varchar(64);not null"`
+	Nickname      string `gorm:"column:nickname;type:varchar(64)"`
+	Password      string `gorm:"column:password;type:varchar(128);not null"`
+	Avatar        string `gorm:"column:avatar;type:varchar(255)"`
+	Type          int    `gorm:"column:type;type:smallint;not null;default:0"`                // 0-普通用户, 1-机器人
+	OwnerUsername string `gorm:"column:owner_username;type:varchar(64);index:idx_user_owner"` // 机器人的创建者，普通用户为空
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// BotToken 机器人 API Token 表
+// 索引：PK(bot_username) + uniq_bot_token_hash(token_hash)
+//   - uniq_bot_token_hash：机器人接口按 Token 哈希查找机器人
+//     典型查询: WHERE token_hash = ?
+//
+// 只保存 Token 的 SHA-256，明文仅在创建或轮换时返回一次；轮换即覆盖，旧 Token 立即失效。
+type BotToken struct {
+	BotUsername string `gorm:"primaryKey;column:bot_username;type:varchar(64);not null"`
+	TokenHash   string `gorm:"column:token_hash;type:varchar(64);not null;uniqueIndex:uniq_bot_token_hash"`
+	CreatedAt   time.Time
+}
+
+// BotCommand 机器人注册的斜杠命令表
+// 索引：PK(bot_username, name)
+//   - 解析命令时按会话成员中的机器人 JOIN 查询：WHERE m.session_id = ? AND c.name = ?
+//
+// 用户在机器人所在的会话中输入 /name 时，Logic 以 Secret 签名请求并同步回调 URL，由机器人决定回复方式。
+type BotCommand struct {
+	BotUsername string `gorm:"primaryKey;column:bot_username;type:varchar(64);not null"`
+	Name        string `gorm:"primaryKey;column:name;type:varchar(32);not null"`
+	Description string `gorm:"column:description;type:varchar(255)"`
+	URL         string `gorm:"column:url;type:varchar(512);not null"`
+	Secret      string `gorm:"column:secret;type:varchar(128);not null"` // 回调签名密钥
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 // Session 会话表（单聊/群聊）
@@ -57,8 +121,9 @@ type Session struct {
 	SessionID     string `gorm:"primaryKey;column:session_id;type:varchar(64);not null"`
 	Type          int    `gorm:"column:type;type:smallint;not null"` // 1-单聊, 2-群聊
 	Name          string `gorm:"column:name;type:varchar(128)"`
-	OwnerUsername string `gorm:"column:owner_username;type:varchar(64)"`
+	OwnerUsername string `gorm:"column:owner_username;type:varchar(64)"` // 群主，可通过转让变更
 	MaxSeqID      int64  `gorm:"column:max_seq_id;type:bigint;default:0"`
+	MessageTTL    int64  `gorm:"column:message_ttl;type:bigint;not null;default:0"` // 消息保留时长（秒），0-永久保留
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
@@ -68,38 +133,321 @@ type Session struct {
 //   - PK 复合主键：按会话查成员列表 / 快速判断某用户是否在某会话中
 //   - idx_member_username：反查某用户加入的所有会话（联系人列表、会话列表）
 type SessionMember struct {
-	SessionID   string `gorm:"primaryKey;column:session_id;type:varchar(64);not null"`
-	Username    string `gorm:"primaryKey;column:username;type:varchar(64);not null;index:idx_member_username"`
-	Role        int    `gorm:"column:role;type:smallint;default:0"` // 0-成员, 1-管理员
-	LastReadSeq int64  `gorm:"column:last_read_seq;type:bigint;default:0"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	SessionID    string     `gorm:"primaryKey;column:session_id;type:varchar(64);not null"`
+	Username     string     `gorm:"primaryKey;column:username;type:varchar(64);not null;index:idx_member_username"`
+	Role         int        `gorm:"column:role;type:smallint;default:0"` // 0-成员, 1-管理员, 2-群主（见 MemberRole*）
+	LastReadSeq  int64      `gorm:"column:last_read_seq;type:bigint;default:0"`
+	ClearedSeq   int64      `gorm:"column:cleared_seq;type:bigint;not null;default:0"` // 清空聊天记录位点：主时间线 seq_id <= cleared_seq 的消息及其话题回复对该成员不可见；受邀加入时取会话当前最大序号
+	Muted        bool       `gorm:"column:muted;not null;default:false"`               // 是否被禁言（群管理操作，被禁言者不能发送消息）
+	MutedUntil   *time.Time `gorm:"column:muted_until"`                                // 禁言截止时间，为空表示直到解除禁言
+	DoNotDisturb bool       `gorm:"column:do_not_disturb;not null;default:false"`      // 成员本人设置的免打扰：新消息静默推送，@提及与关注的话题除外
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// MutedAt 成员在 now 时刻是否处于禁言中
+func (m *SessionMember) MutedAt(now time.Time) bool {
+	return m.Muted && (m.MutedUntil == nil || now.Before(*m.MutedUntil))
 }
 
 // MessageContent 消息内容表
-// 索引：PK(msg_id) + idx_sess_seq(session_id, seq_id)
+// 索引：PK(msg_id) + idx_sess_seq(session_id, seq_id) + idx_thread_seq(thread_root_id, seq_id) + idx_msg_expires(expires_at) + idx_msg_search(search_vector)
 //   - idx_sess_seq：按会话拉取历史消息，支持 seq_id 游标分页
 //     典型查询: WHERE session_id = ? AND seq_id > ? ORDER BY seq_id LIMIT ?
+//   - idx_thread_seq：按话题拉取话题消息
+//     典型查询: WHERE thread_root_id = ? AND seq_id > ? ORDER BY seq_id LIMIT ?
+//   - idx_msg_expires：扫描已过期的消息
+//     典型查询: WHERE expires_at <= NOW() ORDER BY expires_at LIMIT ?
+//   - idx_msg_search：全文搜索，search_vector 为 content 的生成列，编辑后自动更新
+//     典型查询: WHERE search_vector @@ websearch_to_tsquery('simple', ?) ORDER BY msg_id DESC LIMIT ?
+//
+// 撤回：仅标记 recalled，不删除原始行；读取时由 Logic 替换为墓碑内容。
+// 话题：thread_root_id > 0 表示话题消息，其 seq_id 为话题内独立序号，不进入主时间线；
+// 根消息通过 thread_reply_count / thread_last_reply_at 冗余话题统计：回复数只计未撤回、未过期的话题消息，
+// 话题内已分配的最大序号单独记录在 thread_max_seq，用于恢复话题序号生成器。
+// 回复：reply_to_msg_id 指向同会话内被引用的消息，读取时由 Logic 附带引用快照。
+// 编辑：content 始终为最新内容，edit_version 每次编辑递增，旧内容存入 t_message_revision。
+// 搜索：search_vector 使用 simple 配置，按空白与标点切词、不做词干化；中文连续文本会整体成词，
+// 因此查询含中日韩文字时改用 content ILIKE 子串匹配（不走索引，范围由会话成员关系限定），
+// 如需中文分词与索引加速需安装 zhparser 等扩展并替换生成列的配置。
+// 过期：发送时按会话 message_ttl 计算 expires_at，到期后由 Logic 定时任务物理删除消息及其信箱、
+// 编辑历史、附件及 Webhook 投递与复核记录中的内容副本等关联数据（不保留墓碑），并推送过期事件通知客户端清除本地缓存。
+// 已过期但尚未清理的消息在各查询中均视为不存在。
 type MessageContent struct {
-	MsgID          int64  `gorm:"primaryKey;column:msg_id;type:bigint;autoIncrement:false"`
-	SessionID      string `gorm:"column:session_id;type:varchar(64);not null;index:idx_sess_seq,priority:1"`
+	MsgID             int64      `gorm:"primaryKey;column:msg_id;type:bigint;autoIncrement:false"`
+	SessionID         string     `gorm:"column:session_id;type:varchar(64);not null;index:idx_sess_seq,priority:1"`
+	SenderUsername    string     `gorm:"column:sender_username;type:varchar(64);not null"`
+	SeqID             int64      `gorm:"column:seq_id;type:bigint;not null;index:idx_sess_seq,priority:2;index:idx_thread_seq,priority:2"`
+	Content           string     `gorm:"column:content;type:text"`
+	MsgType           string     `gorm:"column:msg_type;type:varchar(32)"`
+	ReplyToMsgID      int64      `gorm:"column:reply_to_msg_id;type:bigint;not null;default:0"` // 0-非回复
+	Recalled          bool       `gorm:"column:recalled;not null;default:false"`
+	RecalledBy        string     `gorm:"column:recalled_by;type:varchar(64)"`
+	RecalledAt        *time.Time `gorm:"column:recalled_at"`
+	EditVersion       int32      `gorm:"column:edit_version;type:int;not null;default:0"` // 0-未编辑
+	EditedAt          *time.Time `gorm:"column:edited_at"`
+	ThreadRootID      int64      `gorm:"column:thread_root_id;type:bigint;not null;default:0;index:idx_thread_seq,priority:1"` // 0-主时间线消息
+	ThreadReplyCount  int32      `gorm:"column:thread_reply_count;type:int;not null;default:0"`
+	ThreadMaxSeq      int64      `gorm:"column:thread_max_seq;type:bigint;not null;default:0"`
+	ThreadLastReplyAt *time.Time `gorm:"column:thread_last_reply_at"`
+	MentionAll        bool       `gorm:"column:mention_all;not null;default:false"` // 是否 @所有人
+	FromBot           bool       `gorm:"column:from_bot;not null;default:false"`    // 发送者是否为机器人账号
+	Body              []byte     `gorm:"column:body;type:bytea"`                    // 结构化消息体（MessageBody 的 protobuf 编码，为空表示纯文本）
+	ExpiresAt         *time.Time `gorm:"column:expires_at;index:idx_msg_expires"`   // 过期时间，为空表示永久保留
+	CreatedAt         time.Time
+
+	// 全文检索向量：content 的生成列，仅在搜索 SQL 中使用，模型不读写
+	SearchVector string `gorm:"column:search_vector;type:tsvector GENERATED ALWAYS AS (to_tsvector('simple', coalesce(content, ''))) STORED;index:idx_msg_search,type:gin;->:false;<-:false"`
+}
+
+// MessageRevision 消息编辑历史表
+// 索引：PK(id) + uniq_msg_version(msg_id, version)
+//   - uniq_msg_version：按消息查询编辑历史；唯一约束保证同一版本只记录一次
+//
+// 每次编辑前，将被替换的内容及其版本号写入本表。
+type MessageRevision struct {
+	ID        int64  `gorm:"primaryKey;column:id;autoIncrement"`
+	MsgID     int64  `gorm:"column:msg_id;type:bigint;not null;uniqueIndex:uniq_msg_version,priority:1"`
+	Version   int32  `gorm:"column:version;type:int;not null;uniqueIndex:uniq_msg_version,priority:2"` // 被替换内容的版本号
+	Content   string `gorm:"column:content;type:text"`
+	EditedBy  string `gorm:"column:edited_by;type:varchar(64)"`
+	CreatedAt time.Time
+}
+
+// MessageReaction 消息表情回应表
+// 索引：PK(msg_id, username, emoji)
+//   - PK 复合主键：同一用户对同一消息的同一表情只记录一次；前缀 msg_id 支持按消息聚合
+//     典型查询: SELECT emoji, COUNT(*) WHERE msg_id IN ? GROUP BY msg_id, emoji
+type MessageReaction struct {
+	MsgID     int64  `gorm:"primaryKey;column:msg_id;type:bigint;autoIncrement:false"`
+	Username  string `gorm:"primaryKey;column:username;type:varchar(64);not null"`
+	Emoji     string `gorm:"primaryKey;column:emoji;type:varchar(32);not null"`
+	CreatedAt time.Time
+}
+
+// MessageMention 消息提及表（按接收者写扩散）
+// 索引：PK(msg_id, username) + idx_mention_user_sess(username, session_id, seq_id)
+//   - PK 复合主键：同一消息对同一用户只记录一次，写扩散重试时幂等
+//   - idx_mention_user_sess：统计某用户在各会话中 seq_id > last_read_seq 的提及数
+//
+// @所有人 时为每个接收者各写一条；话题消息使用独立序号空间，不记录提及。
+type MessageMention struct {
+	MsgID     int64  `gorm:"primaryKey;column:msg_id;type:bigint;autoIncrement:false"`
+	Username  string `gorm:"primaryKey;column:username;type:varchar(64);not null;index:idx_mention_user_sess,priority:1"`
+	SessionID string `gorm:"column:session_id;type:varchar(64);not null;index:idx_mention_user_sess,priority:2"`
+	SeqID     int64  `gorm:"column:seq_id;type:bigint;not null;index:idx_mention_user_sess,priority:3"`
+	CreatedAt time.Time
+}
+
+// ThreadFollower 话题关注表
+// 索引：PK(root_msg_id, username)
+//   - PK 复合主键：按话题查关注者列表 / 判断某用户是否关注
+//
+// 话题消息只推送给关注者，与会话级别的通知设置无关。
+type ThreadFollower struct {
+	RootMsgID int64  `gorm:"primaryKey;column:root_msg_id;type:bigint;autoIncrement:false"`
+	Username  string `gorm:"primaryKey;column:username;type:varchar(64);not null"`
+	CreatedAt time.Time
+}
+
+// MessagePin 消息置顶表
+// 索引：PK(session_id, msg_id)
+//   - PK 复合主键：同一消息在会话中只置顶一次；前缀 session_id 支持按会话列出置顶消息
+type MessagePin struct {
+	SessionID string `gorm:"primaryKey;column:session_id;type:varchar(64);not null"`
+	MsgID     int64  `gorm:"primaryKey;column:msg_id;type:bigint;autoIncrement:false"`
+	PinnedBy  string `gorm:"column:pinned_by;type:varchar(64);not null"`
+	CreatedAt time.Time
+}
+
+// Poll 投票状态表（投票定义见消息体 PollBody，不可修改）
+// 索引：PK(msg_id)
+//   - 首次投票或结束投票时创建；投票与结束投票在事务内对该行加锁，保证结束后不再计票
+type Poll struct {
+	MsgID      int64      `gorm:"primaryKey;column:msg_id;type:bigint;autoIncrement:false"`
+	SessionID  string     `gorm:"column:session_id;type:varchar(64);not null"`
+	VoterCount int32      `gorm:"column:voter_count;type:int;not null;default:0"` // 投票人数（多选时一人计一次）
+	ClosedBy   string     `gorm:"column:closed_by;type:varchar(64)"`
+	ClosedAt   *time.Time `gorm:"column:closed_at"` // 提前结束时间（为空表示未结束，到期结束由截止时间判断）
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// PollVote 投票记录表
+// 索引：PK(msg_id, username, option_index)
+//   - PK 复合主键：多选时每个选项一行；前缀 msg_id 支持按投票聚合票数
+//     典型查询: SELECT option_index, COUNT(*) WHERE msg_id IN ? GROUP BY msg_id, option_index
+type PollVote struct {
+	MsgID       int64  `gorm:"primaryKey;column:msg_id;type:bigint;autoIncrement:false"`
+	Username    string `gorm:"primaryKey;column:username;type:varchar(64);not null"`
+	OptionIndex int32  `gorm:"primaryKey;column:option_index;type:int;autoIncrement:false"`
+	CreatedAt   time.Time
+}
+
+// MessageHidden 消息隐藏表（仅自己删除）
+// 索引：PK(username, msg_id) + idx_hidden_msg_id(msg_id)
+//   - PK 复合主键：读取历史/增量/会话列表时按 (username, msg_id) 排除已删除的消息
+//     典型查询: WHERE NOT EXISTS (SELECT 1 FROM t_message_hidden h WHERE h.username = ? AND h.msg_id = m.msg_id)
+//   - idx_hidden_msg_id：消息过期物理删除时清理隐藏记录
+//
+// 仅影响该用户自己的视图，其他成员不受影响；整段清空使用 t_session_member.cleared_seq 而非逐条记录。
+type MessageHidden struct {
+	Username  string `gorm:"primaryKey;column:username;type:varchar(64);not null"`
+	MsgID     int64  `gorm:"primaryKey;column:msg_id;type:bigint;autoIncrement:false;index:idx_hidden_msg_id"`
+	SessionID string `gorm:"column:session_id;type:varchar(64);not null"`
+	CreatedAt time.Time
+}
+
+// Attachment 附件表
+// 索引：PK(attachment_id) + idx_attachment_msg(msg_id)
+//   - idx_attachment_msg：消息过期时删除其引用的附件
+//     典型查询: DELETE FROM t_attachment WHERE msg_id IN (?)
+//
+// 上传分两步：签发上传凭证时创建待上传记录（仅保存凭证哈希），网关写入 BlobStore 后确认上传，
+// 记录实际大小、MIME 类型与 SHA-256 校验和。消息通过 attachment_id 引用附件，每个附件只能被一条消息引用，
+// 下载时按所属会话的成员关系鉴权。转发时在目标会话下复制附件记录，多条记录共享同一 StorageKey，
+// 最后一条引用该 StorageKey 的记录删除时才删除存储内容。
+type Attachment struct {
+	AttachmentID     int64      `gorm:"primaryKey;column:attachment_id;type:bigint;autoIncrement:false"`
+	SessionID        string     `gorm:"column:session_id;type:varchar(64);not null"`
+	UploaderUsername string     `gorm:"column:uploader_username;type:varchar(64);not null"`
+	FileName         string     `gorm:"column:file_name;type:varchar(255)"`
+	MimeType         string     `gorm:"column:mime_type;type:varchar(128)"`
+	Size             int64      `gorm:"column:size;type:bigint;not null;default:0"`
+	Checksum         string     `gorm:"column:checksum;type:varchar(64)"`               // SHA-256（十六进制）
+	StorageKey       string     `gorm:"column:storage_key;type:varchar(255);not null"`  // 待上传时为存储前缀，确认上传后为实际写入位置
+	Status           int        `gorm:"column:status;type:smallint;not null;default:0"` // 0-待上传, 1-已上传
+	TicketHash       string     `gorm:"column:ticket_hash;type:varchar(64)"`            // 上传凭证的 SHA-256
+	TicketExpiresAt  time.Time  `gorm:"column:ticket_expires_at"`
+	UploadedAt       *time.Time `gorm:"column:uploaded_at"`
+	MsgID            int64      `gorm:"column:msg_id;type:bigint;not null;default:0;index:idx_attachment_msg"` // 引用该附件的消息，0-尚未发送
+	CreatedAt        time.Time
+}
+
+// ScheduledMessage 定时消息表
+// 索引：PK(schedule_id) + idx_sched_status_time(status, scheduled_at) + idx_sched_sender(sender_username, status)
+//   - idx_sched_status_time：定时任务领取到期消息
+//     典型查询: WHERE status = 0 AND scheduled_at <= NOW() ORDER BY scheduled_at LIMIT ? FOR UPDATE SKIP LOCKED
+//   - idx_sched_sender：按发送者列出待发送 / 发送失败的定时消息
+//
+// Payload 为 proto 编码的 logicv1.SendMessageRequest，到期后原样经 ChatService.SendMessage 发送，
+// 发送时重新校验成员关系与附件引用。ClaimedAt 为领取时间，领取后超时未完成的记录会被重新领取。
+type ScheduledMessage struct {
+	ScheduleID     int64      `gorm:"primaryKey;column:schedule_id;type:bigint;autoIncrement:false"`
+	SessionID      string     `gorm:"column:session_id;type:varchar(64);not null"`
+	SenderUsername string     `gorm:"column:sender_username;type:varchar(64);not null;index:idx_sched_sender,priority:1"`
+	Payload        []byte     `gorm:"column:payload;type:bytea;not null"`
+	ScheduledAt    time.Time  `gorm:"column:scheduled_at;not null;index:idx_sched_status_time,priority:2"`
+	Status         int        `gorm:"column:status;type:smallint;not null;default:0;index:idx_sched_status_time,priority:1;index:idx_sched_sender,priority:2"` // 0-待发送, 1-发送中, 2-已发送, 3-已取消, 4-发送失败
+	ClaimedAt      *time.Time `gorm:"column:claimed_at"`
+	MsgID          int64      `gorm:"column:msg_id;type:bigint;not null;default:0"` // 发送成功后生成的消息 ID
+	Error          string     `gorm:"column:error;type:varchar(255)"`               // 发送失败原因
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Announcement 系统公告表
+// 索引：PK(announcement_id)
+//   - PK：公告 ID 为 Snowflake，随时间递增，客户端以本地已看到的最新 ID 为游标补拉
+//     典型查询: WHERE announcement_id > ? ORDER BY announcement_id DESC LIMIT ?
+//
+// 公告不属于任何会话，不进入信箱；在线用户由 Task 广播实时收到，离线用户登录后补拉。
+type Announcement struct {
+	AnnouncementID int64  `gorm:"primaryKey;column:announcement_id;type:bigint;autoIncrement:false"`
+	Title          string `gorm:"column:title;type:varchar(128)"`
+	Content        string `gorm:"column:content;type:text;not null"`
+	FromUsername   string `gorm:"column:from_username;type:varchar(64);not null"`
+	CreatedAt      time.Time
+}
+
+// Webhook 会话出站 Webhook 订阅表
+// 索引：PK(webhook_id) + idx_webhook_session(session_id)
+//   - idx_webhook_session：Task 消费事件时按会话查找订阅
+//     典型查询: WHERE session_id = ?
+//
+// Events 为逗号分隔的事件名（见 WebhookEvent* 常量），为空表示订阅全部事件。
+// Secret 用于对投递内容做 HMAC-SHA256 签名，需明文保存。
+type Webhook struct {
+	WebhookID int64  `gorm:"primaryKey;column:webhook_id;type:bigint;autoIncrement:false"`
+	SessionID string `gorm:"column:session_id;type:varchar(64);not null;index:idx_webhook_session"`
+	URL       string `gorm:"column:url;type:varchar(512);not null"`
+	Secret    string `gorm:"column:secret;type:varchar(128);not null"`
+	Events    string `gorm:"column:events;type:varchar(128)"`
+	CreatedBy string `gorm:"column:created_by;type:varchar(64);not null"`
+	CreatedAt time.Time
+}
+
+// WebhookDelivery Webhook 投递日志表
+// 索引：PK(id) + idx_delivery_webhook(webhook_id, id) + idx_delivery_status_retry(status, next_retry_time) + idx_delivery_msg(msg_id)
+//   - idx_delivery_webhook：按 Webhook 倒序列出投递日志（游标分页）
+//     典型查询: WHERE webhook_id = ? AND id < ? ORDER BY id DESC LIMIT ?
+//   - idx_delivery_status_retry：定时任务领取到期待重试的投递
+//     典型查询: WHERE status = 0 AND next_retry_time <= NOW() ORDER BY next_retry_time LIMIT ? FOR UPDATE SKIP LOCKED
+//   - idx_delivery_msg：消息过期时删除包含其内容的投递记录
+//
+// Payload 为投递的 JSON 请求体，重试时原样重发。领取投递时将 NextRetryTime 推后作为租约，
+// 避免多个 Task 实例重复投递；投递结束后按结果更新状态或下一次重试时间。
+type WebhookDelivery struct {
+	ID            int64     `gorm:"primaryKey;column:id;autoIncrement;index:idx_delivery_webhook,priority:2"`
+	WebhookID     int64     `gorm:"column:webhook_id;type:bigint;not null;index:idx_delivery_webhook,priority:1"`
+	Event         string    `gorm:"column:event;type:varchar(32);not null"`
+	Payload       []byte    `gorm:"column:payload;type:bytea;not null"`
+	MsgID         int64     `gorm:"column:msg_id;type:bigint;not null;default:0;index:idx_delivery_msg"`                       // 事件关联的消息，0-非消息事件
+	Status        int       `gorm:"column:status;type:smallint;not null;default:0;index:idx_delivery_status_retry,priority:1"` // 0-待投递, 1-成功, 2-失败
+	Attempts      int       `gorm:"column:attempts;type:int;not null;default:0"`
+	ResponseCode  int       `gorm:"column:response_code;type:int;not null;default:0"`
+	Error         string    `gorm:"column:error;type:varchar(255)"`
+	NextRetryTime time.Time `gorm:"column:next_retry_time;index:idx_delivery_status_retry,priority:2"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// ModerationRule 内容审核规则表
+// 索引：PK(id)
+//
+// Kind 为 word（敏感词，大小写不敏感的子串匹配）或 regex（Go 正则）；Action 为 mask / flag / reject。
+// 规则表很小，Logic 各实例按 moderation.reload_interval 全量加载并热替换审核管道，修改规则无需重启。
+type ModerationRule struct {
+	ID        int64  `gorm:"primaryKey;column:id;autoIncrement"`
+	Kind      string `gorm:"column:kind;type:varchar(16);not null"`
+	Pattern   string `gorm:"column:pattern;type:varchar(255);not null"`
+	Action    string `gorm:"column:action;type:varchar(16);not null"`
+	Enabled   bool   `gorm:"column:enabled;not null;default:true"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ModerationReview 待复核消息表（审核结果为 flag 的消息）
+// 索引：PK(id) + idx_review_status(status, id)
+//   - idx_review_status：审核人员按入队顺序处理待复核消息
+//     典型查询: WHERE status = 0 ORDER BY id LIMIT ?
+//
+// 被标记的消息照常投递，同时入队等待人工复核；Content 为发送者提交的原文（打码前），便于人工判断。
+// 复核记录保存消息内容副本，消息过期时随消息一并删除。
+type ModerationReview struct {
+	ID             int64  `gorm:"primaryKey;column:id;autoIncrement;index:idx_review_status,priority:2"`
+	MsgID          int64  `gorm:"column:msg_id;type:bigint;not null"`
+	SessionID      string `gorm:"column:session_id;type:varchar(64);not null"`
 	SenderUsername string `gorm:"column:sender_username;type:varchar(64);not null"`
-	SeqID          int64  `gorm:"column:seq_id;type:bigint;not null;index:idx_sess_seq,priority:2"`
-	Content        string `gorm:"column:content;type:text"`
-	MsgType        string `gorm:"column:msg_type;type:varchar(32)"`
+	Content        string `gorm:"column:content;type:text;not null"`
+	Reasons        string `gorm:"column:reasons;type:varchar(255)"`                                                  // 命中的规则，分号分隔
+	Status         int    `gorm:"column:status;type:smallint;not null;default:0;index:idx_review_status,priority:1"` // 0-待复核, 1-已通过, 2-已处置
 	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 // Inbox 用户信箱表（写扩散）
-// 索引：PK(id) + uniq_owner_sess_seq(owner_username, session_id, seq_id) + idx_owner_read(owner_username, is_read)
+// 索引：PK(id) + uniq_owner_sess_seq(owner_username, session_id, seq_id) + idx_owner_read(owner_username, is_read) + idx_inbox_msg_id(msg_id)
 //   - uniq_owner_sess_seq：唯一约束，防止同一条消息重复写入同一用户信箱
 //   - idx_owner_read：查询某用户的未读消息 / 计算未读数
 //     典型查询: WHERE owner_username = ? AND is_read = 0
+//   - idx_inbox_msg_id：消息过期时删除其全部信箱记录
+//     典型查询: DELETE FROM t_inbox WHERE msg_id IN (?)
 type Inbox struct {
 	ID            int64  `gorm:"primaryKey;column:id;autoIncrement;index:idx_owner_id,priority:2"`
 	OwnerUsername string `gorm:"column:owner_username;type:varchar(64);not null;uniqueIndex:uniq_owner_sess_seq,priority:1;index:idx_owner_read,priority:1;index:idx_owner_id,priority:1"`
 	SessionID     string `gorm:"column:session_id;type:varchar(64);not null;uniqueIndex:uniq_owner_sess_seq,priority:2"`
-	MsgID         int64  `gorm:"column:msg_id;type:bigint;not null"`
+	MsgID         int64  `gorm:"column:msg_id;type:bigint;not null;index:idx_inbox_msg_id"`
 	SeqID         int64  `gorm:"column:seq_id;type:bigint;not null;uniqueIndex:uniq_owner_sess_seq,priority:3"`
 	IsRead        int    `gorm:"column:is_read;type:smallint;default:0;index:idx_owner_read,priority:2"`
 	CreatedAt     time.Time
@@ -126,17 +474,90 @@ type MessageOutbox struct {
 // 表名映射
 // ============================================================================
 
-func (User) TableName() string           { return "t_user" }
-func (Session) TableName() string        { return "t_session" }
-func (SessionMember) TableName() string  { return "t_session_member" }
-func (MessageContent) TableName() string { return "t_message_content" }
-func (Inbox) TableName() string          { return "t_inbox" }
-func (MessageOutbox) TableName() string  { return "t_message_outbox" }
+func (User) TableName() string             { return "t_user" }
+func (BotToken) TableName() string         { return "t_bot_token" }
+func (BotCommand) TableName() string       { return "t_bot_command" }
+func (Session) TableName() string          { return "t_session" }
+func (SessionMember) TableName() string    { return "t_session_member" }
+func (MessageContent) TableName() string   { return "t_message_content" }
+func (MessageRevision) TableName() string  { return "t_message_revision" }
+func (MessageReaction) TableName() string  { return "t_message_reaction" }
+func (MessageMention) TableName() string   { return "t_message_mention" }
+func (ThreadFollower) TableName() string   { return "t_thread_follower" }
+func (MessagePin) TableName() string       { return "t_message_pin" }
+func (MessageHidden) TableName() string    { return "t_message_hidden" }
+func (Poll) TableName() string             { return "t_poll" }
+func (PollVote) TableName() string         { return "t_poll_vote" }
+func (Attachment) TableName() string       { return "t_attachment" }
+func (ScheduledMessage) TableName() string { return "t_scheduled_message" }
+func (Announcement) TableName() string     { return "t_announcement" }
+func (Webhook) TableName() string          { return "t_webhook" }
+func (WebhookDelivery) TableName() string  { return "t_webhook_delivery" }
+func (ModerationRule) TableName() string   { return "t_moderation_rule" }
+func (ModerationReview) TableName() string { return "t_moderation_review" }
+func (Inbox) TableName() string            { return "t_inbox" }
+func (MessageOutbox) TableName() string    { return "t_message_outbox" }
 
 // ============================================================================
 // 常量
 // ============================================================================
 
+// 用户类型（User.Type）
+const (
+	UserTypeNormal = 0
+	UserTypeBot    = 1
+)
+
+// 附件状态
+const (
+	AttachmentStatusPending  = 0
+	AttachmentStatusUploaded = 1
+)
+
+// 定时消息状态
+const (
+	ScheduledStatusPending  = 0
+	ScheduledStatusSending  = 1
+	ScheduledStatusSent     = 2
+	ScheduledStatusCanceled = 3
+	ScheduledStatusFailed   = 4
+)
+
+// Webhook 投递状态
+const (
+	WebhookDeliveryPending   = 0
+	WebhookDeliverySucceeded = 1
+	WebhookDeliveryFailed    = 2
+)
+
+// Webhook 事件（Webhook.Events 与投递的 event 字段）
+const (
+	WebhookEventMessageCreated = "message.created"
+	WebhookEventMessageEdited  = "message.edited"
+	WebhookEventMemberChanged  = "member.changed"
+)
+
+// 审核规则类型（ModerationRule.Kind）
+const (
+	ModerationRuleWord  = "word"
+	ModerationRuleRegex = "regex"
+)
+
+// 待复核消息状态
+const (
+	ModerationReviewPending  = 0
+	ModerationReviewApproved = 1
+	ModerationReviewRemoved  = 2
+)
+
+// 会话成员角色（SessionMember.Role），数值越大权限越高
+// 群主以 Session.OwnerUsername 为准，早期创建者的角色记录为管理员
+const (
+	MemberRoleMember = 0
+	MemberRoleAdmin  = 1
+	MemberRoleOwner  = 2
+)
+
 // Outbox 状态
 const (
 	OutboxStatusPending = 0
@@ -144,13 +565,46 @@ const (
 	OutboxStatusFailed  = 2
 )
 
+// RecalledMessageContent 已撤回消息的墓碑占位内容
+const RecalledMessageContent = "[消息已撤回]"
+
+// 消息类型（MessageContent.MsgType）
+const (
+	MessageTypeText     = "text"
+	MessageTypeImage    = "image"
+	MessageTypeFile     = "file"
+	MessageTypeAudio    = "audio"
+	MessageTypeLocation = "location"
+	MessageTypeContact  = "contact"
+	MessageTypeSystem   = "system"
+	MessageTypeForward  = "forward" // 合并转发的聊天记录
+	MessageTypePoll     = "poll"    // 投票
+)
+
 // AllModels 返回所有需要 AutoMigrate 的模型列表
 func AllModels() []any {
 	return []any{
 		&User{},
+		&BotToken{},
+		&BotCommand{},
 		&Session{},
 		&SessionMember{},
 		&MessageContent{},
+		&MessageRevision{},
+		&MessageReaction{},
+		&MessageMention{},
+		&ThreadFollower{},
+		&MessagePin{},
+		&MessageHidden{},
+		&Poll{},
+		&PollVote{},
+		&Attachment{},
+		&ScheduledMessage{},
+		&Announcement{},
+		&Webhook{},
+		&WebhookDelivery{},
+		&ModerationRule{},
+		&ModerationReview{},
 		&Inbox{},
 		&MessageOutbox{},
 	}
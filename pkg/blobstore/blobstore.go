@@ -0,0 +1,134 @@
+// Package blobstore 提供附件二进制内容的存储抽象。
+// 当前实现本地文件系统存储，后续可扩展 S3 兼容的对象存储。
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotFound 对象不存在
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore 附件内容存储接口，key 为 "/" 分隔的相对路径。
+type BlobStore interface {
+	// Put 写入对象，已存在时覆盖；写入需原子完成，读方不会看到半写状态
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Open 打开对象用于读取，调用方负责 Close；不存在时返回 ErrNotFound
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	// Delete 删除对象，不存在时不报错
+	Delete(ctx context.Context, key string) error
+}
+
+// LocalStore 基于本地文件系统的 BlobStore 实现。
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore 创建本地文件系统存储，root 不存在时自动创建。
+func NewLocalStore(root string) (*LocalStore, error) {
+	if root == "" {
+		return nil, errors.New("blobstore: root dir is required")
+	}
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("blobstore: resolve root: %w", err)
+	}
+	if err := os.MkdirAll(abs, 0o755); err != nil {
+		return nil, fmt.Errorf("blobstore: create root: %w", err)
+	}
+	return &LocalStore{root: abs}, nil
+}
+
+// Put 先写入同目录临时文件再 rename，保证原子可见。
+func (s *LocalStore) Put(ctx context.Context, key string, r io.Reader) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return fmt.Errorf("blobstore: create dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("blobstore: create temp file: %w", err)
+	}
+	tmpName := tmp.Name()
+	defer os.Remove(tmpName) // rename 成功后为 no-op
+
+	if _, err := io.Copy(tmp, &ctxReader{ctx: ctx, r: r}); err != nil {
+		tmp.Close()
+		return fmt.Errorf("blobstore: write: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("blobstore: sync: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("blobstore: close: %w", err)
+	}
+	if err := os.Rename(tmpName, p); err != nil {
+		return fmt.Errorf("blobstore: rename: %w", err)
+	}
+	return nil
+}
+
+// Open 打开本地文件。
+func (s *LocalStore) Open(_ context.Context, key string) (io.ReadSeekCloser, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("blobstore: open: %w", err)
+	}
+	return f, nil
+}
+
+// Delete 删除本地文件。
+func (s *LocalStore) Delete(_ context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("blobstore: delete: %w", err)
+	}
+	return nil
+}
+
+// path 将 key 映射为 root 下的文件路径，拒绝绝对路径与越界的 ".." 片段。
+func (s *LocalStore) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("blobstore: invalid key %q", key)
+	}
+	clean := path.Clean(key)
+	if clean != key || clean == "." || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("blobstore: invalid key %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(clean)), nil
+}
+
+// ctxReader 在 context 取消后中断读取。
+type ctxReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *ctxReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
@@ -0,0 +1,26 @@
+// Package errcode 定义 SendMessageResponse.error_code 的取值。
+// Logic 在返回业务错误时填充，网关据此映射 HTTP 状态码并透传到 Ack，调用方无需解析错误文本。
+package errcode
+
+// 发送校验与处理失败
+const (
+	InvalidArgument  = "invalid_argument"   // 消息体、引用、话题或投票等参数校验失败
+	NotSessionMember = "not_session_member" // 发送者不是会话成员
+	PermissionDenied = "permission_denied"  // 无权执行（如 @所有人）
+	Muted            = "muted"              // 发送者在会话中被禁言
+	InProgress       = "in_progress"        // 相同 client_msg_id 的消息正在处理
+	Internal         = "internal"           // 服务端内部错误，可重试
+)
+
+// 斜杠命令
+const (
+	UnknownCommand  = "unknown_command"
+	CommandRejected = "command_rejected"
+	CommandFailed   = "command_failed"
+)
+
+// 内容审核
+const (
+	ContentRejected  = "content_rejected"
+	ModerationFailed = "moderation_failed"
+)
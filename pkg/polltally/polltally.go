@@ -0,0 +1,41 @@
+// Package polltally 将投票的计票记录转换为对外展示的计票状态。
+// Logic 的拉取接口与 Task 的推送共用同一转换，保证两端的计票结果一致。
+package polltally
+
+import (
+	"time"
+
+	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
+	"github.com/ceyewan/resonance/repo"
+)
+
+// Expired 投票是否已过截止时间
+func Expired(poll *gatewayv1.PollBody, now time.Time) bool {
+	return poll.GetDeadline() > 0 && now.Unix() >= poll.GetDeadline()
+}
+
+// Build 将计票记录转换为面向全体成员的 PollTally，不含个人选择
+// 已过截止时间但尚未记录结束的投票视为已结束，结束时间取截止时间
+func Build(poll *gatewayv1.PollBody, t *repo.PollTally, now time.Time) *gatewayv1.PollTally {
+	tally := &gatewayv1.PollTally{
+		Counts: make([]int32, len(poll.GetOptions())),
+	}
+	for option, count := range t.Counts {
+		if int(option) < len(tally.Counts) {
+			tally.Counts[option] = count
+		}
+	}
+	if t.Poll != nil {
+		tally.VoterCount = t.Poll.VoterCount
+		if t.Poll.ClosedAt != nil {
+			tally.Closed = true
+			tally.ClosedBy = t.Poll.ClosedBy
+			tally.ClosedAt = t.Poll.ClosedAt.Unix()
+		}
+	}
+	if !tally.Closed && Expired(poll, now) {
+		tally.Closed = true
+		tally.ClosedAt = poll.GetDeadline()
+	}
+	return tally
+}
@@ -0,0 +1,78 @@
+// Package safehttp 提供请求用户提供的回调地址（Webhook、机器人命令）时使用的 HTTP 客户端。
+// 在建立连接时校验 DNS 解析后的实际目标地址，拒绝回环、内网、链路本地（含云厂商元数据服务）等地址，
+// 防止借回调地址访问内部服务（SSRF）；按连接地址而非主机名校验，可以防御 DNS 重绑定。
+package safehttp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress 目标地址不允许访问
+var ErrForbiddenAddress = errors.New("safehttp: destination address is not allowed")
+
+// forbiddenPrefixes net/netip 未覆盖的保留地址段
+var forbiddenPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // 本网络
+	netip.MustParsePrefix("100.64.0.0/10"), // 运营商级 NAT
+	netip.MustParsePrefix("192.0.0.0/24"),  // IETF 协议分配
+	netip.MustParsePrefix("198.18.0.0/15"), // 基准测试
+	netip.MustParsePrefix("240.0.0.0/4"),   // 保留
+	netip.MustParsePrefix("64:ff9b::/96"),  // NAT64，可映射到任意 IPv4 地址
+}
+
+// Allowed 判断地址是否为可访问的公网地址
+func Allowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range forbiddenPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewClient 创建不跟随重定向的 HTTP 客户端
+// allowPrivate 为 true 时不校验目标地址，仅用于本地开发与测试（回调服务运行在本机或内网）
+func NewClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+	}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+			}
+			if !Allowed(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, addrPort.Addr())
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// 不使用环境变量中的代理：经代理转发时连接的是代理地址，无法校验实际目标
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		// 不跟随重定向，避免回调地址被重定向到非预期目标
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
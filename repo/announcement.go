@@ -0,0 +1,109 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/db"
+	"github.com/ceyewan/resonance/model"
+	"gorm.io/gorm"
+)
+
+// AnnouncementRepoOption 配置 AnnouncementRepo 的选项
+type AnnouncementRepoOption func(*announcementRepoOptions)
+
+type announcementRepoOptions struct {
+	logger clog.Logger
+}
+
+// WithAnnouncementRepoLogger 设置日志记录器
+func WithAnnouncementRepoLogger(logger clog.Logger) AnnouncementRepoOption {
+	return func(o *announcementRepoOptions) {
+		o.logger = logger
+	}
+}
+
+// announcementRepo 实现 AnnouncementRepo 接口
+type announcementRepo struct {
+	db     db.DB
+	logger clog.Logger
+}
+
+// NewAnnouncementRepo 创建 AnnouncementRepo 实例
+func NewAnnouncementRepo(database db.DB, opts ...AnnouncementRepoOption) (AnnouncementRepo, error) {
+	if database == nil {
+		return nil, fmt.Errorf("database cannot be nil")
+	}
+
+	options := &announcementRepoOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	// 提供默认 logger
+	var logger clog.Logger
+	if options.logger != nil {
+		logger = options.logger.WithNamespace("announcement_repo")
+	} else {
+		logger = clog.Discard()
+	}
+
+	return &announcementRepo{
+		db:     database,
+		logger: logger,
+	}, nil
+}
+
+// CreateAnnouncementWithOutbox 事务内保存公告并记录本地消息表
+func (r *announcementRepo) CreateAnnouncementWithOutbox(ctx context.Context, announcement *model.Announcement, outbox *model.MessageOutbox) error {
+	if announcement == nil || outbox == nil {
+		return fmt.Errorf("announcement and outbox cannot be nil")
+	}
+	if announcement.AnnouncementID == 0 {
+		return fmt.Errorf("announcement_id cannot be zero")
+	}
+
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		if err := tx.Create(announcement).Error; err != nil {
+			return fmt.Errorf("failed to save announcement: %w", err)
+		}
+		if err := tx.Create(outbox).Error; err != nil {
+			return fmt.Errorf("failed to save outbox: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("保存系统公告失败",
+			clog.Int64("announcement_id", announcement.AnnouncementID),
+			clog.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ListAnnouncements 获取 announcement_id > afterID 的最近 limit 条公告，按 ID 升序返回
+// 离线较久时只补拉最近的公告，更早的公告不再展示
+func (r *announcementRepo) ListAnnouncements(ctx context.Context, afterID int64, limit int) ([]*model.Announcement, error) {
+	var announcements []*model.Announcement
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("announcement_id > ?", afterID).
+		Order("announcement_id DESC").
+		Limit(limit).
+		Find(&announcements).Error; err != nil {
+		r.logger.Error("获取系统公告失败",
+			clog.Int64("after_id", afterID),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to list announcements: %w", err)
+	}
+
+	for i, j := 0, len(announcements)-1; i < j; i, j = i+1, j-1 {
+		announcements[i], announcements[j] = announcements[j], announcements[i]
+	}
+	return announcements, nil
+}
+
+// Close 释放资源
+func (r *announcementRepo) Close() error {
+	return nil
+}
@@ -0,0 +1,46 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ceyewan/resonance/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnouncementRepo_CreateAndList(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewAnnouncementRepo(database, WithAnnouncementRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	for id := int64(1); id <= 3; id++ {
+		outbox := &model.MessageOutbox{Topic: "test", Payload: []byte("announcement"), Status: model.OutboxStatusPending}
+		require.NoError(t, repo.CreateAnnouncementWithOutbox(ctx, &model.Announcement{
+			AnnouncementID: id,
+			Content:        "maintenance",
+			FromUsername:   "admin",
+		}, outbox))
+		assert.NotZero(t, outbox.ID, "公告与本地消息表同一事务写入")
+	}
+
+	t.Run("按游标补拉", func(t *testing.T) {
+		list, err := repo.ListAnnouncements(ctx, 1, 10)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, int64(2), list[0].AnnouncementID)
+		assert.Equal(t, int64(3), list[1].AnnouncementID)
+	})
+
+	t.Run("超出条数时只返回最近的公告", func(t *testing.T) {
+		list, err := repo.ListAnnouncements(ctx, 0, 2)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, int64(2), list[0].AnnouncementID)
+		assert.Equal(t, int64(3), list[1].AnnouncementID)
+	})
+}
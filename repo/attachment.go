@@ -0,0 +1,124 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/db"
+	"github.com/ceyewan/resonance/model"
+	"gorm.io/gorm"
+)
+
+// AttachmentRepoOption 配置 AttachmentRepo 的选项
+type AttachmentRepoOption func(*attachmentRepoOptions)
+
+type attachmentRepoOptions struct {
+	logger clog.Logger
+}
+
+// WithAttachmentRepoLogger 设置日志记录器
+func WithAttachmentRepoLogger(logger clog.Logger) AttachmentRepoOption {
+	return func(o *attachmentRepoOptions) {
+		o.logger = logger
+	}
+}
+
+// attachmentRepo 实现 AttachmentRepo 接口
+type attachmentRepo struct {
+	db     db.DB
+	logger clog.Logger
+}
+
+// NewAttachmentRepo 创建 AttachmentRepo 实例
+func NewAttachmentRepo(database db.DB, opts ...AttachmentRepoOption) (AttachmentRepo, error) {
+	if database == nil {
+		return nil, fmt.Errorf("database cannot be nil")
+	}
+
+	options := &attachmentRepoOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	// 提供默认 logger
+	var logger clog.Logger
+	if options.logger != nil {
+		logger = options.logger.WithNamespace("attachment_repo")
+	} else {
+		logger = clog.Discard()
+	}
+
+	return &attachmentRepo{
+		db:     database,
+		logger: logger,
+	}, nil
+}
+
+// CreateAttachment 创建待上传的附件记录
+func (r *attachmentRepo) CreateAttachment(ctx context.Context, attachment *model.Attachment) error {
+	if attachment == nil {
+		return fmt.Errorf("attachment cannot be nil")
+	}
+	if attachment.AttachmentID == 0 {
+		return fmt.Errorf("attachment_id cannot be zero")
+	}
+
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Create(attachment).Error; err != nil {
+		r.logger.Error("创建附件记录失败",
+			clog.Int64("attachment_id", attachment.AttachmentID),
+			clog.Error(err))
+		return fmt.Errorf("failed to create attachment: %w", err)
+	}
+	return nil
+}
+
+// GetAttachment 获取附件记录
+func (r *attachmentRepo) GetAttachment(ctx context.Context, attachmentID int64) (*model.Attachment, error) {
+	var attachment model.Attachment
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("attachment_id = ?", attachmentID).First(&attachment).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("attachment %w: %d", ErrNotFound, attachmentID)
+		}
+		r.logger.Error("获取附件记录失败",
+			clog.Int64("attachment_id", attachmentID),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+	return &attachment, nil
+}
+
+// MarkUploaded 确认上传完成
+// 并发上传时仅第一个确认者的存储位置生效，其余确认返回错误
+func (r *attachmentRepo) MarkUploaded(ctx context.Context, attachmentID int64, storageKey string, size int64, mimeType, checksum string, uploadedAt time.Time) error {
+	gormDB := r.db.DB(ctx)
+	result := gormDB.Model(&model.Attachment{}).
+		Where("attachment_id = ? AND status = ?", attachmentID, model.AttachmentStatusPending).
+		Updates(map[string]interface{}{
+			"storage_key": storageKey,
+			"size":        size,
+			"mime_type":   mimeType,
+			"checksum":    checksum,
+			"status":      model.AttachmentStatusUploaded,
+			"ticket_hash": "", // 凭证一次性使用
+			"uploaded_at": uploadedAt,
+		})
+	if result.Error != nil {
+		r.logger.Error("确认附件上传失败",
+			clog.Int64("attachment_id", attachmentID),
+			clog.Error(result.Error))
+		return fmt.Errorf("failed to mark attachment uploaded: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("attachment %w or not found: %d", ErrAlreadyUploaded, attachmentID)
+	}
+	return nil
+}
+
+// Close 释放资源
+func (r *attachmentRepo) Close() error {
+	return nil
+}
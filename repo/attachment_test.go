@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/resonance/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentRepo_UploadLifecycle(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewAttachmentRepo(database, WithAttachmentRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	attachment := &model.Attachment{
+		AttachmentID:     1001,
+		SessionID:        "s_attach",
+		UploaderUsername: "alice",
+		FileName:         "a.png",
+		MimeType:         "image/png",
+		Size:             3,
+		StorageKey:       "attachments/1001",
+		TicketHash:       "hash",
+		TicketExpiresAt:  time.Now().Add(time.Minute),
+	}
+	require.NoError(t, repo.CreateAttachment(ctx, attachment))
+
+	t.Run("确认上传", func(t *testing.T) {
+		err := repo.MarkUploaded(ctx, 1001, "attachments/1001/a", 3, "image/png", "abc", time.Now())
+		require.NoError(t, err)
+
+		found, err := repo.GetAttachment(ctx, 1001)
+		require.NoError(t, err)
+		assert.Equal(t, model.AttachmentStatusUploaded, found.Status)
+		assert.Equal(t, "attachments/1001/a", found.StorageKey)
+		assert.Equal(t, "abc", found.Checksum)
+		assert.Empty(t, found.TicketHash)
+		assert.NotNil(t, found.UploadedAt)
+	})
+
+	t.Run("重复确认应失败", func(t *testing.T) {
+		err := repo.MarkUploaded(ctx, 1001, "attachments/1001/b", 3, "image/png", "abc", time.Now())
+		require.Error(t, err)
+
+		found, err := repo.GetAttachment(ctx, 1001)
+		require.NoError(t, err)
+		assert.Equal(t, "attachments/1001/a", found.StorageKey, "后确认的并发上传不应覆盖存储位置")
+	})
+
+	t.Run("不存在的附件", func(t *testing.T) {
+		_, err := repo.GetAttachment(ctx, 9999)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
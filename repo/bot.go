@@ -0,0 +1,219 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/db"
+	"github.com/ceyewan/resonance/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BotRepoOption 配置 BotRepo 的选项
+type BotRepoOption func(*botRepoOptions)
+
+type botRepoOptions struct {
+	logger clog.Logger
+}
+
+// WithBotRepoLogger 设置日志记录器
+func WithBotRepoLogger(logger clog.Logger) BotRepoOption {
+	return func(o *botRepoOptions) {
+		o.logger = logger
+	}
+}
+
+// botRepo 实现 BotRepo 接口
+type botRepo struct {
+	db     db.DB
+	logger clog.Logger
+}
+
+// NewBotRepo 创建 BotRepo 实例
+func NewBotRepo(database db.DB, opts ...BotRepoOption) (BotRepo, error) {
+	if database == nil {
+		return nil, fmt.Errorf("database cannot be nil")
+	}
+
+	options := &botRepoOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	// 提供默认 logger
+	var logger clog.Logger
+	if options.logger != nil {
+		logger = options.logger.WithNamespace("bot_repo")
+	} else {
+		logger = clog.Discard()
+	}
+
+	return &botRepo{
+		db:     database,
+		logger: logger,
+	}, nil
+}
+
+// CreateBot 事务内创建机器人账号及其 Token
+func (r *botRepo) CreateBot(ctx context.Context, bot *model.User, tokenHash string) error {
+	if bot == nil || bot.Username == "" {
+		return fmt.Errorf("bot username cannot be empty")
+	}
+	if tokenHash == "" {
+		return fmt.Errorf("token hash cannot be empty")
+	}
+	bot.Type = model.UserTypeBot
+
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		if err := tx.Create(bot).Error; err != nil {
+			return fmt.Errorf("failed to create bot: %w", err)
+		}
+		token := &model.BotToken{BotUsername: bot.Username, TokenHash: tokenHash}
+		if err := tx.Create(token).Error; err != nil {
+			return fmt.Errorf("failed to create bot token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("创建机器人失败",
+			clog.String("username", bot.Username),
+			clog.String("owner", bot.OwnerUsername),
+			clog.Error(err))
+		return err
+	}
+
+	r.logger.Info("创建机器人成功",
+		clog.String("username", bot.Username),
+		clog.String("owner", bot.OwnerUsername))
+	return nil
+}
+
+// ListBots 列出创建者的机器人账号
+func (r *botRepo) ListBots(ctx context.Context, ownerUsername string) ([]*model.User, error) {
+	var bots []*model.User
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("owner_username = ? AND type = ?", ownerUsername, model.UserTypeBot).
+		Order("created_at ASC").
+		Find(&bots).Error; err != nil {
+		r.logger.Error("获取机器人列表失败",
+			clog.String("owner", ownerUsername),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to list bots: %w", err)
+	}
+	return bots, nil
+}
+
+// GetBotByTokenHash 按 Token 哈希获取机器人账号
+func (r *botRepo) GetBotByTokenHash(ctx context.Context, tokenHash string) (*model.User, error) {
+	var bot model.User
+	gormDB := r.db.DB(ctx)
+	err := gormDB.Joins("JOIN t_bot_token ON t_bot_token.bot_username = t_user.username").
+		Where("t_bot_token.token_hash = ? AND t_user.type = ?", tokenHash, model.UserTypeBot).
+		First(&bot).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("bot token %w", ErrNotFound)
+		}
+		r.logger.Error("按 Token 获取机器人失败", clog.Error(err))
+		return nil, fmt.Errorf("failed to get bot by token: %w", err)
+	}
+	return &bot, nil
+}
+
+// ReplaceBotToken 覆盖机器人的 Token
+func (r *botRepo) ReplaceBotToken(ctx context.Context, botUsername, tokenHash string) error {
+	if botUsername == "" || tokenHash == "" {
+		return fmt.Errorf("bot username and token hash cannot be empty")
+	}
+
+	token := &model.BotToken{BotUsername: botUsername, TokenHash: tokenHash}
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "bot_username"}},
+		DoUpdates: clause.AssignmentColumns([]string{"token_hash", "created_at"}),
+	}).Create(token).Error; err != nil {
+		r.logger.Error("轮换机器人 Token 失败",
+			clog.String("username", botUsername),
+			clog.Error(err))
+		return fmt.Errorf("failed to replace bot token: %w", err)
+	}
+	return nil
+}
+
+// UpsertBotCommand 注册或更新机器人的斜杠命令
+func (r *botRepo) UpsertBotCommand(ctx context.Context, cmd *model.BotCommand) error {
+	if cmd == nil || cmd.BotUsername == "" || cmd.Name == "" {
+		return fmt.Errorf("bot username and command name cannot be empty")
+	}
+
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "bot_username"}, {Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"description", "url", "secret", "updated_at"}),
+	}).Create(cmd).Error; err != nil {
+		r.logger.Error("注册机器人命令失败",
+			clog.String("bot", cmd.BotUsername),
+			clog.String("name", cmd.Name),
+			clog.Error(err))
+		return fmt.Errorf("failed to upsert bot command: %w", err)
+	}
+	return nil
+}
+
+// DeleteBotCommand 删除机器人的斜杠命令，返回是否删除了记录
+func (r *botRepo) DeleteBotCommand(ctx context.Context, botUsername, name string) (bool, error) {
+	gormDB := r.db.DB(ctx)
+	result := gormDB.Where("bot_username = ? AND name = ?", botUsername, name).Delete(&model.BotCommand{})
+	if result.Error != nil {
+		r.logger.Error("删除机器人命令失败",
+			clog.String("bot", botUsername),
+			clog.String("name", name),
+			clog.Error(result.Error))
+		return false, fmt.Errorf("failed to delete bot command: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ListBotCommands 列出机器人注册的斜杠命令
+func (r *botRepo) ListBotCommands(ctx context.Context, botUsername string) ([]*model.BotCommand, error) {
+	var cmds []*model.BotCommand
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("bot_username = ?", botUsername).
+		Order("name ASC").
+		Find(&cmds).Error; err != nil {
+		r.logger.Error("获取机器人命令列表失败",
+			clog.String("bot", botUsername),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to list bot commands: %w", err)
+	}
+	return cmds, nil
+}
+
+// ListSessionBotCommands 列出会话内机器人成员注册的斜杠命令
+// name 非空时只返回该命令；同名命令按机器人用户名排序，调用方取第一个
+func (r *botRepo) ListSessionBotCommands(ctx context.Context, sessionID, name string) ([]*model.BotCommand, error) {
+	var cmds []*model.BotCommand
+	gormDB := r.db.DB(ctx)
+	query := gormDB.Model(&model.BotCommand{}).
+		Joins("JOIN t_session_member ON t_session_member.username = t_bot_command.bot_username").
+		Where("t_session_member.session_id = ?", sessionID)
+	if name != "" {
+		query = query.Where("t_bot_command.name = ?", name)
+	}
+	if err := query.Order("t_bot_command.name ASC, t_bot_command.bot_username ASC").
+		Find(&cmds).Error; err != nil {
+		r.logger.Error("获取会话机器人命令失败",
+			clog.String("session_id", sessionID),
+			clog.String("name", name),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to list session bot commands: %w", err)
+	}
+	return cmds, nil
+}
+
+// Close 释放资源
+func (r *botRepo) Close() error {
+	return nil
+}
@@ -0,0 +1,92 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ceyewan/resonance/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBotRepo_TokenLifecycle(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewBotRepo(database, WithBotRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	require.NoError(t, repo.CreateBot(ctx, &model.User{
+		Username:      "ci_bot",
+		Nickname:      "CI",
+		OwnerUsername: "alice",
+	}, "hash_1"))
+
+	bots, err := repo.ListBots(ctx, "alice")
+	require.NoError(t, err)
+	require.Len(t, bots, 1)
+	assert.Equal(t, model.UserTypeBot, bots[0].Type)
+
+	bot, err := repo.GetBotByTokenHash(ctx, "hash_1")
+	require.NoError(t, err)
+	assert.Equal(t, "ci_bot", bot.Username)
+
+	t.Run("轮换后旧 Token 失效", func(t *testing.T) {
+		require.NoError(t, repo.ReplaceBotToken(ctx, "ci_bot", "hash_2"))
+
+		_, err := repo.GetBotByTokenHash(ctx, "hash_1")
+		require.Error(t, err)
+
+		bot, err := repo.GetBotByTokenHash(ctx, "hash_2")
+		require.NoError(t, err)
+		assert.Equal(t, "ci_bot", bot.Username)
+	})
+}
+
+func TestBotRepo_SessionCommands(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewBotRepo(database, WithBotRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+	sessionRepo, err := NewSessionRepo(database)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, bot := range []string{"ci_bot", "other_bot"} {
+		require.NoError(t, repo.CreateBot(ctx, &model.User{Username: bot, OwnerUsername: "alice"}, "hash_"+bot))
+		require.NoError(t, repo.UpsertBotCommand(ctx, &model.BotCommand{
+			BotUsername: bot,
+			Name:        "deploy",
+			URL:         "https://example.com/" + bot,
+			Secret:      "secret",
+		}))
+	}
+	require.NoError(t, sessionRepo.AddMember(ctx, &model.SessionMember{SessionID: "s_1", Username: "ci_bot"}))
+
+	cmds, err := repo.ListSessionBotCommands(ctx, "s_1", "deploy")
+	require.NoError(t, err)
+	require.Len(t, cmds, 1, "只解析会话成员中的机器人命令")
+	assert.Equal(t, "ci_bot", cmds[0].BotUsername)
+
+	require.NoError(t, repo.UpsertBotCommand(ctx, &model.BotCommand{
+		BotUsername: "ci_bot",
+		Name:        "deploy",
+		URL:         "https://example.com/v2",
+		Secret:      "secret",
+	}))
+	cmds, err = repo.ListBotCommands(ctx, "ci_bot")
+	require.NoError(t, err)
+	require.Len(t, cmds, 1)
+	assert.Equal(t, "https://example.com/v2", cmds[0].URL)
+
+	deleted, err := repo.DeleteBotCommand(ctx, "ci_bot", "deploy")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	cmds, err = repo.ListSessionBotCommands(ctx, "s_1", "")
+	require.NoError(t, err)
+	assert.Empty(t, cmds)
+}
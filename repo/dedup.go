@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/connector"
+	"github.com/redis/go-redis/v9"
+)
+
+// 确保 messageDedupRepo 实现了 MessageDedupRepo 接口
+var _ MessageDedupRepo = (*messageDedupRepo)(nil)
+
+// dedupKeyPrefix 去重键前缀
+const dedupKeyPrefix = "resonance:dedup:msg:"
+
+// dedupPending 首次发送处理中的占位值
+const dedupPending = ""
+
+// messageDedupRepo MessageDedupRepo 的 Redis 实现
+// 需要 SETNX 的原子语义，因此直接使用 Redis 客户端而非 cache 组件
+type messageDedupRepo struct {
+	client *redis.Client
+	logger clog.Logger
+}
+
+// MessageDedupRepoOption 配置选项
+type MessageDedupRepoOption func(*messageDedupRepoOptions)
+
+type messageDedupRepoOptions struct {
+	logger clog.Logger
+}
+
+// WithMessageDedupRepoLogger 设置日志记录器
+func WithMessageDedupRepoLogger(logger clog.Logger) MessageDedupRepoOption {
+	return func(opts *messageDedupRepoOptions) {
+		opts.logger = logger
+	}
+}
+
+// NewMessageDedupRepo 创建 MessageDedupRepo 实例
+func NewMessageDedupRepo(redisConn connector.RedisConnector, opts ...MessageDedupRepoOption) (MessageDedupRepo, error) {
+	if redisConn == nil {
+		return nil, fmt.Errorf("redis connector cannot be nil")
+	}
+	client := redisConn.GetClient()
+	if client == nil {
+		return nil, fmt.Errorf("redis connector is not connected: call Connect() before NewMessageDedupRepo")
+	}
+
+	options := &messageDedupRepoOptions{
+		logger: clog.Discard(),
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return &messageDedupRepo{
+		client: client,
+		logger: options.logger.WithNamespace("dedup"),
+	}, nil
+}
+
+// Acquire 尝试占用去重键
+func (r *messageDedupRepo) Acquire(ctx context.Context, sender, clientMsgID string, ttl time.Duration) (*SentMessageRecord, bool, error) {
+	key := r.buildKey(sender, clientMsgID)
+
+	ok, err := r.client.SetNX(ctx, key, dedupPending, ttl).Result()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to acquire dedup key: %w", err)
+	}
+	if ok {
+		return nil, true, nil
+	}
+
+	val, err := r.client.Get(ctx, key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			// 占位键恰好过期或被释放，视为处理中，由客户端稍后重试
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to get dedup record: %w", err)
+	}
+	if val == dedupPending {
+		return nil, false, nil
+	}
+
+	var record SentMessageRecord
+	if err := json.Unmarshal([]byte(val), &record); err != nil {
+		return nil, false, fmt.Errorf("failed to decode dedup record: %w", err)
+	}
+	return &record, false, nil
+}
+
+// Complete 记录发送结果
+func (r *messageDedupRepo) Complete(ctx context.Context, sender, clientMsgID string, record *SentMessageRecord, ttl time.Duration) error {
+	if record == nil {
+		return fmt.Errorf("record cannot be nil")
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode dedup record: %w", err)
+	}
+	if err := r.client.Set(ctx, r.buildKey(sender, clientMsgID), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set dedup record: %w", err)
+	}
+	return nil
+}
+
+// Release 释放去重键
+func (r *messageDedupRepo) Release(ctx context.Context, sender, clientMsgID string) error {
+	if err := r.client.Del(ctx, r.buildKey(sender, clientMsgID)).Err(); err != nil {
+		return fmt.Errorf("failed to release dedup key: %w", err)
+	}
+	return nil
+}
+
+// buildKey 构建去重键
+func (r *messageDedupRepo) buildKey(sender, clientMsgID string) string {
+	return fmt.Sprintf("%s%s:%s", dedupKeyPrefix, sender, clientMsgID)
+}
+
+// Close 关闭资源（Redis 连接由调用方管理）
+func (r *messageDedupRepo) Close() error {
+	return nil
+}
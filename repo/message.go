@@ -3,7 +3,12 @@ package repo
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/ceyewan/genesis/clog"
 	"github.com/ceyewan/genesis/db"
@@ -103,6 +108,51 @@ func (r *messageRepo) SaveMessage(ctx context.Context, msg *model.MessageContent
 	return nil
 }
 
+// GetMessage 根据消息 ID 获取消息内容（已过期但尚未清理的消息视为不存在）
+func (r *messageRepo) GetMessage(ctx context.Context, msgID int64) (*model.MessageContent, error) {
+	if msgID == 0 {
+		return nil, fmt.Errorf("msg_id cannot be zero")
+	}
+
+	var message model.MessageContent
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("msg_id = ?", msgID).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		First(&message).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("message %w: %d", ErrNotFound, msgID)
+		}
+		r.logger.Error("获取消息失败",
+			clog.Int64("msg_id", msgID),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	return &message, nil
+}
+
+// GetMessagesByIDs 批量获取消息内容（不存在或已过期的 ID 会被忽略）
+// username 非空时同时忽略该用户已删除（仅自己）的消息
+func (r *messageRepo) GetMessagesByIDs(ctx context.Context, msgIDs []int64, username string) ([]*model.MessageContent, error) {
+	if len(msgIDs) == 0 {
+		return []*model.MessageContent{}, nil
+	}
+
+	var messages []*model.MessageContent
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("msg_id IN ?", msgIDs).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Scopes(visibleTo("t_message_content", username)).
+		Find(&messages).Error; err != nil {
+		r.logger.Error("批量获取消息失败",
+			clog.Int("count", len(msgIDs)),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to get messages by ids: %w", err)
+	}
+
+	return messages, nil
+}
+
 // SaveInbox 批量写入信箱 (写扩散)
 func (r *messageRepo) SaveInbox(ctx context.Context, inboxes []*model.Inbox) error {
 	if len(inboxes) == 0 {
@@ -129,12 +179,122 @@ func (r *messageRepo) SaveInbox(ctx context.Context, inboxes []*model.Inbox) err
 	return nil
 }
 
+// SaveMentions 批量写入提及记录 (写扩散)
+func (r *messageRepo) SaveMentions(ctx context.Context, mentions []*model.MessageMention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	gormDB := r.db.DB(ctx)
+	// 幂等写入：主键冲突（msg_id, username）时忽略
+	if err := gormDB.Clauses(clause.OnConflict{DoNothing: true}).Create(&mentions).Error; err != nil {
+		r.logger.Error("批量写入提及记录失败",
+			clog.Int("count", len(mentions)),
+			clog.Error(err))
+		return fmt.Errorf("failed to save mentions: %w", err)
+	}
+
+	return nil
+}
+
+// GetMentionsByMsgIDs 批量获取消息的提及记录
+func (r *messageRepo) GetMentionsByMsgIDs(ctx context.Context, msgIDs []int64) ([]*model.MessageMention, error) {
+	if len(msgIDs) == 0 {
+		return []*model.MessageMention{}, nil
+	}
+
+	var mentions []*model.MessageMention
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("msg_id IN ?", msgIDs).Find(&mentions).Error; err != nil {
+		r.logger.Error("批量获取提及记录失败",
+			clog.Int("count", len(msgIDs)),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to get mentions: %w", err)
+	}
+
+	return mentions, nil
+}
+
+// GetReadReceipts 获取区间内消息的已读聚合
+// 已读成员：除发送者外 last_read_seq >= seq_id 的会话成员；仅统计主时间线消息
+func (r *messageRepo) GetReadReceipts(ctx context.Context, sessionID string, fromSeq, toSeq int64, reader string, limit int) ([]*ReadReceiptItem, error) {
+	if sessionID == "" {
+		return nil, fmt.Errorf("session_id cannot be empty")
+	}
+	if toSeq <= fromSeq {
+		return []*ReadReceiptItem{}, nil
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+
+	var items []*ReadReceiptItem
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Raw(`
+		SELECT m.msg_id AS msg_id, m.seq_id AS seq_id, m.sender_username AS sender_username,
+			COUNT(sm.username) AS read_count
+		FROM t_message_content m
+		LEFT JOIN t_session_member sm ON sm.session_id = m.session_id
+			AND sm.username <> m.sender_username
+			AND sm.last_read_seq >= m.seq_id
+		WHERE m.session_id = ? AND m.seq_id > ? AND m.seq_id <= ?
+			AND m.thread_root_id = 0 AND m.sender_username <> ?
+		GROUP BY m.msg_id, m.seq_id, m.sender_username
+		ORDER BY m.seq_id DESC
+		LIMIT ?
+	`, sessionID, fromSeq, toSeq, reader, limit).Scan(&items).Error; err != nil {
+		r.logger.Error("获取已读回执失败",
+			clog.String("session_id", sessionID),
+			clog.Int64("from_seq", fromSeq),
+			clog.Int64("to_seq", toSeq),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to get read receipts: %w", err)
+	}
+
+	return items, nil
+}
+
+// GetUnreadMentionCounts 获取用户各会话中未读消息的提及数
+// 未读以会话成员的 last_read_seq 为界，已撤回的消息不计入
+func (r *messageRepo) GetUnreadMentionCounts(ctx context.Context, username string) (map[string]int64, error) {
+	if username == "" {
+		return nil, fmt.Errorf("username cannot be empty")
+	}
+
+	type row struct {
+		SessionID string
+		Count     int64
+	}
+	var rows []row
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Raw(`
+		SELECT mm.session_id AS session_id, COUNT(*) AS count
+		FROM t_message_mention mm
+		JOIN t_session_member sm ON sm.session_id = mm.session_id AND sm.username = mm.username
+		JOIN t_message_content m ON m.msg_id = mm.msg_id
+		WHERE mm.username = ? AND mm.seq_id > sm.last_read_seq AND m.recalled = false
+		GROUP BY mm.session_id
+	`, username).Scan(&rows).Error; err != nil {
+		r.logger.Error("统计未读提及数失败",
+			clog.String("username", username),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to get unread mention counts: %w", err)
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.SessionID] = row.Count
+	}
+	return counts, nil
+}
+
 // GetHistoryMessages 拉取历史消息
 // 语义：
 //   - beforeSeq == 0: 拉取该会话“最近”的 limit 条消息
 //   - beforeSeq > 0: 拉取 seq_id < beforeSeq 的历史消息
 // 返回顺序统一为 seq_id 升序，方便前端直接渲染。
-func (r *messageRepo) GetHistoryMessages(ctx context.Context, sessionID string, beforeSeq int64, limit int) ([]*model.MessageContent, error) {
+// username 非空时排除该用户已删除（仅自己）的消息。
+func (r *messageRepo) GetHistoryMessages(ctx context.Context, sessionID, username string, beforeSeq int64, limit int) ([]*model.MessageContent, error) {
 	if sessionID == "" {
 		return nil, fmt.Errorf("session_id cannot be empty")
 	}
@@ -148,7 +308,10 @@ func (r *messageRepo) GetHistoryMessages(ctx context.Context, sessionID string,
 	var messages []*model.MessageContent
 	gormDB := r.db.DB(ctx)
 
-	query := gormDB.Where("session_id = ?", sessionID)
+	// 话题消息使用独立序号空间，不进入主时间线
+	query := gormDB.Where("session_id = ? AND thread_root_id = 0", sessionID).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()). // 已过期但尚未被清理的消息不再返回
+		Scopes(visibleTo("t_message_content", username))
 	if beforeSeq > 0 {
 		query = query.Where("seq_id < ?", beforeSeq)
 	}
@@ -172,6 +335,156 @@ func (r *messageRepo) GetHistoryMessages(ctx context.Context, sessionID string,
 	return messages, nil
 }
 
+// GetThreadMessages 拉取话题消息
+// 返回话题内 seq_id > afterSeq 且对 username 可见的消息，按 seq_id 升序
+func (r *messageRepo) GetThreadMessages(ctx context.Context, rootMsgID int64, username string, afterSeq int64, limit int) ([]*model.MessageContent, error) {
+	if rootMsgID == 0 {
+		return nil, fmt.Errorf("root_msg_id cannot be zero")
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+
+	var messages []*model.MessageContent
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("thread_root_id = ? AND seq_id > ?", rootMsgID, afterSeq).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Scopes(visibleTo("t_message_content", username)).
+		Order("seq_id ASC").
+		Limit(limit).
+		Find(&messages).Error; err != nil {
+		r.logger.Error("拉取话题消息失败",
+			clog.Int64("root_msg_id", rootMsgID),
+			clog.Int64("after_seq", afterSeq),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to get thread messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+// FollowThread 关注话题（重复关注忽略）
+func (r *messageRepo) FollowThread(ctx context.Context, rootMsgID int64, username string) error {
+	if rootMsgID == 0 || username == "" {
+		return fmt.Errorf("root_msg_id and username are required")
+	}
+
+	follower := &model.ThreadFollower{
+		RootMsgID: rootMsgID,
+		Username:  username,
+	}
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Clauses(clause.OnConflict{DoNothing: true}).Create(follower).Error; err != nil {
+		r.logger.Error("关注话题失败",
+			clog.Int64("root_msg_id", rootMsgID),
+			clog.String("username", username),
+			clog.Error(err))
+		return fmt.Errorf("failed to follow thread: %w", err)
+	}
+
+	return nil
+}
+
+// UnfollowThread 取消关注话题
+func (r *messageRepo) UnfollowThread(ctx context.Context, rootMsgID int64, username string) error {
+	if rootMsgID == 0 || username == "" {
+		return fmt.Errorf("root_msg_id and username are required")
+	}
+
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("root_msg_id = ? AND username = ?", rootMsgID, username).
+		Delete(&model.ThreadFollower{}).Error; err != nil {
+		r.logger.Error("取消关注话题失败",
+			clog.Int64("root_msg_id", rootMsgID),
+			clog.String("username", username),
+			clog.Error(err))
+		return fmt.Errorf("failed to unfollow thread: %w", err)
+	}
+
+	return nil
+}
+
+// GetThreadFollowers 获取话题的关注者用户名列表
+func (r *messageRepo) GetThreadFollowers(ctx context.Context, rootMsgID int64) ([]string, error) {
+	if rootMsgID == 0 {
+		return nil, fmt.Errorf("root_msg_id cannot be zero")
+	}
+
+	var usernames []string
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Model(&model.ThreadFollower{}).
+		Where("root_msg_id = ?", rootMsgID).
+		Pluck("username", &usernames).Error; err != nil {
+		r.logger.Error("获取话题关注者失败",
+			clog.Int64("root_msg_id", rootMsgID),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to get thread followers: %w", err)
+	}
+
+	return usernames, nil
+}
+
+// PinMessage 置顶消息，已置顶时不重复写入
+func (r *messageRepo) PinMessage(ctx context.Context, pin *model.MessagePin) (bool, error) {
+	if pin == nil || pin.SessionID == "" || pin.MsgID == 0 {
+		return false, fmt.Errorf("session_id and msg_id are required")
+	}
+
+	gormDB := r.db.DB(ctx)
+	result := gormDB.Clauses(clause.OnConflict{DoNothing: true}).Create(pin)
+	if result.Error != nil {
+		r.logger.Error("置顶消息失败",
+			clog.String("session_id", pin.SessionID),
+			clog.Int64("msg_id", pin.MsgID),
+			clog.Error(result.Error))
+		return false, fmt.Errorf("failed to pin message: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// UnpinMessage 取消置顶
+func (r *messageRepo) UnpinMessage(ctx context.Context, sessionID string, msgID int64) (bool, error) {
+	if sessionID == "" || msgID == 0 {
+		return false, fmt.Errorf("session_id and msg_id are required")
+	}
+
+	gormDB := r.db.DB(ctx)
+	result := gormDB.Where("session_id = ? AND msg_id = ?", sessionID, msgID).Delete(&model.MessagePin{})
+	if result.Error != nil {
+		r.logger.Error("取消置顶失败",
+			clog.String("session_id", sessionID),
+			clog.Int64("msg_id", msgID),
+			clog.Error(result.Error))
+		return false, fmt.Errorf("failed to unpin message: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// GetPinnedMessages 获取会话的置顶记录（按置顶时间倒序）
+func (r *messageRepo) GetPinnedMessages(ctx context.Context, sessionID string) ([]*model.MessagePin, error) {
+	if sessionID == "" {
+		return nil, fmt.Errorf("session_id cannot be empty")
+	}
+
+	var pins []*model.MessagePin
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("session_id = ?", sessionID).
+		Order("created_at DESC").
+		Find(&pins).Error; err != nil {
+		r.logger.Error("获取置顶消息失败",
+			clog.String("session_id", sessionID),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to get pinned messages: %w", err)
+	}
+
+	return pins, nil
+}
+
 // GetLastMessage 获取会话的最后一条消息
 func (r *messageRepo) GetLastMessage(ctx context.Context, sessionID string) (*model.MessageContent, error) {
 	if sessionID == "" {
@@ -182,11 +495,11 @@ func (r *messageRepo) GetLastMessage(ctx context.Context, sessionID string) (*mo
 	gormDB := r.db.DB(ctx)
 
 	// 按序列号降序获取最后一条消息
-	if err := gormDB.Where("session_id = ?", sessionID).
+	if err := gormDB.Where("session_id = ? AND thread_root_id = 0", sessionID).
 		Order("seq_id DESC").
 		First(&message).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("no message found in session: %s", sessionID)
+			return nil, fmt.Errorf("last message %w in session: %s", ErrNotFound, sessionID)
 		}
 		r.logger.Error("获取最后一条消息失败",
 			clog.String("session_id", sessionID),
@@ -197,8 +510,8 @@ func (r *messageRepo) GetLastMessage(ctx context.Context, sessionID string) (*mo
 	return &message, nil
 }
 
-// GetLastMessagesBatch 批量获取会话的最后一条消息（避免 N+1 查询）
-func (r *messageRepo) GetLastMessagesBatch(ctx context.Context, sessionIDs []string) ([]*model.MessageContent, error) {
+// GetLastMessagesBatch 批量获取会话中 username 可见的最后一条消息（避免 N+1 查询）
+func (r *messageRepo) GetLastMessagesBatch(ctx context.Context, username string, sessionIDs []string) ([]*model.MessageContent, error) {
 	if len(sessionIDs) == 0 {
 		return []*model.MessageContent{}, nil
 	}
@@ -208,11 +521,14 @@ func (r *messageRepo) GetLastMessagesBatch(ctx context.Context, sessionIDs []str
 
 	// 使用子查询获取每个会话的最后一条消息
 	// 子查询：对每个 session_id，获取 seq_id 最大的消息
-	subquery := gormDB.Select("session_id, MAX(seq_id) as max_seq_id").
-		Where("session_id IN ?", sessionIDs).
+	// 话题消息的 seq_id 属于独立序号空间，需同时排除；用户已删除（仅自己）的消息不参与
+	subquery := gormDB.Table("t_message_content").
+		Select("session_id, MAX(seq_id) as max_seq_id").
+		Where("session_id IN ? AND thread_root_id = 0", sessionIDs).
+		Scopes(visibleTo("t_message_content", username)).
 		Group("session_id")
 
-	if err := gormDB.Where("(session_id, seq_id) IN (?)",
+	if err := gormDB.Where("thread_root_id = 0 AND (session_id, seq_id) IN (?)",
 		gormDB.Select("session_id, max_seq_id").Table("(?) as t", subquery)).
 		Find(&messages).Error; err != nil {
 		r.logger.Error("批量获取最后一条消息失败",
@@ -273,6 +589,15 @@ func (r *messageRepo) GetInboxDelta(ctx context.Context, username string, cursor
 		SenderUsername string
 		Content        string
 		MsgType        string
+		Recalled       bool
+		RecalledBy     string
+		EditVersion    int32
+		EditedAt       *time.Time
+		ReplyToMsgID   int64
+		MentionAll     bool
+		FromBot        bool
+		Body           []byte
+		ExpiresAt      *time.Time
 		CreatedAt      time.Time
 	}
 
@@ -287,10 +612,21 @@ func (r *messageRepo) GetInboxDelta(ctx context.Context, username string, cursor
 			m.sender_username AS sender_username,
 			m.content AS content,
 			m.msg_type AS msg_type,
+			m.recalled AS recalled,
+			m.recalled_by AS recalled_by,
+			m.edit_version AS edit_version,
+			m.edited_at AS edited_at,
+			m.reply_to_msg_id AS reply_to_msg_id,
+			m.mention_all AS mention_all,
+			m.from_bot AS from_bot,
+			m.body AS body,
+			m.expires_at AS expires_at,
 			m.created_at AS created_at
 		`).
 		Joins("INNER JOIN t_message_content m ON m.msg_id = i.msg_id").
 		Where("i.owner_username = ? AND i.id > ?", username, cursorID).
+		Where("m.expires_at IS NULL OR m.expires_at > ?", time.Now()).
+		Scopes(visibleTo("m", username)).
 		Order("i.id ASC").
 		Limit(limit).
 		Scan(&rows).Error; err != nil {
@@ -312,6 +648,15 @@ func (r *messageRepo) GetInboxDelta(ctx context.Context, username string, cursor
 			SenderUsername: row.SenderUsername,
 			Content:        row.Content,
 			MsgType:        row.MsgType,
+			Recalled:       row.Recalled,
+			RecalledBy:     row.RecalledBy,
+			EditVersion:    row.EditVersion,
+			EditedAt:       row.EditedAt,
+			ReplyToMsgID:   row.ReplyToMsgID,
+			MentionAll:     row.MentionAll,
+			FromBot:        row.FromBot,
+			Body:           row.Body,
+			ExpiresAt:      row.ExpiresAt,
 			CreatedAt:      row.CreatedAt,
 		})
 	}
@@ -319,8 +664,137 @@ func (r *messageRepo) GetInboxDelta(ctx context.Context, username string, cursor
 	return items, nil
 }
 
-// SaveMessageWithOutbox 事务内保存消息并记录本地消息表
-func (r *messageRepo) SaveMessageWithOutbox(ctx context.Context, msg *model.MessageContent, outbox *model.MessageOutbox) error {
+// SearchMessages 全文搜索用户所在会话的消息
+// 通过 t_session_member 关联限定会话范围；命中结果按 msg_id 倒序（即发送时间倒序），以 msg_id 为游标分页
+func (r *messageRepo) SearchMessages(ctx context.Context, filter *MessageSearchFilter) ([]*MessageSearchItem, error) {
+	if filter == nil || filter.Username == "" || filter.Query == "" {
+		return nil, fmt.Errorf("username and query cannot be empty")
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	type searchRow struct {
+		model.MessageContent
+		Snippet string
+	}
+
+	const columns = `
+			m.msg_id, m.session_id, m.sender_username, m.seq_id, m.content, m.msg_type,
+			m.reply_to_msg_id, m.edit_version, m.edited_at, m.thread_root_id, m.mention_all,
+			m.from_bot, m.body, m.expires_at, m.created_at`
+	query := r.db.DB(ctx).Table("t_message_content m").
+		Joins("INNER JOIN t_session_member sm ON sm.session_id = m.session_id AND sm.username = ?", filter.Username)
+
+	// simple 分词器把连续的中日韩文字整体切为一个词，无法命中其中的子串，
+	// 查询含中日韩文字时改为按空白拆分关键词做子串匹配，摘要在应用层生成
+	cjkTerms := cjkSearchTerms(filter.Query)
+	if cjkTerms != nil {
+		query = query.Select(columns)
+		for _, term := range cjkTerms {
+			query = query.Where(`m.content ILIKE ? ESCAPE '\'`, "%"+escapeLike(term)+"%")
+		}
+	} else {
+		headlineOpts := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxWords=30, MinWords=10, MaxFragments=2",
+			SearchHighlightStart, SearchHighlightStop)
+		query = query.
+			Select(columns+`,
+			ts_headline('simple', m.content, websearch_to_tsquery('simple', ?), ?) AS snippet`, filter.Query, headlineOpts).
+			Where("m.search_vector @@ websearch_to_tsquery('simple', ?)", filter.Query)
+	}
+	query = query.
+		Where("m.recalled = ?", false).
+		Where("m.expires_at IS NULL OR m.expires_at > ?", time.Now()).
+		Scopes(visibleTo("m", filter.Username))
+
+	if filter.SessionID != "" {
+		query = query.Where("m.session_id = ?", filter.SessionID)
+	}
+	if filter.SenderUsername != "" {
+		query = query.Where("m.sender_username = ?", filter.SenderUsername)
+	}
+	if filter.MsgType != "" {
+		query = query.Where("m.msg_type = ?", filter.MsgType)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("m.created_at >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("m.created_at < ?", filter.EndTime)
+	}
+	if filter.BeforeMsgID > 0 {
+		query = query.Where("m.msg_id < ?", filter.BeforeMsgID)
+	}
+
+	rows := make([]*searchRow, 0)
+	if err := query.Order("m.msg_id DESC").Limit(limit).Scan(&rows).Error; err != nil {
+		r.logger.Error("搜索消息失败",
+			clog.String("username", filter.Username),
+			clog.String("session_id", filter.SessionID),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to search messages: %w", err)
+	}
+
+	items := make([]*MessageSearchItem, 0, len(rows))
+	for _, row := range rows {
+		msg := row.MessageContent
+		if cjkTerms != nil {
+			row.Snippet = highlightTerms(msg.Content, cjkTerms)
+		}
+		items = append(items, &MessageSearchItem{
+			Message: &msg,
+			Snippet: row.Snippet,
+		})
+	}
+	return items, nil
+}
+
+// cjkSnippetMaxRunes 子串匹配时摘要的最大字符数
+const cjkSnippetMaxRunes = 60
+
+// cjkSearchTerms 查询含中日韩文字时返回按空白拆分的关键词，否则返回 nil（使用全文索引）
+func cjkSearchTerms(query string) []string {
+	for _, r := range query {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			return strings.Fields(query)
+		}
+	}
+	return nil
+}
+
+// escapeLike 转义 LIKE 模式中的通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// highlightTerms 截取首个命中关键词附近的内容，并以 SearchHighlightStart / SearchHighlightStop 包裹命中词（忽略大小写）
+func highlightTerms(content string, terms []string) string {
+	patterns := make([]string, 0, len(terms))
+	for _, term := range terms {
+		patterns = append(patterns, regexp.QuoteMeta(term))
+	}
+	re := regexp.MustCompile("(?i)" + strings.Join(patterns, "|"))
+
+	runes := []rune(content)
+	if len(runes) > cjkSnippetMaxRunes {
+		start := 0
+		if loc := re.FindStringIndex(content); loc != nil {
+			// 命中词前保留少量上下文
+			start = max(utf8.RuneCountInString(content[:loc[0]])-cjkSnippetMaxRunes/4, 0)
+		}
+		end := min(start+cjkSnippetMaxRunes, len(runes))
+		content = string(runes[start:end])
+	}
+	return re.ReplaceAllString(content, SearchHighlightStart+"$0"+SearchHighlightStop)
+}
+
+// SaveMessageWithOutbox 事务内保存消息、将消息引用的附件绑定到该消息，并记录本地消息表
+// 附件已被其他消息引用时整个事务失败
+func (r *messageRepo) SaveMessageWithOutbox(ctx context.Context, msg *model.MessageContent, attachmentIDs []int64, outbox *model.MessageOutbox) error {
 	if msg == nil || outbox == nil {
 		return fmt.Errorf("message and outbox cannot be nil")
 	}
@@ -331,12 +805,123 @@ func (r *messageRepo) SaveMessageWithOutbox(ctx context.Context, msg *model.Mess
 			return fmt.Errorf("failed to save message: %w", err)
 		}
 
+		// 1.1 绑定附件（CAS：仅未被引用的附件可绑定），消息过期时据此删除附件
+		if len(attachmentIDs) > 0 {
+			result := tx.Model(&model.Attachment{}).
+				Where("attachment_id IN ? AND msg_id = 0", attachmentIDs).
+				Update("msg_id", msg.MsgID)
+			if result.Error != nil {
+				return fmt.Errorf("failed to bind attachments: %w", result.Error)
+			}
+			if result.RowsAffected != int64(len(attachmentIDs)) {
+				return fmt.Errorf("attachment already used by another message")
+			}
+		}
+
 		// 2. 更新会话 MaxSeqID (使用 CAS 乐观锁防止回退)
-		result := tx.Model(&model.Session{}).
-			Where("session_id = ? AND max_seq_id < ?", msg.SessionID, msg.SeqID).
-			Update("max_seq_id", msg.SeqID)
+		// 话题消息不推进会话序号，改为更新根消息的话题统计
+		// 回复数逐条累加（撤回、过期时扣减），最大序号与最后回复时间只增不减
+		if msg.ThreadRootID != 0 {
+			result := tx.Model(&model.MessageContent{}).
+				Where("msg_id = ?", msg.ThreadRootID).
+				Updates(map[string]interface{}{
+					"thread_reply_count":   gorm.Expr("thread_reply_count + 1"),
+					"thread_max_seq":       gorm.Expr("GREATEST(thread_max_seq, ?)", msg.SeqID),
+					"thread_last_reply_at": gorm.Expr("GREATEST(thread_last_reply_at, ?)", msg.CreatedAt),
+				})
+			if result.Error != nil {
+				return fmt.Errorf("failed to update thread stats: %w", result.Error)
+			}
+		} else {
+			result := tx.Model(&model.Session{}).
+				Where("session_id = ? AND max_seq_id < ?", msg.SessionID, msg.SeqID).
+				Update("max_seq_id", msg.SeqID)
+			if result.Error != nil {
+				return fmt.Errorf("failed to update session max_seq_id: %w", result.Error)
+			}
+		}
+
+		// 3. 保存到本地消息表
+		if err := tx.Create(outbox).Error; err != nil {
+			return fmt.Errorf("failed to save outbox: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// RecallMessageWithOutbox 事务内标记消息撤回并记录本地消息表
+// 仅对未撤回的消息生效，重复撤回返回错误，避免产生重复的撤回事件
+func (r *messageRepo) RecallMessageWithOutbox(ctx context.Context, msgID int64, recalledBy string, recalledAt time.Time, outbox *model.MessageOutbox) error {
+	if msgID == 0 {
+		return fmt.Errorf("msg_id cannot be zero")
+	}
+	if outbox == nil {
+		return fmt.Errorf("outbox cannot be nil")
+	}
+
+	return r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		// 1. 标记消息撤回（CAS：仅当尚未撤回）
+		result := tx.Model(&model.MessageContent{}).
+			Where("msg_id = ? AND recalled = ?", msgID, false).
+			Updates(map[string]interface{}{
+				"recalled":    true,
+				"recalled_by": recalledBy,
+				"recalled_at": recalledAt,
+			})
 		if result.Error != nil {
-			return fmt.Errorf("failed to update session max_seq_id: %w", result.Error)
+			return fmt.Errorf("failed to recall message: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("message %w or not found: %d", ErrAlreadyRecalled, msgID)
+		}
+
+		// 1.1 撤回话题消息时扣减根消息的回复数
+		if err := tx.Exec(`UPDATE t_message_content SET thread_reply_count = thread_reply_count - 1
+			WHERE msg_id = (SELECT thread_root_id FROM t_message_content WHERE msg_id = ?) AND thread_reply_count > 0`, msgID).Error; err != nil {
+			return fmt.Errorf("failed to update thread stats: %w", err)
+		}
+
+		// 2. 保存到本地消息表
+		if err := tx.Create(outbox).Error; err != nil {
+			return fmt.Errorf("failed to save outbox: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// EditMessageWithOutbox 事务内更新消息内容、记录编辑历史并记录本地消息表
+// revision 保存被替换的旧内容及其版本号；以 revision.Version 作为 CAS 条件，
+// 防止并发编辑互相覆盖，已撤回的消息不可编辑
+func (r *messageRepo) EditMessageWithOutbox(ctx context.Context, revision *model.MessageRevision, newContent string, editedAt time.Time, outbox *model.MessageOutbox) error {
+	if revision == nil || outbox == nil {
+		return fmt.Errorf("revision and outbox cannot be nil")
+	}
+	if revision.MsgID == 0 {
+		return fmt.Errorf("msg_id cannot be zero")
+	}
+
+	return r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		// 1. 更新消息内容并递增版本号（CAS：版本号未变且未撤回）
+		result := tx.Model(&model.MessageContent{}).
+			Where("msg_id = ? AND edit_version = ? AND recalled = ?", revision.MsgID, revision.Version, false).
+			Updates(map[string]interface{}{
+				"content":      newContent,
+				"body":         nil, // 编辑仅提交纯文本，结构化消息体随之失效
+				"edit_version": gorm.Expr("edit_version + 1"),
+				"edited_at":    editedAt,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to edit message: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("message %w or recalled: %d", ErrVersionConflict, revision.MsgID)
+		}
+
+		// 2. 记录编辑历史
+		if err := tx.Create(revision).Error; err != nil {
+			return fmt.Errorf("failed to save message revision: %w", err)
 		}
 
 		// 3. 保存到本地消息表
@@ -348,6 +933,288 @@ func (r *messageRepo) SaveMessageWithOutbox(ctx context.Context, msg *model.Mess
 	})
 }
 
+// AddReactionWithOutbox 事务内添加表情回应并记录本地消息表
+// 重复回应不产生新记录，也不写入 Outbox，返回 false
+func (r *messageRepo) AddReactionWithOutbox(ctx context.Context, reaction *model.MessageReaction, outbox *model.MessageOutbox) (bool, error) {
+	if reaction == nil || outbox == nil {
+		return false, fmt.Errorf("reaction and outbox cannot be nil")
+	}
+
+	added := false
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(reaction)
+		if result.Error != nil {
+			return fmt.Errorf("failed to add reaction: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		added = true
+
+		if err := tx.Create(outbox).Error; err != nil {
+			return fmt.Errorf("failed to save outbox: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return added, nil
+}
+
+// RemoveReactionWithOutbox 事务内取消表情回应并记录本地消息表
+// 未回应过时不写入 Outbox，返回 false
+func (r *messageRepo) RemoveReactionWithOutbox(ctx context.Context, reaction *model.MessageReaction, outbox *model.MessageOutbox) (bool, error) {
+	if reaction == nil || outbox == nil {
+		return false, fmt.Errorf("reaction and outbox cannot be nil")
+	}
+
+	removed := false
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		result := tx.Where("msg_id = ? AND username = ? AND emoji = ?", reaction.MsgID, reaction.Username, reaction.Emoji).
+			Delete(&model.MessageReaction{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to remove reaction: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		removed = true
+
+		if err := tx.Create(outbox).Error; err != nil {
+			return fmt.Errorf("failed to save outbox: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return removed, nil
+}
+
+// GetReactionSummaries 批量获取消息的表情回应聚合
+// 结果按 msg_id、首次回应时间排序，保证前端展示顺序稳定
+func (r *messageRepo) GetReactionSummaries(ctx context.Context, msgIDs []int64, username string) ([]*ReactionSummary, error) {
+	if len(msgIDs) == 0 {
+		return []*ReactionSummary{}, nil
+	}
+
+	var summaries []*ReactionSummary
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Model(&model.MessageReaction{}).
+		Select("msg_id, emoji, COUNT(*) AS count, BOOL_OR(username = ?) AS reacted", username).
+		Where("msg_id IN ?", msgIDs).
+		Group("msg_id, emoji").
+		Order("msg_id, MIN(created_at)").
+		Scan(&summaries).Error; err != nil {
+		r.logger.Error("获取表情回应失败",
+			clog.Int("count", len(msgIDs)),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to get reaction summaries: %w", err)
+	}
+
+	return summaries, nil
+}
+
+// CastVoteWithOutbox 事务内覆盖用户在投票中的选择并记录本地消息表
+// 对 t_poll 行加锁，与 ClosePollWithOutbox 串行化，保证投票结束后不再计票；选择未变化时不写入 Outbox，返回 false
+func (r *messageRepo) CastVoteWithOutbox(ctx context.Context, poll *model.Poll, username string, options []int32, outbox *model.MessageOutbox) (bool, error) {
+	if poll == nil || outbox == nil {
+		return false, fmt.Errorf("poll and outbox cannot be nil")
+	}
+	if poll.MsgID == 0 || username == "" {
+		return false, fmt.Errorf("msg_id and username cannot be empty")
+	}
+
+	changed := false
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		// 1. 首次投票时创建投票状态，并锁定该行
+		state, err := lockPoll(tx, poll)
+		if err != nil {
+			return err
+		}
+		if state.ClosedAt != nil {
+			return fmt.Errorf("%w: %d", ErrPollClosed, poll.MsgID)
+		}
+
+		// 2. 选择未变化时直接返回
+		var previous []int32
+		if err := tx.Model(&model.PollVote{}).
+			Where("msg_id = ? AND username = ?", poll.MsgID, username).
+			Order("option_index").
+			Pluck("option_index", &previous).Error; err != nil {
+			return fmt.Errorf("failed to get previous votes: %w", err)
+		}
+		if slices.Equal(previous, options) {
+			return nil
+		}
+		changed = true
+
+		// 3. 覆盖选择
+		if len(previous) > 0 {
+			if err := tx.Where("msg_id = ? AND username = ?", poll.MsgID, username).
+				Delete(&model.PollVote{}).Error; err != nil {
+				return fmt.Errorf("failed to delete previous votes: %w", err)
+			}
+		}
+		if len(options) > 0 {
+			votes := make([]*model.PollVote, 0, len(options))
+			for _, option := range options {
+				votes = append(votes, &model.PollVote{MsgID: poll.MsgID, Username: username, OptionIndex: option})
+			}
+			if err := tx.Create(&votes).Error; err != nil {
+				return fmt.Errorf("failed to save votes: %w", err)
+			}
+		}
+
+		// 4. 更新投票人数：首次投票 +1，撤回投票 -1，改投不变
+		delta := 0
+		switch {
+		case len(previous) == 0:
+			delta = 1
+		case len(options) == 0:
+			delta = -1
+		}
+		if delta != 0 {
+			if err := tx.Model(&model.Poll{}).Where("msg_id = ?", poll.MsgID).
+				Update("voter_count", gorm.Expr("voter_count + ?", delta)).Error; err != nil {
+				return fmt.Errorf("failed to update voter count: %w", err)
+			}
+		}
+
+		// 5. 保存到本地消息表
+		if err := tx.Create(outbox).Error; err != nil {
+			return fmt.Errorf("failed to save outbox: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return changed, nil
+}
+
+// ClosePollWithOutbox 事务内结束投票并记录本地消息表
+// 已结束时不写入 Outbox，返回 false
+func (r *messageRepo) ClosePollWithOutbox(ctx context.Context, poll *model.Poll, outbox *model.MessageOutbox) (bool, error) {
+	if poll == nil || outbox == nil {
+		return false, fmt.Errorf("poll and outbox cannot be nil")
+	}
+	if poll.MsgID == 0 || poll.ClosedAt == nil {
+		return false, fmt.Errorf("msg_id and closed_at cannot be empty")
+	}
+
+	closed := false
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		state, err := lockPoll(tx, poll)
+		if err != nil {
+			return err
+		}
+		if state.ClosedAt != nil {
+			return nil
+		}
+		closed = true
+
+		if err := tx.Model(&model.Poll{}).Where("msg_id = ?", poll.MsgID).
+			Updates(map[string]interface{}{
+				"closed_by": poll.ClosedBy,
+				"closed_at": poll.ClosedAt,
+			}).Error; err != nil {
+			return fmt.Errorf("failed to close poll: %w", err)
+		}
+
+		if err := tx.Create(outbox).Error; err != nil {
+			return fmt.Errorf("failed to save outbox: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return closed, nil
+}
+
+// lockPoll 在事务内创建（不存在时）并锁定投票状态行
+func lockPoll(tx *gorm.DB, poll *model.Poll) (*model.Poll, error) {
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.Poll{
+		MsgID:     poll.MsgID,
+		SessionID: poll.SessionID,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to create poll: %w", err)
+	}
+	var state model.Poll
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("msg_id = ?", poll.MsgID).
+		First(&state).Error; err != nil {
+		return nil, fmt.Errorf("failed to lock poll: %w", err)
+	}
+	return &state, nil
+}
+
+// GetPollTallies 批量获取投票的计票状态，结果与 msgIDs 顺序一致
+func (r *messageRepo) GetPollTallies(ctx context.Context, msgIDs []int64, username string) ([]*PollTally, error) {
+	if len(msgIDs) == 0 {
+		return []*PollTally{}, nil
+	}
+
+	tallies := make([]*PollTally, 0, len(msgIDs))
+	byID := make(map[int64]*PollTally, len(msgIDs))
+	for _, id := range msgIDs {
+		if _, ok := byID[id]; ok {
+			continue
+		}
+		tally := &PollTally{MsgID: id, Counts: make(map[int32]int32)}
+		byID[id] = tally
+		tallies = append(tallies, tally)
+	}
+
+	gormDB := r.db.DB(ctx)
+
+	// 1. 投票状态
+	var polls []*model.Poll
+	if err := gormDB.Where("msg_id IN ?", msgIDs).Find(&polls).Error; err != nil {
+		r.logger.Error("获取投票状态失败", clog.Int("count", len(msgIDs)), clog.Error(err))
+		return nil, fmt.Errorf("failed to get polls: %w", err)
+	}
+	for _, poll := range polls {
+		byID[poll.MsgID].Poll = poll
+	}
+
+	// 2. 各选项票数
+	var counts []struct {
+		MsgID       int64
+		OptionIndex int32
+		Count       int32
+	}
+	if err := gormDB.Model(&model.PollVote{}).
+		Select("msg_id, option_index, COUNT(*) AS count").
+		Where("msg_id IN ?", msgIDs).
+		Group("msg_id, option_index").
+		Scan(&counts).Error; err != nil {
+		r.logger.Error("获取投票票数失败", clog.Int("count", len(msgIDs)), clog.Error(err))
+		return nil, fmt.Errorf("failed to get poll counts: %w", err)
+	}
+	for _, c := range counts {
+		byID[c.MsgID].Counts[c.OptionIndex] = c.Count
+	}
+
+	// 3. 指定用户的选择
+	if username != "" {
+		var votes []*model.PollVote
+		if err := gormDB.Where("msg_id IN ? AND username = ?", msgIDs, username).
+			Order("msg_id, option_index").
+			Find(&votes).Error; err != nil {
+			r.logger.Error("获取用户投票失败", clog.String("username", username), clog.Error(err))
+			return nil, fmt.Errorf("failed to get user votes: %w", err)
+		}
+		for _, vote := range votes {
+			byID[vote.MsgID].MyChoices = append(byID[vote.MsgID].MyChoices, vote.OptionIndex)
+		}
+	}
+
+	return tallies, nil
+}
+
 // UpdateOutboxStatus 更新本地消息表状态
 func (r *messageRepo) UpdateOutboxStatus(ctx context.Context, id int64, status int) error {
 	gormDB := r.db.DB(ctx)
@@ -383,6 +1250,161 @@ func (r *messageRepo) GetPendingOutboxMessages(ctx context.Context, limit int) (
 	return messages, nil
 }
 
+// GetExpiredMessages 获取 expires_at <= now 的消息（按过期时间升序）
+func (r *messageRepo) GetExpiredMessages(ctx context.Context, now time.Time, limit int) ([]*model.MessageContent, error) {
+	var messages []*model.MessageContent
+	gormDB := r.db.DB(ctx)
+
+	if err := gormDB.Select("msg_id", "session_id", "sender_username", "seq_id", "msg_type", "thread_root_id", "expires_at").
+		Where("expires_at <= ?", now).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&messages).Error; err != nil {
+		return nil, fmt.Errorf("failed to get expired messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+// HideMessagesWithOutbox 事务内记录用户删除（仅自己）的消息并推进清空位点，同时记录本地消息表
+// 重复删除幂等；清空位点只增不减，避免乱序请求恢复已清空的消息
+func (r *messageRepo) HideMessagesWithOutbox(ctx context.Context, username, sessionID string, msgIDs []int64, clearedSeq int64, outbox *model.MessageOutbox) error {
+	if username == "" || sessionID == "" || outbox == nil {
+		return fmt.Errorf("username, session_id and outbox cannot be empty")
+	}
+
+	return r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		if len(msgIDs) > 0 {
+			hidden := make([]*model.MessageHidden, 0, len(msgIDs))
+			for _, msgID := range msgIDs {
+				hidden = append(hidden, &model.MessageHidden{Username: username, MsgID: msgID, SessionID: sessionID})
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&hidden).Error; err != nil {
+				return fmt.Errorf("failed to save hidden messages: %w", err)
+			}
+		}
+
+		if clearedSeq > 0 {
+			if err := tx.Model(&model.SessionMember{}).
+				Where("session_id = ? AND username = ? AND cleared_seq < ?", sessionID, username, clearedSeq).
+				Update("cleared_seq", clearedSeq).Error; err != nil {
+				return fmt.Errorf("failed to update cleared seq: %w", err)
+			}
+		}
+
+		if err := tx.Create(outbox).Error; err != nil {
+			return fmt.Errorf("failed to save outbox: %w", err)
+		}
+		return nil
+	})
+}
+
+// visibleTo 排除 username 已删除（仅自己）的消息：逐条隐藏记录，以及不超过清空位点的消息
+// 清空位点比较主时间线序号，话题回复随根消息可见；受邀加入的成员以加入时的会话序号为清空位点，看不到加入前的消息
+// table 为查询中 t_message_content 的表名或别名；username 为空时不过滤
+func visibleTo(table, username string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if username == "" {
+			return db
+		}
+		return db.
+			Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM t_message_hidden h WHERE h.username = ? AND h.msg_id = %s.msg_id)", table), username).
+			Where(fmt.Sprintf("CASE WHEN %[1]s.thread_root_id = 0 THEN %[1]s.seq_id ELSE (SELECT r.seq_id FROM t_message_content r WHERE r.msg_id = %[1]s.thread_root_id) END > COALESCE((SELECT cm.cleared_seq FROM t_session_member cm WHERE cm.session_id = %[1]s.session_id AND cm.username = ?), 0)", table), username)
+	}
+}
+
+// DeleteExpiredMessagesWithOutbox 事务内物理删除消息及其关联数据，并记录过期事件的本地消息表
+// 关联数据包括信箱、提及、表情回应、编辑历史、置顶、话题关注、附件记录，以及含消息内容副本的
+// 本地消息、Webhook 投递记录与内容复核记录
+// 返回不再被任何附件记录引用的存储位置（转发产生的附件副本共享存储位置），由调用方在事务提交后删除存储内容
+func (r *messageRepo) DeleteExpiredMessagesWithOutbox(ctx context.Context, msgIDs []int64, outboxes []*model.MessageOutbox) ([]string, error) {
+	if len(msgIDs) == 0 {
+		return nil, nil
+	}
+
+	var orphanKeys []string
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		// 1. 记录被删除附件的存储位置
+		var storageKeys []string
+		if err := tx.Model(&model.Attachment{}).
+			Where("msg_id IN ?", msgIDs).
+			Distinct().
+			Pluck("storage_key", &storageKeys).Error; err != nil {
+			return fmt.Errorf("failed to get attachment storage keys: %w", err)
+		}
+
+		// 2. 删除关联数据（需先于过期事件写入，避免误删本次的过期事件）
+		related := []struct {
+			model  any
+			column string
+		}{
+			{&model.MessageOutbox{}, "msg_id"},
+			{&model.Inbox{}, "msg_id"},
+			{&model.MessageMention{}, "msg_id"},
+			{&model.MessageReaction{}, "msg_id"},
+			{&model.MessageRevision{}, "msg_id"},
+			{&model.MessagePin{}, "msg_id"},
+			{&model.MessageHidden{}, "msg_id"},
+			{&model.PollVote{}, "msg_id"},
+			{&model.Poll{}, "msg_id"},
+			{&model.ThreadFollower{}, "root_msg_id"},
+			{&model.Attachment{}, "msg_id"},
+			{&model.WebhookDelivery{}, "msg_id"},
+			{&model.ModerationReview{}, "msg_id"},
+		}
+		for _, rel := range related {
+			if err := tx.Where(rel.column+" IN ?", msgIDs).Delete(rel.model).Error; err != nil {
+				return fmt.Errorf("failed to delete related rows: %w", err)
+			}
+		}
+
+		// 3. 扣减根消息的回复数（已撤回的回复在撤回时已扣减），再删除消息内容
+		if err := tx.Exec(`UPDATE t_message_content r SET thread_reply_count = GREATEST(r.thread_reply_count - c.n, 0)
+			FROM (SELECT thread_root_id, COUNT(*) AS n FROM t_message_content
+				WHERE msg_id IN ? AND thread_root_id <> 0 AND recalled = false GROUP BY thread_root_id) c
+			WHERE r.msg_id = c.thread_root_id`, msgIDs).Error; err != nil {
+			return fmt.Errorf("failed to update thread stats: %w", err)
+		}
+		if err := tx.Where("msg_id IN ?", msgIDs).Delete(&model.MessageContent{}).Error; err != nil {
+			return fmt.Errorf("failed to delete messages: %w", err)
+		}
+
+		// 4. 保存过期事件到本地消息表
+		if len(outboxes) > 0 {
+			if err := tx.Create(&outboxes).Error; err != nil {
+				return fmt.Errorf("failed to save outbox: %w", err)
+			}
+		}
+
+		// 5. 筛选仍被其他附件记录引用的存储位置
+		if len(storageKeys) == 0 {
+			return nil
+		}
+		var referenced []string
+		if err := tx.Model(&model.Attachment{}).
+			Where("storage_key IN ?", storageKeys).
+			Distinct().
+			Pluck("storage_key", &referenced).Error; err != nil {
+			return fmt.Errorf("failed to get referenced storage keys: %w", err)
+		}
+		inUse := make(map[string]struct{}, len(referenced))
+		for _, key := range referenced {
+			inUse[key] = struct{}{}
+		}
+		orphanKeys = orphanKeys[:0]
+		for _, key := range storageKeys {
+			if _, ok := inUse[key]; !ok {
+				orphanKeys = append(orphanKeys, key)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orphanKeys, nil
+}
+
 // Close 释放资源
 func (r *messageRepo) Close() error {
 	r.logger.Info("关闭 MessageRepo")
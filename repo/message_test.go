@@ -3,8 +3,10 @@ package repo
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/ceyewan/resonance/model"
 	"github.com/stretchr/testify/assert"
@@ -35,7 +37,7 @@ func TestMessageRepo_SaveMessage(t *testing.T) {
 		require.NoError(t, err)
 
 		// 验证消息已保存
-		messages, err := repo.GetHistoryMessages(ctx, "test_session_001", 0, 10)
+		messages, err := repo.GetHistoryMessages(ctx, "test_session_001", "", 0, 10)
 		require.NoError(t, err)
 		assert.Len(t, messages, 1)
 		assert.Equal(t, "Hello, World!", messages[0].Content)
@@ -57,7 +59,7 @@ func TestMessageRepo_SaveMessage(t *testing.T) {
 		}
 
 		// 验证所有消息已保存
-		messages, err := repo.GetHistoryMessages(ctx, sessionID, 0, 10)
+		messages, err := repo.GetHistoryMessages(ctx, sessionID, "", 0, 10)
 		require.NoError(t, err)
 		assert.Len(t, messages, 5)
 	})
@@ -230,13 +232,13 @@ func TestMessageRepo_GetHistoryMessages(t *testing.T) {
 	}
 
 	t.Run("获取所有历史消息（默认限制）", func(t *testing.T) {
-		messages, err := repo.GetHistoryMessages(ctx, sessionID, 0, 0)
+		messages, err := repo.GetHistoryMessages(ctx, sessionID, "", 0, 0)
 		require.NoError(t, err)
 		assert.Len(t, messages, 20) // 默认拉取50条，但只有20条
 	})
 
 	t.Run("限制拉取数量", func(t *testing.T) {
-		messages, err := repo.GetHistoryMessages(ctx, sessionID, 0, 5)
+		messages, err := repo.GetHistoryMessages(ctx, sessionID, "", 0, 5)
 		require.NoError(t, err)
 		assert.Len(t, messages, 5)
 		assert.Equal(t, int64(16), messages[0].SeqID)
@@ -244,7 +246,7 @@ func TestMessageRepo_GetHistoryMessages(t *testing.T) {
 	})
 
 	t.Run("从 beforeSeq 向前拉取历史", func(t *testing.T) {
-		messages, err := repo.GetHistoryMessages(ctx, sessionID, 11, 10)
+		messages, err := repo.GetHistoryMessages(ctx, sessionID, "", 11, 10)
 		require.NoError(t, err)
 		assert.Len(t, messages, 10)
 		assert.Equal(t, int64(1), messages[0].SeqID)
@@ -252,7 +254,7 @@ func TestMessageRepo_GetHistoryMessages(t *testing.T) {
 	})
 
 	t.Run("按序列号升序排列", func(t *testing.T) {
-		messages, err := repo.GetHistoryMessages(ctx, sessionID, 19, 10)
+		messages, err := repo.GetHistoryMessages(ctx, sessionID, "", 19, 10)
 		require.NoError(t, err)
 		assert.GreaterOrEqual(t, len(messages), 2)
 
@@ -263,7 +265,7 @@ func TestMessageRepo_GetHistoryMessages(t *testing.T) {
 	})
 
 	t.Run("beforeSeq 为最小值时返回空", func(t *testing.T) {
-		allMessages, err := repo.GetHistoryMessages(ctx, sessionID, 0, 1000)
+		allMessages, err := repo.GetHistoryMessages(ctx, sessionID, "", 0, 1000)
 		require.NoError(t, err)
 		require.NotEmpty(t, allMessages)
 
@@ -274,25 +276,25 @@ func TestMessageRepo_GetHistoryMessages(t *testing.T) {
 			}
 		}
 
-		messages, err := repo.GetHistoryMessages(ctx, sessionID, minSeq, 10)
+		messages, err := repo.GetHistoryMessages(ctx, sessionID, "", minSeq, 10)
 		require.NoError(t, err)
 		assert.Empty(t, messages)
 	})
 
 	t.Run("获取不存在会话的历史消息应返回空列表", func(t *testing.T) {
-		messages, err := repo.GetHistoryMessages(ctx, "non_existent_session", 0, 10)
+		messages, err := repo.GetHistoryMessages(ctx, "non_existent_session", "", 0, 10)
 		require.NoError(t, err)
 		assert.Empty(t, messages)
 	})
 
 	t.Run("获取空会话ID应返回错误", func(t *testing.T) {
-		_, err := repo.GetHistoryMessages(ctx, "", 0, 10)
+		_, err := repo.GetHistoryMessages(ctx, "", "", 0, 10)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "session_id cannot be empty")
 	})
 
 	t.Run("限制数量超过最大值应使用最大值", func(t *testing.T) {
-		messages, err := repo.GetHistoryMessages(ctx, sessionID, 0, 2000)
+		messages, err := repo.GetHistoryMessages(ctx, sessionID, "", 0, 2000)
 		require.NoError(t, err)
 		assert.LessOrEqual(t, len(messages), 1000) // 最大1000条
 	})
@@ -333,8 +335,7 @@ func TestMessageRepo_GetLastMessage(t *testing.T) {
 
 	t.Run("获取不存在会话的最后一条消息应返回错误", func(t *testing.T) {
 		_, err := repo.GetLastMessage(ctx, "non_existent_session")
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "no message found")
+		assert.ErrorIs(t, err, ErrNotFound)
 	})
 
 	t.Run("获取空会话ID应返回错误", func(t *testing.T) {
@@ -499,6 +500,39 @@ func TestMessageRepo_SaveInbox_Idempotent(t *testing.T) {
 	assert.Equal(t, "idempotent message", delta[0].Content)
 }
 
+func TestMessageRepo_PinMessage(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewMessageRepo(database, WithMessageRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	pin := &model.MessagePin{SessionID: "pin_session", MsgID: 1001, PinnedBy: "alice"}
+
+	// 重复置顶只生效一次
+	created, err := repo.PinMessage(ctx, pin)
+	require.NoError(t, err)
+	assert.True(t, created)
+	created, err = repo.PinMessage(ctx, &model.MessagePin{SessionID: "pin_session", MsgID: 1001, PinnedBy: "bob"})
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	pins, err := repo.GetPinnedMessages(ctx, "pin_session")
+	require.NoError(t, err)
+	require.Len(t, pins, 1)
+	assert.Equal(t, "alice", pins[0].PinnedBy)
+
+	// 取消置顶后再次取消返回 false
+	removed, err := repo.UnpinMessage(ctx, "pin_session", 1001)
+	require.NoError(t, err)
+	assert.True(t, removed)
+	removed, err = repo.UnpinMessage(ctx, "pin_session", 1001)
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
+
 func TestMessageRepo_GetInboxDelta(t *testing.T) {
 	database, cleanup := setupTestContext(t)
 	defer cleanup()
@@ -547,6 +581,422 @@ func TestMessageRepo_GetInboxDelta(t *testing.T) {
 	assert.Equal(t, "delta-3", secondPage[0].Content)
 }
 
+func TestMessageRepo_DeleteExpiredMessages(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewMessageRepo(database, WithMessageRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	sessionID := "expiry_session"
+	expired := time.Now().Add(-time.Minute)
+	alive := time.Now().Add(time.Hour)
+
+	for i, expiresAt := range []*time.Time{&expired, &alive, nil} {
+		msgID := int64(3001 + i)
+		err = repo.SaveMessage(ctx, &model.MessageContent{
+			MsgID:          msgID,
+			SessionID:      sessionID,
+			SenderUsername: "alice",
+			SeqID:          int64(i + 1),
+			Content:        fmt.Sprintf("expiry-%d", i),
+			MsgType:        "text",
+			ExpiresAt:      expiresAt,
+		})
+		require.NoError(t, err)
+		require.NoError(t, repo.SaveInbox(ctx, []*model.Inbox{{
+			OwnerUsername: "bob",
+			SessionID:     sessionID,
+			MsgID:         msgID,
+			SeqID:         int64(i + 1),
+		}}))
+	}
+
+	// 已过期消息不再出现在历史记录中
+	history, err := repo.GetHistoryMessages(ctx, sessionID, "", 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, history, 2)
+
+	msgs, err := repo.GetExpiredMessages(ctx, time.Now(), 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, int64(3001), msgs[0].MsgID)
+
+	// 已过期但尚未清理的消息不能再被引用或转发
+	_, err = repo.GetMessage(ctx, 3001)
+	assert.Error(t, err)
+	byIDs, err := repo.GetMessagesByIDs(ctx, []int64{3001, 3002}, "")
+	require.NoError(t, err)
+	require.Len(t, byIDs, 1)
+	assert.Equal(t, int64(3002), byIDs[0].MsgID)
+
+	// 过期消息的附件、Webhook 投递记录与复核记录一并删除；转发副本仍引用的存储位置不返回
+	gormDB := database.DB(ctx)
+	require.NoError(t, gormDB.Create([]*model.Attachment{
+		{AttachmentID: 1, SessionID: sessionID, UploaderUsername: "alice", StorageKey: "attachments/1/a", MsgID: 3001},
+		{AttachmentID: 2, SessionID: sessionID, UploaderUsername: "alice", StorageKey: "attachments/2/a", MsgID: 3001},
+		{AttachmentID: 3, SessionID: "other", UploaderUsername: "bob", StorageKey: "attachments/2/a", MsgID: 4001},
+	}).Error)
+	require.NoError(t, gormDB.Create(&model.WebhookDelivery{WebhookID: 1, Event: "message.created", Payload: []byte("{}"), MsgID: 3001, NextRetryTime: time.Now()}).Error)
+	require.NoError(t, gormDB.Create(&model.ModerationReview{MsgID: 3001, SessionID: sessionID, SenderUsername: "alice", Content: "expiry-0"}).Error)
+
+	outbox := &model.MessageOutbox{MsgID: 3001, Topic: "expire", Payload: []byte("expire"), Status: model.OutboxStatusPending, NextRetryTime: time.Now()}
+	orphanKeys, err := repo.DeleteExpiredMessagesWithOutbox(ctx, []int64{3001}, []*model.MessageOutbox{outbox})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"attachments/1/a"}, orphanKeys)
+
+	var remaining int64
+	require.NoError(t, gormDB.Model(&model.Attachment{}).Count(&remaining).Error)
+	assert.Equal(t, int64(1), remaining)
+	require.NoError(t, gormDB.Model(&model.WebhookDelivery{}).Where("msg_id = ?", 3001).Count(&remaining).Error)
+	assert.Zero(t, remaining)
+	require.NoError(t, gormDB.Model(&model.ModerationReview{}).Where("msg_id = ?", 3001).Count(&remaining).Error)
+	assert.Zero(t, remaining)
+	delta, err := repo.GetInboxDelta(ctx, "bob", 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, delta, 2)
+
+	pending, err := repo.GetPendingOutboxMessages(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "expire", pending[0].Topic)
+}
+
+func TestMessageRepo_SearchMessages(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewMessageRepo(database, WithMessageRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	require.NoError(t, database.DB(ctx).Create(&model.SessionMember{SessionID: "search_mine", Username: "alice"}).Error)
+
+	contents := []struct {
+		msgID     int64
+		sessionID string
+		content   string
+		recalled  bool
+	}{
+		{4001, "search_mine", "release plan for friday", false},
+		{4002, "search_mine", "the release is delayed", false},
+		{4003, "search_mine", "release notes draft", true},
+		{4004, "search_other", "release party", false},
+		{4005, "search_mine", "下周五发布新版本，Beta 说明见文档", false},
+		{4006, "search_mine", "版本发布推迟", false},
+		{4007, "search_mine", "进度 100%_done", false},
+	}
+	for i, c := range contents {
+		require.NoError(t, repo.SaveMessage(ctx, &model.MessageContent{
+			MsgID:          c.msgID,
+			SessionID:      c.sessionID,
+			SenderUsername: "bob",
+			SeqID:          int64(i + 1),
+			Content:        c.content,
+			MsgType:        "text",
+			Recalled:       c.recalled,
+		}))
+	}
+
+	// 仅返回所在会话、未撤回的消息，按 msg_id 倒序
+	items, err := repo.SearchMessages(ctx, &MessageSearchFilter{Username: "alice", Query: "release", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, int64(4002), items[0].Message.MsgID)
+	assert.Contains(t, items[0].Snippet, SearchHighlightStart+"release"+SearchHighlightStop)
+
+	// 游标分页
+	items, err = repo.SearchMessages(ctx, &MessageSearchFilter{Username: "alice", Query: "release", BeforeMsgID: 4002, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, int64(4001), items[0].Message.MsgID)
+
+	items, err = repo.SearchMessages(ctx, &MessageSearchFilter{Username: "alice", Query: "release", SenderUsername: "carol", Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	// 中日韩文字按子串匹配，多个关键词须同时命中
+	items, err = repo.SearchMessages(ctx, &MessageSearchFilter{Username: "alice", Query: "发布", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, int64(4006), items[0].Message.MsgID)
+	assert.Equal(t, "版本"+SearchHighlightStart+"发布"+SearchHighlightStop+"推迟", items[0].Snippet)
+	items, err = repo.SearchMessages(ctx, &MessageSearchFilter{Username: "alice", Query: "新版本 beta", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, int64(4005), items[0].Message.MsgID)
+	assert.Contains(t, items[0].Snippet, SearchHighlightStart+"Beta"+SearchHighlightStop)
+
+	// LIKE 通配符按字面匹配
+	items, err = repo.SearchMessages(ctx, &MessageSearchFilter{Username: "alice", Query: "进度 0%_", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	items, err = repo.SearchMessages(ctx, &MessageSearchFilter{Username: "alice", Query: "进度 %x", Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
+
+func TestHighlightTerms(t *testing.T) {
+	assert.Equal(t, "版本"+SearchHighlightStart+"发布"+SearchHighlightStop, highlightTerms("版本发布", []string{"发布"}))
+
+	// 长内容截取命中词附近的片段
+	content := strings.Repeat("前", 100) + "关键词" + strings.Repeat("后", 100)
+	snippet := highlightTerms(content, []string{"关键词"})
+	assert.Contains(t, snippet, SearchHighlightStart+"关键词"+SearchHighlightStop)
+	assert.Equal(t, cjkSnippetMaxRunes, utf8.RuneCountInString(strings.NewReplacer(SearchHighlightStart, "", SearchHighlightStop, "").Replace(snippet)))
+
+	assert.Nil(t, cjkSearchTerms("release plan"))
+	assert.Equal(t, []string{"发布", "plan"}, cjkSearchTerms(" 发布  plan "))
+}
+
+func TestMessageRepo_HideMessages(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewMessageRepo(database, WithMessageRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	sessionID := "hide_session"
+	for _, username := range []string{"alice", "bob"} {
+		require.NoError(t, database.DB(ctx).Create(&model.SessionMember{SessionID: sessionID, Username: username}).Error)
+	}
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, repo.SaveMessage(ctx, &model.MessageContent{
+			MsgID:          5000 + i,
+			SessionID:      sessionID,
+			SenderUsername: "bob",
+			SeqID:          i,
+			Content:        fmt.Sprintf("hide-%d", i),
+			MsgType:        "text",
+		}))
+	}
+	newOutbox := func() *model.MessageOutbox {
+		return &model.MessageOutbox{Topic: "hide", Payload: []byte("hide"), Status: model.OutboxStatusPending, NextRetryTime: time.Now()}
+	}
+
+	// 逐条删除，重复删除幂等
+	require.NoError(t, repo.HideMessagesWithOutbox(ctx, "alice", sessionID, []int64{5003}, 0, newOutbox()))
+	require.NoError(t, repo.HideMessagesWithOutbox(ctx, "alice", sessionID, []int64{5003}, 0, newOutbox()))
+
+	history, err := repo.GetHistoryMessages(ctx, sessionID, "alice", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	last, err := repo.GetLastMessagesBatch(ctx, "alice", []string{sessionID})
+	require.NoError(t, err)
+	require.Len(t, last, 1)
+	assert.Equal(t, int64(5002), last[0].MsgID)
+
+	// 清空位点只增不减
+	require.NoError(t, repo.HideMessagesWithOutbox(ctx, "alice", sessionID, nil, 1, newOutbox()))
+	require.NoError(t, repo.HideMessagesWithOutbox(ctx, "alice", sessionID, nil, 0, newOutbox()))
+	history, err = repo.GetHistoryMessages(ctx, sessionID, "alice", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, int64(5002), history[0].MsgID)
+
+	// 按 ID 查询（引用快照、转发）同样排除已删除的消息
+	byIDs, err := repo.GetMessagesByIDs(ctx, []int64{5001, 5002, 5003}, "alice")
+	require.NoError(t, err)
+	require.Len(t, byIDs, 1)
+	assert.Equal(t, int64(5002), byIDs[0].MsgID)
+
+	// 其他成员不受影响
+	history, err = repo.GetHistoryMessages(ctx, sessionID, "bob", 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, history, 3)
+	byIDs, err = repo.GetMessagesByIDs(ctx, []int64{5001, 5002, 5003}, "bob")
+	require.NoError(t, err)
+	assert.Len(t, byIDs, 3)
+}
+
+func TestMessageRepo_RejoinHistory(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewMessageRepo(database, WithMessageRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+	sessionRepo, err := NewSessionRepo(database, WithSessionRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer sessionRepo.Close()
+
+	ctx := context.Background()
+	sessionID := "rejoin_session"
+	newOutbox := func() *model.MessageOutbox {
+		return &model.MessageOutbox{Topic: "rejoin", Payload: []byte("rejoin"), Status: model.OutboxStatusPending, NextRetryTime: time.Now()}
+	}
+	send := func(msgID, seqID, threadRootID int64) {
+		require.NoError(t, repo.SaveMessageWithOutbox(ctx, &model.MessageContent{
+			MsgID: msgID, SessionID: sessionID, SenderUsername: "alice", SeqID: seqID, ThreadRootID: threadRootID,
+			Content: fmt.Sprintf("rejoin-%d", msgID), MsgType: "text", CreatedAt: time.Now(),
+		}, nil, newOutbox()))
+	}
+	require.NoError(t, sessionRepo.CreateSession(ctx, &model.Session{SessionID: sessionID, Type: 2, OwnerUsername: "alice"}))
+	require.NoError(t, sessionRepo.AddMember(ctx, &model.SessionMember{SessionID: sessionID, Username: "alice", Role: model.MemberRoleOwner}))
+	_, err = sessionRepo.AddMembersWithOutbox(ctx, []*model.SessionMember{{SessionID: sessionID, Username: "bob"}}, newOutbox())
+	require.NoError(t, err)
+
+	send(7001, 1, 0)
+	removed, err := sessionRepo.RemoveMemberWithOutbox(ctx, sessionID, "bob", newOutbox())
+	require.NoError(t, err)
+	require.True(t, removed)
+
+	// 离开期间的消息及其话题回复
+	send(7002, 2, 0)
+	send(7003, 1, 7002)
+
+	added, err := sessionRepo.AddMembersWithOutbox(ctx, []*model.SessionMember{{SessionID: sessionID, Username: "bob"}}, newOutbox())
+	require.NoError(t, err)
+	require.Equal(t, []string{"bob"}, added)
+	member, err := sessionRepo.GetUserSession(ctx, "bob", sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), member.ClearedSeq)
+	assert.Equal(t, int64(2), member.LastReadSeq)
+
+	send(7004, 3, 0)
+
+	// 重新加入后只能看到加入之后的消息
+	history, err := repo.GetHistoryMessages(ctx, sessionID, "bob", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, int64(7004), history[0].MsgID)
+	byIDs, err := repo.GetMessagesByIDs(ctx, []int64{7001, 7002, 7003, 7004}, "bob")
+	require.NoError(t, err)
+	require.Len(t, byIDs, 1)
+	assert.Equal(t, int64(7004), byIDs[0].MsgID)
+
+	// 一直在会话中的成员不受影响
+	history, err = repo.GetHistoryMessages(ctx, sessionID, "alice", 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, history, 3)
+}
+
+func TestMessageRepo_Thread(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewMessageRepo(database, WithMessageRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	sessionID := "thread_session"
+	newOutbox := func() *model.MessageOutbox {
+		return &model.MessageOutbox{Topic: "thread", Payload: []byte("thread"), Status: model.OutboxStatusPending, NextRetryTime: time.Now()}
+	}
+	require.NoError(t, repo.SaveMessageWithOutbox(ctx, &model.MessageContent{
+		MsgID: 6000, SessionID: sessionID, SenderUsername: "alice", SeqID: 1, Content: "root", MsgType: "text",
+	}, nil, newOutbox()))
+	expired := time.Now().Add(-time.Minute)
+	for i := int64(1); i <= 3; i++ {
+		msg := &model.MessageContent{
+			MsgID: 6000 + i, SessionID: sessionID, SenderUsername: "bob", SeqID: i, ThreadRootID: 6000,
+			Content: fmt.Sprintf("reply-%d", i), MsgType: "text", CreatedAt: time.Now(),
+		}
+		if i == 3 {
+			msg.ExpiresAt = &expired
+		}
+		require.NoError(t, repo.SaveMessageWithOutbox(ctx, msg, nil, newOutbox()))
+	}
+
+	root, err := repo.GetMessage(ctx, 6000)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), root.ThreadReplyCount)
+	assert.Equal(t, int64(3), root.ThreadMaxSeq)
+	require.NotNil(t, root.ThreadLastReplyAt)
+
+	// 撤回与过期的回复不计入回复数，最大序号不回退
+	require.NoError(t, repo.RecallMessageWithOutbox(ctx, 6001, "bob", time.Now(), newOutbox()))
+	_, err = repo.DeleteExpiredMessagesWithOutbox(ctx, []int64{6003}, nil)
+	require.NoError(t, err)
+	root, err = repo.GetMessage(ctx, 6000)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), root.ThreadReplyCount)
+	assert.Equal(t, int64(3), root.ThreadMaxSeq)
+
+	// 关注重复执行幂等，取消关注后不再是关注者
+	require.NoError(t, repo.FollowThread(ctx, 6000, "carol"))
+	require.NoError(t, repo.FollowThread(ctx, 6000, "carol"))
+	require.NoError(t, repo.FollowThread(ctx, 6000, "dave"))
+	followers, err := repo.GetThreadFollowers(ctx, 6000)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"carol", "dave"}, followers)
+	require.NoError(t, repo.UnfollowThread(ctx, 6000, "dave"))
+	followers, err = repo.GetThreadFollowers(ctx, 6000)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"carol"}, followers)
+}
+
+func TestMessageRepo_Poll(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewMessageRepo(database, WithMessageRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	poll := &model.Poll{MsgID: 6001, SessionID: "poll_session"}
+	newOutbox := func() *model.MessageOutbox {
+		return &model.MessageOutbox{Topic: "poll", Payload: []byte("poll"), Status: model.OutboxStatusPending, NextRetryTime: time.Now()}
+	}
+
+	// 尚无人投票时没有投票状态
+	tallies, err := repo.GetPollTallies(ctx, []int64{poll.MsgID}, "alice")
+	require.NoError(t, err)
+	require.Len(t, tallies, 1)
+	assert.Nil(t, tallies[0].Poll)
+
+	// 投票、重复投票、改投
+	changed, err := repo.CastVoteWithOutbox(ctx, poll, "alice", []int32{0, 2}, newOutbox())
+	require.NoError(t, err)
+	assert.True(t, changed)
+	changed, err = repo.CastVoteWithOutbox(ctx, poll, "alice", []int32{0, 2}, newOutbox())
+	require.NoError(t, err)
+	assert.False(t, changed)
+	changed, err = repo.CastVoteWithOutbox(ctx, poll, "bob", []int32{2}, newOutbox())
+	require.NoError(t, err)
+	assert.True(t, changed)
+	changed, err = repo.CastVoteWithOutbox(ctx, poll, "alice", []int32{1}, newOutbox())
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	tallies, err = repo.GetPollTallies(ctx, []int64{poll.MsgID}, "alice")
+	require.NoError(t, err)
+	require.NotNil(t, tallies[0].Poll)
+	assert.Equal(t, int32(2), tallies[0].Poll.VoterCount)
+	assert.Equal(t, map[int32]int32{1: 1, 2: 1}, tallies[0].Counts)
+	assert.Equal(t, []int32{1}, tallies[0].MyChoices)
+
+	// 撤回投票
+	changed, err = repo.CastVoteWithOutbox(ctx, poll, "bob", nil, newOutbox())
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	// 结束后不可再投票，重复结束返回 false
+	closedAt := time.Now()
+	closed, err := repo.ClosePollWithOutbox(ctx, &model.Poll{MsgID: poll.MsgID, ClosedBy: "alice", ClosedAt: &closedAt}, newOutbox())
+	require.NoError(t, err)
+	assert.True(t, closed)
+	closed, err = repo.ClosePollWithOutbox(ctx, &model.Poll{MsgID: poll.MsgID, ClosedBy: "bob", ClosedAt: &closedAt}, newOutbox())
+	require.NoError(t, err)
+	assert.False(t, closed)
+	_, err = repo.CastVoteWithOutbox(ctx, poll, "bob", []int32{0}, newOutbox())
+	assert.Error(t, err)
+
+	tallies, err = repo.GetPollTallies(ctx, []int64{poll.MsgID}, "")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), tallies[0].Poll.VoterCount)
+	assert.Equal(t, "alice", tallies[0].Poll.ClosedBy)
+	assert.Equal(t, map[int32]int32{1: 1}, tallies[0].Counts)
+	assert.Empty(t, tallies[0].MyChoices)
+}
+
 func TestMessageRepo_CompleteLifecycle(t *testing.T) {
 	database, cleanup := setupTestContext(t)
 	defer cleanup()
@@ -587,7 +1037,7 @@ func TestMessageRepo_CompleteLifecycle(t *testing.T) {
 	require.NoError(t, err)
 
 	// 3. 拉取历史消息
-	history, err := repo.GetHistoryMessages(ctx, sessionID, 0, 10)
+	history, err := repo.GetHistoryMessages(ctx, sessionID, "", 0, 10)
 	require.NoError(t, err)
 	assert.Len(t, history, 1)
 	assert.Equal(t, "Hello, this is a lifecycle test!", history[0].Content)
@@ -644,7 +1094,7 @@ func TestMessageRepo_Concurrent(t *testing.T) {
 		}
 
 		// 验证至少有一些消息保存成功
-		messages, _ := repo.GetHistoryMessages(ctx, sessionID, 0, 1000)
+		messages, _ := repo.GetHistoryMessages(ctx, sessionID, "", 0, 1000)
 		t.Logf("并发保存了 %d 条消息", len(messages))
 	})
 }
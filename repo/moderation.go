@@ -0,0 +1,107 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/db"
+	"github.com/ceyewan/resonance/model"
+)
+
+// ModerationRepoOption 配置 ModerationRepo 的选项
+type ModerationRepoOption func(*moderationRepoOptions)
+
+type moderationRepoOptions struct {
+	logger clog.Logger
+}
+
+// WithModerationRepoLogger 设置日志记录器
+func WithModerationRepoLogger(logger clog.Logger) ModerationRepoOption {
+	return func(o *moderationRepoOptions) {
+		o.logger = logger
+	}
+}
+
+// moderationRepo 实现 ModerationRepo 接口
+type moderationRepo struct {
+	db     db.DB
+	logger clog.Logger
+}
+
+// NewModerationRepo 创建 ModerationRepo 实例
+func NewModerationRepo(database db.DB, opts ...ModerationRepoOption) (ModerationRepo, error) {
+	if database == nil {
+		return nil, fmt.Errorf("database cannot be nil")
+	}
+
+	options := &moderationRepoOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	// 提供默认 logger
+	var logger clog.Logger
+	if options.logger != nil {
+		logger = options.logger.WithNamespace("moderation_repo")
+	} else {
+		logger = clog.Discard()
+	}
+
+	return &moderationRepo{
+		db:     database,
+		logger: logger,
+	}, nil
+}
+
+// ListEnabledRules 获取全部启用的审核规则
+func (r *moderationRepo) ListEnabledRules(ctx context.Context) ([]*model.ModerationRule, error) {
+	var rules []*model.ModerationRule
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("enabled = ?", true).
+		Order("id ASC").
+		Find(&rules).Error; err != nil {
+		r.logger.Error("获取审核规则失败", clog.Error(err))
+		return nil, fmt.Errorf("failed to list moderation rules: %w", err)
+	}
+	return rules, nil
+}
+
+// CreateReview 写入待复核消息
+func (r *moderationRepo) CreateReview(ctx context.Context, review *model.ModerationReview) error {
+	if review == nil || review.MsgID == 0 {
+		return fmt.Errorf("review msg_id cannot be empty")
+	}
+	// 命中的敏感词可能是多字节字符，按字符截断
+	if reasons := []rune(review.Reasons); len(reasons) > 255 {
+		review.Reasons = string(reasons[:255])
+	}
+
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Create(review).Error; err != nil {
+		r.logger.Error("写入待复核消息失败",
+			clog.Int64("msg_id", review.MsgID),
+			clog.Error(err))
+		return fmt.Errorf("failed to create moderation review: %w", err)
+	}
+	return nil
+}
+
+// ListPendingReviews 按入队顺序获取待复核消息
+func (r *moderationRepo) ListPendingReviews(ctx context.Context, afterID int64, limit int) ([]*model.ModerationReview, error) {
+	var reviews []*model.ModerationReview
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("status = ? AND id > ?", model.ModerationReviewPending, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&reviews).Error; err != nil {
+		r.logger.Error("获取待复核消息失败", clog.Error(err))
+		return nil, fmt.Errorf("failed to list pending reviews: %w", err)
+	}
+	return reviews, nil
+}
+
+// Close 释放资源
+func (r *moderationRepo) Close() error {
+	return nil
+}
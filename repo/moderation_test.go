@@ -0,0 +1,47 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ceyewan/resonance/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModerationRepo_RulesAndReviews(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewModerationRepo(database, WithModerationRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	gormDB := database.DB(ctx)
+	require.NoError(t, gormDB.Create(&model.ModerationRule{Kind: model.ModerationRuleWord, Pattern: "spam", Action: "mask", Enabled: true}).Error)
+	disabled := &model.ModerationRule{Kind: model.ModerationRuleRegex, Pattern: "https?://\\S+", Action: "reject", Enabled: true}
+	require.NoError(t, gormDB.Create(disabled).Error)
+	require.NoError(t, gormDB.Model(disabled).Update("enabled", false).Error)
+
+	rules, err := repo.ListEnabledRules(ctx)
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, "spam", rules[0].Pattern)
+
+	require.NoError(t, repo.CreateReview(ctx, &model.ModerationReview{
+		MsgID:          1,
+		SessionID:      "s_1",
+		SenderUsername: "alice",
+		Content:        "hello",
+		Reasons:        "word:spam",
+	}))
+	reviews, err := repo.ListPendingReviews(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	assert.Equal(t, model.ModerationReviewPending, reviews[0].Status)
+
+	reviews, err = repo.ListPendingReviews(ctx, reviews[0].ID, 10)
+	require.NoError(t, err)
+	assert.Empty(t, reviews)
+}
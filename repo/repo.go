@@ -2,11 +2,28 @@ package repo
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/ceyewan/resonance/model"
 )
 
+// 仓储层哨兵错误，调用方通过 errors.Is 判断错误类别，不依赖错误文本
+var (
+	// ErrNotFound 记录不存在
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyUploaded 附件已确认上传（或不存在），不能重复确认
+	ErrAlreadyUploaded = errors.New("already uploaded")
+	// ErrVersionConflict 消息已被并发编辑（或已撤回）
+	ErrVersionConflict = errors.New("version conflict")
+	// ErrPollClosed 投票已结束
+	ErrPollClosed = errors.New("poll already closed")
+	// ErrAlreadyRecalled 消息已被撤回（或不存在）
+	ErrAlreadyRecalled = errors.New("already recalled")
+	// ErrOwnerChanged 群主已变更
+	ErrOwnerChanged = errors.New("session owner changed")
+)
+
 // InboxDeltaItem 表示基于 inbox 游标拉取到的增量消息
 type InboxDeltaItem struct {
 	InboxID        int64
@@ -16,9 +33,94 @@ type InboxDeltaItem struct {
 	SenderUsername string
 	Content        string
 	MsgType        string
+	Recalled       bool
+	RecalledBy     string
+	EditVersion    int32
+	EditedAt       *time.Time
+	ReplyToMsgID   int64
+	MentionAll     bool
+	FromBot        bool
+	Body           []byte
+	ExpiresAt      *time.Time
 	CreatedAt      time.Time
 }
 
+// 搜索高亮摘要中命中词的起止标记（Unicode 私用区字符，不会出现在正常文本中），由调用方替换为展示格式
+const (
+	SearchHighlightStart = "\uE000"
+	SearchHighlightStop  = "\uE001"
+)
+
+// MessageSearchFilter 消息全文搜索条件，空值表示不限
+type MessageSearchFilter struct {
+	Username       string // 搜索者，仅返回其所在会话的消息
+	Query          string // 关键词（websearch_to_tsquery 语法）
+	SessionID      string
+	SenderUsername string
+	MsgType        string
+	StartTime      time.Time // 发送时间下界（含）
+	EndTime        time.Time // 发送时间上界（不含）
+	BeforeMsgID    int64     // 游标：仅返回 msg_id < BeforeMsgID 的消息
+	Limit          int
+}
+
+// MessageSearchItem 表示一条命中的消息及其高亮摘要
+type MessageSearchItem struct {
+	Message *model.MessageContent
+	Snippet string // 命中词以 SearchHighlightStart / SearchHighlightStop 包裹
+}
+
+// ReactionSummary 表示某条消息上某个表情的聚合回应
+type ReactionSummary struct {
+	MsgID   int64
+	Emoji   string
+	Count   int32
+	Reacted bool // 指定用户是否回应过该表情
+}
+
+// PollTally 表示一个投票的计票状态
+// 尚无人投票且未结束的投票没有 t_poll 记录，此时 Poll 为 nil
+type PollTally struct {
+	MsgID     int64
+	Poll      *model.Poll
+	Counts    map[int32]int32 // 选项下标 -> 票数
+	MyChoices []int32         // 指定用户选择的选项下标（升序）
+}
+
+// ReadReceiptItem 表示一条消息的已读聚合
+type ReadReceiptItem struct {
+	MsgID          int64
+	SeqID          int64
+	SenderUsername string
+	ReadCount      int32 // 已读成员数（不含发送者）
+}
+
+// SentMessageRecord 表示一次已完成发送的结果，用于客户端重试时原样返回
+// 斜杠命令在落库前已处理完毕时（仅回复发送者、拒绝或未知命令）MsgID 为 0，记录命令结果
+type SentMessageRecord struct {
+	MsgID int64 `json:"msg_id"`
+	SeqID int64 `json:"seq_id"`
+
+	Error        string `json:"error,omitempty"`
+	ErrorCode    string `json:"error_code,omitempty"`
+	ReplyCommand string `json:"reply_command,omitempty"`
+	ReplyFrom    string `json:"reply_from,omitempty"`
+	ReplyContent string `json:"reply_content,omitempty"`
+}
+
+// MessageDedupRepo 定义了消息发送去重的数据访问接口，按 (发送者, 客户端消息ID) 去重，通常由 Redis 实现
+type MessageDedupRepo interface {
+	// Acquire 尝试占用去重键（ttl 为保留窗口）
+	// acquired=true 表示首次发送；否则 record 非空为首次发送的结果，为空表示首次发送仍在处理中
+	Acquire(ctx context.Context, sender, clientMsgID string, ttl time.Duration) (record *SentMessageRecord, acquired bool, err error)
+	// Complete 记录发送结果，保留窗口内的重试将直接返回该结果
+	Complete(ctx context.Context, sender, clientMsgID string, record *SentMessageRecord, ttl time.Duration) error
+	// Release 释放去重键（发送失败时调用，允许客户端重试）
+	Release(ctx context.Context, sender, clientMsgID string) error
+	// Close 释放资源
+	Close() error
+}
+
 // RouterRepo 定义了路由表（用户与网关实例映射）的数据访问接口，通常由 Redis 实现
 type RouterRepo interface {
 	// SetUserGateway 设置用户的网关映射关系
@@ -78,6 +180,24 @@ type SessionRepo interface {
 	GetContactList(ctx context.Context, username string) ([]*model.User, error)
 	// UpdateLastReadSeq 更新用户在会话中的已读位置
 	UpdateLastReadSeq(ctx context.Context, sessionID, username string, lastReadSeq int64) error
+	// UpdateMessageTTL 更新会话消息保留时长（秒），返回是否实际变更
+	UpdateMessageTTL(ctx context.Context, sessionID string, ttlSeconds int64) (bool, error)
+	// AddMembersWithOutbox 事务内批量添加成员（已是成员的忽略）并记录成员变更事件的本地消息表，返回实际加入的用户名
+	// 新成员看不到加入前的消息；无人加入时不写入 Outbox
+	AddMembersWithOutbox(ctx context.Context, members []*model.SessionMember, outbox *model.MessageOutbox) ([]string, error)
+	// RemoveMemberWithOutbox 事务内删除成员关系并记录成员变更事件的本地消息表，返回是否实际删除
+	// 同时删除该成员创建的 Webhook；不是成员时不写入 Outbox
+	RemoveMemberWithOutbox(ctx context.Context, sessionID, username string, outbox *model.MessageOutbox) (bool, error)
+	// UpdateMemberRole 更新成员角色，返回是否实际变更；降为普通成员时删除其创建的 Webhook
+	UpdateMemberRole(ctx context.Context, sessionID, username string, role int) (bool, error)
+	// TransferOwnership 事务内将群主由 from 转让给 to（须为成员），from 降为管理员
+	TransferOwnership(ctx context.Context, sessionID, from, to string) error
+	// UpdateSessionName 更新会话名称，返回是否实际变更
+	UpdateSessionName(ctx context.Context, sessionID, name string) (bool, error)
+	// SetMemberMute 禁言（until 为空表示直到解除禁言）或解除禁言，返回是否实际变更；不是成员时返回 false
+	SetMemberMute(ctx context.Context, sessionID, username string, muted bool, until *time.Time) (bool, error)
+	// SetDoNotDisturb 设置成员对会话的免打扰
+	SetDoNotDisturb(ctx context.Context, sessionID, username string, enabled bool) error
 	// Close 释放资源（如数据库连接等）
 	Close() error
 }
@@ -86,28 +206,187 @@ type SessionRepo interface {
 type MessageRepo interface {
 	// SaveMessage 保存消息内容
 	SaveMessage(ctx context.Context, msg *model.MessageContent) error
+	// GetMessage 根据消息 ID 获取消息内容（已过期但尚未清理的消息视为不存在）
+	GetMessage(ctx context.Context, msgID int64) (*model.MessageContent, error)
 	// SaveInbox 批量写入信箱 (写扩散)
 	SaveInbox(ctx context.Context, inboxes []*model.Inbox) error
+	// SaveMentions 批量写入提及记录 (写扩散)
+	SaveMentions(ctx context.Context, mentions []*model.MessageMention) error
+	// GetMentionsByMsgIDs 批量获取消息的提及记录
+	GetMentionsByMsgIDs(ctx context.Context, msgIDs []int64) ([]*model.MessageMention, error)
+	// GetReadReceipts 获取会话内 seq_id 在 (fromSeq, toSeq] 区间、非 reader 发送的消息的已读聚合（取最新的 limit 条）
+	GetReadReceipts(ctx context.Context, sessionID string, fromSeq, toSeq int64, reader string, limit int) ([]*ReadReceiptItem, error)
+	// GetUnreadMentionCounts 获取用户各会话中未读消息的提及数（session_id -> count）
+	GetUnreadMentionCounts(ctx context.Context, username string) (map[string]int64, error)
 	// GetHistoryMessages 拉取历史消息（beforeSeq=0 表示最近一页，否则拉取 seq_id < beforeSeq）
-	GetHistoryMessages(ctx context.Context, sessionID string, beforeSeq int64, limit int) ([]*model.MessageContent, error)
+	// username 非空时排除该用户已删除（仅自己）的消息
+	GetHistoryMessages(ctx context.Context, sessionID, username string, beforeSeq int64, limit int) ([]*model.MessageContent, error)
 	// GetLastMessage 获取会话的最后一条消息
 	GetLastMessage(ctx context.Context, sessionID string) (*model.MessageContent, error)
-	// GetLastMessagesBatch 批量获取会话的最后一条消息（避免 N+1 查询）
-	GetLastMessagesBatch(ctx context.Context, sessionIDs []string) ([]*model.MessageContent, error)
+	// GetLastMessagesBatch 批量获取会话中 username 可见的最后一条消息（避免 N+1 查询）
+	GetLastMessagesBatch(ctx context.Context, username string, sessionIDs []string) ([]*model.MessageContent, error)
 	// GetUnreadMessages 获取用户未读消息 (从小群信箱)
 	GetUnreadMessages(ctx context.Context, username string, limit int) ([]*model.Inbox, error)
 	// GetInboxDelta 按游标拉取用户增量消息
 	GetInboxDelta(ctx context.Context, username string, cursorID int64, limit int) ([]*InboxDeltaItem, error)
+	// SearchMessages 全文搜索用户所在会话的消息（排除已撤回、已过期），按 msg_id 倒序
+	SearchMessages(ctx context.Context, filter *MessageSearchFilter) ([]*MessageSearchItem, error)
 
-	// SaveMessageWithOutbox 事务内保存消息并记录本地消息表
-	SaveMessageWithOutbox(ctx context.Context, msg *model.MessageContent, outbox *model.MessageOutbox) error
+	// SaveMessageWithOutbox 事务内保存消息、将消息引用的附件绑定到该消息，并记录本地消息表
+	// 附件已被其他消息引用时整个事务失败
+	SaveMessageWithOutbox(ctx context.Context, msg *model.MessageContent, attachmentIDs []int64, outbox *model.MessageOutbox) error
+	// RecallMessageWithOutbox 事务内标记消息撤回并记录本地消息表
+	// 消息已撤回（含并发撤回）时返回 ErrAlreadyRecalled
+	RecallMessageWithOutbox(ctx context.Context, msgID int64, recalledBy string, recalledAt time.Time, outbox *model.MessageOutbox) error
+	// GetMessagesByIDs 批量获取消息内容（不存在或已过期的 ID 会被忽略）
+	// username 非空时同时忽略该用户已删除（仅自己）的消息
+	GetMessagesByIDs(ctx context.Context, msgIDs []int64, username string) ([]*model.MessageContent, error)
+	// GetThreadMessages 拉取话题内 seq_id > afterSeq 的消息
+	// username 非空时忽略该用户已删除（仅自己）或入群前的消息
+	GetThreadMessages(ctx context.Context, rootMsgID int64, username string, afterSeq int64, limit int) ([]*model.MessageContent, error)
+	// FollowThread 关注话题
+	FollowThread(ctx context.Context, rootMsgID int64, username string) error
+	// UnfollowThread 取消关注话题
+	UnfollowThread(ctx context.Context, rootMsgID int64, username string) error
+	// GetThreadFollowers 获取话题的关注者
+	GetThreadFollowers(ctx context.Context, rootMsgID int64) ([]string, error)
+	// PinMessage 置顶消息，返回是否实际新增（已置顶时返回 false）
+	PinMessage(ctx context.Context, pin *model.MessagePin) (bool, error)
+	// UnpinMessage 取消置顶，返回是否实际删除（未置顶时返回 false）
+	UnpinMessage(ctx context.Context, sessionID string, msgID int64) (bool, error)
+	// GetPinnedMessages 获取会话的置顶记录（按置顶时间倒序）
+	GetPinnedMessages(ctx context.Context, sessionID string) ([]*model.MessagePin, error)
+	// AddReactionWithOutbox 事务内添加表情回应并记录本地消息表，返回是否实际新增
+	AddReactionWithOutbox(ctx context.Context, reaction *model.MessageReaction, outbox *model.MessageOutbox) (bool, error)
+	// RemoveReactionWithOutbox 事务内取消表情回应并记录本地消息表，返回是否实际删除
+	RemoveReactionWithOutbox(ctx context.Context, reaction *model.MessageReaction, outbox *model.MessageOutbox) (bool, error)
+	// GetReactionSummaries 批量获取消息的表情回应聚合，username 用于标记是否回应过（可为空）
+	GetReactionSummaries(ctx context.Context, msgIDs []int64, username string) ([]*ReactionSummary, error)
+	// CastVoteWithOutbox 事务内以 options 覆盖用户在投票中的选择并记录本地消息表，返回选择是否实际变化
+	// options 需已去重排序，为空表示撤回投票；投票已结束时返回错误
+	CastVoteWithOutbox(ctx context.Context, poll *model.Poll, username string, options []int32, outbox *model.MessageOutbox) (bool, error)
+	// ClosePollWithOutbox 事务内结束投票并记录本地消息表，返回是否实际结束（已结束时返回 false）
+	ClosePollWithOutbox(ctx context.Context, poll *model.Poll, outbox *model.MessageOutbox) (bool, error)
+	// GetPollTallies 批量获取投票的计票状态，username 用于查询其选择（可为空）
+	GetPollTallies(ctx context.Context, msgIDs []int64, username string) ([]*PollTally, error)
+	// EditMessageWithOutbox 事务内更新消息内容、记录编辑历史并记录本地消息表
+	EditMessageWithOutbox(ctx context.Context, revision *model.MessageRevision, newContent string, editedAt time.Time, outbox *model.MessageOutbox) error
 	// UpdateOutboxStatus 更新本地消息表状态
 	UpdateOutboxStatus(ctx context.Context, id int64, status int) error
 	// UpdateOutboxRetry 更新本地消息表重试信息
 	UpdateOutboxRetry(ctx context.Context, id int64, nextRetry time.Time, count int) error
 	// GetPendingOutboxMessages 获取待发送的本地消息
 	GetPendingOutboxMessages(ctx context.Context, limit int) ([]*model.MessageOutbox, error)
+	// GetExpiredMessages 获取 expires_at <= now 的消息（按过期时间升序）
+	GetExpiredMessages(ctx context.Context, now time.Time, limit int) ([]*model.MessageContent, error)
+	// HideMessagesWithOutbox 事务内记录用户删除（仅自己）的消息、推进清空位点（clearedSeq > 0 时，只增不减），并记录本地消息表
+	HideMessagesWithOutbox(ctx context.Context, username, sessionID string, msgIDs []int64, clearedSeq int64, outbox *model.MessageOutbox) error
+	// DeleteExpiredMessagesWithOutbox 事务内物理删除消息及其关联数据，并记录过期事件的本地消息表
+	// 返回不再被任何附件记录引用的存储位置，由调用方在事务提交后删除存储内容
+	DeleteExpiredMessagesWithOutbox(ctx context.Context, msgIDs []int64, outboxes []*model.MessageOutbox) ([]string, error)
+
+	// Close 释放资源（如数据库连接等）
+	Close() error
+}
+
+// AttachmentRepo 定义了附件元数据的数据访问接口（附件内容由 BlobStore 存储）
+type AttachmentRepo interface {
+	// CreateAttachment 创建待上传的附件记录
+	CreateAttachment(ctx context.Context, attachment *model.Attachment) error
+	// GetAttachment 获取附件记录
+	GetAttachment(ctx context.Context, attachmentID int64) (*model.Attachment, error)
+	// MarkUploaded 确认上传完成，记录实际存储位置、大小、MIME 类型与校验和（CAS：仅待上传状态可确认）
+	MarkUploaded(ctx context.Context, attachmentID int64, storageKey string, size int64, mimeType, checksum string, uploadedAt time.Time) error
+	// Close 释放资源（如数据库连接等）
+	Close() error
+}
+
+// ScheduledMessageRepo 定义了定时消息的数据访问接口
+type ScheduledMessageRepo interface {
+	// CreateScheduledMessage 创建定时消息
+	CreateScheduledMessage(ctx context.Context, msg *model.ScheduledMessage) error
+	// GetScheduledMessage 获取定时消息
+	GetScheduledMessage(ctx context.Context, scheduleID int64) (*model.ScheduledMessage, error)
+	// ListScheduledMessages 列出发送者尚未成功发送的定时消息（sessionID 为空时不过滤会话）
+	ListScheduledMessages(ctx context.Context, senderUsername, sessionID string) ([]*model.ScheduledMessage, error)
+	// CountPendingScheduledMessages 统计发送者待发送的定时消息数
+	CountPendingScheduledMessages(ctx context.Context, senderUsername string) (int64, error)
+	// UpdateScheduledMessage 替换待发送定时消息的内容与计划时间（CAS：仅待发送状态可编辑）
+	UpdateScheduledMessage(ctx context.Context, scheduleID int64, payload []byte, scheduledAt time.Time) (bool, error)
+	// CancelScheduledMessage 取消定时消息（CAS：仅待发送或发送失败状态可取消）
+	CancelScheduledMessage(ctx context.Context, scheduleID int64) (bool, error)
+	// ClaimDueScheduledMessages 领取到期（或领取超时）的定时消息并标记为发送中
+	ClaimDueScheduledMessages(ctx context.Context, now time.Time, claimTimeout time.Duration, limit int) ([]*model.ScheduledMessage, error)
+	// MarkScheduledMessageSent 标记定时消息已发送
+	MarkScheduledMessageSent(ctx context.Context, scheduleID, msgID int64) error
+	// MarkScheduledMessageFailed 标记定时消息发送失败
+	MarkScheduledMessageFailed(ctx context.Context, scheduleID int64, reason string) error
+	// Close 释放资源（如数据库连接等）
+	Close() error
+}
+
+// AnnouncementRepo 定义了系统公告的数据访问接口
+type AnnouncementRepo interface {
+	// CreateAnnouncementWithOutbox 事务内保存公告并记录本地消息表
+	CreateAnnouncementWithOutbox(ctx context.Context, announcement *model.Announcement, outbox *model.MessageOutbox) error
+	// ListAnnouncements 获取 announcement_id > afterID 的最近 limit 条公告（按 ID 升序）
+	ListAnnouncements(ctx context.Context, afterID int64, limit int) ([]*model.Announcement, error)
+	// Close 释放资源（如数据库连接等）
+	Close() error
+}
+
+// WebhookRepo 定义了出站 Webhook 订阅与投递日志的数据访问接口
+type WebhookRepo interface {
+	// CreateWebhook 创建 Webhook 订阅
+	CreateWebhook(ctx context.Context, webhook *model.Webhook) error
+	// GetWebhook 获取 Webhook 订阅
+	GetWebhook(ctx context.Context, webhookID int64) (*model.Webhook, error)
+	// ListWebhooks 列出会话的 Webhook 订阅（按创建时间升序）
+	ListWebhooks(ctx context.Context, sessionID string) ([]*model.Webhook, error)
+	// DeleteWebhook 删除 Webhook 订阅及其投递日志，返回是否实际删除
+	DeleteWebhook(ctx context.Context, webhookID int64) (bool, error)
+	// CreateDeliveries 批量创建投递记录
+	CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error
+	// ClaimDueDeliveries 领取到期的待投递记录，并将其下一次重试时间推后 lease 作为租约
+	ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.WebhookDelivery, error)
+	// UpdateDelivery 更新投递结果（状态、尝试次数、响应码、失败原因与下一次重试时间）
+	UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+	// ListDeliveries 按 ID 倒序列出 Webhook 的投递日志（beforeID 为 0 时从最新开始）
+	ListDeliveries(ctx context.Context, webhookID, beforeID int64, limit int) ([]*model.WebhookDelivery, error)
+	// Close 释放资源（如数据库连接等）
+	Close() error
+}
 
+// BotRepo 定义了机器人账号与 API Token 的数据访问接口
+type BotRepo interface {
+	// CreateBot 事务内创建机器人账号及其 Token（只保存 Token 哈希）
+	CreateBot(ctx context.Context, bot *model.User, tokenHash string) error
+	// ListBots 列出创建者的机器人账号（按创建时间升序）
+	ListBots(ctx context.Context, ownerUsername string) ([]*model.User, error)
+	// GetBotByTokenHash 按 Token 哈希获取机器人账号
+	GetBotByTokenHash(ctx context.Context, tokenHash string) (*model.User, error)
+	// ReplaceBotToken 覆盖机器人的 Token，旧 Token 立即失效
+	ReplaceBotToken(ctx context.Context, botUsername, tokenHash string) error
+	// UpsertBotCommand 注册或更新机器人的斜杠命令（按 bot_username + name 覆盖）
+	UpsertBotCommand(ctx context.Context, cmd *model.BotCommand) error
+	// DeleteBotCommand 删除机器人的斜杠命令，返回是否删除了记录
+	DeleteBotCommand(ctx context.Context, botUsername, name string) (bool, error)
+	// ListBotCommands 列出机器人注册的斜杠命令（按命令名排序）
+	ListBotCommands(ctx context.Context, botUsername string) ([]*model.BotCommand, error)
+	// ListSessionBotCommands 列出会话内机器人成员注册的斜杠命令，name 非空时只返回该命令
+	ListSessionBotCommands(ctx context.Context, sessionID, name string) ([]*model.BotCommand, error)
 	// Close 释放资源（如数据库连接等）
 	Close() error
 }
+
+// ModerationRepo 定义了内容审核规则与待复核队列的数据访问接口
+type ModerationRepo interface {
+	// ListEnabledRules 获取全部启用的审核规则（按 ID 升序）
+	ListEnabledRules(ctx context.Context) ([]*model.ModerationRule, error)
+	// CreateReview 写入待复核消息（reasons 超长时截断）
+	CreateReview(ctx context.Context, review *model.ModerationReview) error
+	// ListPendingReviews 按入队顺序获取 ID 大于 afterID 的待复核消息
+	ListPendingReviews(ctx context.Context, afterID int64, limit int) ([]*model.ModerationReview, error)
+	// Close 释放资源
+	Close() error
+}
@@ -0,0 +1,235 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/db"
+	"github.com/ceyewan/resonance/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ScheduledMessageRepoOption 配置 ScheduledMessageRepo 的选项
+type ScheduledMessageRepoOption func(*scheduledMessageRepoOptions)
+
+type scheduledMessageRepoOptions struct {
+	logger clog.Logger
+}
+
+// WithScheduledMessageRepoLogger 设置日志记录器
+func WithScheduledMessageRepoLogger(logger clog.Logger) ScheduledMessageRepoOption {
+	return func(o *scheduledMessageRepoOptions) {
+		o.logger = logger
+	}
+}
+
+// scheduledMessageRepo 实现 ScheduledMessageRepo 接口
+type scheduledMessageRepo struct {
+	db     db.DB
+	logger clog.Logger
+}
+
+// NewScheduledMessageRepo 创建 ScheduledMessageRepo 实例
+func NewScheduledMessageRepo(database db.DB, opts ...ScheduledMessageRepoOption) (ScheduledMessageRepo, error) {
+	if database == nil {
+		return nil, fmt.Errorf("database cannot be nil")
+	}
+
+	options := &scheduledMessageRepoOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	// 提供默认 logger
+	var logger clog.Logger
+	if options.logger != nil {
+		logger = options.logger.WithNamespace("scheduled_message_repo")
+	} else {
+		logger = clog.Discard()
+	}
+
+	return &scheduledMessageRepo{
+		db:     database,
+		logger: logger,
+	}, nil
+}
+
+// CreateScheduledMessage 创建定时消息
+func (r *scheduledMessageRepo) CreateScheduledMessage(ctx context.Context, msg *model.ScheduledMessage) error {
+	if msg == nil {
+		return fmt.Errorf("scheduled message cannot be nil")
+	}
+	if msg.ScheduleID == 0 {
+		return fmt.Errorf("schedule_id cannot be zero")
+	}
+
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Create(msg).Error; err != nil {
+		r.logger.Error("创建定时消息失败",
+			clog.Int64("schedule_id", msg.ScheduleID),
+			clog.Error(err))
+		return fmt.Errorf("failed to create scheduled message: %w", err)
+	}
+	return nil
+}
+
+// GetScheduledMessage 获取定时消息
+func (r *scheduledMessageRepo) GetScheduledMessage(ctx context.Context, scheduleID int64) (*model.ScheduledMessage, error) {
+	var msg model.ScheduledMessage
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("schedule_id = ?", scheduleID).First(&msg).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("scheduled message %w: %d", ErrNotFound, scheduleID)
+		}
+		r.logger.Error("获取定时消息失败",
+			clog.Int64("schedule_id", scheduleID),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to get scheduled message: %w", err)
+	}
+	return &msg, nil
+}
+
+// ListScheduledMessages 列出发送者待发送、发送中与发送失败的定时消息，按计划时间升序
+func (r *scheduledMessageRepo) ListScheduledMessages(ctx context.Context, senderUsername, sessionID string) ([]*model.ScheduledMessage, error) {
+	var msgs []*model.ScheduledMessage
+	gormDB := r.db.DB(ctx)
+	query := gormDB.Where("sender_username = ? AND status IN ?", senderUsername,
+		[]int{model.ScheduledStatusPending, model.ScheduledStatusSending, model.ScheduledStatusFailed})
+	if sessionID != "" {
+		query = query.Where("session_id = ?", sessionID)
+	}
+	if err := query.Order("scheduled_at ASC").Find(&msgs).Error; err != nil {
+		r.logger.Error("获取定时消息列表失败",
+			clog.String("username", senderUsername),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to list scheduled messages: %w", err)
+	}
+	return msgs, nil
+}
+
+// CountPendingScheduledMessages 统计发送者待发送的定时消息数
+func (r *scheduledMessageRepo) CountPendingScheduledMessages(ctx context.Context, senderUsername string) (int64, error) {
+	var count int64
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Model(&model.ScheduledMessage{}).
+		Where("sender_username = ? AND status = ?", senderUsername, model.ScheduledStatusPending).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count scheduled messages: %w", err)
+	}
+	return count, nil
+}
+
+// UpdateScheduledMessage 替换待发送定时消息的内容与计划时间（CAS：仅待发送状态可编辑）
+// 返回 false 表示消息已被领取、发送或取消
+func (r *scheduledMessageRepo) UpdateScheduledMessage(ctx context.Context, scheduleID int64, payload []byte, scheduledAt time.Time) (bool, error) {
+	gormDB := r.db.DB(ctx)
+	result := gormDB.Model(&model.ScheduledMessage{}).
+		Where("schedule_id = ? AND status = ?", scheduleID, model.ScheduledStatusPending).
+		Updates(map[string]interface{}{
+			"payload":      payload,
+			"scheduled_at": scheduledAt,
+		})
+	if result.Error != nil {
+		r.logger.Error("更新定时消息失败",
+			clog.Int64("schedule_id", scheduleID),
+			clog.Error(result.Error))
+		return false, fmt.Errorf("failed to update scheduled message: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CancelScheduledMessage 取消定时消息（CAS：仅待发送或发送失败状态可取消，取消发送失败的消息即将其移出列表）
+// 返回 false 表示消息已被领取、发送或取消
+func (r *scheduledMessageRepo) CancelScheduledMessage(ctx context.Context, scheduleID int64) (bool, error) {
+	gormDB := r.db.DB(ctx)
+	result := gormDB.Model(&model.ScheduledMessage{}).
+		Where("schedule_id = ? AND status IN ?", scheduleID,
+			[]int{model.ScheduledStatusPending, model.ScheduledStatusFailed}).
+		Update("status", model.ScheduledStatusCanceled)
+	if result.Error != nil {
+		r.logger.Error("取消定时消息失败",
+			clog.Int64("schedule_id", scheduleID),
+			clog.Error(result.Error))
+		return false, fmt.Errorf("failed to cancel scheduled message: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ClaimDueScheduledMessages 领取到期的定时消息并标记为发送中
+// 领取超过 claimTimeout 仍未完成的记录（如实例崩溃）会被重新领取；
+// 使用 FOR UPDATE SKIP LOCKED，多个 Logic 实例并发领取时互不重复
+func (r *scheduledMessageRepo) ClaimDueScheduledMessages(ctx context.Context, now time.Time, claimTimeout time.Duration, limit int) ([]*model.ScheduledMessage, error) {
+	var msgs []*model.ScheduledMessage
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("(status = ? AND scheduled_at <= ?) OR (status = ? AND claimed_at <= ?)",
+				model.ScheduledStatusPending, now,
+				model.ScheduledStatusSending, now.Add(-claimTimeout)).
+			Order("scheduled_at ASC").
+			Limit(limit).
+			Find(&msgs).Error; err != nil {
+			return err
+		}
+		if len(msgs) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, 0, len(msgs))
+		for _, msg := range msgs {
+			ids = append(ids, msg.ScheduleID)
+			msg.Status = model.ScheduledStatusSending
+			msg.ClaimedAt = &now
+		}
+		return tx.Model(&model.ScheduledMessage{}).
+			Where("schedule_id IN ?", ids).
+			Updates(map[string]interface{}{
+				"status":     model.ScheduledStatusSending,
+				"claimed_at": now,
+			}).Error
+	})
+	if err != nil {
+		r.logger.Error("领取到期定时消息失败", clog.Error(err))
+		return nil, fmt.Errorf("failed to claim scheduled messages: %w", err)
+	}
+	return msgs, nil
+}
+
+// MarkScheduledMessageSent 标记定时消息已发送
+func (r *scheduledMessageRepo) MarkScheduledMessageSent(ctx context.Context, scheduleID, msgID int64) error {
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Model(&model.ScheduledMessage{}).
+		Where("schedule_id = ? AND status = ?", scheduleID, model.ScheduledStatusSending).
+		Updates(map[string]interface{}{
+			"status": model.ScheduledStatusSent,
+			"msg_id": msgID,
+			"error":  "",
+		}).Error; err != nil {
+		return fmt.Errorf("failed to mark scheduled message sent: %w", err)
+	}
+	return nil
+}
+
+// MarkScheduledMessageFailed 标记定时消息发送失败
+func (r *scheduledMessageRepo) MarkScheduledMessageFailed(ctx context.Context, scheduleID int64, reason string) error {
+	if len(reason) > 255 {
+		reason = reason[:255]
+	}
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Model(&model.ScheduledMessage{}).
+		Where("schedule_id = ? AND status = ?", scheduleID, model.ScheduledStatusSending).
+		Updates(map[string]interface{}{
+			"status": model.ScheduledStatusFailed,
+			"error":  reason,
+		}).Error; err != nil {
+		return fmt.Errorf("failed to mark scheduled message failed: %w", err)
+	}
+	return nil
+}
+
+// Close 释放资源
+func (r *scheduledMessageRepo) Close() error {
+	return nil
+}
@@ -0,0 +1,79 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/resonance/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduledMessageRepo_Lifecycle(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewScheduledMessageRepo(database, WithScheduledMessageRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	now := time.Now()
+	for _, msg := range []*model.ScheduledMessage{
+		{ScheduleID: 1, SessionID: "s_sched", SenderUsername: "alice", Payload: []byte("due"), ScheduledAt: now.Add(-time.Minute)},
+		{ScheduleID: 2, SessionID: "s_sched", SenderUsername: "alice", Payload: []byte("later"), ScheduledAt: now.Add(time.Hour)},
+		{ScheduleID: 3, SessionID: "s_sched", SenderUsername: "alice", Payload: []byte("canceled"), ScheduledAt: now.Add(-time.Minute)},
+	} {
+		require.NoError(t, repo.CreateScheduledMessage(ctx, msg))
+	}
+
+	t.Run("取消", func(t *testing.T) {
+		canceled, err := repo.CancelScheduledMessage(ctx, 3)
+		require.NoError(t, err)
+		assert.True(t, canceled)
+
+		canceled, err = repo.CancelScheduledMessage(ctx, 3)
+		require.NoError(t, err)
+		assert.False(t, canceled, "重复取消不生效")
+	})
+
+	t.Run("只领取到期的待发送消息", func(t *testing.T) {
+		claimed, err := repo.ClaimDueScheduledMessages(ctx, now, time.Minute, 10)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		assert.Equal(t, int64(1), claimed[0].ScheduleID)
+		assert.Equal(t, model.ScheduledStatusSending, claimed[0].Status)
+
+		claimed, err = repo.ClaimDueScheduledMessages(ctx, now, time.Minute, 10)
+		require.NoError(t, err)
+		assert.Empty(t, claimed, "发送中的消息在领取超时前不会被重复领取")
+
+		claimed, err = repo.ClaimDueScheduledMessages(ctx, now.Add(2*time.Minute), time.Minute, 10)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1, "领取超时后可重新领取")
+	})
+
+	t.Run("发送中的消息不可编辑", func(t *testing.T) {
+		updated, err := repo.UpdateScheduledMessage(ctx, 1, []byte("edited"), now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.False(t, updated)
+
+		updated, err = repo.UpdateScheduledMessage(ctx, 2, []byte("edited"), now.Add(2*time.Hour))
+		require.NoError(t, err)
+		assert.True(t, updated)
+	})
+
+	t.Run("标记已发送", func(t *testing.T) {
+		require.NoError(t, repo.MarkScheduledMessageSent(ctx, 1, 10001))
+		found, err := repo.GetScheduledMessage(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, model.ScheduledStatusSent, found.Status)
+		assert.Equal(t, int64(10001), found.MsgID)
+
+		list, err := repo.ListScheduledMessages(ctx, "alice", "")
+		require.NoError(t, err)
+		require.Len(t, list, 1, "已发送与已取消的消息不在列表中")
+		assert.Equal(t, []byte("edited"), list[0].Payload)
+	})
+}
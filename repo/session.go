@@ -3,11 +3,13 @@ package repo
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ceyewan/genesis/clog"
 	"github.com/ceyewan/genesis/db"
 	"github.com/ceyewan/resonance/model"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // SessionRepoOption 配置 SessionRepo 的选项
@@ -95,7 +97,7 @@ func (r *sessionRepo) GetSession(ctx context.Context, sessionID string) (*model.
 	gormDB := r.db.DB(ctx)
 	if err := gormDB.Where("session_id = ?", sessionID).First(&session).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("session not found: %s", sessionID)
+			return nil, fmt.Errorf("session %w: %s", ErrNotFound, sessionID)
 		}
 		r.logger.Error("获取会话失败",
 			clog.String("session_id", sessionID),
@@ -120,7 +122,7 @@ func (r *sessionRepo) GetUserSession(ctx context.Context, username, sessionID st
 	if err := gormDB.Where("session_id = ? AND username = ?", sessionID, username).
 		First(&member).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("user session not found: username=%s, session_id=%s", username, sessionID)
+			return nil, fmt.Errorf("user session %w: username=%s, session_id=%s", ErrNotFound, username, sessionID)
 		}
 		r.logger.Error("获取用户会话失败",
 			clog.String("username", username),
@@ -262,7 +264,7 @@ func (r *sessionRepo) UpdateMaxSeqID(ctx context.Context, sessionID string, newS
 		var session model.Session
 		if err := gormDB.Where("session_id = ?", sessionID).First(&session).Error; err != nil {
 			if err == gorm.ErrRecordNotFound {
-				return fmt.Errorf("session not found: %s", sessionID)
+				return fmt.Errorf("session %w: %s", ErrNotFound, sessionID)
 			}
 			return fmt.Errorf("failed to check session: %w", err)
 		}
@@ -359,6 +361,265 @@ func (r *sessionRepo) UpdateLastReadSeq(ctx context.Context, sessionID, username
 	return nil
 }
 
+// UpdateMessageTTL 更新会话消息保留时长（秒），返回是否实际变更
+func (r *sessionRepo) UpdateMessageTTL(ctx context.Context, sessionID string, ttlSeconds int64) (bool, error) {
+	if sessionID == "" {
+		return false, fmt.Errorf("session_id cannot be empty")
+	}
+
+	gormDB := r.db.DB(ctx)
+	result := gormDB.Model(&model.Session{}).
+		Where("session_id = ? AND message_ttl <> ?", sessionID, ttlSeconds).
+		Update("message_ttl", ttlSeconds)
+	if result.Error != nil {
+		r.logger.Error("更新会话消息保留时长失败",
+			clog.String("session_id", sessionID),
+			clog.Int64("ttl_seconds", ttlSeconds),
+			clog.Error(result.Error))
+		return false, fmt.Errorf("failed to update message ttl: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// AddMembersWithOutbox 事务内批量添加成员并记录成员变更事件的本地消息表
+// 已是成员的用户被忽略（保留原有角色与已读位置），返回实际加入的用户名；无人加入时不写入 Outbox
+// 新成员的清空位点与已读位置取会话当前最大序号：加入前的消息（含曾被移除后离开期间的消息）对其不可见
+func (r *sessionRepo) AddMembersWithOutbox(ctx context.Context, members []*model.SessionMember, outbox *model.MessageOutbox) ([]string, error) {
+	if len(members) == 0 {
+		return nil, nil
+	}
+	if outbox == nil {
+		return nil, fmt.Errorf("outbox cannot be nil")
+	}
+	for _, m := range members {
+		if m == nil || m.SessionID == "" || m.Username == "" {
+			return nil, fmt.Errorf("session_id and username cannot be empty")
+		}
+	}
+
+	var added []string
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		added = added[:0]
+		var maxSeqs []int64
+		if err := tx.Model(&model.Session{}).
+			Where("session_id = ?", members[0].SessionID).
+			Pluck("max_seq_id", &maxSeqs).Error; err != nil {
+			return fmt.Errorf("failed to get session max_seq_id: %w", err)
+		}
+		var maxSeq int64
+		if len(maxSeqs) > 0 {
+			maxSeq = maxSeqs[0]
+		}
+		for _, m := range members {
+			m.ClearedSeq, m.LastReadSeq = maxSeq, maxSeq
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(m)
+			if result.Error != nil {
+				return fmt.Errorf("failed to add member: %w", result.Error)
+			}
+			if result.RowsAffected > 0 {
+				added = append(added, m.Username)
+			}
+		}
+		if len(added) == 0 {
+			return nil
+		}
+		if err := tx.Create(outbox).Error; err != nil {
+			return fmt.Errorf("failed to save outbox: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("批量添加成员失败",
+			clog.String("session_id", members[0].SessionID),
+			clog.Error(err))
+		return nil, err
+	}
+	return added, nil
+}
+
+// RemoveMemberWithOutbox 事务内删除成员关系并记录成员变更事件的本地消息表，返回是否实际删除
+// 成员关系删除后，推送扇出、信箱写扩散与历史消息的成员校验均不再包含该用户；其创建的 Webhook 一并删除
+func (r *sessionRepo) RemoveMemberWithOutbox(ctx context.Context, sessionID, username string, outbox *model.MessageOutbox) (bool, error) {
+	if sessionID == "" || username == "" || outbox == nil {
+		return false, fmt.Errorf("session_id, username and outbox cannot be empty")
+	}
+
+	var removed bool
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		result := tx.Where("session_id = ? AND username = ?", sessionID, username).Delete(&model.SessionMember{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to remove member: %w", result.Error)
+		}
+		removed = result.RowsAffected > 0
+		if !removed {
+			return nil
+		}
+		if err := deleteWebhooksCreatedBy(tx, sessionID, username); err != nil {
+			return err
+		}
+		if err := tx.Create(outbox).Error; err != nil {
+			return fmt.Errorf("failed to save outbox: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("移除成员失败",
+			clog.String("session_id", sessionID),
+			clog.String("username", username),
+			clog.Error(err))
+		return false, err
+	}
+	return removed, nil
+}
+
+// UpdateMemberRole 事务内更新成员角色，返回是否实际变更（不是成员或角色未变化时为 false）
+// 降为普通成员时一并删除其创建的 Webhook
+func (r *sessionRepo) UpdateMemberRole(ctx context.Context, sessionID, username string, role int) (bool, error) {
+	if sessionID == "" || username == "" {
+		return false, fmt.Errorf("session_id and username cannot be empty")
+	}
+
+	var updated bool
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		result := tx.Model(&model.SessionMember{}).
+			Where("session_id = ? AND username = ? AND role <> ?", sessionID, username, role).
+			Update("role", role)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update member role: %w", result.Error)
+		}
+		updated = result.RowsAffected > 0
+		if !updated || role >= model.MemberRoleAdmin {
+			return nil
+		}
+		return deleteWebhooksCreatedBy(tx, sessionID, username)
+	})
+	if err != nil {
+		r.logger.Error("更新成员角色失败",
+			clog.String("session_id", sessionID),
+			clog.String("username", username),
+			clog.Int("role", role),
+			clog.Error(err))
+		return false, err
+	}
+	return updated, nil
+}
+
+// TransferOwnership 事务内转让群主：更新会话的 owner_username，新群主角色置为群主，原群主降为管理员
+// 以 owner_username = from 作为条件，并发转让时仅一次成功
+func (r *sessionRepo) TransferOwnership(ctx context.Context, sessionID, from, to string) error {
+	if sessionID == "" || from == "" || to == "" {
+		return fmt.Errorf("session_id, from and to cannot be empty")
+	}
+
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		result := tx.Model(&model.Session{}).
+			Where("session_id = ? AND owner_username = ?", sessionID, from).
+			Update("owner_username", to)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update session owner: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: session_id=%s", ErrOwnerChanged, sessionID)
+		}
+
+		result = tx.Model(&model.SessionMember{}).
+			Where("session_id = ? AND username = ?", sessionID, to).
+			Update("role", model.MemberRoleOwner)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update new owner role: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("user session %w: username=%s, session_id=%s", ErrNotFound, to, sessionID)
+		}
+
+		if err := tx.Model(&model.SessionMember{}).
+			Where("session_id = ? AND username = ?", sessionID, from).
+			Update("role", model.MemberRoleAdmin).Error; err != nil {
+			return fmt.Errorf("failed to update previous owner role: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("转让群主失败",
+			clog.String("session_id", sessionID),
+			clog.String("from", from),
+			clog.String("to", to),
+			clog.Error(err))
+		return err
+	}
+	return nil
+}
+
+// UpdateSessionName 更新会话名称，返回是否实际变更
+func (r *sessionRepo) UpdateSessionName(ctx context.Context, sessionID, name string) (bool, error) {
+	if sessionID == "" {
+		return false, fmt.Errorf("session_id cannot be empty")
+	}
+
+	gormDB := r.db.DB(ctx)
+	result := gormDB.Model(&model.Session{}).
+		Where("session_id = ? AND name <> ?", sessionID, name).
+		Update("name", name)
+	if result.Error != nil {
+		r.logger.Error("更新会话名称失败",
+			clog.String("session_id", sessionID),
+			clog.Error(result.Error))
+		return false, fmt.Errorf("failed to update session name: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// SetMemberMute 禁言或解除禁言，返回是否实际变更
+// 重复禁言时更新截止时间；解除未被禁言的成员视为无变更
+func (r *sessionRepo) SetMemberMute(ctx context.Context, sessionID, username string, muted bool, until *time.Time) (bool, error) {
+	if sessionID == "" || username == "" {
+		return false, fmt.Errorf("session_id and username cannot be empty")
+	}
+	if !muted {
+		until = nil
+	}
+
+	gormDB := r.db.DB(ctx)
+	query := gormDB.Model(&model.SessionMember{}).
+		Where("session_id = ? AND username = ?", sessionID, username)
+	if !muted {
+		query = query.Where("muted = ?", true)
+	}
+	result := query.Updates(map[string]interface{}{
+		"muted":       muted,
+		"muted_until": until,
+	})
+	if result.Error != nil {
+		r.logger.Error("更新成员禁言状态失败",
+			clog.String("session_id", sessionID),
+			clog.String("username", username),
+			clog.Bool("muted", muted),
+			clog.Error(result.Error))
+		return false, fmt.Errorf("failed to update member mute: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// SetDoNotDisturb 设置成员对会话的免打扰
+func (r *sessionRepo) SetDoNotDisturb(ctx context.Context, sessionID, username string, enabled bool) error {
+	if sessionID == "" || username == "" {
+		return fmt.Errorf("session_id and username cannot be empty")
+	}
+
+	gormDB := r.db.DB(ctx)
+	result := gormDB.Model(&model.SessionMember{}).
+		Where("session_id = ? AND username = ?", sessionID, username).
+		Update("do_not_disturb", enabled)
+	if result.Error != nil {
+		r.logger.Error("更新会话免打扰失败",
+			clog.String("session_id", sessionID),
+			clog.String("username", username),
+			clog.Error(result.Error))
+		return fmt.Errorf("failed to update do not disturb: %w", result.Error)
+	}
+	return nil
+}
+
 // Close 释放资源
 func (r *sessionRepo) Close() error {
 	r.logger.Info("关闭 SessionRepo")
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ceyewan/resonance/model"
 	"github.com/stretchr/testify/assert"
@@ -477,6 +478,132 @@ func TestSessionRepo_GetContactList(t *testing.T) {
 	})
 }
 
+func TestSessionRepo_AddRemoveMembersWithOutbox(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewSessionRepo(database, WithSessionRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	require.NoError(t, repo.CreateSession(ctx, &model.Session{SessionID: "g_member", Type: 2, OwnerUsername: "alice"}))
+	require.NoError(t, repo.AddMember(ctx, &model.SessionMember{SessionID: "g_member", Username: "alice", Role: 1}))
+
+	newOutbox := func() *model.MessageOutbox {
+		return &model.MessageOutbox{Topic: "member", Payload: []byte("member"), Status: model.OutboxStatusPending, NextRetryTime: time.Now()}
+	}
+	countOutbox := func() int64 {
+		var n int64
+		require.NoError(t, database.DB(ctx).Model(&model.MessageOutbox{}).Where("topic = ?", "member").Count(&n).Error)
+		return n
+	}
+
+	// 已是成员的用户被忽略，且不修改其角色
+	added, err := repo.AddMembersWithOutbox(ctx, []*model.SessionMember{
+		{SessionID: "g_member", Username: "alice"},
+		{SessionID: "g_member", Username: "bob"},
+		{SessionID: "g_member", Username: "carol"},
+	}, newOutbox())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bob", "carol"}, added)
+	assert.Equal(t, int64(1), countOutbox())
+
+	alice, err := repo.GetUserSession(ctx, "alice", "g_member")
+	require.NoError(t, err)
+	assert.Equal(t, 1, alice.Role)
+
+	// 无人加入时不写入 Outbox
+	added, err = repo.AddMembersWithOutbox(ctx, []*model.SessionMember{{SessionID: "g_member", Username: "bob"}}, newOutbox())
+	require.NoError(t, err)
+	assert.Empty(t, added)
+	assert.Equal(t, int64(1), countOutbox())
+
+	removed, err := repo.RemoveMemberWithOutbox(ctx, "g_member", "bob", newOutbox())
+	require.NoError(t, err)
+	assert.True(t, removed)
+	assert.Equal(t, int64(2), countOutbox())
+
+	_, err = repo.GetUserSession(ctx, "bob", "g_member")
+	assert.Error(t, err)
+	members, err := repo.GetMembers(ctx, "g_member")
+	require.NoError(t, err)
+	assert.Len(t, members, 2)
+
+	// 重复移除视为无变更
+	removed, err = repo.RemoveMemberWithOutbox(ctx, "g_member", "bob", newOutbox())
+	require.NoError(t, err)
+	assert.False(t, removed)
+	assert.Equal(t, int64(2), countOutbox())
+}
+
+func TestSessionRepo_RolesAndOwnership(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewSessionRepo(database, WithSessionRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	require.NoError(t, repo.CreateSession(ctx, &model.Session{SessionID: "g_role", Type: 2, Name: "old", OwnerUsername: "alice"}))
+	require.NoError(t, repo.AddMember(ctx, &model.SessionMember{SessionID: "g_role", Username: "alice", Role: model.MemberRoleOwner}))
+	require.NoError(t, repo.AddMember(ctx, &model.SessionMember{SessionID: "g_role", Username: "bob", Role: model.MemberRoleMember}))
+
+	changed, err := repo.UpdateMemberRole(ctx, "g_role", "bob", model.MemberRoleAdmin)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	// 角色未变化时视为无变更
+	changed, err = repo.UpdateMemberRole(ctx, "g_role", "bob", model.MemberRoleAdmin)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	changed, err = repo.UpdateSessionName(ctx, "g_role", "new")
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	// 新群主须为成员
+	assert.Error(t, repo.TransferOwnership(ctx, "g_role", "alice", "carol"))
+	session, err := repo.GetSession(ctx, "g_role")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", session.OwnerUsername)
+	assert.Equal(t, "new", session.Name)
+
+	require.NoError(t, repo.TransferOwnership(ctx, "g_role", "alice", "bob"))
+	session, err = repo.GetSession(ctx, "g_role")
+	require.NoError(t, err)
+	assert.Equal(t, "bob", session.OwnerUsername)
+	alice, err := repo.GetUserSession(ctx, "alice", "g_role")
+	require.NoError(t, err)
+	assert.Equal(t, model.MemberRoleAdmin, alice.Role)
+	bob, err := repo.GetUserSession(ctx, "bob", "g_role")
+	require.NoError(t, err)
+	assert.Equal(t, model.MemberRoleOwner, bob.Role)
+
+	// 原群主已变更时拒绝
+	assert.Error(t, repo.TransferOwnership(ctx, "g_role", "alice", "bob"))
+
+	until := time.Now().Add(time.Hour)
+	changed, err = repo.SetMemberMute(ctx, "g_role", "alice", true, &until)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	alice, err = repo.GetUserSession(ctx, "alice", "g_role")
+	require.NoError(t, err)
+	assert.True(t, alice.MutedAt(time.Now()))
+
+	changed, err = repo.SetMemberMute(ctx, "g_role", "alice", false, nil)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	// 未被禁言时解除视为无变更
+	changed, err = repo.SetMemberMute(ctx, "g_role", "alice", false, nil)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	alice, err = repo.GetUserSession(ctx, "alice", "g_role")
+	require.NoError(t, err)
+	assert.False(t, alice.Muted)
+	assert.Nil(t, alice.MutedUntil)
+}
+
 func TestSessionRepo_CompleteLifecycle(t *testing.T) {
 	database, cleanup := setupTestContext(t)
 	defer cleanup()
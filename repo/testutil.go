@@ -295,9 +295,26 @@ func cleanupTestData(t *testing.T, database db.DB) {
 	tables := []string{
 		"t_inbox",
 		"t_message_outbox",
+		"t_message_revision",
+		"t_thread_follower",
+		"t_message_pin",
+		"t_message_hidden",
+		"t_poll_vote",
+		"t_poll",
+		"t_attachment",
+		"t_scheduled_message",
+		"t_announcement",
+		"t_moderation_review",
+		"t_moderation_rule",
+		"t_webhook_delivery",
+		"t_webhook",
+		"t_message_reaction",
+		"t_message_mention",
 		"t_message_content",
 		"t_session_member",
 		"t_session",
+		"t_bot_command",
+		"t_bot_token",
 		"t_user",
 	}
 
@@ -95,7 +95,7 @@ func (r *userRepo) GetUserByUsername(ctx context.Context, username string) (*mod
 	gormDB := r.db.DB(ctx)
 	if err := gormDB.Where("username = ?", username).First(&user).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("user not found: %s", username)
+			return nil, fmt.Errorf("user %w: %s", ErrNotFound, username)
 		}
 		r.logger.Error("获取用户失败",
 			clog.String("username", username),
@@ -167,7 +167,7 @@ func (r *userRepo) UpdateUser(ctx context.Context, user *model.User) error {
 	}
 
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("user not found: %s", user.Username)
+		return fmt.Errorf("user %w: %s", ErrNotFound, user.Username)
 	}
 
 	r.logger.Info("更新用户成功", clog.String("username", user.Username))
@@ -0,0 +1,246 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	"github.com/ceyewan/genesis/db"
+	"github.com/ceyewan/resonance/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WebhookRepoOption 配置 WebhookRepo 的选项
+type WebhookRepoOption func(*webhookRepoOptions)
+
+type webhookRepoOptions struct {
+	logger clog.Logger
+}
+
+// WithWebhookRepoLogger 设置日志记录器
+func WithWebhookRepoLogger(logger clog.Logger) WebhookRepoOption {
+	return func(o *webhookRepoOptions) {
+		o.logger = logger
+	}
+}
+
+// webhookRepo 实现 WebhookRepo 接口
+type webhookRepo struct {
+	db     db.DB
+	logger clog.Logger
+}
+
+// NewWebhookRepo 创建 WebhookRepo 实例
+func NewWebhookRepo(database db.DB, opts ...WebhookRepoOption) (WebhookRepo, error) {
+	if database == nil {
+		return nil, fmt.Errorf("database cannot be nil")
+	}
+
+	options := &webhookRepoOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	// 提供默认 logger
+	var logger clog.Logger
+	if options.logger != nil {
+		logger = options.logger.WithNamespace("webhook_repo")
+	} else {
+		logger = clog.Discard()
+	}
+
+	return &webhookRepo{
+		db:     database,
+		logger: logger,
+	}, nil
+}
+
+// CreateWebhook 创建 Webhook 订阅
+func (r *webhookRepo) CreateWebhook(ctx context.Context, webhook *model.Webhook) error {
+	if webhook == nil {
+		return fmt.Errorf("webhook cannot be nil")
+	}
+	if webhook.WebhookID == 0 {
+		return fmt.Errorf("webhook_id cannot be zero")
+	}
+
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Create(webhook).Error; err != nil {
+		r.logger.Error("创建 Webhook 失败",
+			clog.Int64("webhook_id", webhook.WebhookID),
+			clog.String("session_id", webhook.SessionID),
+			clog.Error(err))
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+	return nil
+}
+
+// GetWebhook 获取 Webhook 订阅
+func (r *webhookRepo) GetWebhook(ctx context.Context, webhookID int64) (*model.Webhook, error) {
+	var webhook model.Webhook
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("webhook_id = ?", webhookID).First(&webhook).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("webhook %w: %d", ErrNotFound, webhookID)
+		}
+		r.logger.Error("获取 Webhook 失败",
+			clog.Int64("webhook_id", webhookID),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	return &webhook, nil
+}
+
+// ListWebhooks 列出会话的 Webhook 订阅，按创建时间升序
+func (r *webhookRepo) ListWebhooks(ctx context.Context, sessionID string) ([]*model.Webhook, error) {
+	var webhooks []*model.Webhook
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Where("session_id = ?", sessionID).
+		Order("created_at ASC").
+		Find(&webhooks).Error; err != nil {
+		r.logger.Error("获取 Webhook 列表失败",
+			clog.String("session_id", sessionID),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	return webhooks, nil
+}
+
+// DeleteWebhook 事务内删除 Webhook 订阅及其投递日志
+// 返回 false 表示订阅不存在
+func (r *webhookRepo) DeleteWebhook(ctx context.Context, webhookID int64) (bool, error) {
+	var deleted bool
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		if err := tx.Where("webhook_id = ?", webhookID).Delete(&model.WebhookDelivery{}).Error; err != nil {
+			return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+		}
+		result := tx.Where("webhook_id = ?", webhookID).Delete(&model.Webhook{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete webhook: %w", result.Error)
+		}
+		deleted = result.RowsAffected > 0
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("删除 Webhook 失败",
+			clog.Int64("webhook_id", webhookID),
+			clog.Error(err))
+		return false, err
+	}
+	return deleted, nil
+}
+
+// deleteWebhooksCreatedBy 事务内删除用户在会话中创建的 Webhook 订阅及其投递日志
+// 创建者失去 Webhook 管理权限（被移除、退出或降为普通成员）时调用，避免其订阅继续接收会话消息
+func deleteWebhooksCreatedBy(tx *gorm.DB, sessionID, username string) error {
+	if err := tx.Exec(`DELETE FROM t_webhook_delivery WHERE webhook_id IN
+		(SELECT webhook_id FROM t_webhook WHERE session_id = ? AND created_by = ?)`, sessionID, username).Error; err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+	if err := tx.Where("session_id = ? AND created_by = ?", sessionID, username).Delete(&model.Webhook{}).Error; err != nil {
+		return fmt.Errorf("failed to delete webhooks: %w", err)
+	}
+	return nil
+}
+
+// CreateDeliveries 批量创建投递记录
+func (r *webhookRepo) CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Create(&deliveries).Error; err != nil {
+		r.logger.Error("创建 Webhook 投递记录失败",
+			clog.Int("count", len(deliveries)),
+			clog.Error(err))
+		return fmt.Errorf("failed to create webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// ClaimDueDeliveries 领取到期的待投递记录
+// 领取时将 next_retry_time 推后 lease 作为租约：投递进行中（或实例崩溃）时其他实例不会重复领取，
+// 租约到期仍未更新的记录会被重新领取；使用 FOR UPDATE SKIP LOCKED，多个 Task 实例并发领取时互不重复
+func (r *webhookRepo) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.WebhookDelivery, error) {
+	var deliveries []*model.WebhookDelivery
+	err := r.db.Transaction(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_retry_time <= ?", model.WebhookDeliveryPending, now).
+			Order("next_retry_time ASC").
+			Limit(limit).
+			Find(&deliveries).Error; err != nil {
+			return err
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		leaseUntil := now.Add(lease)
+		ids := make([]int64, 0, len(deliveries))
+		for _, d := range deliveries {
+			ids = append(ids, d.ID)
+			d.NextRetryTime = leaseUntil
+		}
+		return tx.Model(&model.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_retry_time", leaseUntil).Error
+	})
+	if err != nil {
+		r.logger.Error("领取 Webhook 投递记录失败", clog.Error(err))
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// UpdateDelivery 更新投递结果
+func (r *webhookRepo) UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	if delivery == nil || delivery.ID == 0 {
+		return fmt.Errorf("delivery id cannot be zero")
+	}
+	reason := delivery.Error
+	if len(reason) > 255 {
+		reason = reason[:255]
+	}
+
+	gormDB := r.db.DB(ctx)
+	if err := gormDB.Model(&model.WebhookDelivery{}).
+		Where("id = ?", delivery.ID).
+		Updates(map[string]interface{}{
+			"status":          delivery.Status,
+			"attempts":        delivery.Attempts,
+			"response_code":   delivery.ResponseCode,
+			"error":           reason,
+			"next_retry_time": delivery.NextRetryTime,
+		}).Error; err != nil {
+		r.logger.Error("更新 Webhook 投递记录失败",
+			clog.Int64("delivery_id", delivery.ID),
+			clog.Error(err))
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// ListDeliveries 按 ID 倒序列出 Webhook 的投递日志
+func (r *webhookRepo) ListDeliveries(ctx context.Context, webhookID, beforeID int64, limit int) ([]*model.WebhookDelivery, error) {
+	var deliveries []*model.WebhookDelivery
+	gormDB := r.db.DB(ctx)
+	query := gormDB.Where("webhook_id = ?", webhookID)
+	if beforeID > 0 {
+		query = query.Where("id < ?", beforeID)
+	}
+	if err := query.Order("id DESC").Limit(limit).Find(&deliveries).Error; err != nil {
+		r.logger.Error("获取 Webhook 投递日志失败",
+			clog.Int64("webhook_id", webhookID),
+			clog.Error(err))
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// Close 释放资源
+func (r *webhookRepo) Close() error {
+	return nil
+}
@@ -0,0 +1,132 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/resonance/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookRepo_DeliveryLifecycle(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	repo, err := NewWebhookRepo(database, WithWebhookRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	ctx := context.Background()
+	require.NoError(t, repo.CreateWebhook(ctx, &model.Webhook{
+		WebhookID: 1,
+		SessionID: "s_hook",
+		URL:       "https://example.com/hook",
+		Secret:    "secret",
+		CreatedBy: "alice",
+	}))
+
+	hooks, err := repo.ListWebhooks(ctx, "s_hook")
+	require.NoError(t, err)
+	require.Len(t, hooks, 1)
+
+	now := time.Now()
+	deliveries := []*model.WebhookDelivery{
+		{WebhookID: 1, Event: model.WebhookEventMessageCreated, Payload: []byte(`{}`), NextRetryTime: now.Add(-time.Second)},
+		{WebhookID: 1, Event: model.WebhookEventMessageEdited, Payload: []byte(`{}`), NextRetryTime: now.Add(time.Hour)},
+	}
+	require.NoError(t, repo.CreateDeliveries(ctx, deliveries))
+	require.NotZero(t, deliveries[0].ID)
+
+	t.Run("领取到期记录并加租约", func(t *testing.T) {
+		claimed, err := repo.ClaimDueDeliveries(ctx, now, time.Minute, 10)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		assert.Equal(t, deliveries[0].ID, claimed[0].ID)
+
+		claimed, err = repo.ClaimDueDeliveries(ctx, now, time.Minute, 10)
+		require.NoError(t, err)
+		assert.Empty(t, claimed, "租约期内不会被重复领取")
+	})
+
+	t.Run("更新投递结果", func(t *testing.T) {
+		deliveries[0].Status = model.WebhookDeliverySucceeded
+		deliveries[0].Attempts = 1
+		deliveries[0].ResponseCode = 200
+		require.NoError(t, repo.UpdateDelivery(ctx, deliveries[0]))
+
+		claimed, err := repo.ClaimDueDeliveries(ctx, now.Add(2*time.Minute), time.Minute, 10)
+		require.NoError(t, err)
+		assert.Empty(t, claimed, "投递成功的记录不再领取")
+
+		list, err := repo.ListDeliveries(ctx, 1, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, deliveries[1].ID, list[0].ID, "按 ID 倒序")
+		assert.Equal(t, 200, list[1].ResponseCode)
+	})
+
+	t.Run("删除订阅同时删除投递日志", func(t *testing.T) {
+		deleted, err := repo.DeleteWebhook(ctx, 1)
+		require.NoError(t, err)
+		assert.True(t, deleted)
+
+		list, err := repo.ListDeliveries(ctx, 1, 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+
+		_, err = repo.GetWebhook(ctx, 1)
+		require.Error(t, err)
+	})
+}
+
+func TestWebhookRepo_CreatorLosesPermission(t *testing.T) {
+	database, cleanup := setupTestContext(t)
+	defer cleanup()
+
+	webhookRepo, err := NewWebhookRepo(database, WithWebhookRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer webhookRepo.Close()
+	sessionRepo, err := NewSessionRepo(database, WithSessionRepoLogger(getTestLogger(t)))
+	require.NoError(t, err)
+	defer sessionRepo.Close()
+
+	ctx := context.Background()
+	require.NoError(t, sessionRepo.CreateSession(ctx, &model.Session{SessionID: "g_hook", Type: 2, OwnerUsername: "alice"}))
+	for i, username := range []string{"alice", "bob", "carol"} {
+		require.NoError(t, sessionRepo.AddMember(ctx, &model.SessionMember{SessionID: "g_hook", Username: username, Role: model.MemberRoleAdmin}))
+		require.NoError(t, webhookRepo.CreateWebhook(ctx, &model.Webhook{
+			WebhookID: int64(10 + i),
+			SessionID: "g_hook",
+			URL:       "https://example.com/" + username,
+			Secret:    "secret",
+			CreatedBy: username,
+		}))
+	}
+	require.NoError(t, webhookRepo.CreateDeliveries(ctx, []*model.WebhookDelivery{
+		{WebhookID: 11, Event: model.WebhookEventMessageCreated, Payload: []byte(`{}`), NextRetryTime: time.Now()},
+	}))
+
+	// 降为普通成员：删除其创建的 Webhook 及投递日志
+	changed, err := sessionRepo.UpdateMemberRole(ctx, "g_hook", "bob", model.MemberRoleMember)
+	require.NoError(t, err)
+	require.True(t, changed)
+	_, err = webhookRepo.GetWebhook(ctx, 11)
+	assert.ErrorIs(t, err, ErrNotFound)
+	deliveries, err := webhookRepo.ListDeliveries(ctx, 11, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+
+	// 被移除或退出
+	removed, err := sessionRepo.RemoveMemberWithOutbox(ctx, "g_hook", "carol", &model.MessageOutbox{
+		Topic: "member", Payload: []byte("member"), Status: model.OutboxStatusPending, NextRetryTime: time.Now(),
+	})
+	require.NoError(t, err)
+	require.True(t, removed)
+
+	hooks, err := webhookRepo.ListWebhooks(ctx, "g_hook")
+	require.NoError(t, err)
+	require.Len(t, hooks, 1)
+	assert.Equal(t, "alice", hooks[0].CreatedBy)
+}
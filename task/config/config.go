@@ -40,6 +40,10 @@ type Config struct {
 	// 消费者配置
 	StorageConsumer ConsumerConfig `mapstructure:"storage_consumer"` // 存储任务消费者
 	PushConsumer    ConsumerConfig `mapstructure:"push_consumer"`    // 推送任务消费者
+	WebhookConsumer ConsumerConfig `mapstructure:"webhook_consumer"` // Webhook 投递消费者
+
+	// Webhook 投递配置
+	Webhook WebhookConfig `mapstructure:"webhook"`
 
 	// 可观测性配置
 	Observability struct {
@@ -84,6 +88,75 @@ type ConsumerConfig struct {
 	RetryInterval int    `mapstructure:"retry_interval"` // 重试间隔（秒）
 }
 
+// WebhookConfig 出站 Webhook 投递配置
+type WebhookConfig struct {
+	Timeout     time.Duration `mapstructure:"timeout"`      // 单次投递的 HTTP 超时
+	MaxAttempts int           `mapstructure:"max_attempts"` // 最大尝试次数，耗尽后标记为失败
+	BaseBackoff time.Duration `mapstructure:"base_backoff"` // 首次重试间隔，之后按指数退避
+	MaxBackoff  time.Duration `mapstructure:"max_backoff"`  // 重试间隔上限
+	BatchSize   int           `mapstructure:"batch_size"`   // 每次领取的待重试记录批次大小
+	TickerTime  time.Duration `mapstructure:"ticker_time"`  // 重试扫描间隔
+	Lease       time.Duration `mapstructure:"lease"`        // 领取后的租约时长，超时未更新的记录会被重新领取
+	// AllowPrivateNetwork 允许投递到回环、内网等地址，仅用于本地开发与测试，生产环境必须关闭
+	AllowPrivateNetwork bool `mapstructure:"allow_private_network"`
+}
+
+// GetTimeout 获取单次投递超时，默认 5 秒
+func (c *WebhookConfig) GetTimeout() time.Duration {
+	if c.Timeout <= 0 {
+		return 5 * time.Second
+	}
+	return c.Timeout
+}
+
+// GetMaxAttempts 获取最大尝试次数，默认 5
+func (c *WebhookConfig) GetMaxAttempts() int {
+	if c.MaxAttempts <= 0 {
+		return 5
+	}
+	return c.MaxAttempts
+}
+
+// GetBaseBackoff 获取首次重试间隔，默认 1 秒
+func (c *WebhookConfig) GetBaseBackoff() time.Duration {
+	if c.BaseBackoff <= 0 {
+		return time.Second
+	}
+	return c.BaseBackoff
+}
+
+// GetMaxBackoff 获取重试间隔上限，默认 5 分钟
+func (c *WebhookConfig) GetMaxBackoff() time.Duration {
+	if c.MaxBackoff <= 0 {
+		return 5 * time.Minute
+	}
+	return c.MaxBackoff
+}
+
+// GetBatchSize 获取批次大小，默认 100
+func (c *WebhookConfig) GetBatchSize() int {
+	if c.BatchSize <= 0 {
+		return 100
+	}
+	return c.BatchSize
+}
+
+// GetTickerTime 获取重试扫描间隔，默认 1 秒
+func (c *WebhookConfig) GetTickerTime() time.Duration {
+	if c.TickerTime <= 0 {
+		return time.Second
+	}
+	return c.TickerTime
+}
+
+// GetLease 获取领取租约时长，默认 1 分钟
+func (c *WebhookConfig) GetLease() time.Duration {
+	if c.Lease <= 0 {
+		return time.Minute
+	}
+	return c.Lease
+}
+
 // GetHTTPAddr 获取 HTTP 健康检查地址，默认 :15092
 func (c *Config) GetHTTPAddr() string {
 	port := c.Service.HTTPPort
@@ -130,12 +203,22 @@ func Load() (*Config, error) {
 	if cfg.PushConsumer.QueueGroup == "" {
 		cfg.PushConsumer.QueueGroup = "resonance_group_push"
 	}
+	if cfg.WebhookConsumer.WorkerCount <= 0 {
+		cfg.WebhookConsumer.WorkerCount = 10
+	}
+	if cfg.WebhookConsumer.QueueGroup == "" {
+		cfg.WebhookConsumer.QueueGroup = "resonance_group_webhook"
+	}
 	// 继承 Topic 配置如果未设置（通常两个组订阅同一个 Topic）
 	if cfg.StorageConsumer.Topic == "" && cfg.PushConsumer.Topic != "" {
 		cfg.StorageConsumer.Topic = cfg.PushConsumer.Topic
 	} else if cfg.PushConsumer.Topic == "" && cfg.StorageConsumer.Topic != "" {
 		cfg.PushConsumer.Topic = cfg.StorageConsumer.Topic
 	}
+	// Webhook 消费者与推送消费者订阅同一个 Topic
+	if cfg.WebhookConsumer.Topic == "" {
+		cfg.WebhookConsumer.Topic = cfg.PushConsumer.Topic
+	}
 
 	// 在 debug 模式下，打印最终生效的配置
 	if os.Getenv("DEBUG_CONFIG") == "true" || os.Getenv("RESONANCE_DEBUG_CONFIG") == "true" {
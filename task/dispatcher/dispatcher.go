@@ -2,16 +2,19 @@ package dispatcher
 
 import (
 	"context"
+	"time"
 
 	"github.com/ceyewan/genesis/clog"
 	"github.com/ceyewan/genesis/metrics"
 	gatewayv1 "github.com/ceyewan/resonance/api/gen/go/gateway/v1"
 	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
 	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/polltally"
 	"github.com/ceyewan/resonance/repo"
 	"github.com/ceyewan/resonance/task/observability"
 	"github.com/ceyewan/resonance/task/pusher"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
 )
 
 // Dispatcher 消息分发器
@@ -53,6 +56,16 @@ func (d *Dispatcher) DispatchStorage(ctx context.Context, event *mqv1.PushEvent)
 		clog.Int64("msg_id", event.MsgId),
 		clog.String("session_id", event.SessionId))
 
+	// 撤回等变更类事件不产生新消息，无需写扩散
+	if event.EventType != mqv1.EventType_EVENT_TYPE_UNSPECIFIED {
+		return nil
+	}
+
+	// 话题消息使用独立序号空间，不进入会话信箱，由关注者通过话题接口拉取
+	if event.ThreadRootId != 0 {
+		return nil
+	}
+
 	// 1. 获取会话成员列表
 	members, err := d.sessionRepo.GetMembers(ctx, event.SessionId)
 	if err != nil {
@@ -78,6 +91,31 @@ func (d *Dispatcher) DispatchStorage(ctx context.Context, event *mqv1.PushEvent)
 		return err // 存储失败需要重试
 	}
 
+	// 3. 提及写扩散：@所有人为除发送者外的全部成员，否则为被提及的成员
+	mentioned := make(map[string]struct{}, len(event.Mentions))
+	for _, username := range event.Mentions {
+		mentioned[username] = struct{}{}
+	}
+	mentions := make([]*model.MessageMention, 0, len(event.Mentions))
+	for _, m := range members {
+		if m.Username == event.FromUsername {
+			continue
+		}
+		if _, ok := mentioned[m.Username]; !ok && !event.MentionAll {
+			continue
+		}
+		mentions = append(mentions, &model.MessageMention{
+			MsgID:     event.MsgId,
+			Username:  m.Username,
+			SessionID: event.SessionId,
+			SeqID:     event.SeqId,
+		})
+	}
+	if err := d.messageRepo.SaveMentions(ctx, mentions); err != nil {
+		d.logger.Error("failed to save mentions", clog.Error(err))
+		return err
+	}
+
 	d.logger.Debug("storage task completed",
 		clog.Int64("msg_id", event.MsgId),
 		clog.Int("inbox_count", len(inboxes)))
@@ -100,45 +138,37 @@ func (d *Dispatcher) DispatchPush(ctx context.Context, event *mqv1.PushEvent) er
 		clog.Int64("msg_id", event.MsgId),
 		clog.String("session_id", event.SessionId))
 
-	// 1. 获取会话成员列表
-	members, err := d.sessionRepo.GetMembers(ctx, event.SessionId)
-	if err != nil {
-		d.logger.Error("failed to get session members", clog.Error(err))
-		return err
+	// 已读事件不推送给会话成员，而是按消息发送者聚合已读回执
+	if event.EventType == mqv1.EventType_EVENT_TYPE_READ {
+		return d.dispatchReadReceipts(ctx, event)
 	}
-
-	// 2. 提取需要在线推送的用户名列表
-	usernames := make([]string, 0, len(members))
-	for _, m := range members {
-		// 跳过发送者自己，发送者不需要在线推送（因为他是消息源）
-		if m.Username == event.FromUsername {
-			continue
-		}
-		usernames = append(usernames, m.Username)
+	// 仅自己删除事件只推送给操作者本人，用于同步其他设备
+	if event.EventType == mqv1.EventType_EVENT_TYPE_HIDE {
+		return d.dispatchHide(ctx, event)
 	}
-
-	if len(usernames) == 0 {
-		d.logger.Debug("no target users to push", clog.Int64("msg_id", event.MsgId))
-		return nil
+	// 系统公告不属于任何会话，不查路由，直接广播到所有 Gateway
+	if event.EventType == mqv1.EventType_EVENT_TYPE_ANNOUNCEMENT {
+		return d.dispatchAnnouncement(ctx, event)
+	}
+	// 成员变更事件仅通知离开者本人；其余成员通过随后的系统消息感知，扇出随成员关系实时生效
+	if event.EventType == mqv1.EventType_EVENT_TYPE_MEMBER {
+		return d.dispatchMemberChange(ctx, event)
 	}
 
-	// 3. 批量获取用户网关路由
-	routers, err := d.routerRepo.BatchGetUsersGateway(ctx, usernames)
+	// 1. 获取推送目标：话题消息推送给话题关注者，其余推送给会话成员
+	targets, err := d.getPushTargets(ctx, event)
 	if err != nil {
-		d.logger.Error("failed to batch get user gateways", clog.Error(err))
-		return err // Redis 错误可以选择重试
+		return err
 	}
 
-	// 4. 按 GatewayID 分组
-	gatewayGroups := make(map[string][]string) // gatewayID -> []username
-	for _, router := range routers {
-		if router == nil {
-			continue // 用户离线或无路由
-		}
-		gatewayGroups[router.GatewayID] = append(gatewayGroups[router.GatewayID], router.Username)
+	// 2. 提取需要在线推送的用户名列表，开启免打扰的成员单独分组静默推送
+	usernames, silent := splitPushTargets(event, targets)
+	if len(usernames) == 0 && len(silent) == 0 {
+		d.logger.Debug("no target users to push", clog.Int64("msg_id", event.MsgId))
+		return nil
 	}
 
-	// 5. 构造推送消息
+	// 3. 构造推送消息
 	pushMsg := &gatewayv1.PushMessage{
 		MsgId:        event.MsgId,
 		SeqId:        event.SeqId,
@@ -147,6 +177,67 @@ func (d *Dispatcher) DispatchPush(ctx context.Context, event *mqv1.PushEvent) er
 		Content:      event.Content,
 		Type:         event.Type,
 		Timestamp:    event.Timestamp,
+		ReplyToMsgId: event.ReplyToMsgId,
+		ThreadRootId: event.ThreadRootId,
+		Mentions:     event.Mentions,
+		MentionAll:   event.MentionAll,
+		Body:         event.Body,
+		ExpiresAt:    event.ExpiresAt,
+		FromBot:      event.FromBot,
+	}
+
+	// 过期通知：客户端按 msg_id 从本地缓存中删除消息
+	if event.EventType == mqv1.EventType_EVENT_TYPE_EXPIRE {
+		pushMsg.Action = gatewayv1.MessageAction_MESSAGE_ACTION_EXPIRE
+	}
+
+	// 撤回通知：客户端按 msg_id 将原消息替换为墓碑
+	if event.EventType == mqv1.EventType_EVENT_TYPE_RECALL {
+		pushMsg.Action = gatewayv1.MessageAction_MESSAGE_ACTION_RECALL
+		pushMsg.Content = model.RecalledMessageContent
+		pushMsg.Recalled = true
+		pushMsg.RecalledBy = event.OperatorUsername
+	}
+
+	// 编辑通知：客户端按 msg_id 替换内容，并以版本号丢弃乱序到达的旧版本
+	if event.EventType == mqv1.EventType_EVENT_TYPE_EDIT {
+		pushMsg.Action = gatewayv1.MessageAction_MESSAGE_ACTION_EDIT
+		pushMsg.Version = event.EditVersion
+		pushMsg.EditedAt = event.EditedAt
+	}
+
+	// 表情回应通知：携带推送时刻的最新聚合，客户端直接覆盖，避免乱序导致计数漂移
+	if event.EventType == mqv1.EventType_EVENT_TYPE_REACTION {
+		pushMsg.Action = gatewayv1.MessageAction_MESSAGE_ACTION_REACTION
+		pushMsg.ReactionChange = &gatewayv1.ReactionChange{
+			Username: event.OperatorUsername,
+			Emoji:    event.ReactionEmoji,
+			Removed:  event.ReactionRemoved,
+		}
+		summaries, err := d.messageRepo.GetReactionSummaries(ctx, []int64{event.MsgId}, "")
+		if err != nil {
+			d.logger.Error("failed to get reaction summaries", clog.Error(err))
+			return err
+		}
+		for _, s := range summaries {
+			pushMsg.Reactions = append(pushMsg.Reactions, &gatewayv1.Reaction{
+				Emoji: s.Emoji,
+				Count: s.Count,
+			})
+		}
+	}
+
+	// 投票计票变更：携带推送时刻的最新计票，客户端直接覆盖，避免乱序导致计数漂移
+	if event.EventType == mqv1.EventType_EVENT_TYPE_POLL {
+		pushMsg.Action = gatewayv1.MessageAction_MESSAGE_ACTION_POLL
+		tallies, err := d.messageRepo.GetPollTallies(ctx, []int64{event.MsgId}, "")
+		if err != nil {
+			d.logger.Error("failed to get poll tallies", clog.Error(err))
+			return err
+		}
+		if len(tallies) > 0 {
+			pushMsg.PollTally = polltally.Build(event.Body.GetPoll(), tallies[0], time.Now())
+		}
 	}
 
 	// 携带会话元数据（用于前端自动创建会话）
@@ -157,7 +248,72 @@ func (d *Dispatcher) DispatchPush(ctx context.Context, event *mqv1.PushEvent) er
 		}
 	}
 
-	// 6. 投递到各 Gateway 的推送队列
+	if len(usernames) > 0 {
+		if err := d.enqueuePush(ctx, &pusher.PushTask{Message: pushMsg}, usernames); err != nil {
+			return err
+		}
+	}
+	if len(silent) > 0 {
+		silentMsg := proto.Clone(pushMsg).(*gatewayv1.PushMessage)
+		silentMsg.Silent = true
+		return d.enqueuePush(ctx, &pusher.PushTask{Message: silentMsg}, silent)
+	}
+	return nil
+}
+
+// splitPushTargets 将推送目标分为正常推送与静默推送两组
+// 跳过事件发起者自己：新消息为发送者，撤回/编辑为操作者（发起者已在本地更新）
+// 过期事件由服务端发起，发送者本地同样需要清理，不跳过任何人
+// 免打扰只对会话主时间线的新消息生效：话题消息只推送给主动关注的成员，始终正常提醒；
+// 被 @ 的成员（含 @所有人）同样正常提醒
+func splitPushTargets(event *mqv1.PushEvent, targets []*model.SessionMember) (usernames, silent []string) {
+	initiator := event.FromUsername
+	if event.OperatorUsername != "" {
+		initiator = event.OperatorUsername
+	}
+	if event.EventType == mqv1.EventType_EVENT_TYPE_EXPIRE {
+		initiator = ""
+	}
+	quiet := event.EventType == mqv1.EventType_EVENT_TYPE_UNSPECIFIED && event.ThreadRootId == 0 && !event.MentionAll
+	mentioned := make(map[string]struct{}, len(event.Mentions))
+	for _, username := range event.Mentions {
+		mentioned[username] = struct{}{}
+	}
+
+	usernames = make([]string, 0, len(targets))
+	for _, m := range targets {
+		if m.Username == initiator {
+			continue
+		}
+		if _, ok := mentioned[m.Username]; quiet && m.DoNotDisturb && !ok {
+			silent = append(silent, m.Username)
+			continue
+		}
+		usernames = append(usernames, m.Username)
+	}
+	return usernames, silent
+}
+
+// enqueuePush 按用户路由将推送内容投递到对应 Gateway 的推送队列
+// payload 只需填充推送内容，目标用户按 Gateway 分组后填充
+func (d *Dispatcher) enqueuePush(ctx context.Context, payload *pusher.PushTask, usernames []string) error {
+	// 1. 批量获取用户网关路由
+	routers, err := d.routerRepo.BatchGetUsersGateway(ctx, usernames)
+	if err != nil {
+		d.logger.Error("failed to batch get user gateways", clog.Error(err))
+		return err // Redis 错误可以选择重试
+	}
+
+	// 2. 按 GatewayID 分组
+	gatewayGroups := make(map[string][]string) // gatewayID -> []username
+	for _, router := range routers {
+		if router == nil {
+			continue // 用户离线或无路由
+		}
+		gatewayGroups[router.GatewayID] = append(gatewayGroups[router.GatewayID], router.Username)
+	}
+
+	// 3. 投递到各 Gateway 的推送队列
 	successCount := 0
 	failedCount := 0
 	for gatewayID, users := range gatewayGroups {
@@ -174,7 +330,7 @@ func (d *Dispatcher) DispatchPush(ctx context.Context, event *mqv1.PushEvent) er
 		// 投递任务到队列（非阻塞）
 		task := &pusher.PushTask{
 			ToUsernames: users,
-			Message:     pushMsg,
+			Message:     payload.Message,
 		}
 
 		if err := client.Enqueue(task); err != nil {
@@ -205,10 +361,146 @@ func (d *Dispatcher) DispatchPush(ctx context.Context, event *mqv1.PushEvent) er
 	}
 
 	d.logger.Debug("push task enqueued",
-		clog.Int64("msg_id", event.MsgId),
+		clog.Int64("msg_id", payload.Message.GetMsgId()),
 		clog.Int("total_targets", len(usernames)),
 		clog.Int("enqueued_targets", successCount),
 		clog.Int("failed_targets", failedCount))
 
 	return nil
 }
+
+// maxReadReceiptsPerEvent 单次已读事件最多聚合的消息数，避免一次性已读大量历史消息时放大查询
+const maxReadReceiptsPerEvent = 100
+
+// dispatchReadReceipts 处理已读事件：聚合区间内消息的已读数，按发送者分别推送已读回执
+func (d *Dispatcher) dispatchReadReceipts(ctx context.Context, event *mqv1.PushEvent) error {
+	items, err := d.messageRepo.GetReadReceipts(ctx, event.SessionId, event.ReadFromSeq, event.SeqId,
+		event.OperatorUsername, maxReadReceiptsPerEvent)
+	if err != nil {
+		d.logger.Error("failed to get read receipts", clog.Error(err))
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	members, err := d.sessionRepo.GetMembers(ctx, event.SessionId)
+	if err != nil {
+		d.logger.Error("failed to get session members", clog.Error(err))
+		return err
+	}
+	// 回执人数不含发送者本人
+	recipientCount := int32(len(members) - 1)
+
+	bySender := make(map[string][]*gatewayv1.ReadReceipt)
+	for _, item := range items {
+		unread := recipientCount - item.ReadCount
+		if unread < 0 {
+			unread = 0
+		}
+		bySender[item.SenderUsername] = append(bySender[item.SenderUsername], &gatewayv1.ReadReceipt{
+			MsgId:       item.MsgID,
+			SeqId:       item.SeqID,
+			ReadCount:   item.ReadCount,
+			UnreadCount: unread,
+		})
+	}
+
+	for sender, receipts := range bySender {
+		pushMsg := &gatewayv1.PushMessage{
+			SeqId:        event.SeqId,
+			SessionId:    event.SessionId,
+			FromUsername: event.OperatorUsername,
+			Timestamp:    event.Timestamp,
+			Action:       gatewayv1.MessageAction_MESSAGE_ACTION_READ_RECEIPT,
+			ReadReceipts: receipts,
+		}
+		if err := d.enqueuePush(ctx, &pusher.PushTask{Message: pushMsg}, []string{sender}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dispatchHide 处理仅自己删除事件：推送给操作者本人（发起设备重复收到时幂等处理）
+func (d *Dispatcher) dispatchHide(ctx context.Context, event *mqv1.PushEvent) error {
+	pushMsg := &gatewayv1.PushMessage{
+		SessionId:    event.SessionId,
+		FromUsername: event.OperatorUsername,
+		Timestamp:    event.Timestamp,
+		Action:       gatewayv1.MessageAction_MESSAGE_ACTION_HIDE,
+		HiddenMsgIds: event.HiddenMsgIds,
+		ClearedSeq:   event.ClearedSeq,
+	}
+	return d.enqueuePush(ctx, &pusher.PushTask{Message: pushMsg}, []string{event.OperatorUsername})
+}
+
+// dispatchMemberChange 处理成员变更事件：退出或被移除时推送给离开者本人（含其所有设备）
+// 离开者已不在成员列表中，收不到会话的系统消息；新加入的成员由随后的系统消息触发会话展示，无需单独推送
+func (d *Dispatcher) dispatchMemberChange(ctx context.Context, event *mqv1.PushEvent) error {
+	if event.MemberChange != mqv1.MemberChange_MEMBER_CHANGE_LEFT && event.MemberChange != mqv1.MemberChange_MEMBER_CHANGE_REMOVED {
+		return nil
+	}
+	if len(event.MemberUsernames) == 0 {
+		return nil
+	}
+
+	pushMsg := &gatewayv1.PushMessage{
+		SessionId:    event.SessionId,
+		FromUsername: event.OperatorUsername,
+		Content:      event.Content,
+		Type:         event.Type,
+		Timestamp:    event.Timestamp,
+		Action:       gatewayv1.MessageAction_MESSAGE_ACTION_MEMBER_REMOVED,
+	}
+	return d.enqueuePush(ctx, &pusher.PushTask{Message: pushMsg}, event.MemberUsernames)
+}
+
+// dispatchAnnouncement 处理系统公告事件：投递到每个已注册的 Gateway，由 Gateway 广播给全部在线用户
+// 公告已持久化，离线用户与投递失败的 Gateway 上的用户可在登录后补拉，因此投递失败不重试
+func (d *Dispatcher) dispatchAnnouncement(ctx context.Context, event *mqv1.PushEvent) error {
+	if event.Announcement == nil {
+		d.logger.Warn("announcement event without payload", clog.Int64("msg_id", event.MsgId))
+		return nil
+	}
+
+	enqueued := d.pusherMgr.Broadcast(&pusher.PushTask{Announcement: event.Announcement})
+	d.logger.Info("announcement dispatched",
+		clog.Int64("announcement_id", event.Announcement.AnnouncementId),
+		clog.Int("gateway_count", enqueued))
+	return nil
+}
+
+// getPushTargets 获取事件的推送目标成员
+// 话题事件只推送给仍在会话中的话题关注者，不受会话级别通知设置影响
+func (d *Dispatcher) getPushTargets(ctx context.Context, event *mqv1.PushEvent) ([]*model.SessionMember, error) {
+	members, err := d.sessionRepo.GetMembers(ctx, event.SessionId)
+	if err != nil {
+		d.logger.Error("failed to get session members", clog.Error(err))
+		return nil, err
+	}
+
+	// 过期事件推送给全体成员：话题关注关系可能已随话题根消息一并删除，且非关注者也可能缓存过话题消息
+	if event.ThreadRootId == 0 || event.EventType == mqv1.EventType_EVENT_TYPE_EXPIRE {
+		return members, nil
+	}
+
+	followers, err := d.messageRepo.GetThreadFollowers(ctx, event.ThreadRootId)
+	if err != nil {
+		d.logger.Error("failed to get thread followers", clog.Error(err))
+		return nil, err
+	}
+
+	memberSet := make(map[string]*model.SessionMember, len(members))
+	for _, m := range members {
+		memberSet[m.Username] = m
+	}
+	targets := make([]*model.SessionMember, 0, len(followers))
+	for _, username := range followers {
+		if m, ok := memberSet[username]; ok {
+			targets = append(targets, m)
+		}
+	}
+	return targets, nil
+}
@@ -0,0 +1,95 @@
+package dispatcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ceyewan/genesis/clog"
+	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitPushTargets(t *testing.T) {
+	targets := []*model.SessionMember{
+		{Username: "alice"},
+		{Username: "bob", DoNotDisturb: true},
+		{Username: "carol"},
+	}
+
+	// 主时间线新消息：免打扰成员静默推送，发送者本人不推送
+	usernames, silent := splitPushTargets(&mqv1.PushEvent{FromUsername: "alice"}, targets)
+	assert.Equal(t, []string{"carol"}, usernames)
+	assert.Equal(t, []string{"bob"}, silent)
+
+	// 话题消息推送给关注者，不受免打扰影响
+	usernames, silent = splitPushTargets(&mqv1.PushEvent{FromUsername: "alice", ThreadRootId: 1}, targets)
+	assert.Equal(t, []string{"bob", "carol"}, usernames)
+	assert.Empty(t, silent)
+
+	// 被 @ 的免打扰成员正常提醒，@所有人时全部正常提醒
+	targets = append(targets, &model.SessionMember{Username: "dave", DoNotDisturb: true})
+	usernames, silent = splitPushTargets(&mqv1.PushEvent{FromUsername: "alice", Mentions: []string{"bob"}}, targets)
+	assert.Equal(t, []string{"bob", "carol"}, usernames)
+	assert.Equal(t, []string{"dave"}, silent)
+	usernames, silent = splitPushTargets(&mqv1.PushEvent{FromUsername: "alice", MentionAll: true}, targets)
+	assert.Equal(t, []string{"bob", "carol", "dave"}, usernames)
+	assert.Empty(t, silent)
+
+	// 撤回等变更事件不是新消息提醒，正常推送
+	usernames, silent = splitPushTargets(&mqv1.PushEvent{
+		FromUsername:     "carol",
+		OperatorUsername: "alice",
+		EventType:        mqv1.EventType_EVENT_TYPE_RECALL,
+	}, targets)
+	assert.Equal(t, []string{"bob", "carol", "dave"}, usernames)
+	assert.Empty(t, silent)
+}
+
+// testSessionRepo 仅实现获取成员，其余方法未使用
+type testSessionRepo struct {
+	repo.SessionRepo
+	members []*model.SessionMember
+}
+
+func (r *testSessionRepo) GetMembers(ctx context.Context, sessionID string) ([]*model.SessionMember, error) {
+	return r.members, nil
+}
+
+// testMessageRepo 仅实现获取话题关注者，其余方法未使用
+type testMessageRepo struct {
+	repo.MessageRepo
+	followers []string
+}
+
+func (r *testMessageRepo) GetThreadFollowers(ctx context.Context, rootMsgID int64) ([]string, error) {
+	return r.followers, nil
+}
+
+func TestGetPushTargets_ThreadFollowers(t *testing.T) {
+	sessionRepo := &testSessionRepo{members: []*model.SessionMember{
+		{Username: "alice"},
+		{Username: "bob", DoNotDisturb: true},
+		{Username: "carol"},
+	}}
+	// dave 关注后已退出会话
+	messageRepo := &testMessageRepo{followers: []string{"bob", "dave"}}
+	d := NewDispatcher(sessionRepo, messageRepo, nil, nil, clog.Discard())
+	ctx := context.Background()
+
+	// 话题消息只推送给仍在会话中的关注者
+	targets, err := d.getPushTargets(ctx, &mqv1.PushEvent{SessionId: "s_1", ThreadRootId: 42})
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+	assert.Equal(t, "bob", targets[0].Username)
+
+	// 主时间线消息与过期事件推送给全体成员
+	targets, err = d.getPushTargets(ctx, &mqv1.PushEvent{SessionId: "s_1"})
+	require.NoError(t, err)
+	assert.Len(t, targets, 3)
+	targets, err = d.getPushTargets(ctx, &mqv1.PushEvent{SessionId: "s_1", ThreadRootId: 42, EventType: mqv1.EventType_EVENT_TYPE_EXPIRE})
+	require.NoError(t, err)
+	assert.Len(t, targets, 3)
+}
@@ -18,6 +18,8 @@ import (
 type PushTask struct {
 	ToUsernames []string
 	Message     *gatewayv1.PushMessage
+	// Announcement 系统公告（非空时忽略 ToUsernames 与 Message，经 Broadcast 发给该 Gateway 的全部在线用户）
+	Announcement *gatewayv1.Announcement
 }
 
 // GatewayClient 单个 Gateway 的推送客户端
@@ -160,6 +162,11 @@ func (c *GatewayClient) drainQueue(workerID int) {
 
 // doPush 执行单次推送（带重试）
 func (c *GatewayClient) doPush(task *PushTask) {
+	if task.Announcement != nil {
+		c.doBroadcast(task.Announcement)
+		return
+	}
+
 	const maxRetry = 3
 	const retryDelay = 1 * time.Second
 
@@ -168,7 +175,7 @@ func (c *GatewayClient) doPush(task *PushTask) {
 		if attempt > 0 {
 			c.logger.Warn("retrying push",
 				clog.String("gateway_id", c.id),
-				clog.Int64("msg_id", task.Message.MsgId),
+				clog.Int64("msg_id", task.Message.GetMsgId()),
 				clog.Int("attempt", attempt+1))
 			time.Sleep(retryDelay)
 		}
@@ -186,7 +193,7 @@ func (c *GatewayClient) doPush(task *PushTask) {
 			lastErr = err
 			c.logger.Warn("push attempt failed",
 				clog.String("gateway_id", c.id),
-				clog.Int64("msg_id", task.Message.MsgId),
+				clog.Int64("msg_id", task.Message.GetMsgId()),
 				clog.Int("attempt", attempt+1),
 				clog.Error(err))
 			continue
@@ -209,7 +216,7 @@ func (c *GatewayClient) doPush(task *PushTask) {
 
 		c.logger.Debug("push success",
 			clog.String("gateway_id", c.id),
-			clog.Int64("msg_id", task.Message.MsgId),
+			clog.Int64("msg_id", task.Message.GetMsgId()),
 			clog.Int("user_count", len(task.ToUsernames)))
 		return // 成功
 	}
@@ -217,11 +224,50 @@ func (c *GatewayClient) doPush(task *PushTask) {
 	// 重试耗尽，记录错误
 	c.logger.Error("push failed after retries",
 		clog.String("gateway_id", c.id),
-		clog.Int64("msg_id", task.Message.MsgId),
+		clog.Int64("msg_id", task.Message.GetMsgId()),
 		clog.Int("user_count", len(task.ToUsernames)),
 		clog.Error(lastErr))
 }
 
+// doBroadcast 执行单次公告广播（带重试）
+// 重试可能导致少数用户重复收到同一公告，客户端按 announcement_id 去重
+func (c *GatewayClient) doBroadcast(announcement *gatewayv1.Announcement) {
+	const retryDelay = 1 * time.Second
+	const maxRetry = 3
+
+	var lastErr error
+	for attempt := 0; attempt < maxRetry; attempt++ {
+		if attempt > 0 {
+			time.Sleep(retryDelay)
+		}
+
+		ctx, cancel := context.WithTimeout(c.ctx, 3*time.Second)
+		resp, err := c.client.Broadcast(ctx, &gatewayv1.BroadcastRequest{Announcement: announcement})
+		cancel()
+
+		if err != nil {
+			lastErr = err
+			c.logger.Warn("broadcast attempt failed",
+				clog.String("gateway_id", c.id),
+				clog.Int64("announcement_id", announcement.AnnouncementId),
+				clog.Int("attempt", attempt+1),
+				clog.Error(err))
+			continue
+		}
+
+		c.logger.Debug("broadcast success",
+			clog.String("gateway_id", c.id),
+			clog.Int64("announcement_id", announcement.AnnouncementId),
+			clog.Int("online_count", int(resp.OnlineCount)))
+		return
+	}
+
+	c.logger.Error("broadcast failed after retries",
+		clog.String("gateway_id", c.id),
+		clog.Int64("announcement_id", announcement.AnnouncementId),
+		clog.Error(lastErr))
+}
+
 // Close 关闭连接
 func (c *GatewayClient) Close() error {
 	var closeErr error
@@ -9,6 +9,9 @@ type PusherManager interface {
 	// GetClient 获取指定 Gateway 的客户端
 	GetClient(gatewayID string) (*GatewayClient, error)
 
+	// Broadcast 将推送任务投递到每个已注册的 Gateway，返回投递成功的 Gateway 数
+	Broadcast(task *PushTask) int
+
 	// Close 关闭所有连接
 	Close()
 }
@@ -174,6 +174,25 @@ func (m *Manager) GetClient(gatewayID string) (*GatewayClient, error) {
 	return client, nil
 }
 
+// Broadcast 将推送任务投递到每个已注册 Gateway 的推送队列，返回投递成功的 Gateway 数
+// 单个 Gateway 入队失败只记录日志，不影响其他 Gateway
+func (m *Manager) Broadcast(task *PushTask) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	enqueued := 0
+	for id, client := range m.clients {
+		if err := client.Enqueue(task); err != nil {
+			m.logger.Error("failed to enqueue broadcast task",
+				clog.String("gateway_id", id),
+				clog.Error(err))
+			continue
+		}
+		enqueued++
+	}
+	return enqueued
+}
+
 // Close 关闭所有连接
 func (m *Manager) Close() {
 	m.cancel()
@@ -17,6 +17,7 @@ import (
 	"github.com/ceyewan/resonance/task/dispatcher"
 	"github.com/ceyewan/resonance/task/observability"
 	"github.com/ceyewan/resonance/task/pusher"
+	"github.com/ceyewan/resonance/task/webhook"
 )
 
 // Task 任务服务生命周期管理器
@@ -34,6 +35,8 @@ type Task struct {
 	dispatcher      *dispatcher.Dispatcher
 	storageConsumer *consumer.Consumer
 	pushConsumer    *consumer.Consumer
+	webhookConsumer *consumer.Consumer
+	deliverer       *webhook.Deliverer
 	healthServer    *health.Server
 }
 
@@ -48,6 +51,7 @@ type resources struct {
 	routerRepo   repo.RouterRepo
 	sessionRepo  repo.SessionRepo
 	messageRepo  repo.MessageRepo
+	webhookRepo  repo.WebhookRepo
 }
 
 // New 创建 Task 实例
@@ -140,6 +144,16 @@ func (t *Task) initComponents() error {
 	)
 	t.pushConsumer.SetName("push")
 
+	// 6.3 Webhook Consumer (出站 Webhook 投递)
+	t.deliverer = webhook.NewDeliverer(res.webhookRepo, &t.config.Webhook, logger.WithNamespace("webhook"))
+	t.webhookConsumer = consumer.NewConsumer(
+		res.mqClient,
+		t.deliverer.Handle,
+		t.config.WebhookConsumer,
+		logger.WithNamespace("consumer_webhook"),
+	)
+	t.webhookConsumer.SetName("webhook")
+
 	// 7. 健康检查 Server
 	t.healthServer = health.NewServer(t.config.GetHTTPAddr(), logger)
 
@@ -223,6 +237,11 @@ func (t *Task) initResources() (*resources, error) {
 		return nil, fmt.Errorf("message repo init: %w", err)
 	}
 
+	webhookRepo, err := repo.NewWebhookRepo(dbInstance, repo.WithWebhookRepoLogger(t.logger))
+	if err != nil {
+		return nil, fmt.Errorf("webhook repo init: %w", err)
+	}
+
 	return &resources{
 		postgresConn: postgresConn,
 		redisConn:    redisConn,
@@ -233,6 +252,7 @@ func (t *Task) initResources() (*resources, error) {
 		sessionRepo:  sessionRepo,
 		messageRepo:  messageRepo,
 		routerRepo:   routerRepo,
+		webhookRepo:  webhookRepo,
 	}, nil
 }
 
@@ -257,6 +277,12 @@ func (t *Task) Run() error {
 	if err := t.pushConsumer.Start(); err != nil {
 		return fmt.Errorf("push consumer start: %w", err)
 	}
+	if err := t.webhookConsumer.Start(); err != nil {
+		return fmt.Errorf("webhook consumer start: %w", err)
+	}
+
+	// 启动 Webhook 重试循环
+	go t.deliverer.Start(t.ctx)
 
 	// 服务就绪，标记健康检查
 	t.healthServer.SetReady(true)
@@ -289,6 +315,9 @@ func (t *Task) Close() error {
 	if t.pushConsumer != nil {
 		t.pushConsumer.Stop()
 	}
+	if t.webhookConsumer != nil {
+		t.webhookConsumer.Stop()
+	}
 
 	// 3. 关闭 Pusher (断开 Gateway 连接)
 	if t.pusherMgr != nil {
@@ -0,0 +1,349 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/safehttp"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/ceyewan/resonance/task/config"
+)
+
+// 投递请求头
+const (
+	HeaderEvent     = "X-Resonance-Event"
+	HeaderDelivery  = "X-Resonance-Delivery"
+	HeaderWebhook   = "X-Resonance-Webhook"
+	HeaderTimestamp = "X-Resonance-Timestamp"
+	HeaderSignature = "X-Resonance-Signature"
+)
+
+// maxResponseDrain 读取并丢弃的响应体上限，便于复用连接
+const maxResponseDrain = 64 * 1024
+
+// Payload 投递的 JSON 请求体
+type Payload struct {
+	Event     string          `json:"event"`
+	SessionID string          `json:"session_id"`
+	Timestamp int64           `json:"timestamp"`
+	Message   *MessagePayload `json:"message,omitempty"`
+	Member    *MemberPayload  `json:"member,omitempty"`
+}
+
+// MessagePayload 消息事件（message.created / message.edited）的消息内容
+// ID 以字符串编码，避免 JavaScript 等语言的精度丢失
+type MessagePayload struct {
+	MsgID        int64    `json:"msg_id,string"`
+	SeqID        int64    `json:"seq_id"`
+	FromUsername string   `json:"from_username"`
+	FromBot      bool     `json:"from_bot"`
+	Content      string   `json:"content"`
+	Type         string   `json:"type"`
+	Timestamp    int64    `json:"timestamp"`
+	ReplyToMsgID int64    `json:"reply_to_msg_id,string,omitempty"`
+	ThreadRootID int64    `json:"thread_root_id,string,omitempty"`
+	Mentions     []string `json:"mentions,omitempty"`
+	EditVersion  int32    `json:"edit_version,omitempty"`
+	EditedAt     int64    `json:"edited_at,omitempty"`
+}
+
+// MemberPayload 成员变更事件（member.changed）的内容
+type MemberPayload struct {
+	Action    string   `json:"action"` // joined / left / removed
+	Usernames []string `json:"usernames"`
+	Operator  string   `json:"operator"`
+}
+
+// Sign 计算投递签名：HMAC-SHA256(secret, "{timestamp}.{body}") 的十六进制编码
+// 接收方应校验时间戳以防重放，并使用常量时间比较签名
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Deliverer 出站 Webhook 投递器
+// 作为 MQ 消费者为每个匹配的订阅写入投递记录并立即尝试投递；失败的记录由 Start 启动的重试循环按指数退避重投
+type Deliverer struct {
+	webhookRepo repo.WebhookRepo
+	httpClient  *http.Client
+	config      *config.WebhookConfig
+	logger      clog.Logger
+}
+
+// NewDeliverer 创建 Webhook 投递器
+func NewDeliverer(webhookRepo repo.WebhookRepo, cfg *config.WebhookConfig, logger clog.Logger) *Deliverer {
+	return &Deliverer{
+		webhookRepo: webhookRepo,
+		httpClient:  safehttp.NewClient(cfg.GetTimeout(), cfg.AllowPrivateNetwork),
+		config:      cfg,
+		logger:      logger,
+	}
+}
+
+// Handle 处理 MQ 事件，作为 Webhook 消费者的处理函数
+// 仅写入投递记录失败时返回错误（由消费者重试），投递失败只记录在投递日志中
+func (d *Deliverer) Handle(ctx context.Context, event *mqv1.PushEvent) error {
+	name := eventName(event)
+	if name == "" || event.SessionId == "" {
+		return nil
+	}
+
+	webhooks, err := d.webhookRepo.ListWebhooks(ctx, event.SessionId)
+	if err != nil {
+		return err
+	}
+	var targets []*model.Webhook
+	for _, webhook := range webhooks {
+		if subscribed(webhook, name) {
+			targets = append(targets, webhook)
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(buildPayload(name, event))
+	if err != nil {
+		d.logger.Error("failed to marshal webhook payload", clog.Error(err))
+		return nil
+	}
+
+	// 写入时即持有租约：内联投递期间重试循环不会领取这些记录，实例崩溃时租约到期后由重试循环接手
+	leaseUntil := time.Now().Add(d.config.GetLease())
+	deliveries := make([]*model.WebhookDelivery, 0, len(targets))
+	for _, webhook := range targets {
+		deliveries = append(deliveries, &model.WebhookDelivery{
+			WebhookID:     webhook.WebhookID,
+			Event:         name,
+			Payload:       body,
+			MsgID:         event.MsgId, // 消息过期时据此删除含消息内容的投递记录
+			Status:        model.WebhookDeliveryPending,
+			NextRetryTime: leaseUntil,
+		})
+	}
+	if err := d.webhookRepo.CreateDeliveries(ctx, deliveries); err != nil {
+		return err
+	}
+
+	for i, delivery := range deliveries {
+		d.attempt(ctx, targets[i], delivery)
+	}
+	return nil
+}
+
+// Start 启动重试循环，直到 ctx 取消
+func (d *Deliverer) Start(ctx context.Context) {
+	d.logger.Info("starting webhook retry loop")
+	ticker := time.NewTicker(d.config.GetTickerTime())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("webhook retry loop stopped")
+			return
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						d.logger.Error("panic in webhook retry loop", clog.Any("panic", r))
+					}
+				}()
+				d.retryDue(ctx)
+			}()
+		}
+	}
+}
+
+// retryDue 领取到期的待重试记录并重新投递
+func (d *Deliverer) retryDue(ctx context.Context) {
+	deliveries, err := d.webhookRepo.ClaimDueDeliveries(ctx, time.Now(), d.config.GetLease(), d.config.GetBatchSize())
+	if err != nil {
+		d.logger.Error("failed to claim webhook deliveries", clog.Error(err))
+		return
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+
+	d.logger.Debug("retrying webhook deliveries", clog.Int("count", len(deliveries)))
+
+	webhooks := make(map[int64]*model.Webhook)
+	for _, delivery := range deliveries {
+		webhook, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			webhook, err = d.webhookRepo.GetWebhook(ctx, delivery.WebhookID)
+			if err != nil {
+				// 订阅已删除时其投递记录会一并删除，这里只可能是查询失败，等待租约到期后重试
+				d.logger.Warn("failed to get webhook",
+					clog.Int64("webhook_id", delivery.WebhookID),
+					clog.Error(err))
+				continue
+			}
+			webhooks[delivery.WebhookID] = webhook
+		}
+		d.attempt(ctx, webhook, delivery)
+	}
+}
+
+// attempt 执行一次投递并更新投递记录
+func (d *Deliverer) attempt(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) {
+	code, err := d.send(ctx, webhook, delivery)
+
+	delivery.Attempts++
+	delivery.ResponseCode = code
+	delivery.Error = ""
+	switch {
+	case err == nil:
+		delivery.Status = model.WebhookDeliverySucceeded
+	case delivery.Attempts >= d.config.GetMaxAttempts():
+		delivery.Status = model.WebhookDeliveryFailed
+		delivery.Error = err.Error()
+	default:
+		delivery.Error = err.Error()
+		delivery.NextRetryTime = time.Now().Add(d.backoff(delivery.Attempts))
+	}
+
+	if err != nil {
+		d.logger.Warn("webhook delivery failed",
+			clog.Int64("webhook_id", webhook.WebhookID),
+			clog.Int64("delivery_id", delivery.ID),
+			clog.Int("attempts", delivery.Attempts),
+			clog.Error(err))
+	}
+
+	if err := d.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+		// 更新失败时记录保持租约，到期后会被重投，接收方需按 X-Resonance-Delivery 幂等处理
+		d.logger.Error("failed to update webhook delivery",
+			clog.Int64("delivery_id", delivery.ID),
+			clog.Error(err))
+	}
+}
+
+// send 发送投递请求，返回 HTTP 状态码（未收到响应时为 0）；非 2xx 视为失败
+func (d *Deliverer) send(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Resonance-Webhook/1.0")
+	req.Header.Set(HeaderEvent, delivery.Event)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderWebhook, strconv.FormatInt(webhook.WebhookID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, "sha256="+Sign(webhook.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseDrain))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff 第 attempts 次失败后的重试间隔：base * 2^(attempts-1)，不超过上限
+func (d *Deliverer) backoff(attempts int) time.Duration {
+	backoff := d.config.GetBaseBackoff()
+	maxBackoff := d.config.GetMaxBackoff()
+	for i := 1; i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+// eventName 将 MQ 事件映射为 Webhook 事件名，不支持的事件返回空串
+func eventName(event *mqv1.PushEvent) string {
+	switch event.EventType {
+	case mqv1.EventType_EVENT_TYPE_UNSPECIFIED:
+		return model.WebhookEventMessageCreated
+	case mqv1.EventType_EVENT_TYPE_EDIT:
+		return model.WebhookEventMessageEdited
+	case mqv1.EventType_EVENT_TYPE_MEMBER:
+		return model.WebhookEventMemberChanged
+	}
+	return ""
+}
+
+// subscribed 判断订阅是否包含事件，未指定事件时订阅全部
+func subscribed(webhook *model.Webhook, name string) bool {
+	if webhook.Events == "" {
+		return true
+	}
+	for _, event := range strings.Split(webhook.Events, ",") {
+		if event == name {
+			return true
+		}
+	}
+	return false
+}
+
+// buildPayload 构造投递请求体
+func buildPayload(name string, event *mqv1.PushEvent) *Payload {
+	payload := &Payload{
+		Event:     name,
+		SessionID: event.SessionId,
+		Timestamp: event.Timestamp,
+	}
+	if name == model.WebhookEventMemberChanged {
+		payload.Member = &MemberPayload{
+			Action:    memberAction(event.MemberChange),
+			Usernames: event.MemberUsernames,
+			Operator:  event.OperatorUsername,
+		}
+		return payload
+	}
+	payload.Message = &MessagePayload{
+		MsgID:        event.MsgId,
+		SeqID:        event.SeqId,
+		FromUsername: event.FromUsername,
+		FromBot:      event.FromBot,
+		Content:      event.Content,
+		Type:         event.Type,
+		Timestamp:    event.Timestamp,
+		ReplyToMsgID: event.ReplyToMsgId,
+		ThreadRootID: event.ThreadRootId,
+		Mentions:     event.Mentions,
+		EditVersion:  event.EditVersion,
+		EditedAt:     event.EditedAt,
+	}
+	return payload
+}
+
+// memberAction 成员变更类型的字符串表示
+func memberAction(change mqv1.MemberChange) string {
+	switch change {
+	case mqv1.MemberChange_MEMBER_CHANGE_JOINED:
+		return "joined"
+	case mqv1.MemberChange_MEMBER_CHANGE_LEFT:
+		return "left"
+	case mqv1.MemberChange_MEMBER_CHANGE_REMOVED:
+		return "removed"
+	}
+	return "unknown"
+}
@@ -0,0 +1,171 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ceyewan/genesis/clog"
+	mqv1 "github.com/ceyewan/resonance/api/gen/go/mq/v1"
+	"github.com/ceyewan/resonance/model"
+	"github.com/ceyewan/resonance/pkg/safehttp"
+	"github.com/ceyewan/resonance/repo"
+	"github.com/ceyewan/resonance/task/config"
+	"github.com/stretchr/testify/require"
+)
+
+type testWebhookRepo struct {
+	webhooks []*model.Webhook
+	created  []*model.WebhookDelivery
+	updated  []model.WebhookDelivery
+}
+
+func (r *testWebhookRepo) CreateWebhook(ctx context.Context, webhook *model.Webhook) error {
+	return nil
+}
+func (r *testWebhookRepo) GetWebhook(ctx context.Context, webhookID int64) (*model.Webhook, error) {
+	for _, webhook := range r.webhooks {
+		if webhook.WebhookID == webhookID {
+			return webhook, nil
+		}
+	}
+	return nil, fmt.Errorf("webhook %w: %d", repo.ErrNotFound, webhookID)
+}
+func (r *testWebhookRepo) ListWebhooks(ctx context.Context, sessionID string) ([]*model.Webhook, error) {
+	return r.webhooks, nil
+}
+func (r *testWebhookRepo) DeleteWebhook(ctx context.Context, webhookID int64) (bool, error) {
+	return false, nil
+}
+func (r *testWebhookRepo) CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	for _, delivery := range deliveries {
+		delivery.ID = int64(len(r.created) + 1)
+		r.created = append(r.created, delivery)
+	}
+	return nil
+}
+func (r *testWebhookRepo) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.WebhookDelivery, error) {
+	return nil, nil
+}
+func (r *testWebhookRepo) UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	r.updated = append(r.updated, *delivery)
+	return nil
+}
+func (r *testWebhookRepo) ListDeliveries(ctx context.Context, webhookID, beforeID int64, limit int) ([]*model.WebhookDelivery, error) {
+	return nil, nil
+}
+func (r *testWebhookRepo) Close() error { return nil }
+
+func TestDeliverer_Handle_SignsAndRecords(t *testing.T) {
+	var got *http.Request
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	repo := &testWebhookRepo{webhooks: []*model.Webhook{
+		{WebhookID: 1, SessionID: "s_1", URL: server.URL, Secret: "secret"},
+		{WebhookID: 2, SessionID: "s_1", URL: server.URL, Secret: "other", Events: model.WebhookEventMemberChanged},
+	}}
+	d := NewDeliverer(repo, &config.WebhookConfig{AllowPrivateNetwork: true}, clog.Discard())
+
+	err := d.Handle(context.Background(), &mqv1.PushEvent{MsgId: 42, SessionId: "s_1", FromUsername: "alice", Content: "hi", Type: "text", Timestamp: 100})
+	require.NoError(t, err)
+	require.Len(t, repo.created, 1, "只投递给订阅了该事件的 Webhook")
+	require.Len(t, repo.updated, 1)
+	require.Equal(t, model.WebhookDeliverySucceeded, repo.updated[0].Status)
+	require.Equal(t, http.StatusOK, repo.updated[0].ResponseCode)
+
+	require.NotNil(t, got)
+	require.Equal(t, model.WebhookEventMessageCreated, got.Header.Get(HeaderEvent))
+	require.Equal(t, "1", got.Header.Get(HeaderDelivery))
+	timestamp, err := strconv.ParseInt(got.Header.Get(HeaderTimestamp), 10, 64)
+	require.NoError(t, err)
+	require.Equal(t, "sha256="+Sign("secret", timestamp, gotBody), got.Header.Get(HeaderSignature))
+
+	var payload Payload
+	require.NoError(t, json.Unmarshal(gotBody, &payload))
+	require.Equal(t, "s_1", payload.SessionID)
+	require.Equal(t, int64(42), payload.Message.MsgID)
+	require.Nil(t, payload.Member)
+}
+
+func TestDeliverer_FailureBacksOffThenFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	repo := &testWebhookRepo{}
+	webhook := &model.Webhook{WebhookID: 1, URL: server.URL, Secret: "secret"}
+	d := NewDeliverer(repo, &config.WebhookConfig{MaxAttempts: 2, BaseBackoff: time.Minute, AllowPrivateNetwork: true}, clog.Discard())
+	delivery := &model.WebhookDelivery{ID: 1, WebhookID: 1, Payload: []byte(`{}`)}
+
+	d.attempt(context.Background(), webhook, delivery)
+	require.Equal(t, model.WebhookDeliveryPending, repo.updated[0].Status)
+	require.Equal(t, http.StatusInternalServerError, repo.updated[0].ResponseCode)
+	require.WithinDuration(t, time.Now().Add(time.Minute), repo.updated[0].NextRetryTime, 5*time.Second)
+
+	d.attempt(context.Background(), webhook, delivery)
+	require.Equal(t, model.WebhookDeliveryFailed, repo.updated[1].Status)
+	require.Equal(t, 2, repo.updated[1].Attempts)
+	require.NotEmpty(t, repo.updated[1].Error)
+}
+
+func TestDeliverer_DoesNotFollowRedirects(t *testing.T) {
+	var followed bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		followed = true
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusFound)
+	}))
+	defer server.Close()
+
+	d := NewDeliverer(&testWebhookRepo{}, &config.WebhookConfig{AllowPrivateNetwork: true}, clog.Discard())
+	code, err := d.send(context.Background(), &model.Webhook{WebhookID: 1, URL: server.URL}, &model.WebhookDelivery{ID: 1})
+	require.Error(t, err)
+	require.Equal(t, http.StatusFound, code)
+	require.False(t, followed)
+}
+
+func TestDeliverer_RejectsPrivateAddress(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	d := NewDeliverer(&testWebhookRepo{}, &config.WebhookConfig{}, clog.Discard())
+	// 主机名在连接时解析，解析到回环地址同样被拒绝
+	for _, url := range []string{server.URL, strings.Replace(server.URL, "127.0.0.1", "localhost", 1)} {
+		_, err := d.send(context.Background(), &model.Webhook{WebhookID: 1, URL: url}, &model.WebhookDelivery{ID: 1})
+		require.ErrorIs(t, err, safehttp.ErrForbiddenAddress)
+	}
+	require.False(t, called)
+
+	for _, addr := range []string{"10.0.0.1", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.64.0.1", "::1", "fd00::1", "::ffff:127.0.0.1"} {
+		require.False(t, safehttp.Allowed(netip.MustParseAddr(addr)), addr)
+	}
+	require.True(t, safehttp.Allowed(netip.MustParseAddr("93.184.216.34")))
+}
+
+func TestDeliverer_Backoff(t *testing.T) {
+	d := NewDeliverer(&testWebhookRepo{}, &config.WebhookConfig{BaseBackoff: time.Second, MaxBackoff: 5 * time.Second}, clog.Discard())
+	require.Equal(t, time.Second, d.backoff(1))
+	require.Equal(t, 2*time.Second, d.backoff(2))
+	require.Equal(t, 4*time.Second, d.backoff(3))
+	require.Equal(t, 5*time.Second, d.backoff(4))
+	require.Equal(t, 5*time.Second, d.backoff(30))
+}
@@ -6,7 +6,10 @@ import {
   CreateSessionRequest,
   SearchUserRequest,
   GetContactListRequest,
+  ListAnnouncementsRequest,
 } from "@/gen/gateway/v1/api_pb";
+import type { Announcement } from "@/gen/gateway/v1/packet_pb";
+import type { AnnouncementItem } from "@/stores/announcement";
 import { defaultApiBaseUrl, runtimeApiBaseUrl } from "@/config/runtime";
 
 const baseUrl = runtimeApiBaseUrl || import.meta.env.VITE_API_BASE_URL || defaultApiBaseUrl();
@@ -87,3 +90,25 @@ export async function getContactList(): Promise<
     avatarUrl: c.avatarUrl,
   }));
 }
+
+/**
+ * 转换系统公告
+ */
+export function convertAnnouncement(a: Announcement): AnnouncementItem {
+  return {
+    announcementId: a.announcementId.toString(),
+    title: a.title,
+    content: a.content,
+    fromUsername: a.fromUsername,
+    createdAt: Number(a.createdAt),
+  };
+}
+
+/**
+ * 补拉离线期间错过的系统公告（afterId 为本地已收到的最新公告 ID）
+ */
+export async function listAnnouncements(afterId: string): Promise<AnnouncementItem[]> {
+  const req = new ListAnnouncementsRequest({ afterId: BigInt(afterId) });
+  const resp = await sessionClient.listAnnouncements(req);
+  return resp.announcements.map(convertAnnouncement);
+}
@@ -0,0 +1,42 @@
+import { useAnnouncementStore } from "@/stores/announcement";
+
+interface AnnouncementBannerProps {
+  username?: string;
+}
+
+/**
+ * 系统公告横幅
+ * 按发布顺序逐条展示尚未关闭的公告
+ */
+export function AnnouncementBanner({ username }: AnnouncementBannerProps) {
+  const pending = useAnnouncementStore((state) => (username ? state.pending[username] : undefined));
+  const dismiss = useAnnouncementStore((state) => state.dismiss);
+
+  if (!username || !pending || pending.length === 0) {
+    return null;
+  }
+  const current = pending[0];
+
+  return (
+    <div className="lg-glass mb-3 flex shrink-0 items-start gap-3 rounded-2xl px-4 py-3">
+      <span className="mt-0.5 text-xs font-semibold text-sky-600 dark:text-sky-400">公告</span>
+      <div className="min-w-0 flex-1">
+        {current.title && (
+          <p className="text-sm font-semibold text-slate-900 dark:text-white">{current.title}</p>
+        )}
+        <p className="whitespace-pre-wrap break-words text-sm text-slate-700 dark:text-slate-200">
+          {current.content}
+        </p>
+      </div>
+      {pending.length > 1 && (
+        <span className="text-xs text-slate-500 dark:text-slate-400">+{pending.length - 1}</span>
+      )}
+      <button
+        onClick={() => dismiss(username, current.announcementId)}
+        className="rounded-xl px-2 py-1 text-xs text-slate-500 transition-all duration-200 hover:bg-white/45 hover:text-slate-900 dark:text-slate-300 dark:hover:bg-slate-700/55 dark:hover:text-white"
+      >
+        知道了
+      </button>
+    </div>
+  );
+}
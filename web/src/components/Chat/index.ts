@@ -1,3 +1,4 @@
 export { ChatHeader } from "./ChatHeader";
 export { ChatArea } from "./ChatArea";
 export { SessionSidebar } from "./SessionSidebar";
+export { AnnouncementBanner } from "./AnnouncementBanner";
@@ -149,7 +149,14 @@ export function MessageBubble({
       <div className={cn("flex max-w-[75%] flex-col", isOwn ? "items-end" : "items-start")}>
         {/* 发送者名称 */}
         {!isOwn && showAvatar && senderName && (
-          <span className="mb-1 text-xs text-slate-500 dark:text-slate-400">{senderName}</span>
+          <span className="mb-1 flex items-center gap-1 text-xs text-slate-500 dark:text-slate-400">
+            {senderName}
+            {message.fromBot && (
+              <span className="rounded bg-sky-100 px-1 text-[10px] font-medium text-sky-600 dark:bg-sky-900/40 dark:text-sky-300">
+                BOT
+              </span>
+            )}
+          </span>
         )}
 
         {/* 气泡 */}
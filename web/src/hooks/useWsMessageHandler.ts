@@ -1,6 +1,8 @@
 import { useCallback } from "react";
 import { useAuthStore } from "@/stores/auth";
 import { useMessageStore } from "@/stores/message";
+import { useAnnouncementStore } from "@/stores/announcement";
+import { convertAnnouncement } from "@/api/client";
 import { WsPacket } from "@/gen/gateway/v1/packet_pb";
 import { applyIncomingPush, shouldTriggerGapCompensation, syncInboxDelta } from "@/sync/inboxSync";
 
@@ -15,9 +17,10 @@ interface UseWsMessageHandlerOptions {
 /**
  * WebSocket 消息处理器 Hook
  *
- * 处理来自服务器的两种消息类型：
+ * 处理来自服务器的三种消息类型：
  * 1. push - 推送消息（新消息）
  * 2. ack - 消息确认（发送回执）
+ * 3. announcement - 系统公告（广播给全部在线用户）
  *
  * 职责：
  * - 解析 WebSocket 消息包
@@ -29,7 +32,8 @@ interface UseWsMessageHandlerOptions {
  */
 export function useWsMessageHandler({ getSend }: UseWsMessageHandlerOptions) {
   const { user } = useAuthStore();
-  const { markAsSent, markAsFailed } = useMessageStore();
+  const { markAsSent, markAsFailed, updateMessage } = useMessageStore();
+  const receiveAnnouncements = useAnnouncementStore((state) => state.receive);
 
   /**
    * 处理推送消息（新消息）
@@ -86,16 +90,32 @@ export function useWsMessageHandler({ getSend }: UseWsMessageHandlerOptions) {
       }
 
       if (ack.error) {
+        // 斜杠命令的结构化错误（unknown_command 等）同样标记为失败，由气泡展示重试入口
+        if (ack.errorCode) {
+          console.warn("[WsMessageHandler] Command failed:", ack.errorCode, ack.error);
+        }
         markAsFailed(ack.refSeq);
         return;
       }
 
+      // 斜杠命令的仅自己可见回复：原地替换待发送消息，不落库、不参与同步
+      if (ack.commandReply) {
+        updateMessage(ack.refSeq, {
+          fromUsername: ack.commandReply.fromUsername || "系统",
+          content: ack.commandReply.content,
+          msgType: "ephemeral",
+          isOwn: false,
+          status: "sent",
+        });
+        return;
+      }
+
       const seqId = typeof ack.seqId === "bigint" ? ack.seqId : BigInt(ack.seqId ?? 0);
       const msgId =
         typeof ack.msgId === "bigint" ? ack.msgId.toString() : String(ack.msgId ?? ack.refSeq);
       markAsSent(ack.refSeq, msgId, seqId);
     },
-    [markAsFailed, markAsSent],
+    [markAsFailed, markAsSent, updateMessage],
   );
 
   /**
@@ -110,9 +130,11 @@ export function useWsMessageHandler({ getSend }: UseWsMessageHandlerOptions) {
         handlePush(payload.value);
       } else if (payload.case === "ack") {
         handleAck(payload.value);
+      } else if (payload.case === "announcement" && user?.username) {
+        receiveAnnouncements(user.username, [convertAnnouncement(payload.value)]);
       }
     },
-    [handlePush, handleAck],
+    [handlePush, handleAck, receiveAnnouncements, user],
   );
 
   return { handleMessage };
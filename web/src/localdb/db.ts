@@ -38,6 +38,8 @@ export interface DBMessage {
   timestamp: string;
   isOwn: boolean;
   status: "sending" | "sent" | "failed";
+  expiresAt?: string; // Unix 秒，到期后本地删除
+  fromBot?: boolean;
 }
 
 class ResonanceDB extends Dexie {
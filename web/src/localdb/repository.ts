@@ -61,6 +61,8 @@ function toDBMessage(ownerUsername: string, message: ChatMessage): DBMessage {
     timestamp: message.timestamp.toString(),
     isOwn: message.isOwn,
     status: message.status,
+    expiresAt: message.expiresAt?.toString(),
+    fromBot: message.fromBot,
   };
 }
 
@@ -76,6 +78,8 @@ function fromDBMessage(item: DBMessage): ChatMessage {
     timestamp: BigInt(item.timestamp),
     isOwn: item.isOwn,
     status: item.status,
+    expiresAt: item.expiresAt ? BigInt(item.expiresAt) : undefined,
+    fromBot: item.fromBot,
   };
 }
 
@@ -109,6 +113,35 @@ export async function saveMessage(username: string, message: ChatMessage): Promi
   await db.messages.put(toDBMessage(username, message));
 }
 
+export async function deleteMessage(username: string, sessionId: string, seqId: bigint): Promise<void> {
+  await db.messages.delete(`${username}:${sessionId}:${seqId.toString()}`);
+}
+
+// 删除本人“仅自己删除”的消息：指定 msgId，以及 seqId <= clearedSeq 的消息（clearedSeq 为 0 表示不清空）
+export async function deleteHiddenMessages(
+  username: string,
+  sessionId: string,
+  msgIds: string[],
+  clearedSeq: bigint,
+): Promise<void> {
+  const hidden = new Set(msgIds);
+  await db.messages
+    .where("[ownerUsername+sessionId]")
+    .equals([username, sessionId])
+    .filter((row) => hidden.has(row.msgId) || (clearedSeq > 0n && BigInt(row.seqId) <= clearedSeq))
+    .delete();
+}
+
+// 删除本地已过期的消息（离线期间错过过期推送时兜底）
+export async function purgeExpiredMessages(username: string): Promise<void> {
+  const nowSeconds = BigInt(Math.floor(Date.now() / 1000));
+  await db.messages
+    .where("ownerUsername")
+    .equals(username)
+    .filter((row) => !!row.expiresAt && BigInt(row.expiresAt) <= nowSeconds)
+    .delete();
+}
+
 export async function loadAllMessagesGrouped(username: string): Promise<Record<string, ChatMessage[]>> {
   const rows = await db.messages.where("ownerUsername").equals(username).toArray();
   const grouped: Record<string, ChatMessage[]> = {};
@@ -174,6 +207,8 @@ export async function hydrateLocalSnapshot(username: string): Promise<{
   messagesBySession: Record<string, ChatMessage[]>;
   cursor: bigint;
 }> {
+  await purgeExpiredMessages(username);
+
   const [sessions, messagesBySession, cursor] = await Promise.all([
     loadSessions(username),
     loadAllMessagesGrouped(username),
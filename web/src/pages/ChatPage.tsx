@@ -1,8 +1,10 @@
 import { useCallback, useEffect } from "react";
 import { useSession } from "@/hooks/useSession";
 import { useAuthStore } from "@/stores/auth";
+import { useAnnouncementStore } from "@/stores/announcement";
+import { listAnnouncements } from "@/api/client";
 import { WsPacket } from "@/gen/gateway/v1/packet_pb";
-import { ChatHeader, ChatArea, SessionSidebar } from "@/components/Chat";
+import { AnnouncementBanner, ChatHeader, ChatArea, SessionSidebar } from "@/components/Chat";
 
 interface ChatPageProps {
   isConnected: boolean;
@@ -24,6 +26,20 @@ export default function ChatPage({ isConnected, isConnecting = false, send }: Ch
     loadSessions();
   }, [loadSessions]);
 
+  // 补拉离线期间错过的系统公告（在线期间的公告经 WebSocket 实时送达）
+  useEffect(() => {
+    const username = user?.username;
+    if (!username) {
+      return;
+    }
+    const { cursors, receive } = useAnnouncementStore.getState();
+    listAnnouncements(cursors[username] ?? "0")
+      .then((items) => receive(username, items))
+      .catch((err) => {
+        console.error("[ChatPage] Failed to list announcements:", err);
+      });
+  }, [user?.username]);
+
   // 处理会话选择
   const handleSelectSession = useCallback(
     (sessionId: string) => {
@@ -47,6 +63,9 @@ export default function ChatPage({ isConnected, isConnecting = false, send }: Ch
       {/* 顶部导航栏 */}
       <ChatHeader isConnected={isConnected} isConnecting={isConnecting} />
 
+      {/* 系统公告 */}
+      <AnnouncementBanner username={user?.username} />
+
       {/* 主内容区 */}
       <div className="flex min-h-0 flex-1 flex-col gap-3 overflow-hidden md:flex-row">
         {/* 左侧会话列表 */}
@@ -0,0 +1,64 @@
+import { create } from "zustand";
+import { persist } from "zustand/middleware";
+
+/**
+ * 系统公告
+ * 对应 protobuf resonance.gateway.v1.Announcement
+ */
+export interface AnnouncementItem {
+  announcementId: string; // int64 以字符串保存，便于持久化
+  title: string;
+  content: string;
+  fromUsername: string;
+  createdAt: number; // Unix 秒
+}
+
+interface AnnouncementState {
+  // 按用户保存：已收到的最新公告 ID（补拉游标）与尚未关闭的公告
+  cursors: Record<string, string>;
+  pending: Record<string, AnnouncementItem[]>;
+
+  // 收到公告（实时推送或登录补拉），按 announcementId 去重
+  receive: (username: string, items: AnnouncementItem[]) => void;
+  // 关闭公告
+  dismiss: (username: string, announcementId: string) => void;
+}
+
+export const useAnnouncementStore = create<AnnouncementState>()(
+  persist(
+    (set) => ({
+      cursors: {},
+      pending: {},
+
+      receive: (username, items) =>
+        set((state) => {
+          const cursor = BigInt(state.cursors[username] ?? "0");
+          const fresh = items.filter((item) => BigInt(item.announcementId) > cursor);
+          if (fresh.length === 0) {
+            return state;
+          }
+          const latest = fresh.reduce(
+            (max, item) => (BigInt(item.announcementId) > max ? BigInt(item.announcementId) : max),
+            cursor,
+          );
+          return {
+            cursors: { ...state.cursors, [username]: latest.toString() },
+            pending: { ...state.pending, [username]: [...(state.pending[username] ?? []), ...fresh] },
+          };
+        }),
+
+      dismiss: (username, announcementId) =>
+        set((state) => ({
+          pending: {
+            ...state.pending,
+            [username]: (state.pending[username] ?? []).filter(
+              (item) => item.announcementId !== announcementId,
+            ),
+          },
+        })),
+    }),
+    {
+      name: "resonance-announcement-storage",
+    },
+  ),
+);
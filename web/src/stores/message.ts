@@ -27,6 +27,10 @@ export interface ChatMessage {
   isOwn: boolean;
   // 发送状态
   status: "sending" | "sent" | "failed";
+  // 过期时间（Unix 秒），会话开启消息自动删除时存在
+  expiresAt?: bigint;
+  // 是否为机器人发送的消息
+  fromBot?: boolean;
 }
 
 interface MessageState {
@@ -270,6 +274,8 @@ export function pushMessageToChatMessage(
     timestamp: push.timestamp,
     isOwn: push.fromUsername === currentUsername,
     status: MESSAGE_STATUS.SENT,
+    expiresAt: push.expiresAt > 0n ? push.expiresAt : undefined,
+    fromBot: push.fromBot || undefined,
   };
 }
 
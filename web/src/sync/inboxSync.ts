@@ -1,8 +1,10 @@
 import { sessionClient } from "@/api/client";
-import type { PushMessage } from "@/gen/gateway/v1/packet_pb";
+import { MessageAction, type PushMessage } from "@/gen/gateway/v1/packet_pb";
 import { useMessageStore, pushMessageToChatMessage } from "@/stores/message";
 import { useSessionStore, type SessionInfo } from "@/stores/session";
 import {
+  deleteHiddenMessages,
+  deleteMessage,
   getInboxCursor,
   hydrateLocalSnapshot,
   saveMessage,
@@ -86,6 +88,24 @@ export async function applyIncomingPush(
   const sessionStore = useSessionStore.getState();
   const messageStore = useMessageStore.getState();
 
+  // 过期通知：服务端已删除消息，同步清理内存与本地缓存
+  if (push.action === MessageAction.EXPIRE) {
+    messageStore.removeMessage(push.msgId.toString());
+    await deleteMessage(currentUsername, push.sessionId, push.seqId);
+    return;
+  }
+
+  // 仅自己删除：来自本人其他设备的同步，删除指定消息及清空位点之前的消息
+  if (push.action === MessageAction.HIDE) {
+    const hidden = new Set(push.hiddenMsgIds.map((id) => id.toString()));
+    const remaining = messageStore
+      .getSessionMessages(push.sessionId)
+      .filter((msg) => !hidden.has(msg.msgId) && (push.clearedSeq === 0n || msg.seqId > push.clearedSeq));
+    messageStore.setMessages(push.sessionId, remaining);
+    await deleteHiddenMessages(currentUsername, push.sessionId, [...hidden], push.clearedSeq);
+    return;
+  }
+
   const chatMessage = pushMessageToChatMessage(push, currentUsername);
   messageStore.addMessage(chatMessage);
   await saveMessage(currentUsername, chatMessage);